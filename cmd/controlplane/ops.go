@@ -16,6 +16,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/admin"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/apikeylastusedtimeupdater"
+	"github.com/pipe-cd/pipecd/pkg/app/ops/auditlogcleaner"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/deploymentchaincontroller"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/firestoreindexensurer"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/handler"
@@ -35,6 +37,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/ops/orphancommandcleaner"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/pipedstatsbuilder"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/planpreviewoutputcleaner"
+	"github.com/pipe-cd/pipecd/pkg/app/ops/postgresensurer"
 	"github.com/pipe-cd/pipecd/pkg/app/ops/staledpipedstatcleaner"
 	"github.com/pipe-cd/pipecd/pkg/cache/rediscache"
 	"github.com/pipe-cd/pipecd/pkg/cli"
@@ -113,7 +116,7 @@ func (s *ops) run(ctx context.Context, input cli.Input) error {
 	}()
 
 	// Prepare sql database.
-	if cfg.Datastore.Type == model.DataStoreMySQL {
+	if cfg.Datastore.Type == model.DataStoreMySQL || cfg.Datastore.Type == model.DataStorePostgreSQL {
 		if err := ensureSQLDatabase(ctx, cfg, input.Logger); err != nil {
 			input.Logger.Error("failed to ensure prepare SQL database", zap.Error(err))
 			return err
@@ -171,6 +174,14 @@ func (s *ops) run(ctx context.Context, input cli.Input) error {
 		})
 	}
 
+	// Start running audit log cleaner.
+	{
+		cleaner := auditlogcleaner.NewCleaner(ds, fs, cfg.AuditLog, input.Logger)
+		group.Go(func() error {
+			return cleaner.Run(ctx)
+		})
+	}
+
 	// Start runnning apiKeyLastUsedTime updater.
 	{
 		updater := apikeylastusedtimeupdater.NewAPIKeyLastUsedTimeUpdater(ds, rd, input.Logger)
@@ -256,26 +267,20 @@ func (s *ops) run(ctx context.Context, input cli.Input) error {
 }
 
 func ensureSQLDatabase(ctx context.Context, cfg *config.ControlPlaneSpec, logger *zap.Logger) error {
-	mysqlEnsurer, err := mysqlensurer.NewMySQLEnsurer(
-		cfg.Datastore.MySQLConfig.URL,
-		cfg.Datastore.MySQLConfig.Database,
-		cfg.Datastore.MySQLConfig.UsernameFile,
-		cfg.Datastore.MySQLConfig.PasswordFile,
-		logger,
-	)
+	sqlEnsurer, err := createSQLEnsurer(cfg, logger)
 	if err != nil {
 		logger.Error("failed to create SQL ensurer instance", zap.Error(err))
 		return err
 	}
 	defer func() {
 		// Close connection held by the client.
-		if err := mysqlEnsurer.Close(); err != nil {
+		if err := sqlEnsurer.Close(); err != nil {
 			logger.Error("failed to close database ensurer connection", zap.Error(err))
 		}
 	}()
 
 	logger.Info("start running SQL schema and indexes ensurer")
-	if err = mysqlEnsurer.Run(ctx); err != nil {
+	if err = sqlEnsurer.Run(ctx); err != nil {
 		logger.Error("failed to ensure SQL schema and indexes", zap.Error(err))
 		return err
 	}
@@ -284,6 +289,34 @@ func ensureSQLDatabase(ctx context.Context, cfg *config.ControlPlaneSpec, logger
 	return nil
 }
 
+type sqlEnsurer interface {
+	Run(ctx context.Context) error
+	Close() error
+}
+
+func createSQLEnsurer(cfg *config.ControlPlaneSpec, logger *zap.Logger) (sqlEnsurer, error) {
+	switch cfg.Datastore.Type {
+	case model.DataStoreMySQL:
+		return mysqlensurer.NewMySQLEnsurer(
+			cfg.Datastore.MySQLConfig.URL,
+			cfg.Datastore.MySQLConfig.Database,
+			cfg.Datastore.MySQLConfig.UsernameFile,
+			cfg.Datastore.MySQLConfig.PasswordFile,
+			logger,
+		)
+	case model.DataStorePostgreSQL:
+		return postgresensurer.NewPostgreSQLEnsurer(
+			cfg.Datastore.PostgreSQLConfig.URL,
+			cfg.Datastore.PostgreSQLConfig.Database,
+			cfg.Datastore.PostgreSQLConfig.UsernameFile,
+			cfg.Datastore.PostgreSQLConfig.PasswordFile,
+			logger,
+		)
+	default:
+		return nil, fmt.Errorf("unsupported sql datastore type %q", cfg.Datastore.Type)
+	}
+}
+
 func registerOpsMetrics(col ...prometheus.Collector) *prometheus.Registry {
 	r := prometheus.NewRegistry()
 	wrapped := prometheus.WrapRegistererWith(map[string]string{
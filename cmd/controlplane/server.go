@@ -39,6 +39,8 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/server/httpapi"
 	"github.com/pipe-cd/pipecd/pkg/app/server/httpapi/httpapimetrics"
 	"github.com/pipe-cd/pipecd/pkg/app/server/pipedverifier"
+	"github.com/pipe-cd/pipecd/pkg/app/server/registrywebhook"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/webservice"
 	"github.com/pipe-cd/pipecd/pkg/app/server/stagelogstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/unregisteredappstore"
@@ -50,8 +52,11 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/datastore"
 	"github.com/pipe-cd/pipecd/pkg/datastore/firestore"
 	"github.com/pipe-cd/pipecd/pkg/datastore/mysql"
+	"github.com/pipe-cd/pipecd/pkg/datastore/postgres"
+	"github.com/pipe-cd/pipecd/pkg/datastore/sqlite"
 	"github.com/pipe-cd/pipecd/pkg/filestore"
 	"github.com/pipe-cd/pipecd/pkg/filestore/gcs"
+	"github.com/pipe-cd/pipecd/pkg/filestore/local"
 	"github.com/pipe-cd/pipecd/pkg/filestore/minio"
 	"github.com/pipe-cd/pipecd/pkg/filestore/s3"
 	"github.com/pipe-cd/pipecd/pkg/insight"
@@ -201,6 +206,7 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 		statCache            = rediscache.NewHashCache(rd, defaultPipedStatHashKey)
 		unregisteredAppStore = unregisteredappstore.NewStore(rd, input.Logger)
 		apiKeyLastUsedCache  = rediscache.NewHashCache(rd, apiKeyLastUsedCacheHashKey)
+		auditLogStore        = datastore.NewAuditLogStore(ds)
 	)
 
 	// Start a gRPC server for handling PipedAPI requests.
@@ -256,7 +262,8 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 				rpc.WithGracePeriod(s.gracePeriod),
 				rpc.WithLogger(input.Logger),
 				rpc.WithLogUnaryInterceptor(input.Logger),
-				rpc.WithAPIKeyAuthUnaryInterceptor(verifier, input.Logger),
+				rpc.WithAPIKeyAuthUnaryInterceptor(verifier, apiservice.NewAPIKeyAuthorizer(), input.Logger),
+				rpc.WithAuditLogUnaryInterceptor(auditLogStore, input.Logger),
 				rpc.WithRequestValidationUnaryInterceptor(),
 			}
 		)
@@ -307,6 +314,7 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			rpc.WithLogger(input.Logger),
 			rpc.WithLogUnaryInterceptor(input.Logger),
 			rpc.WithJWTAuthUnaryInterceptor(verifier, webservice.NewRBACAuthorizer(ctx, ds, cfg.ProjectMap(), input.Logger), input.Logger),
+			rpc.WithAuditLogUnaryInterceptor(auditLogStore, input.Logger),
 			rpc.WithRequestValidationUnaryInterceptor(),
 		}
 		if s.tls {
@@ -347,9 +355,15 @@ func (s *server) run(ctx context.Context, input cli.Input) error {
 			!s.insecureCookie,
 			input.Logger,
 		)
+		mux := http.NewServeMux()
+		mux.Handle("/", h)
+		mux.Handle(registrywebhook.Path, httpapimetrics.Handler(
+			registrywebhook.Path,
+			registrywebhook.NewHandler(datastore.NewProjectStore(ds), datastore.NewEventStore(ds), encryptDecrypter, input.Logger),
+		))
 		httpServer := &http.Server{
 			Addr:    fmt.Sprintf(":%d", s.httpPort),
-			Handler: h,
+			Handler: mux,
 		}
 
 		group.Go(func() error {
@@ -483,6 +497,19 @@ func createDatastore(ctx context.Context, cfg *config.ControlPlaneSpec, logger *
 			options = append(options, mysql.WithAuthenticationFile(mqConfig.UsernameFile, mqConfig.PasswordFile))
 		}
 		return mysql.NewMySQL(mqConfig.URL, mqConfig.Database, options...)
+
+	case model.DataStorePostgreSQL:
+		pgConfig := cfg.Datastore.PostgreSQLConfig
+		options := []postgres.Option{
+			postgres.WithLogger(logger),
+		}
+		if pgConfig.UsernameFile != "" || pgConfig.PasswordFile != "" {
+			options = append(options, postgres.WithAuthenticationFile(pgConfig.UsernameFile, pgConfig.PasswordFile))
+		}
+		return postgres.NewPostgreSQL(pgConfig.URL, pgConfig.Database, options...)
+
+	case model.DataStoreSQLite:
+		return sqlite.NewSQLite(ctx, cfg.Datastore.SQLiteConfig.DataSourceName, sqlite.WithLogger(logger))
 	default:
 		return nil, fmt.Errorf("unknown datastore type %q", cfg.Datastore.Type)
 	}
@@ -532,6 +559,13 @@ func createFilestore(ctx context.Context, cfg *config.ControlPlaneSpec, logger *
 		}
 		return s, nil
 
+	case model.FileStoreLocal:
+		localCfg := cfg.Filestore.LocalConfig
+		options := []local.Option{
+			local.WithLogger(logger),
+		}
+		return local.NewStore(localCfg.Dir, options...)
+
 	default:
 		return nil, fmt.Errorf("unknown filestore type %q", cfg.Filestore.Type)
 	}
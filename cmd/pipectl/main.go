@@ -26,6 +26,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/pipectl/cmd/planpreview"
 	"github.com/pipe-cd/pipecd/pkg/app/pipectl/cmd/plugin"
 	"github.com/pipe-cd/pipecd/pkg/app/pipectl/cmd/transfer"
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 )
 
@@ -35,15 +36,19 @@ func main() {
 		"The command line tool for PipeCD.",
 	)
 
+	// The output format is shared by all sub commands.
+	printOptions := &printer.Options{}
+	printOptions.RegisterFlags(app.PersistentFlags())
+
 	app.AddCommands(
-		application.NewCommand(),
-		deployment.NewCommand(),
-		event.NewCommand(),
-		planpreview.NewCommand(),
-		piped.NewCommand(),
-		encrypt.NewCommand(),
+		application.NewCommand(printOptions),
+		deployment.NewCommand(printOptions),
+		event.NewCommand(printOptions),
+		planpreview.NewCommand(printOptions),
+		piped.NewCommand(printOptions),
+		encrypt.NewCommand(printOptions),
 		migrate.NewCommand(),
-		plugin.NewCommand(),
+		plugin.NewCommand(printOptions),
 		transfer.NewCommand(),
 	)
 
@@ -29,6 +29,8 @@ import (
 
 var (
 	interval = 10 * time.Minute
+	// The API keys which have not been used for longer than this are flagged as stale.
+	staleThreshold = 90 * 24 * time.Hour
 )
 
 type apiKeyStore interface {
@@ -43,7 +45,10 @@ type apiKeyLastUsedTimeCache interface {
 type APIKeyLastUsedTimeUpdater struct {
 	apiKeyStore             apiKeyStore
 	apiKeyLastUsedTimeCache apiKeyLastUsedTimeCache
-	logger                  *zap.Logger
+	// The warning last logged for each flagged API key, keyed by the API key ID.
+	// It is used to warn only once about the same problem of a key.
+	flaggedAPIKeys map[string]string
+	logger         *zap.Logger
 }
 
 const apiKeyLastUsedCacheHashKey = "HASHKEY:PIPED:API_KEYS" //nolint:gosec
@@ -56,6 +61,7 @@ func NewAPIKeyLastUsedTimeUpdater(
 	return &APIKeyLastUsedTimeUpdater{
 		apiKeyStore:             datastore.NewAPIKeyStore(ds),
 		apiKeyLastUsedTimeCache: rediscache.NewHashCache(rd, apiKeyLastUsedCacheHashKey),
+		flaggedAPIKeys:          make(map[string]string),
 		logger:                  logger.Named("api-key-last-used-time-updater"),
 	}
 }
@@ -97,13 +103,17 @@ func (c *APIKeyLastUsedTimeUpdater) updateAPIKeyLastUsedTime(ctx context.Context
 		return err
 	}
 
+	now := time.Now()
+	listed := make(map[string]struct{}, len(apiKeys))
 	for _, apiKey := range apiKeys {
+		listed[apiKey.Id] = struct{}{}
 		cachedLastUse, err := c.apiKeyLastUsedTimeCache.Get(apiKey.Id)
 		if err != nil {
 			c.logger.Error("failed to fetch last used time from cache",
 				zap.String("id", apiKey.Id),
 				zap.Error(err),
 			)
+			c.flagUnhealthyAPIKey(apiKey, apiKey.LastUsedAt, now)
 			continue
 		}
 
@@ -114,8 +124,10 @@ func (c *APIKeyLastUsedTimeUpdater) updateAPIKeyLastUsedTime(ctx context.Context
 				zap.String("id", apiKey.Id),
 				zap.Error(err),
 			)
+			c.flagUnhealthyAPIKey(apiKey, apiKey.LastUsedAt, now)
 			continue
 		}
+		c.flagUnhealthyAPIKey(apiKey, lastUsedTime, now)
 
 		// Skip update last_used_at in database if no changed.
 		if lastUsedTime == apiKey.LastUsedAt {
@@ -130,5 +142,52 @@ func (c *APIKeyLastUsedTimeUpdater) updateAPIKeyLastUsedTime(ctx context.Context
 		}
 	}
 
+	// Forget the keys which were disabled or deleted since the last run.
+	for id := range c.flaggedAPIKeys {
+		if _, ok := listed[id]; !ok {
+			delete(c.flaggedAPIKeys, id)
+		}
+	}
+
 	return nil
 }
+
+// flagUnhealthyAPIKey warns about the given API key when it was already expired
+// or has not been used for a long time, so that the project admin can disable it.
+// The same warning is logged only once per key until the key becomes healthy again.
+func (c *APIKeyLastUsedTimeUpdater) flagUnhealthyAPIKey(apiKey *model.APIKey, lastUsedAt int64, now time.Time) {
+	var (
+		msg    string
+		fields = []zap.Field{
+			zap.String("id", apiKey.Id),
+			zap.String("project", apiKey.ProjectId),
+		}
+	)
+	switch {
+	case apiKey.IsExpired(now):
+		msg = "detected an expired API key that is still enabled"
+		fields = append(fields, zap.Int64("expires-at", apiKey.ExpiresAt))
+	case isStale(apiKey, lastUsedAt, now):
+		msg = "detected a stale API key that has not been used for a long time"
+		fields = append(fields, zap.Int64("last-used-at", lastUsedAt))
+	default:
+		delete(c.flaggedAPIKeys, apiKey.Id)
+		return
+	}
+
+	if c.flaggedAPIKeys[apiKey.Id] == msg {
+		return
+	}
+	c.flaggedAPIKeys[apiKey.Id] = msg
+	c.logger.Warn(msg, fields...)
+}
+
+// isStale checks whether the given API key has not been used for longer than staleThreshold.
+// The keys which have never been used are checked by their created time.
+func isStale(apiKey *model.APIKey, lastUsedAt int64, now time.Time) bool {
+	last := lastUsedAt
+	if last == 0 {
+		last = apiKey.CreatedAt
+	}
+	return now.Sub(time.Unix(last, 0)) > staleThreshold
+}
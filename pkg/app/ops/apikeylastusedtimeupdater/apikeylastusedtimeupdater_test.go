@@ -0,0 +1,142 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apikeylastusedtimeupdater
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/pipe-cd/pipecd/pkg/cache"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestIsStale(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	testcases := []struct {
+		name       string
+		apiKey     *model.APIKey
+		lastUsedAt int64
+		expected   bool
+	}{
+		{
+			name:       "recently used",
+			apiKey:     &model.APIKey{CreatedAt: now.Add(-365 * 24 * time.Hour).Unix()},
+			lastUsedAt: now.Add(-time.Hour).Unix(),
+			expected:   false,
+		},
+		{
+			name:       "not used for a long time",
+			apiKey:     &model.APIKey{CreatedAt: now.Add(-365 * 24 * time.Hour).Unix()},
+			lastUsedAt: now.Add(-100 * 24 * time.Hour).Unix(),
+			expected:   true,
+		},
+		{
+			name:     "never used but recently created",
+			apiKey:   &model.APIKey{CreatedAt: now.Add(-24 * time.Hour).Unix()},
+			expected: false,
+		},
+		{
+			name:     "never used since created long ago",
+			apiKey:   &model.APIKey{CreatedAt: now.Add(-100 * 24 * time.Hour).Unix()},
+			expected: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isStale(tc.apiKey, tc.lastUsedAt, now))
+		})
+	}
+}
+
+type fakeAPIKeyStore struct {
+	apiKeys []*model.APIKey
+}
+
+func (s *fakeAPIKeyStore) List(_ context.Context, _ datastore.ListOptions) ([]*model.APIKey, error) {
+	return s.apiKeys, nil
+}
+
+func (s *fakeAPIKeyStore) UpdateLastUsedAt(_ context.Context, id string, time int64) error {
+	for _, k := range s.apiKeys {
+		if k.Id == id {
+			k.LastUsedAt = time
+		}
+	}
+	return nil
+}
+
+type fakeAPIKeyLastUsedTimeCache map[string]int64
+
+func (c fakeAPIKeyLastUsedTimeCache) Get(k string) (interface{}, error) {
+	v, ok := c[k]
+	if !ok {
+		return nil, cache.ErrNotFound
+	}
+	return []byte(strconv.FormatInt(v, 10)), nil
+}
+
+func TestUpdateAPIKeyLastUsedTimeFlagsStaleKeys(t *testing.T) {
+	now := time.Now()
+	longAgo := now.Add(-100 * 24 * time.Hour).Unix()
+	store := &fakeAPIKeyStore{
+		apiKeys: []*model.APIKey{
+			{Id: "stale", ProjectId: "project", CreatedAt: longAgo, LastUsedAt: longAgo},
+			{Id: "healthy", ProjectId: "project", CreatedAt: longAgo, LastUsedAt: longAgo},
+			{Id: "expired", ProjectId: "project", CreatedAt: longAgo, ExpiresAt: now.Add(-time.Hour).Unix()},
+		},
+	}
+	lastUsedTimeCache := fakeAPIKeyLastUsedTimeCache{
+		"stale":   longAgo,
+		"healthy": now.Add(-time.Hour).Unix(),
+		"expired": now.Add(-time.Hour).Unix(),
+	}
+	core, logs := observer.New(zap.WarnLevel)
+	u := &APIKeyLastUsedTimeUpdater{
+		apiKeyStore:             store,
+		apiKeyLastUsedTimeCache: lastUsedTimeCache,
+		flaggedAPIKeys:          make(map[string]string),
+		logger:                  zap.New(core),
+	}
+
+	// Each unhealthy key is warned only once even though the updater runs repeatedly.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, u.updateAPIKeyLastUsedTime(context.Background()))
+	}
+	warned := make(map[string]string)
+	for _, e := range logs.TakeAll() {
+		warned[e.ContextMap()["id"].(string)] = e.Message
+	}
+	assert.Equal(t, map[string]string{
+		"stale":   "detected a stale API key that has not been used for a long time",
+		"expired": "detected an expired API key that is still enabled",
+	}, warned)
+
+	// The stale key is warned again after it was used and then left unused for a long time again.
+	lastUsedTimeCache["stale"] = now.Unix()
+	require.NoError(t, u.updateAPIKeyLastUsedTime(context.Background()))
+	assert.Zero(t, logs.Len())
+	lastUsedTimeCache["stale"] = longAgo
+	require.NoError(t, u.updateAPIKeyLastUsedTime(context.Background()))
+	require.Equal(t, 1, logs.Len())
+	assert.Equal(t, "stale", logs.All()[0].ContextMap()["id"])
+}
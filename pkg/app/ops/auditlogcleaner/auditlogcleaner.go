@@ -0,0 +1,185 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlogcleaner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	interval  = 6 * time.Hour
+	batchSize = 500
+	prefix    = "audit-log"
+)
+
+type auditLogStore interface {
+	List(ctx context.Context, opts datastore.ListOptions) ([]*model.AuditLog, string, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// Cleaner periodically deletes the audit logs which are older than the configured retention.
+// When the export is enabled, those logs are stored into the filestore before being deleted.
+type Cleaner struct {
+	auditLogStore auditLogStore
+	filestore     filestore.Putter
+	retention     time.Duration
+	export        bool
+	nowFunc       func() time.Time
+	logger        *zap.Logger
+}
+
+func NewCleaner(
+	ds datastore.DataStore,
+	fs filestore.Putter,
+	cfg config.ControlPlaneAuditLog,
+	logger *zap.Logger,
+) *Cleaner {
+	return &Cleaner{
+		auditLogStore: datastore.NewAuditLogStore(ds),
+		filestore:     fs,
+		retention:     cfg.RetentionDuration(),
+		export:        cfg.ExportToFilestore,
+		nowFunc:       time.Now,
+		logger:        logger.Named("audit-log-cleaner"),
+	}
+}
+
+func (c *Cleaner) Run(ctx context.Context) error {
+	c.logger.Info("start running audit log cleaner")
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.logger.Info("audit log cleaner has been stopped")
+			return nil
+
+		case <-t.C:
+			start := time.Now()
+			if err := c.clean(ctx); err == nil {
+				c.logger.Info("successfully cleaned expired audit logs", zap.Duration("duration", time.Since(start)))
+			}
+		}
+	}
+}
+
+func (c *Cleaner) clean(ctx context.Context) error {
+	cutoff := c.nowFunc().Add(-c.retention).Unix()
+	opts := datastore.ListOptions{
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "CreatedAt",
+				Operator: datastore.OperatorLessThan,
+				Value:    cutoff,
+			},
+		},
+		Orders: []datastore.Order{
+			{
+				Field:     "CreatedAt",
+				Direction: datastore.Asc,
+			},
+		},
+		Limit: batchSize,
+	}
+
+	deletes := 0
+	for {
+		logs, _, err := c.auditLogStore.List(ctx, opts)
+		if err != nil {
+			c.logger.Error("failed to list expired audit logs", zap.Error(err))
+			return err
+		}
+		if len(logs) == 0 {
+			break
+		}
+
+		if c.export {
+			if err := c.exportLogs(ctx, logs); err != nil {
+				return err
+			}
+		}
+
+		// Stop at the first failure since the same logs would be listed again in the next batch.
+		for _, l := range logs {
+			if err := c.auditLogStore.Delete(ctx, l.Id); err != nil && !errors.Is(err, datastore.ErrNotFound) {
+				c.logger.Error("failed to delete expired audit log",
+					zap.String("id", l.Id),
+					zap.Error(err),
+				)
+				return err
+			}
+			deletes++
+		}
+
+		if len(logs) < batchSize {
+			break
+		}
+	}
+
+	c.logger.Info(fmt.Sprintf("deleted %d expired audit logs", deletes))
+	return nil
+}
+
+// exportLogs stores the given logs into the filestore as one JSON file per project.
+// The logs must be sorted by their creation time.
+func (c *Cleaner) exportLogs(ctx context.Context, logs []*model.AuditLog) error {
+	var (
+		projects []string
+		groups   = make(map[string][]*model.AuditLog)
+	)
+	for _, l := range logs {
+		if _, ok := groups[l.ProjectId]; !ok {
+			projects = append(projects, l.ProjectId)
+		}
+		groups[l.ProjectId] = append(groups[l.ProjectId], l)
+	}
+
+	for _, projectID := range projects {
+		group := groups[projectID]
+		data, err := json.Marshal(group)
+		if err != nil {
+			c.logger.Error("failed to marshal audit logs", zap.String("project-id", projectID), zap.Error(err))
+			return err
+		}
+		path := exportPath(projectID, group[0], group[len(group)-1])
+		if err := c.filestore.Put(ctx, path, data); err != nil {
+			c.logger.Error("failed to export audit logs",
+				zap.String("path", path),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+	return nil
+}
+
+// exportPath returns the filestore path for the logs between the given ones.
+// The ID of the first log is included to avoid conflicting with the other batches created in the same seconds.
+func exportPath(projectID string, first, last *model.AuditLog) string {
+	return fmt.Sprintf("%s/%s/%d-%d-%s.json", prefix, projectID, first.CreatedAt, last.CreatedAt, first.Id)
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlogcleaner
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeAuditLogStore struct {
+	logs []*model.AuditLog
+}
+
+func (s *fakeAuditLogStore) List(_ context.Context, opts datastore.ListOptions) ([]*model.AuditLog, string, error) {
+	cutoff := opts.Filters[0].Value.(int64)
+	var logs []*model.AuditLog
+	for _, l := range s.logs {
+		if l.CreatedAt < cutoff {
+			logs = append(logs, l)
+		}
+	}
+	return logs, "", nil
+}
+
+func (s *fakeAuditLogStore) Delete(_ context.Context, id string) error {
+	for i, l := range s.logs {
+		if l.Id == id {
+			s.logs = append(s.logs[:i], s.logs[i+1:]...)
+			return nil
+		}
+	}
+	return datastore.ErrNotFound
+}
+
+type fakeFilestore struct {
+	objects map[string][]byte
+}
+
+func (s *fakeFilestore) Put(_ context.Context, path string, content []byte) error {
+	s.objects[path] = content
+	return nil
+}
+
+func TestClean(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	newStore := func() *fakeAuditLogStore {
+		return &fakeAuditLogStore{
+			logs: []*model.AuditLog{
+				{Id: "log-1", ProjectId: "project-1", CreatedAt: now.Add(-48 * time.Hour).Unix()},
+				{Id: "log-2", ProjectId: "project-2", CreatedAt: now.Add(-36 * time.Hour).Unix()},
+				{Id: "log-3", ProjectId: "project-1", CreatedAt: now.Add(-30 * time.Hour).Unix()},
+				{Id: "log-4", ProjectId: "project-1", CreatedAt: now.Add(-time.Hour).Unix()},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name         string
+		export       bool
+		expectedLogs []string
+		expectedObjs map[string][]string
+	}{
+		{
+			name:         "delete without exporting",
+			expectedLogs: []string{"log-4"},
+			expectedObjs: map[string][]string{},
+		},
+		{
+			name:         "export and delete",
+			export:       true,
+			expectedLogs: []string{"log-4"},
+			expectedObjs: map[string][]string{
+				"audit-log/project-1/1699827200-1699892000-log-1.json": {"log-1", "log-3"},
+				"audit-log/project-2/1699870400-1699870400-log-2.json": {"log-2"},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newStore()
+			fs := &fakeFilestore{objects: make(map[string][]byte)}
+			c := &Cleaner{
+				auditLogStore: store,
+				filestore:     fs,
+				retention:     24 * time.Hour,
+				export:        tc.export,
+				nowFunc:       func() time.Time { return now },
+				logger:        zap.NewNop(),
+			}
+			require.NoError(t, c.clean(context.Background()))
+
+			logs := make([]string, 0, len(store.logs))
+			for _, l := range store.logs {
+				logs = append(logs, l.Id)
+			}
+			assert.Equal(t, tc.expectedLogs, logs)
+
+			objs := make(map[string][]string, len(fs.objects))
+			for path, data := range fs.objects {
+				var exported []*model.AuditLog
+				require.NoError(t, json.Unmarshal(data, &exported))
+				for _, l := range exported {
+					objs[path] = append(objs[path], l.Id)
+				}
+			}
+			assert.Equal(t, tc.expectedObjs, objs)
+		})
+	}
+}
@@ -42,9 +42,11 @@ type deploymentStore interface {
 }
 
 type deploymentChainStore interface {
+	Get(ctx context.Context, id string) (*model.DeploymentChain, error)
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.DeploymentChain, string, error)
 	AddNodeDeployment(ctx context.Context, chainID string, deployment *model.Deployment) error
 	UpdateNodeDeploymentStatus(ctx context.Context, chainID string, blockIndex uint32, deploymentID string, status model.DeploymentStatus, reason string) error
+	UpdateBlockGateStatus(ctx context.Context, chainID string, blockIndex uint32, status model.ChainGateStatus, reason string) error
 }
 
 type DeploymentChainController struct {
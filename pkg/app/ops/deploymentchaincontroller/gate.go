@@ -0,0 +1,82 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploymentchaincontroller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// evaluateGates evaluates the gates of the blocks whose previous block has passed
+// and stores the results to the deployment chain.
+func (u *updater) evaluateGates(ctx context.Context) error {
+	dc, err := u.deploymentChainStore.Get(ctx, u.deploymentChainID)
+	if err != nil {
+		u.logger.Error("failed while evaluate gates of deployment chain: can not get deployment chain",
+			zap.String("deploymentChainId", u.deploymentChainID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// The first block never has a gate since it's the block which triggers the chain.
+	for i := 1; i < len(dc.Blocks); i++ {
+		gate := dc.Blocks[i].Gate
+		if gate == nil || gate.IsCompleted() {
+			continue
+		}
+		previousBlock := dc.Blocks[i-1]
+		if !previousBlock.IsPassed() {
+			continue
+		}
+
+		status, reason := u.evaluateGate(gate, time.Unix(previousBlock.CompletedAt, 0))
+		if status == gate.Status && reason == gate.StatusReason {
+			continue
+		}
+		if err := u.deploymentChainStore.UpdateBlockGateStatus(ctx, u.deploymentChainID, uint32(i), status, reason); err != nil {
+			u.logger.Error("failed to update state of gate in chain",
+				zap.String("deploymentChainId", u.deploymentChainID),
+				zap.Int("blockIndex", i),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+	return nil
+}
+
+// evaluateGate checks the conditions of the given gate in order of wait duration, analysis and approval.
+// It returns the status of the gate and the reason of that status.
+// The analysis is run by piped, so this only waits for its result to be reported.
+// A failed analysis makes the gate FAILED when it is reported.
+func (u *updater) evaluateGate(gate *model.ChainBlockGate, previousBlockCompletedAt time.Time) (model.ChainGateStatus, string) {
+	now := u.nowFunc()
+	if until := gate.WaitUntil(previousBlockCompletedAt); now.Before(until) {
+		return model.ChainGateStatus_CHAIN_GATE_RUNNING, fmt.Sprintf("Waiting until %s", until.Format(time.RFC3339))
+	}
+	if gate.IsWaitingForAnalysis(previousBlockCompletedAt, now) {
+		return model.ChainGateStatus_CHAIN_GATE_RUNNING, fmt.Sprintf("Waiting for the result of analysis %s", gate.AnalysisTemplate)
+	}
+	if gate.RequireApproval && gate.Approver == "" {
+		return model.ChainGateStatus_CHAIN_GATE_RUNNING, "Waiting for approval"
+	}
+	return model.ChainGateStatus_CHAIN_GATE_PASSED, ""
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploymentchaincontroller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestEvaluateGate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now                 = time.Date(2025, 10, 6, 9, 0, 0, 0, time.UTC)
+		previousCompletedAt = now.Add(-10 * time.Minute)
+	)
+
+	testcases := []struct {
+		name           string
+		gate           *model.ChainBlockGate
+		expectedStatus model.ChainGateStatus
+		expectedReason string
+	}{
+		{
+			name: "waiting",
+			gate: &model.ChainBlockGate{
+				WaitSeconds: 30 * 60,
+			},
+			expectedStatus: model.ChainGateStatus_CHAIN_GATE_RUNNING,
+			expectedReason: "Waiting until 2025-10-06T09:20:00Z",
+		},
+		{
+			name: "wait duration elapsed",
+			gate: &model.ChainBlockGate{
+				WaitSeconds: 5 * 60,
+			},
+			expectedStatus: model.ChainGateStatus_CHAIN_GATE_PASSED,
+		},
+		{
+			name: "waiting for analysis result",
+			gate: &model.ChainBlockGate{
+				AnalysisTemplate: "smoke-test",
+				AnalysisConfig:   []byte(`{"duration":"10m"}`),
+			},
+			expectedStatus: model.ChainGateStatus_CHAIN_GATE_RUNNING,
+			expectedReason: "Waiting for the result of analysis smoke-test",
+		},
+		{
+			name: "waiting for approval after analysis passed",
+			gate: &model.ChainBlockGate{
+				AnalysisTemplate: "smoke-test",
+				AnalysisConfig:   []byte(`{"duration":"10m"}`),
+				AnalysisPassed:   true,
+				RequireApproval:  true,
+			},
+			expectedStatus: model.ChainGateStatus_CHAIN_GATE_RUNNING,
+			expectedReason: "Waiting for approval",
+		},
+		{
+			name: "approved",
+			gate: &model.ChainBlockGate{
+				AnalysisTemplate: "smoke-test",
+				AnalysisConfig:   []byte(`{"duration":"10m"}`),
+				AnalysisPassed:   true,
+				RequireApproval:  true,
+				Approver:         "key-1",
+			},
+			expectedStatus: model.ChainGateStatus_CHAIN_GATE_PASSED,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			u := &updater{
+				logger:  zap.NewNop(),
+				nowFunc: func() time.Time { return now },
+			}
+			status, reason := u.evaluateGate(tc.gate, previousCompletedAt)
+			assert.Equal(t, tc.expectedStatus, status)
+			assert.Equal(t, tc.expectedReason, reason)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 // updater watches for a specified deployment model object
 // and updates the state of that object based on states of
 // its deployments.
+// The gates of the blocks are evaluated by this updater too.
 type updater struct {
 	deploymentChainID string
 	// applicationRefs contains list of all applications of
@@ -36,6 +37,8 @@ type updater struct {
 	// value is the last state of deployment ref to the deployment
 	// of that in chain application.
 	deploymentRefs map[string]*model.ChainDeploymentRef
+	// hasGates is true if any block of the chain has a gate.
+	hasGates bool
 
 	deploymentStore      deploymentStore
 	deploymentChainStore deploymentChainStore
@@ -57,6 +60,7 @@ func newUpdater(
 		deploymentChainID:    dc.Id,
 		applicationRefs:      dc.ListAllInChainApplications(),
 		deploymentRefs:       dc.ListAllInChainApplicationDeploymentsMap(),
+		hasGates:             dc.HasGates(),
 		deploymentStore:      ds,
 		deploymentChainStore: dcs,
 		logger:               lg,
@@ -153,6 +157,14 @@ func (u *updater) Run(ctx context.Context) error {
 		}
 	}
 
+	// Evaluate the gates of the blocks to decide whether
+	// their deployments can be planned or not.
+	if u.hasGates {
+		if err := u.evaluateGates(ctx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
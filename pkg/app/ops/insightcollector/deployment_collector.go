@@ -87,12 +87,18 @@ func (c *completedDeploymentDataCollector) Execute(ctx context.Context) {
 		zap.Duration("duration", time.Since(now)),
 	)
 
+	openFailures := make(map[string]int64, len(m.OpenFailures))
+	for app, failedAt := range m.OpenFailures {
+		openFailures[app] = failedAt
+	}
+
 	deploysByProject := make(map[string][]*insight.DeploymentData)
 	for _, d := range ds {
 		var (
 			project = d.ProjectId
 			data    = insight.BuildDeploymentData(d)
 		)
+		trackRecovery(&data, d.Status, openFailures)
 		deploysByProject[project] = append(deploysByProject[project], &data)
 	}
 
@@ -116,6 +122,7 @@ func (c *completedDeploymentDataCollector) Execute(ctx context.Context) {
 	)
 
 	m.DeploymentCompletedAtMilestone = dataRangeTo
+	m.OpenFailures = openFailures
 	if err := c.store.PutMilestone(ctx, m); err != nil {
 		c.logger.Error("failed to store milestone", zap.Error(err))
 		return
@@ -124,6 +131,24 @@ func (c *completedDeploymentDataCollector) Execute(ctx context.Context) {
 	c.logger.Info("successfully stored a new milestone", zap.Int64("milestone", m.DeploymentCompletedAtMilestone))
 }
 
+// trackRecovery updates the given open failures of applications by the given deployment.
+// The deployments must be passed in the order of their completed time.
+// When the deployment recovers its application from failures, the time of the first failure
+// is recorded into the deployment data to be able to calculate the time to restore.
+func trackRecovery(data *insight.DeploymentData, status model.DeploymentStatus, openFailures map[string]int64) {
+	switch status {
+	case model.DeploymentStatus_DEPLOYMENT_FAILURE:
+		if _, ok := openFailures[data.AppID]; !ok {
+			openFailures[data.AppID] = data.CompletedAt
+		}
+	case model.DeploymentStatus_DEPLOYMENT_SUCCESS:
+		if failedAt, ok := openFailures[data.AppID]; ok {
+			data.FailureStartedAt = failedAt
+			delete(openFailures, data.AppID)
+		}
+	}
+}
+
 func (c *completedDeploymentDataCollector) listCompletedDeployments(ctx context.Context, from, to int64) ([]*model.Deployment, error) {
 	const callLimit = 50
 
@@ -0,0 +1,93 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package insightcollector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/insight"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestTrackRecovery(t *testing.T) {
+	type deployment struct {
+		appID       string
+		completedAt int64
+		status      model.DeploymentStatus
+	}
+	testcases := []struct {
+		name                 string
+		openFailures         map[string]int64
+		deployments          []deployment
+		expectedFailureStart []int64
+		expectedOpenFailures map[string]int64
+	}{
+		{
+			name: "no failure",
+			deployments: []deployment{
+				{appID: "app-1", completedAt: 100, status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+				{appID: "app-1", completedAt: 200, status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+			},
+			expectedFailureStart: []int64{0, 0},
+			expectedOpenFailures: map[string]int64{},
+		},
+		{
+			name: "recovered from consecutive failures",
+			deployments: []deployment{
+				{appID: "app-1", completedAt: 100, status: model.DeploymentStatus_DEPLOYMENT_FAILURE},
+				{appID: "app-2", completedAt: 150, status: model.DeploymentStatus_DEPLOYMENT_FAILURE},
+				{appID: "app-1", completedAt: 200, status: model.DeploymentStatus_DEPLOYMENT_FAILURE},
+				{appID: "app-1", completedAt: 250, status: model.DeploymentStatus_DEPLOYMENT_CANCELLED},
+				{appID: "app-1", completedAt: 300, status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+				{appID: "app-1", completedAt: 400, status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+			},
+			expectedFailureStart: []int64{0, 0, 0, 0, 100, 0},
+			expectedOpenFailures: map[string]int64{"app-2": 150},
+		},
+		{
+			name:         "recovered from failure collected in the previous run",
+			openFailures: map[string]int64{"app-1": 50},
+			deployments: []deployment{
+				{appID: "app-1", completedAt: 100, status: model.DeploymentStatus_DEPLOYMENT_FAILURE},
+				{appID: "app-1", completedAt: 200, status: model.DeploymentStatus_DEPLOYMENT_SUCCESS},
+			},
+			expectedFailureStart: []int64{0, 50},
+			expectedOpenFailures: map[string]int64{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			openFailures := make(map[string]int64)
+			for k, v := range tc.openFailures {
+				openFailures[k] = v
+			}
+
+			got := make([]int64, 0, len(tc.deployments))
+			for _, d := range tc.deployments {
+				data := insight.DeploymentData{
+					AppID:       d.appID,
+					CompletedAt: d.completedAt,
+				}
+				trackRecovery(&data, d.status, openFailures)
+				got = append(got, data.FailureStartedAt)
+			}
+			assert.Equal(t, tc.expectedFailureStart, got)
+			assert.Equal(t, tc.expectedOpenFailures, openFailures)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresensurer
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore/postgres/ensurer"
+)
+
+type postgresEnsurer struct {
+	exec ensurer.SQLEnsurer
+}
+
+func NewPostgreSQLEnsurer(url, database, usernameFile, passwordFile string, logger *zap.Logger) (SQLEnsurer, error) {
+	executor, err := ensurer.NewPostgreSQLEnsurer(url, database, usernameFile, passwordFile, logger.Named("postgres-ensurer"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create postgresql ensurer executor: %w", err)
+	}
+
+	return &postgresEnsurer{
+		exec: executor,
+	}, nil
+}
+
+func (m *postgresEnsurer) Run(ctx context.Context) error {
+	err := m.exec.EnsureSchema(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to prepare sql database: %w", err)
+	}
+
+	// The indexes are created while the control plane is starting up,
+	// so it is fine to block writes to the tables during the creation.
+	err = m.exec.EnsureIndexes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create required indexes on sql database: %w", err)
+	}
+
+	return nil
+}
+
+func (m *postgresEnsurer) Close() error {
+	return m.exec.Close()
+}
@@ -33,6 +33,7 @@ func SyncApplication(
 	ctx context.Context,
 	cli apiservice.Client,
 	appID string,
+	ignoreFreezeWindows bool,
 	checkInterval, timeout time.Duration,
 	logger *zap.Logger,
 ) (string, error) {
@@ -40,7 +41,8 @@ func SyncApplication(
 	defer cancel()
 
 	req := &apiservice.SyncApplicationRequest{
-		ApplicationId: appID,
+		ApplicationId:       appID,
+		IgnoreFreezeWindows: ignoreFreezeWindows,
 	}
 	resp, err := cli.SyncApplication(ctx, req)
 	if err != nil {
@@ -75,6 +77,10 @@ func SyncApplication(
 			return
 
 		case model.CommandStatus_COMMAND_FAILED:
+			if reason, ok := cmd.Metadata[model.MetadataKeyCommandFailureReason]; ok {
+				err = fmt.Errorf("the request was unable to handle: %s", reason)
+				return
+			}
 			logger.Error("The request was unable to handle")
 			return
 
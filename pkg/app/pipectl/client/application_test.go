@@ -26,6 +26,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestSyncApplicationRetriesUnavailableCommandUntilTimeout(t *testing.T) {
@@ -48,6 +49,7 @@ func TestSyncApplicationRetriesUnavailableCommandUntilTimeout(t *testing.T) {
 		context.Background(),
 		cli,
 		"app-id",
+		false,
 		time.Millisecond,
 		50*time.Millisecond,
 		zap.NewNop(),
@@ -74,6 +76,7 @@ func TestSyncApplicationReturnsNotFoundForMissingCommand(t *testing.T) {
 		context.Background(),
 		cli,
 		"app-id",
+		false,
 		time.Nanosecond,
 		time.Hour,
 		zap.NewNop(),
@@ -82,3 +85,42 @@ func TestSyncApplicationReturnsNotFoundForMissingCommand(t *testing.T) {
 	require.Equal(t, codes.NotFound, status.Code(err))
 	require.Contains(t, err.Error(), commandID)
 }
+
+func TestSyncApplicationReturnsFailureReason(t *testing.T) {
+	const commandID = "frozen-command"
+
+	var req *apiservice.SyncApplicationRequest
+	cli := &fakeAPIClient{
+		syncApplication: func(_ context.Context, in *apiservice.SyncApplicationRequest, _ ...grpc.CallOption) (*apiservice.SyncApplicationResponse, error) {
+			req = in
+			return &apiservice.SyncApplicationResponse{
+				CommandId: commandID,
+			}, nil
+		},
+		getCommand: func(context.Context, *apiservice.GetCommandRequest, ...grpc.CallOption) (*apiservice.GetCommandResponse, error) {
+			return &apiservice.GetCommandResponse{
+				Command: &model.Command{
+					Id:     commandID,
+					Type:   model.Command_SYNC_APPLICATION,
+					Status: model.CommandStatus_COMMAND_FAILED,
+					Metadata: map[string]string{
+						model.MetadataKeyCommandFailureReason: "Deployments are frozen",
+					},
+				},
+			}, nil
+		},
+	}
+
+	_, err := SyncApplication(
+		context.Background(),
+		cli,
+		"app-id",
+		true,
+		time.Nanosecond,
+		time.Hour,
+		zap.NewNop(),
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Deployments are frozen")
+	require.True(t, req.IgnoreFreezeWindows)
+}
@@ -17,10 +17,13 @@ package application
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -39,12 +42,15 @@ type add struct {
 	repoID         string
 	appDir         string
 	configFileName string
+
+	stdout io.Writer
 }
 
 func newAddCommand(root *command) *cobra.Command {
 	c := &add{
 		root:           root,
 		configFileName: model.DefaultApplicationConfigFilename,
+		stdout:         os.Stdout,
 	}
 	cmd := &cobra.Command{
 		Use:   "add",
@@ -73,13 +79,22 @@ func newAddCommand(root *command) *cobra.Command {
 	return cmd
 }
 
-func (c *add) run(ctx context.Context, input cli.Input) error {
+func (c *add) run(ctx context.Context, _ cli.Input) error {
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
 	cli, err := c.root.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
 	}
 	defer cli.Close()
 
+	return c.addApplication(ctx, cli, p)
+}
+
+func (c *add) addApplication(ctx context.Context, cli apiservice.Client, p *printer.Printer) error {
 	appKind, ok := model.ApplicationKind_value[c.appKind]
 	if !ok {
 		return fmt.Errorf("unsupported application kind %s", c.appKind)
@@ -117,6 +132,8 @@ func (c *add) run(ctx context.Context, input cli.Input) error {
 		return fmt.Errorf("failed to add application: %w", err)
 	}
 
-	input.Logger.Info(fmt.Sprintf("Successfully added application id = %s", resp.ApplicationId))
+	if err := p.Print(resp, printer.Text(fmt.Sprintf("Successfully added application id = %s", resp.ApplicationId))); err != nil {
+		return fmt.Errorf("failed to print the result: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,83 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestAddPrintsApplicationID(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		output   string
+		expected string
+	}{
+		{
+			name:     "table",
+			expected: "Successfully added application id = app-id\n",
+		},
+		{
+			name:     "json",
+			output:   "json",
+			expected: "{\n  \"application_id\": \"app-id\"\n}\n",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *apiservice.AddApplicationRequest
+			cli := &fakeAPIClient{
+				addApplication: func(_ context.Context, req *apiservice.AddApplicationRequest, _ ...grpc.CallOption) (*apiservice.AddApplicationResponse, error) {
+					got = req
+					return &apiservice.AddApplicationResponse{ApplicationId: "app-id"}, nil
+				},
+			}
+
+			var out bytes.Buffer
+			p, err := (&printer.Options{Output: tc.output}).NewPrinter(&out, printer.FormatTable)
+			require.NoError(t, err)
+
+			c := &add{
+				appName:          "app",
+				appKind:          "KUBERNETES",
+				labels:           "env=dev",
+				pipedID:          "piped-id",
+				platformProvider: "kubernetes",
+				repoID:           "repo-id",
+				appDir:           "app",
+				configFileName:   model.DefaultApplicationConfigFilename,
+			}
+			require.NoError(t, c.addApplication(context.Background(), cli, p))
+
+			assert.Equal(t, tc.expected, out.String())
+			assert.Equal(t, "app", got.Name)
+			assert.Equal(t, model.ApplicationKind_KUBERNETES, got.Kind)
+			assert.Equal(t, map[string]string{"env": "dev"}, got.Labels)
+		})
+	}
+}
@@ -17,9 +17,12 @@ package application
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 )
@@ -28,11 +31,14 @@ type delete struct {
 	root *command
 
 	appID string
+
+	stdout io.Writer
 }
 
 func newDeleteCommand(root *command) *cobra.Command {
 	c := &delete{
-		root: root,
+		root:   root,
+		stdout: os.Stdout,
 	}
 	cmd := &cobra.Command{
 		Use:   "delete",
@@ -46,7 +52,12 @@ func newDeleteCommand(root *command) *cobra.Command {
 	return cmd
 }
 
-func (c *delete) run(ctx context.Context, input cli.Input) error {
+func (c *delete) run(ctx context.Context, _ cli.Input) error {
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
 	cli, err := c.root.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
@@ -62,6 +73,8 @@ func (c *delete) run(ctx context.Context, input cli.Input) error {
 		return fmt.Errorf("failed to delete application: %w", err)
 	}
 
-	input.Logger.Info(fmt.Sprintf("Successfully deleted application id = %s", resp.ApplicationId))
+	if err := p.Print(resp, printer.Text(fmt.Sprintf("Successfully deleted application id = %s", resp.ApplicationId))); err != nil {
+		return fmt.Errorf("failed to print the result: %w", err)
+	}
 	return nil
 }
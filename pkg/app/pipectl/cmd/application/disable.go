@@ -17,9 +17,12 @@ package application
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 )
@@ -28,11 +31,14 @@ type disable struct {
 	root *command
 
 	appID string
+
+	stdout io.Writer
 }
 
 func newDisableCommand(root *command) *cobra.Command {
 	c := &disable{
-		root: root,
+		root:   root,
+		stdout: os.Stdout,
 	}
 	cmd := &cobra.Command{
 		Use:   "disable",
@@ -46,7 +52,12 @@ func newDisableCommand(root *command) *cobra.Command {
 	return cmd
 }
 
-func (c *disable) run(ctx context.Context, input cli.Input) error {
+func (c *disable) run(ctx context.Context, _ cli.Input) error {
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
 	cli, err := c.root.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
@@ -62,6 +73,8 @@ func (c *disable) run(ctx context.Context, input cli.Input) error {
 		return fmt.Errorf("failed to disable application: %w", err)
 	}
 
-	input.Logger.Info(fmt.Sprintf("Successfully disable application id = %s", resp.ApplicationId))
+	if err := p.Print(resp, printer.Text(fmt.Sprintf("Successfully disabled application id = %s", resp.ApplicationId))); err != nil {
+		return fmt.Errorf("failed to print the result: %w", err)
+	}
 	return nil
 }
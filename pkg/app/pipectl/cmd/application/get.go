@@ -16,13 +16,13 @@ package application
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 )
@@ -52,6 +52,11 @@ func newGetCommand(root *command) *cobra.Command {
 }
 
 func (c *get) run(ctx context.Context, _ cli.Input) error {
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatJSON)
+	if err != nil {
+		return err
+	}
+
 	cli, err := c.root.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
@@ -67,11 +72,8 @@ func (c *get) run(ctx context.Context, _ cli.Input) error {
 		return fmt.Errorf("failed to get application: %w", err)
 	}
 
-	bytes, err := json.Marshal(resp.Application)
-	if err != nil {
-		return fmt.Errorf("failed to marshal application: %w", err)
+	if err := p.Print(resp.Application, applicationRows(resp.Application)); err != nil {
+		return fmt.Errorf("failed to print application: %w", err)
 	}
-
-	fmt.Fprintln(c.stdout, string(bytes))
 	return nil
 }
@@ -16,7 +16,6 @@ package application
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -24,6 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -71,6 +71,11 @@ func (c *list) run(ctx context.Context, _ cli.Input) error {
 		}
 	}
 
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatJSON)
+	if err != nil {
+		return err
+	}
+
 	labels := map[string]string{}
 	for _, label := range c.labels {
 		sp := strings.SplitN(label, ":", 2)
@@ -100,11 +105,8 @@ func (c *list) run(ctx context.Context, _ cli.Input) error {
 		return fmt.Errorf("failed to list application: %w", err)
 	}
 
-	bytes, err := json.Marshal(resp)
-	if err != nil {
-		return fmt.Errorf("failed to marshal applications: %w", err)
+	if err := p.Print(resp, applicationRows(resp.Applications...)); err != nil {
+		return fmt.Errorf("failed to print applications: %w", err)
 	}
-
-	fmt.Fprintln(c.stdout, string(bytes))
 	return nil
 }
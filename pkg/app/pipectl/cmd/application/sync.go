@@ -17,12 +17,17 @@ package application
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipectl/client"
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -30,10 +35,18 @@ import (
 type sync struct {
 	root *command
 
-	appID         string
-	statuses      []string
-	checkInterval time.Duration
-	timeout       time.Duration
+	appID               string
+	ignoreFreezeWindows bool
+	statuses            []string
+	checkInterval       time.Duration
+	timeout             time.Duration
+
+	stdout io.Writer
+}
+
+// syncResult is the printed result of the sync command.
+type syncResult struct {
+	DeploymentID string `json:"deployment_id"`
 }
 
 func newSyncCommand(root *command) *cobra.Command {
@@ -41,6 +54,7 @@ func newSyncCommand(root *command) *cobra.Command {
 		root:          root,
 		checkInterval: 15 * time.Second,
 		timeout:       5 * time.Minute,
+		stdout:        os.Stdout,
 	}
 	cmd := &cobra.Command{
 		Use:   "sync",
@@ -49,6 +63,7 @@ func newSyncCommand(root *command) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&c.appID, "app-id", c.appID, "The application ID.")
+	cmd.Flags().BoolVar(&c.ignoreFreezeWindows, "ignore-freeze-windows", c.ignoreFreezeWindows, "Whether to start the deployment even during a deployment freeze window.")
 	cmd.Flags().StringSliceVar(&c.statuses, "wait-status", c.statuses, fmt.Sprintf("The list of waiting statuses. Empty means returning immediately after triggered. (%s)", strings.Join(model.DeploymentStatusStrings(), "|")))
 	cmd.Flags().DurationVar(&c.checkInterval, "check-interval", c.checkInterval, "The interval of checking the requested command.")
 	cmd.Flags().DurationVar(&c.timeout, "timeout", c.timeout, "Maximum execution time.")
@@ -64,23 +79,35 @@ func (c *sync) run(ctx context.Context, input cli.Input) error {
 		return fmt.Errorf("invalid deployment status: %w", err)
 	}
 
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
 	cli, err := c.root.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
 	}
 	defer cli.Close()
 
-	deploymentID, err := client.SyncApplication(ctx, cli, c.appID, c.checkInterval, c.timeout, input.Logger)
+	return c.syncApplication(ctx, cli, p, statuses, input.Logger)
+}
+
+func (c *sync) syncApplication(ctx context.Context, cli apiservice.Client, p *printer.Printer, statuses []model.DeploymentStatus, logger *zap.Logger) error {
+	deploymentID, err := client.SyncApplication(ctx, cli, c.appID, c.ignoreFreezeWindows, c.checkInterval, c.timeout, logger)
 	if err != nil {
 		return err
 	}
 
-	input.Logger.Info(fmt.Sprintf("Successfully triggered deployment %s", deploymentID))
+	result := syncResult{DeploymentID: deploymentID}
+	if err := p.PrintObject(result, printer.Text(fmt.Sprintf("Successfully triggered deployment %s", deploymentID))); err != nil {
+		return fmt.Errorf("failed to print the result: %w", err)
+	}
 	if len(statuses) == 0 {
 		return nil
 	}
 
-	input.Logger.Info("Waiting until the deployment reaches one of the specified statuses")
+	logger.Info("Waiting until the deployment reaches one of the specified statuses")
 
 	return client.WaitDeploymentStatuses(
 		ctx,
@@ -89,6 +116,6 @@ func (c *sync) run(ctx context.Context, input cli.Input) error {
 		statuses,
 		c.checkInterval,
 		c.timeout,
-		input.Logger,
+		logger,
 	)
 }
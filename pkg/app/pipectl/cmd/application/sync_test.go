@@ -0,0 +1,92 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package application
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestSyncPrintsDeploymentID(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		output   string
+		expected string
+	}{
+		{
+			name:     "table",
+			expected: "Successfully triggered deployment deployment-id\n",
+		},
+		{
+			name:     "json",
+			output:   "json",
+			expected: "{\n  \"deployment_id\": \"deployment-id\"\n}\n",
+		},
+		{
+			name:     "yaml",
+			output:   "yaml",
+			expected: "deployment_id: deployment-id\n",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cli := &fakeAPIClient{
+				syncApplication: func(context.Context, *apiservice.SyncApplicationRequest, ...grpc.CallOption) (*apiservice.SyncApplicationResponse, error) {
+					return &apiservice.SyncApplicationResponse{CommandId: "command-id"}, nil
+				},
+				getCommand: func(context.Context, *apiservice.GetCommandRequest, ...grpc.CallOption) (*apiservice.GetCommandResponse, error) {
+					return &apiservice.GetCommandResponse{
+						Command: &model.Command{
+							Id:     "command-id",
+							Type:   model.Command_SYNC_APPLICATION,
+							Status: model.CommandStatus_COMMAND_SUCCEEDED,
+							Metadata: map[string]string{
+								model.MetadataKeyTriggeredDeploymentID: "deployment-id",
+							},
+						},
+					}, nil
+				},
+			}
+
+			var out bytes.Buffer
+			p, err := (&printer.Options{Output: tc.output}).NewPrinter(&out, printer.FormatTable)
+			require.NoError(t, err)
+
+			c := &sync{
+				appID:         "app-id",
+				checkInterval: time.Millisecond,
+				timeout:       time.Second,
+			}
+			require.NoError(t, c.syncApplication(context.Background(), cli, p, nil, zap.NewNop()))
+
+			assert.Equal(t, tc.expected, out.String())
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package application
+
+import (
+	"strconv"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// applicationRows returns the table representation of the given applications.
+func applicationRows(apps ...*model.Application) printer.Rows {
+	rows := make([][]string, 0, len(apps))
+	for _, app := range apps {
+		rows = append(rows, []string{
+			app.Id,
+			app.Name,
+			app.PipedId,
+			app.GetSyncState().GetStatus().String(),
+			strconv.FormatBool(app.Disabled),
+		})
+	}
+	return printer.Rows{
+		Header: []string{"ID", "NAME", "PIPED", "SYNC STATUS", "DISABLED"},
+		Rows:   rows,
+	}
+}
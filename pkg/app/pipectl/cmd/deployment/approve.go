@@ -0,0 +1,85 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type approve struct {
+	root *command
+
+	deploymentID string
+	stageID      string
+
+	stdout io.Writer
+}
+
+func newApproveCommand(root *command) *cobra.Command {
+	c := &approve{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Approve a WAIT_APPROVAL stage of a deployment.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.deploymentID, "deployment-id", c.deploymentID, "The deployment ID.")
+	cmd.Flags().StringVar(&c.stageID, "stage-id", c.stageID, "The ID of the WAIT_APPROVAL stage to approve.")
+
+	cmd.MarkFlagRequired("deployment-id")
+	cmd.MarkFlagRequired("stage-id")
+
+	return cmd
+}
+
+func (c *approve) run(ctx context.Context, _ cli.Input) error {
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.ApproveStageRequest{
+		DeploymentId: c.deploymentID,
+		StageId:      c.stageID,
+	}
+
+	resp, err := cli.ApproveStage(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to approve stage: %w", err)
+	}
+
+	if err := p.Print(resp, printer.Text(fmt.Sprintf("Successfully requested to approve the stage %s of the deployment %s (command id: %s)", c.stageID, c.deploymentID, resp.CommandId))); err != nil {
+		return fmt.Errorf("failed to print the result: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type approveChainGate struct {
+	root *command
+
+	deploymentChainID string
+	blockIndex        uint32
+
+	stdout io.Writer
+}
+
+func newApproveChainGateCommand(root *command) *cobra.Command {
+	c := &approveChainGate{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "approve-chain-gate",
+		Short: "Approve the gate placed before a block of the deployment chain.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.deploymentChainID, "deployment-chain-id", c.deploymentChainID, "The deployment chain ID.")
+	cmd.Flags().Uint32Var(&c.blockIndex, "block-index", c.blockIndex, "The index of the block whose gate should be approved. The first block in the chain has index 0.")
+
+	cmd.MarkFlagRequired("deployment-chain-id")
+	cmd.MarkFlagRequired("block-index")
+
+	return cmd
+}
+
+func (c *approveChainGate) run(ctx context.Context, _ cli.Input) error {
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.ApproveDeploymentChainGateRequest{
+		DeploymentChainId: c.deploymentChainID,
+		BlockIndex:        c.blockIndex,
+	}
+
+	resp, err := cli.ApproveDeploymentChainGate(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to approve deployment chain gate: %w", err)
+	}
+
+	if err := p.Print(resp, printer.Text(fmt.Sprintf("Successfully approved the gate of block %d in deployment chain %s", c.blockIndex, c.deploymentChainID))); err != nil {
+		return fmt.Errorf("failed to print the result: %w", err)
+	}
+	return nil
+}
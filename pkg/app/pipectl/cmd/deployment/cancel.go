@@ -0,0 +1,92 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/cli"
+)
+
+type cancel struct {
+	root *command
+
+	deploymentID    string
+	forceRollback   bool
+	forceNoRollback bool
+
+	stdout io.Writer
+}
+
+func newCancelCommand(root *command) *cobra.Command {
+	c := &cancel{
+		root:   root,
+		stdout: os.Stdout,
+	}
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel a running deployment.",
+		RunE:  cli.WithContext(c.run),
+	}
+
+	cmd.Flags().StringVar(&c.deploymentID, "deployment-id", c.deploymentID, "The deployment ID.")
+	cmd.Flags().BoolVar(&c.forceRollback, "force-rollback", c.forceRollback, "Whether to roll back the deployment even if auto rollback is disabled.")
+	cmd.Flags().BoolVar(&c.forceNoRollback, "force-no-rollback", c.forceNoRollback, "Whether to skip rolling back the deployment even if auto rollback is enabled.")
+
+	cmd.MarkFlagRequired("deployment-id")
+
+	return cmd
+}
+
+func (c *cancel) run(ctx context.Context, _ cli.Input) error {
+	if c.forceRollback && c.forceNoRollback {
+		return errors.New("only one of --force-rollback and --force-no-rollback can be specified")
+	}
+
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
+	cli, err := c.root.clientOptions.NewClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize client: %w", err)
+	}
+	defer cli.Close()
+
+	req := &apiservice.CancelDeploymentRequest{
+		DeploymentId:    c.deploymentID,
+		ForceRollback:   c.forceRollback,
+		ForceNoRollback: c.forceNoRollback,
+	}
+
+	resp, err := cli.CancelDeployment(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to cancel deployment: %w", err)
+	}
+
+	if err := p.Print(resp, printer.Text(fmt.Sprintf("Successfully requested to cancel the deployment %s (command id: %s)", c.deploymentID, resp.CommandId))); err != nil {
+		return fmt.Errorf("failed to print the result: %w", err)
+	}
+	return nil
+}
@@ -16,7 +16,6 @@ package deployment
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -25,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -83,6 +83,11 @@ func (c *list) run(ctx context.Context, _ cli.Input) error {
 		}
 	}
 
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatJSON)
+	if err != nil {
+		return err
+	}
+
 	labels := map[string]string{}
 	for _, label := range c.labels {
 		sp := strings.SplitN(label, ":", 2)
@@ -112,11 +117,8 @@ func (c *list) run(ctx context.Context, _ cli.Input) error {
 		return fmt.Errorf("failed to list deployment: %w", err)
 	}
 
-	bytes, err := json.Marshal(resp)
-	if err != nil {
-		return fmt.Errorf("failed to marshal deployments: %w", err)
+	if err := p.Print(resp, deploymentRows(resp.Deployments)); err != nil {
+		return fmt.Errorf("failed to print deployments: %w", err)
 	}
-
-	fmt.Fprintln(c.stdout, string(bytes))
 	return nil
 }
@@ -16,13 +16,13 @@ package deployment
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 
 	"github.com/spf13/cobra"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 )
@@ -53,6 +53,11 @@ func newLogsCommand(root *command) *cobra.Command {
 }
 
 func (c *logs) run(ctx context.Context, input cli.Input) error {
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatJSON)
+	if err != nil {
+		return err
+	}
+
 	cli, err := c.root.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
@@ -68,11 +73,8 @@ func (c *logs) run(ctx context.Context, input cli.Input) error {
 		return fmt.Errorf("failed to get stage log: %w", err)
 	}
 
-	bytes, err := json.Marshal(resp)
-	if err != nil {
-		return fmt.Errorf("failed to marshal stage log: %w", err)
+	if err := p.Print(resp, stageLogRows(resp.StageLogs)); err != nil {
+		return fmt.Errorf("failed to print stage log: %w", err)
 	}
-
-	fmt.Fprintln(c.stdout, string(bytes))
 	return nil
 }
@@ -0,0 +1,80 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// deploymentRows returns the table representation of the given deployments.
+func deploymentRows(deployments []*model.Deployment) printer.Rows {
+	rows := make([][]string, 0, len(deployments))
+	for _, d := range deployments {
+		rows = append(rows, []string{
+			d.Id,
+			d.ApplicationName,
+			d.Status.String(),
+			d.GetTrigger().GetCommander(),
+			formatUnix(d.CreatedAt),
+		})
+	}
+	return printer.Rows{
+		Header: []string{"ID", "APPLICATION", "STATUS", "COMMANDER", "CREATED AT"},
+		Rows:   rows,
+	}
+}
+
+// stageLogRows returns the table representation of the given stage logs.
+// The logs are ordered by the stage ID and then by the index of the log blocks.
+func stageLogRows(logs map[string]*apiservice.StageLog) printer.Rows {
+	stageIDs := make([]string, 0, len(logs))
+	for id := range logs {
+		stageIDs = append(stageIDs, id)
+	}
+	sort.Strings(stageIDs)
+
+	rows := make([][]string, 0)
+	for _, id := range stageIDs {
+		blocks := append([]*model.LogBlock(nil), logs[id].GetBlocks()...)
+		sort.Slice(blocks, func(i, j int) bool {
+			return blocks[i].Index < blocks[j].Index
+		})
+		for _, b := range blocks {
+			rows = append(rows, []string{
+				id,
+				formatUnix(b.CreatedAt),
+				b.Severity.String(),
+				strings.TrimRight(b.Log, "\n"),
+			})
+		}
+	}
+	return printer.Rows{
+		Header: []string{"STAGE", "TIME", "SEVERITY", "LOG"},
+		Rows:   rows,
+	}
+}
+
+func formatUnix(sec int64) string {
+	if sec == 0 {
+		return ""
+	}
+	return time.Unix(sec, 0).UTC().Format(time.RFC3339)
+}
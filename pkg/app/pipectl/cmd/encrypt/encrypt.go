@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipectl/client"
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 )
@@ -33,6 +34,7 @@ const maxDataSize int64 = 10485760
 
 type command struct {
 	clientOptions *client.Options
+	printOptions  *printer.Options
 
 	pipedID        string
 	inputFile      string
@@ -41,9 +43,10 @@ type command struct {
 	stdout io.Writer
 }
 
-func NewCommand() *cobra.Command {
+func NewCommand(printOptions *printer.Options) *cobra.Command {
 	c := &command{
 		clientOptions: &client.Options{},
+		printOptions:  printOptions,
 		stdout:        os.Stdout,
 	}
 	cmd := &cobra.Command{
@@ -66,6 +69,11 @@ func NewCommand() *cobra.Command {
 }
 
 func (c *command) run(ctx context.Context, input cli.Input) error {
+	p, err := c.printOptions.NewPrinter(c.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
 	cli, err := c.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
@@ -107,6 +115,8 @@ func (c *command) run(ctx context.Context, input cli.Input) error {
 		return fmt.Errorf("failed to encrypt: %w", err)
 	}
 
-	fmt.Fprintln(c.stdout, resp.Ciphertext)
+	if err := p.Print(resp, printer.Text(resp.Ciphertext)); err != nil {
+		return fmt.Errorf("failed to print the ciphertext: %w", err)
+	}
 	return nil
 }
@@ -17,11 +17,13 @@ package event
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"regexp"
 
 	"github.com/spf13/cobra"
-	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 )
@@ -43,11 +45,14 @@ type register struct {
 	commitURL       string
 	commitAuthor    string
 	commitTimestamp int64
+
+	stdout io.Writer
 }
 
 func newRegisterCommand(root *command) *cobra.Command {
 	r := &register{
-		root: root,
+		root:   root,
+		stdout: os.Stdout,
 	}
 	cmd := &cobra.Command{
 		Use:   "register",
@@ -73,11 +78,16 @@ func newRegisterCommand(root *command) *cobra.Command {
 	return cmd
 }
 
-func (r *register) run(ctx context.Context, input cli.Input) error {
+func (r *register) run(ctx context.Context, _ cli.Input) error {
 	if err := r.validateEventContexts(); err != nil {
 		return fmt.Errorf("failed to validate event context: %w", err)
 	}
 
+	p, err := r.root.printOptions.NewPrinter(r.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
 	cli, err := r.root.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
@@ -102,9 +112,9 @@ func (r *register) run(ctx context.Context, input cli.Input) error {
 		return fmt.Errorf("failed to register event: %w", err)
 	}
 
-	input.Logger.Info("Successfully registered event",
-		zap.String("id", res.EventId),
-	)
+	if err := p.Print(res, printer.Text(fmt.Sprintf("Successfully registered event %s", res.EventId))); err != nil {
+		return fmt.Errorf("failed to print the result: %w", err)
+	}
 	return nil
 }
 
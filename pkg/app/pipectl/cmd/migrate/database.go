@@ -16,12 +16,18 @@ package migrate
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/ops/postgresensurer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/datastore/mysql"
+	"github.com/pipe-cd/pipecd/pkg/datastore/postgres"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -29,6 +35,16 @@ type database struct {
 	root *command
 
 	applications []string
+
+	mysql    sqlDatabaseOptions
+	postgres sqlDatabaseOptions
+}
+
+type sqlDatabaseOptions struct {
+	url          string
+	database     string
+	usernameFile string
+	passwordFile string
 }
 
 func newDatabaseCommand(root *command) *cobra.Command {
@@ -38,16 +54,35 @@ func newDatabaseCommand(root *command) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "database",
 		Short: "Do migration tasks for database.",
-		Long:  "Make existing applications compatible with plugin-architectured piped. Once you execute this command for an application, it can be deployed using plugin-architectured piped.",
-		RunE:  cli.WithContext(c.run),
+		Long: "Do migration tasks for database.\n" +
+			"With --applications, make existing applications compatible with plugin-architectured piped. Once you execute this command for an application, it can be deployed using plugin-architectured piped.\n" +
+			"With --mysql-url and --postgres-url, copy all data stored in the MySQL datastore to the PostgreSQL datastore.",
+		RunE: cli.WithContext(c.run),
 	}
 
 	cmd.Flags().StringSliceVar(&c.applications, "applications", c.applications, "The list of application to migrate database.")
-	cmd.MarkFlagRequired("applications")
+
+	cmd.Flags().StringVar(&c.mysql.url, "mysql-url", c.mysql.url, "The url of the source MySQL datastore.")
+	cmd.Flags().StringVar(&c.mysql.database, "mysql-database", c.mysql.database, "The name of the source MySQL database.")
+	cmd.Flags().StringVar(&c.mysql.usernameFile, "mysql-username-file", c.mysql.usernameFile, "The path to the username file of the source MySQL datastore.")
+	cmd.Flags().StringVar(&c.mysql.passwordFile, "mysql-password-file", c.mysql.passwordFile, "The path to the password file of the source MySQL datastore.")
+
+	cmd.Flags().StringVar(&c.postgres.url, "postgres-url", c.postgres.url, "The url of the destination PostgreSQL datastore.")
+	cmd.Flags().StringVar(&c.postgres.database, "postgres-database", c.postgres.database, "The name of the destination PostgreSQL database.")
+	cmd.Flags().StringVar(&c.postgres.usernameFile, "postgres-username-file", c.postgres.usernameFile, "The path to the username file of the destination PostgreSQL datastore.")
+	cmd.Flags().StringVar(&c.postgres.passwordFile, "postgres-password-file", c.postgres.passwordFile, "The path to the password file of the destination PostgreSQL datastore.")
+
+	cmd.MarkFlagsOneRequired("applications", "mysql-url")
+	cmd.MarkFlagsMutuallyExclusive("applications", "mysql-url")
+	cmd.MarkFlagsRequiredTogether("mysql-url", "postgres-url")
 	return cmd
 }
 
 func (c *database) run(ctx context.Context, input cli.Input) error {
+	if c.mysql.url != "" {
+		return c.migrateMySQLToPostgreSQL(ctx, input.Logger)
+	}
+
 	client, err := c.root.clientOptions.NewClient(ctx)
 	if err != nil {
 		input.Logger.Error("failed to create client", zap.Error(err))
@@ -101,6 +136,102 @@ func (c *database) migrateApplication(ctx context.Context, client apiservice.Cli
 	return nil
 }
 
+func (c *database) migrateMySQLToPostgreSQL(ctx context.Context, logger *zap.Logger) error {
+	// Prepare the schema and indexes of the destination database.
+	ensurer, err := postgresensurer.NewPostgreSQLEnsurer(c.postgres.url, c.postgres.database, c.postgres.usernameFile, c.postgres.passwordFile, logger)
+	if err != nil {
+		logger.Error("failed to create postgresql ensurer", zap.Error(err))
+		return err
+	}
+	defer ensurer.Close()
+
+	if err := ensurer.Run(ctx); err != nil {
+		logger.Error("failed to prepare postgresql database", zap.Error(err))
+		return err
+	}
+
+	src, err := c.mysql.newMySQL(logger)
+	if err != nil {
+		logger.Error("failed to connect to mysql datastore", zap.Error(err))
+		return err
+	}
+	defer src.Close()
+
+	dst, err := c.postgres.newPostgreSQL(logger)
+	if err != nil {
+		logger.Error("failed to connect to postgresql datastore", zap.Error(err))
+		return err
+	}
+	defer dst.Close()
+
+	for _, col := range datastore.Collections() {
+		logger.Info("migrating collection", zap.String("kind", col.Kind()))
+		copied, err := copyCollection(ctx, src, dst, col)
+		if err != nil {
+			logger.Error("failed to migrate collection", zap.String("kind", col.Kind()), zap.Error(err))
+			return err
+		}
+		logger.Info("successfully migrated collection", zap.String("kind", col.Kind()), zap.Int("count", copied))
+	}
+	return nil
+}
+
+// copyCollection copies all entities of the given collection from src to dst.
+// The entities which are already existing in dst are skipped
+// so that the migration can be resumed after a failure.
+func copyCollection(ctx context.Context, src, dst datastore.DataStore, col datastore.Collection) (int, error) {
+	it, err := src.Find(ctx, col, datastore.ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	var copied int
+	factory := col.Factory()
+	for {
+		entity := factory()
+		err := it.Next(entity)
+		if errors.Is(err, datastore.ErrIteratorDone) {
+			return copied, nil
+		}
+		if err != nil {
+			return copied, err
+		}
+
+		e, ok := entity.(interface{ GetId() string })
+		if !ok {
+			return copied, fmt.Errorf("unexpected entity type %T", entity)
+		}
+		err = dst.Create(ctx, col, e.GetId(), entity)
+		if errors.Is(err, datastore.ErrAlreadyExists) {
+			continue
+		}
+		if err != nil {
+			return copied, fmt.Errorf("failed to create %s %s: %w", col.Kind(), e.GetId(), err)
+		}
+		copied++
+	}
+}
+
+func (o sqlDatabaseOptions) newMySQL(logger *zap.Logger) (*mysql.MySQL, error) {
+	options := []mysql.Option{
+		mysql.WithLogger(logger),
+	}
+	if o.usernameFile != "" || o.passwordFile != "" {
+		options = append(options, mysql.WithAuthenticationFile(o.usernameFile, o.passwordFile))
+	}
+	return mysql.NewMySQL(o.url, o.database, options...)
+}
+
+func (o sqlDatabaseOptions) newPostgreSQL(logger *zap.Logger) (*postgres.PostgreSQL, error) {
+	options := []postgres.Option{
+		postgres.WithLogger(logger),
+	}
+	if o.usernameFile != "" || o.passwordFile != "" {
+		options = append(options, postgres.WithAuthenticationFile(o.usernameFile, o.passwordFile))
+	}
+	return postgres.NewPostgreSQL(o.url, o.database, options...)
+}
+
 // NOTE: Convention for Application plugins migration
 // The plugins name for this migration task are defined based on the Application Kind
 // Eg: KubernetesApp -> kubernetes | ECSApp -> ecs | ...
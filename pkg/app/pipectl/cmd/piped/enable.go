@@ -22,6 +22,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 )
@@ -51,6 +52,11 @@ func newEnableCommand(root *command) *cobra.Command {
 }
 
 func (c *enable) run(ctx context.Context, _ cli.Input) error {
+	p, err := c.root.printOptions.NewPrinter(c.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
 	cli, err := c.root.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
@@ -60,11 +66,13 @@ func (c *enable) run(ctx context.Context, _ cli.Input) error {
 	req := &apiservice.EnablePipedRequest{
 		PipedId: c.pipedID,
 	}
-	if _, err := cli.EnablePiped(ctx, req); err != nil {
-		fmt.Fprintf(c.stdout, "Failed to enable Piped %s (%v)\n", c.pipedID, err)
-		return err
+	resp, err := cli.EnablePiped(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to enable Piped %s: %w", c.pipedID, err)
 	}
 
-	fmt.Fprintf(c.stdout, "Successfully enabled Piped %s\n", c.pipedID)
+	if err := p.Print(resp, printer.Text(fmt.Sprintf("Successfully enabled Piped %s", c.pipedID))); err != nil {
+		return fmt.Errorf("failed to print the result: %w", err)
+	}
 	return nil
 }
@@ -18,15 +18,18 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipectl/client"
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 )
 
 type command struct {
 	clientOptions *client.Options
+	printOptions  *printer.Options
 }
 
-func NewCommand() *cobra.Command {
+func NewCommand(printOptions *printer.Options) *cobra.Command {
 	c := &command{
 		clientOptions: &client.Options{},
+		printOptions:  printOptions,
 	}
 	cmd := &cobra.Command{
 		Use:   "piped",
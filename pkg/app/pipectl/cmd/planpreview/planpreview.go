@@ -29,6 +29,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipectl/client"
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -53,11 +54,13 @@ type command struct {
 	sortLabelKeys      []string
 
 	clientOptions *client.Options
+	printOptions  *printer.Options
 }
 
-func NewCommand() *cobra.Command {
+func NewCommand(printOptions *printer.Options) *cobra.Command {
 	c := &command{
 		clientOptions:      &client.Options{},
+		printOptions:       printOptions,
 		pipedHandleTimeout: defaultPipedHandleTimeout,
 		timeout:            defaultTimeout,
 		checkInterval:      defaultCheckInterval,
@@ -91,6 +94,17 @@ func (c *command) run(ctx context.Context, _ cli.Input) error {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
+	p, err := c.printOptions.NewPrinter(os.Stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
+	// Keep stdout only for the results when they are printed in a machine-readable format.
+	progress := io.Writer(os.Stdout)
+	if p.Format() != printer.FormatTable {
+		progress = os.Stderr
+	}
+
 	cli, err := c.clientOptions.NewClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to initialize client: %w", err)
@@ -107,14 +121,17 @@ func (c *command) run(ctx context.Context, _ cli.Input) error {
 
 	resp, err := cli.RequestPlanPreview(ctx, req)
 	if err != nil {
-		fmt.Printf("Failed to request plan-preview: %v\n", err)
+		fmt.Fprintf(progress, "Failed to request plan-preview: %v\n", err)
 		return err
 	}
 	if len(resp.Commands) == 0 {
-		fmt.Println("There is no piped that is handling the given Git repository")
-		return nil
+		fmt.Fprintln(progress, "There is no piped that is handling the given Git repository")
+		if p.Format() == printer.FormatTable {
+			return nil
+		}
+		return printer.PrintList(p, []*model.PlanPreviewCommandResult{}, nil)
 	}
-	fmt.Printf("Requested plan-preview, waiting for its results (commands: %v)\n", resp.Commands)
+	fmt.Fprintf(progress, "Requested plan-preview, waiting for its results (commands: %v)\n", resp.Commands)
 
 	getResults := func(commands []string) ([]*model.PlanPreviewCommandResult, error) {
 		req := &apiservice.GetPlanPreviewResultsRequest{
@@ -143,15 +160,15 @@ func (c *command) run(ctx context.Context, _ cli.Input) error {
 			if err != nil {
 				s := status.Convert(err)
 				if s.Code() == codes.NotFound {
-					fmt.Println(s.Message())
-					fmt.Println("waiting...")
+					fmt.Fprintln(progress, s.Message())
+					fmt.Fprintln(progress, "waiting...")
 					break
 				}
-				fmt.Printf("Failed to retrieve plan-preview results: %v\n", err)
+				fmt.Fprintf(progress, "Failed to retrieve plan-preview results: %v\n", err)
 				return err
 			}
 			sortResults(results, c.sortLabelKeys)
-			return printResults(results, os.Stdout, c.out)
+			return printResults(results, p, c.out)
 		}
 	}
 }
@@ -176,11 +193,17 @@ func sortResults(allResults []*model.PlanPreviewCommandResult, sortLabelKeys []s
 	}
 }
 
-func printResults(results []*model.PlanPreviewCommandResult, stdout io.Writer, outFile string) error {
+func printResults(results []*model.PlanPreviewCommandResult, p *printer.Printer, outFile string) error {
 	r := convert(results)
 
-	// Print out a readable format to stdout.
-	fmt.Fprint(stdout, r)
+	// Print out a readable format or the raw results in the specified format to stdout.
+	table := printer.TableFunc(func(w io.Writer) error {
+		_, err := fmt.Fprint(w, r)
+		return err
+	})
+	if err := printer.PrintList(p, results, table); err != nil {
+		return err
+	}
 
 	if outFile == "" {
 		return nil
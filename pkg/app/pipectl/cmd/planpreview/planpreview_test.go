@@ -19,7 +19,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -392,7 +394,9 @@ NOTE: An error occurred while building plan-preview for the following applicatio
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 			var buf bytes.Buffer
-			printResults(tc.results, &buf, "")
+			p, err := (&printer.Options{}).NewPrinter(&buf, printer.FormatTable)
+			require.NoError(t, err)
+			require.NoError(t, printResults(tc.results, p, ""))
 
 			assert.Equal(t, tc.expected, buf.String())
 		})
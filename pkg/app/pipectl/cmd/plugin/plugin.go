@@ -16,12 +16,18 @@ package plugin
 
 import (
 	"github.com/spf13/cobra"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 )
 
-type command struct{}
+type command struct {
+	printOptions *printer.Options
+}
 
-func NewCommand() *cobra.Command {
-	c := &command{}
+func NewCommand(printOptions *printer.Options) *cobra.Command {
+	c := &command{
+		printOptions: printOptions,
+	}
 	cmd := &cobra.Command{
 		Use:   "plugin",
 		Short: "Do plugin tasks.",
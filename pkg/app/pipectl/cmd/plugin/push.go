@@ -17,12 +17,14 @@ package plugin
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipectl/printer"
 	"github.com/pipe-cd/pipecd/pkg/cli"
 	"github.com/pipe-cd/pipecd/pkg/oci"
 )
@@ -35,11 +37,19 @@ type push struct {
 	insecure   bool
 	registry   string
 	repository string
+
+	stdout io.Writer
+}
+
+// pushResult is the result of the push command.
+type pushResult struct {
+	Target string `json:"target"`
 }
 
 func newPushCommand(root *command) *cobra.Command {
 	p := &push{
-		root: root,
+		root:   root,
+		stdout: os.Stdout,
 	}
 	cmd := &cobra.Command{
 		Use:   "push",
@@ -62,6 +72,11 @@ func newPushCommand(root *command) *cobra.Command {
 }
 
 func (p *push) run(ctx context.Context, input cli.Input) error {
+	prt, err := p.root.printOptions.NewPrinter(p.stdout, printer.FormatTable)
+	if err != nil {
+		return err
+	}
+
 	workdir, err := os.MkdirTemp("", "pipectl-plugin-push")
 	if err != nil {
 		input.Logger.Error("failed to create temp directory", zap.Error(err))
@@ -95,7 +110,10 @@ func (p *push) run(ctx context.Context, input cli.Input) error {
 		return err
 	}
 
-	input.Logger.Info("successfully pushed plugin to the server", zap.String("target", targetURL))
+	result := pushResult{Target: targetURL}
+	if err := prt.PrintObject(result, printer.Text(fmt.Sprintf("Successfully pushed plugin to %s", targetURL))); err != nil {
+		return fmt.Errorf("failed to print the result: %w", err)
+	}
 
 	return nil
 }
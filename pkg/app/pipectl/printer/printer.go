@@ -0,0 +1,202 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package printer provides the way to print the results of pipectl commands
+// in the format specified by the --output flag.
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+)
+
+// Format represents the output format of pipectl commands.
+type Format string
+
+const (
+	// FormatJSON prints the protobuf JSON of the returned objects.
+	FormatJSON Format = "json"
+	// FormatYAML prints the YAML converted from the protobuf JSON of the returned objects.
+	FormatYAML Format = "yaml"
+	// FormatTable prints the human-readable representation of the returned objects.
+	FormatTable Format = "table"
+)
+
+var formats = []Format{FormatJSON, FormatYAML, FormatTable}
+
+// Options represents the flags to configure the printer.
+type Options struct {
+	Output string
+}
+
+// RegisterFlags registers the printer flags to the given flag set.
+// It is expected to be the persistent flag set of the root command
+// so that all sub commands share the same --output flag.
+func (o *Options) RegisterFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Output, "output", o.Output, "The output format. (json|yaml|table) Empty means the default format of each command.")
+}
+
+// NewPrinter returns a printer writing to the given writer.
+// The given default format is used when the --output flag was not specified.
+func (o *Options) NewPrinter(w io.Writer, defaultFormat Format) (*Printer, error) {
+	format := defaultFormat
+	if o.Output != "" {
+		format = Format(o.Output)
+	}
+	for _, f := range formats {
+		if f == format {
+			return &Printer{format: format, w: w}, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid output format %q, it must be one of json, yaml or table", format)
+}
+
+// Table is the human-readable representation of the printed objects.
+type Table interface {
+	WriteTable(w io.Writer) error
+}
+
+// TableFunc is an adapter to allow the use of ordinary functions as Table.
+type TableFunc func(w io.Writer) error
+
+func (f TableFunc) WriteTable(w io.Writer) error {
+	return f(w)
+}
+
+// Rows is a Table whose columns are aligned by tabs.
+type Rows struct {
+	Header []string
+	Rows   [][]string
+}
+
+func (r Rows) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(r.Header, "\t"))
+	for _, row := range r.Rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// Text is a Table which writes the given text as a line.
+type Text string
+
+func (t Text) WriteTable(w io.Writer) error {
+	_, err := fmt.Fprintln(w, string(t))
+	return err
+}
+
+// Printer prints the results of pipectl commands in the configured format.
+type Printer struct {
+	format Format
+	w      io.Writer
+}
+
+// Format returns the format used by this printer.
+func (p *Printer) Format() Format {
+	return p.format
+}
+
+// Print prints the given message.
+// The table is used only when the format is table.
+func (p *Printer) Print(m proto.Message, t Table) error {
+	if p.format == FormatTable {
+		return t.WriteTable(p.w)
+	}
+
+	data, err := marshalMessage(m)
+	if err != nil {
+		return err
+	}
+	return p.write(data)
+}
+
+// PrintObject prints the given object which is not a protobuf message.
+// The object is marshaled by encoding/json, so its fields should have json tags.
+// The table is used only when the format is table.
+func (p *Printer) PrintObject(v any, t Table) error {
+	if p.format == FormatTable {
+		return t.WriteTable(p.w)
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %T: %w", v, err)
+	}
+	return p.write(data)
+}
+
+// PrintList prints the given messages as a list.
+// The table is used only when the format is table.
+func PrintList[T proto.Message](p *Printer, ms []T, t Table) error {
+	if p.format == FormatTable {
+		return t.WriteTable(p.w)
+	}
+
+	items := make([]json.RawMessage, 0, len(ms))
+	for _, m := range ms {
+		data, err := marshalMessage(m)
+		if err != nil {
+			return err
+		}
+		items = append(items, data)
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	return p.write(data)
+}
+
+func (p *Printer) write(data []byte) error {
+	if p.format == FormatYAML {
+		out, err := yaml.JSONToYAML(data)
+		if err != nil {
+			return fmt.Errorf("failed to convert to YAML: %w", err)
+		}
+		_, err = p.w.Write(out)
+		return err
+	}
+
+	// The output of protojson is intentionally unstable in whitespace,
+	// so it is re-indented here to keep the output stable for scripting.
+	var b bytes.Buffer
+	if err := json.Indent(&b, data, "", "  "); err != nil {
+		return fmt.Errorf("failed to indent JSON: %w", err)
+	}
+	b.WriteByte('\n')
+	_, err := p.w.Write(b.Bytes())
+	return err
+}
+
+func marshalMessage(m proto.Message) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s: %w", m.ProtoReflect().Descriptor().FullName(), err)
+	}
+	var b bytes.Buffer
+	if err := json.Compact(&b, data); err != nil {
+		return nil, fmt.Errorf("failed to compact JSON: %w", err)
+	}
+	return b.Bytes(), nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestOptions_NewPrinter(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		output        string
+		defaultFormat Format
+		expected      Format
+		expectedErr   bool
+	}{
+		{
+			name:          "use the default format when output is not specified",
+			defaultFormat: FormatTable,
+			expected:      FormatTable,
+		},
+		{
+			name:          "use the specified format",
+			output:        "yaml",
+			defaultFormat: FormatJSON,
+			expected:      FormatYAML,
+		},
+		{
+			name:          "invalid format",
+			output:        "xml",
+			defaultFormat: FormatJSON,
+			expectedErr:   true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			o := &Options{Output: tc.output}
+			p, err := o.NewPrinter(&bytes.Buffer{}, tc.defaultFormat)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, p.Format())
+		})
+	}
+}
+
+func TestPrinter_Print(t *testing.T) {
+	t.Parallel()
+
+	app := &model.Application{
+		Id:       "app-1",
+		Name:     "app-name",
+		PipedId:  "piped-1",
+		Disabled: true,
+		SyncState: &model.ApplicationSyncState{
+			Status: model.ApplicationSyncStatus_SYNCED,
+		},
+	}
+	table := Rows{
+		Header: []string{"ID", "NAME"},
+		Rows:   [][]string{{"app-1", "app-name"}},
+	}
+
+	testcases := []struct {
+		name     string
+		format   Format
+		expected string
+	}{
+		{
+			name:   "json",
+			format: FormatJSON,
+			expected: `{
+  "id": "app-1",
+  "name": "app-name",
+  "piped_id": "piped-1",
+  "sync_state": {
+    "status": "SYNCED"
+  },
+  "disabled": true
+}
+`,
+		},
+		{
+			name:   "yaml",
+			format: FormatYAML,
+			expected: `disabled: true
+id: app-1
+name: app-name
+piped_id: piped-1
+sync_state:
+  status: SYNCED
+`,
+		},
+		{
+			name:   "table",
+			format: FormatTable,
+			expected: `ID      NAME
+app-1   app-name
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			p, err := (&Options{Output: string(tc.format)}).NewPrinter(&buf, FormatJSON)
+			require.NoError(t, err)
+
+			require.NoError(t, p.Print(app, table))
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestPrintList(t *testing.T) {
+	t.Parallel()
+
+	apps := []*model.Application{
+		{Id: "app-1"},
+		{Id: "app-2"},
+	}
+
+	var buf bytes.Buffer
+	p, err := (&Options{}).NewPrinter(&buf, FormatJSON)
+	require.NoError(t, err)
+
+	require.NoError(t, PrintList(p, apps, nil))
+	assert.Equal(t, `[
+  {
+    "id": "app-1"
+  },
+  {
+    "id": "app-2"
+  }
+]
+`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, PrintList(p, []*model.Application{}, nil))
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+func TestPrinter_PrintObject(t *testing.T) {
+	t.Parallel()
+
+	obj := struct {
+		Target string `json:"target"`
+	}{
+		Target: "oci://registry/plugin:v1",
+	}
+
+	testcases := []struct {
+		name     string
+		format   Format
+		expected string
+	}{
+		{
+			name:   "json",
+			format: FormatJSON,
+			expected: `{
+  "target": "oci://registry/plugin:v1"
+}
+`,
+		},
+		{
+			name:     "yaml",
+			format:   FormatYAML,
+			expected: "target: oci://registry/plugin:v1\n",
+		},
+		{
+			name:     "table",
+			format:   FormatTable,
+			expected: "Successfully pushed\n",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			p, err := (&Options{Output: string(tc.format)}).NewPrinter(&buf, FormatJSON)
+			require.NoError(t, err)
+
+			require.NoError(t, p.PrintObject(obj, Text("Successfully pushed")))
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
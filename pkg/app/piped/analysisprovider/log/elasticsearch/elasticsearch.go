@@ -0,0 +1,304 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+)
+
+const (
+	ProviderType   = "Elasticsearch"
+	defaultTimeout = 30 * time.Second
+
+	defaultTimestampField = "@timestamp"
+	defaultMessageField   = "message"
+
+	// maxCountedEntries is the maximum number of entries counted in one evaluation.
+	maxCountedEntries = 1000
+	// maxSampleEntries is the maximum number of entries shown in the reason.
+	maxSampleEntries = 3
+	// maxSampleLength is the maximum length of each entry shown in the reason.
+	maxSampleLength = 200
+)
+
+// Provider is a client for Elasticsearch and OpenSearch.
+type Provider struct {
+	address        string
+	index          string
+	timestampField string
+	messageField   string
+	client         *http.Client
+	username       string
+	password       string
+	apiKey         string
+
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+type Option func(*Provider)
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("elasticsearch-provider")
+	}
+}
+
+func WithBasicAuth(username, password string) Option {
+	return func(p *Provider) {
+		p.username = username
+		p.password = password
+	}
+}
+
+// WithAPIKey sets the API key sent as the "Authorization: ApiKey" header.
+func WithAPIKey(apiKey string) Option {
+	return func(p *Provider) {
+		p.apiKey = apiKey
+	}
+}
+
+// WithTimestampField overrides the field used to filter the documents by time.
+func WithTimestampField(field string) Option {
+	return func(p *Provider) {
+		p.timestampField = field
+	}
+}
+
+// WithMessageField overrides the field shown as the message of the matched documents.
+func WithMessageField(field string) Option {
+	return func(p *Provider) {
+		p.messageField = field
+	}
+}
+
+// NewProvider returns a provider which searches the documents
+// in the given index (or index pattern) of the cluster.
+func NewProvider(address, index string, opts ...Option) (*Provider, error) {
+	if address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+	if _, err := url.Parse(address); err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+	if index == "" {
+		return nil, fmt.Errorf("index is required")
+	}
+
+	p := &Provider{
+		address:        strings.TrimSuffix(address, "/"),
+		index:          index,
+		timestampField: defaultTimestampField,
+		messageField:   defaultMessageField,
+		client:         &http.Client{},
+		timeout:        defaultTimeout,
+		logger:         zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p, nil
+}
+
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// searchResponse represents the response of the search API.
+// ref: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-search.html
+type searchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int `json:"value"`
+			// Either "eq" or "gte".
+			Relation string `json:"relation"`
+		} `json:"total"`
+		Hits []struct {
+			Source map[string]interface{} `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// Evaluate counts the documents matched the given query within the given range.
+// The query must be written in the Lucene query string syntax.
+// ref: https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-query-string-query.html
+func (p *Provider) Evaluate(ctx context.Context, query string, queryRange log.QueryRange) (bool, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if err := queryRange.Validate(); err != nil {
+		return false, "", err
+	}
+
+	p.logger.Info("run query", zap.String("query", query))
+	resp, err := p.search(ctx, p.buildSearchBody(query, queryRange))
+	if err != nil {
+		return false, "", fmt.Errorf("failed to run query for %s: %w", ProviderType, err)
+	}
+
+	total := resp.Hits.Total
+	if total.Value == 0 {
+		return true, fmt.Sprintf("no log entry matched the query within the range (%s)", queryRange.String()), nil
+	}
+
+	samples := make([]string, 0, len(resp.Hits.Hits))
+	for _, h := range resp.Hits.Hits {
+		samples = append(samples, p.formatEntry(h.Source))
+	}
+	countStr := strconv.Itoa(total.Value)
+	if total.Relation == "gte" {
+		countStr = fmt.Sprintf("more than %d", total.Value)
+	}
+	reason := fmt.Sprintf("found %s log entries matched the query within the range (%s), latest entries: %s",
+		countStr, queryRange.String(), strings.Join(samples, ", "))
+	return false, reason, nil
+}
+
+// buildSearchBody returns the search request body which finds the latest documents
+// matched the given query within the given range.
+func (p *Provider) buildSearchBody(query string, queryRange log.QueryRange) map[string]interface{} {
+	filters := []interface{}{
+		map[string]interface{}{
+			"range": map[string]interface{}{
+				p.timestampField: map[string]interface{}{
+					"gte":    queryRange.From.UTC().Format(time.RFC3339Nano),
+					"lte":    queryRange.To.UTC().Format(time.RFC3339Nano),
+					"format": "strict_date_optional_time",
+				},
+			},
+		},
+	}
+	if query = strings.TrimSpace(query); query != "" {
+		filters = append(filters, map[string]interface{}{
+			"query_string": map[string]interface{}{
+				"query": query,
+			},
+		})
+	}
+	return map[string]interface{}{
+		"size":             maxSampleEntries,
+		"track_total_hits": maxCountedEntries,
+		"sort": []interface{}{
+			map[string]interface{}{
+				p.timestampField: map[string]interface{}{"order": "desc"},
+			},
+		},
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": filters,
+			},
+		},
+	}
+}
+
+func (p *Provider) search(ctx context.Context, body map[string]interface{}) (*searchResponse, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/%s/_search", p.address, url.PathEscape(p.index))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	switch {
+	case p.apiKey != "":
+		req.Header.Set("Authorization", "ApiKey "+p.apiKey)
+	case p.username != "" && p.password != "":
+		req.SetBasicAuth(p.username, p.password)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var out searchResponse
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse the response: %w", err)
+	}
+	return &out, nil
+}
+
+// formatEntry returns a single line representation of the given document.
+func (p *Provider) formatEntry(source map[string]interface{}) string {
+	var message string
+	if v, ok := lookupField(source, p.messageField); ok {
+		message = fmt.Sprint(v)
+	} else {
+		data, _ := json.Marshal(source)
+		message = string(data)
+	}
+	message = strings.Join(strings.Fields(message), " ")
+	if len(message) > maxSampleLength {
+		message = message[:maxSampleLength] + "..."
+	}
+	if v, ok := lookupField(source, p.timestampField); ok {
+		message = fmt.Sprintf("%v %s", v, message)
+	}
+	return fmt.Sprintf("%q", message)
+}
+
+// lookupField returns the value of the given field.
+// The field can be a dotted path to the nested object like "log.message".
+func lookupField(source map[string]interface{}, field string) (interface{}, bool) {
+	if v, ok := source[field]; ok {
+		return v, true
+	}
+	obj := source
+	keys := strings.Split(field, ".")
+	for i, k := range keys {
+		v, ok := obj[k]
+		if !ok {
+			return nil, false
+		}
+		if i == len(keys)-1 {
+			return v, true
+		}
+		if obj, ok = v.(map[string]interface{}); !ok {
+			return nil, false
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,234 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+)
+
+const (
+	ProviderType   = "Loki"
+	defaultTimeout = 30 * time.Second
+
+	// maxCountedEntries is the maximum number of entries counted in one evaluation.
+	maxCountedEntries = 1000
+	// maxSampleEntries is the maximum number of entries shown in the reason.
+	maxSampleEntries = 3
+	// maxSampleLength is the maximum length of each entry shown in the reason.
+	maxSampleLength = 200
+
+	queryRangePath = "/loki/api/v1/query_range"
+)
+
+// Provider is a client for Grafana Loki.
+type Provider struct {
+	address  string
+	client   *http.Client
+	username string
+	password string
+	tenantID string
+
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+type Option func(*Provider)
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("loki-provider")
+	}
+}
+
+func WithBasicAuth(username, password string) Option {
+	return func(p *Provider) {
+		p.username = username
+		p.password = password
+	}
+}
+
+// WithTenantID sets the tenant ID sent as the X-Scope-OrgID header
+// for the multi-tenant Loki.
+func WithTenantID(tenantID string) Option {
+	return func(p *Provider) {
+		p.tenantID = tenantID
+	}
+}
+
+func NewProvider(address string, opts ...Option) (*Provider, error) {
+	if address == "" {
+		return nil, fmt.Errorf("address is required")
+	}
+	if _, err := url.Parse(address); err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	p := &Provider{
+		address: strings.TrimSuffix(address, "/"),
+		client:  &http.Client{},
+		timeout: defaultTimeout,
+		logger:  zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p, nil
+}
+
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// queryRangeResponse represents the response of the query_range API.
+// ref: https://grafana.com/docs/loki/latest/reference/loki-http-api/#query-logs-within-a-range-of-time
+type queryRangeResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Stream map[string]string `json:"stream"`
+			// Each value is a pair of the timestamp in nanoseconds and the log line.
+			Values [][2]string `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// Evaluate counts the log lines matched the given LogQL log query within the given range.
+// Metric queries are not supported.
+func (p *Provider) Evaluate(ctx context.Context, query string, queryRange log.QueryRange) (bool, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if err := queryRange.Validate(); err != nil {
+		return false, "", err
+	}
+
+	p.logger.Info("run query", zap.String("query", query))
+	resp, err := p.queryRange(ctx, query, queryRange)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to run query for %s: %w", ProviderType, err)
+	}
+	if resp.Data.ResultType != "streams" {
+		return false, "", fmt.Errorf("unexpected result type %q: only log queries are supported", resp.Data.ResultType)
+	}
+
+	type entry struct {
+		timestamp int64
+		line      string
+	}
+	var entries []entry
+	for _, s := range resp.Data.Result {
+		for _, v := range s.Values {
+			ts, err := strconv.ParseInt(v[0], 10, 64)
+			if err != nil {
+				return false, "", fmt.Errorf("invalid timestamp %q found in the response: %w", v[0], err)
+			}
+			entries = append(entries, entry{timestamp: ts, line: v[1]})
+		}
+	}
+
+	if len(entries) == 0 {
+		return true, fmt.Sprintf("no log entry matched the query within the range (%s)", queryRange.String()), nil
+	}
+
+	// Entries are sorted only within each stream, so sort them across streams.
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].timestamp > entries[j].timestamp
+	})
+	samples := make([]string, 0, maxSampleEntries)
+	for i := 0; i < len(entries) && i < maxSampleEntries; i++ {
+		samples = append(samples, formatEntry(entries[i].timestamp, entries[i].line))
+	}
+
+	countStr := strconv.Itoa(len(entries))
+	if len(entries) >= maxCountedEntries {
+		countStr = fmt.Sprintf("more than %d", maxCountedEntries)
+	}
+	reason := fmt.Sprintf("found %s log entries matched the query within the range (%s), latest entries: %s",
+		countStr, queryRange.String(), strings.Join(samples, ", "))
+	return false, reason, nil
+}
+
+func (p *Provider) queryRange(ctx context.Context, query string, queryRange log.QueryRange) (*queryRangeResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("start", strconv.FormatInt(queryRange.From.UnixNano(), 10))
+	params.Set("end", strconv.FormatInt(queryRange.To.UnixNano(), 10))
+	params.Set("limit", strconv.Itoa(maxCountedEntries))
+	params.Set("direction", "backward")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.address+queryRangePath+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.username != "" && p.password != "" {
+		req.SetBasicAuth(p.username, p.password)
+	}
+	if p.tenantID != "" {
+		req.Header.Set("X-Scope-OrgID", p.tenantID)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var out queryRangeResponse
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse the response: %w", err)
+	}
+	if out.Status != "success" {
+		return nil, fmt.Errorf("unexpected status %q in the response", out.Status)
+	}
+	return &out, nil
+}
+
+// formatEntry returns a single line representation of the given entry.
+func formatEntry(timestamp int64, line string) string {
+	line = strings.Join(strings.Fields(line), " ")
+	if len(line) > maxSampleLength {
+		line = line[:maxSampleLength] + "..."
+	}
+	ts := time.Unix(0, timestamp).UTC().Format(time.RFC3339)
+	return fmt.Sprintf("%q", fmt.Sprintf("%s %s", ts, line))
+}
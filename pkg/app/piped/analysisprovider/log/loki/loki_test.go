@@ -0,0 +1,154 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loki
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewProvider("")
+	assert.Error(t, err)
+
+	p, err := NewProvider("http://loki:3100/")
+	require.NoError(t, err)
+	assert.Equal(t, "http://loki:3100", p.address)
+}
+
+func TestProviderEvaluate(t *testing.T) {
+	t.Parallel()
+
+	queryRange := log.QueryRange{
+		From: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, time.January, 1, 0, 5, 0, 0, time.UTC),
+	}
+	ts := func(sec int) string {
+		return fmt.Sprintf("%d", queryRange.From.Add(time.Duration(sec)*time.Second).UnixNano())
+	}
+
+	testcases := []struct {
+		name          string
+		status        int
+		response      string
+		queryRange    log.QueryRange
+		expected      bool
+		reasonContain []string
+		wantErr       bool
+	}{
+		{
+			name:          "no entries matched",
+			response:      `{"status":"success","data":{"resultType":"streams","result":[]}}`,
+			queryRange:    queryRange,
+			expected:      true,
+			reasonContain: []string{"no log entry matched"},
+		},
+		{
+			name: "some entries matched",
+			response: `{"status":"success","data":{"resultType":"streams","result":[
+				{"stream":{"app":"foo","pod":"foo-1"},"values":[["` + ts(50) + `","connection refused"],["` + ts(10) + `","not shown"]]},
+				{"stream":{"app":"foo","pod":"foo-2"},"values":[["` + ts(60) + `","panic: nil pointer dereference"],["` + ts(40) + `","timeout"]]}
+			]}}`,
+			queryRange: queryRange,
+			expected:   false,
+			reasonContain: []string{
+				"found 4 log entries",
+				`"2024-01-01T00:01:00Z panic: nil pointer dereference", "2024-01-01T00:00:50Z connection refused", "2024-01-01T00:00:40Z timeout"`,
+			},
+		},
+		{
+			name:       "metric query is not supported",
+			response:   `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name:       "query failed",
+			status:     http.StatusBadRequest,
+			response:   `parse error at line 1, col 1: syntax error`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name:       "invalid timestamp",
+			response:   `{"status":"success","data":{"resultType":"streams","result":[{"stream":{},"values":[["invalid","error"]]}]}}`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name: "invalid query range",
+			queryRange: log.QueryRange{
+				From: queryRange.To,
+				To:   queryRange.From,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got url.Values
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, queryRangePath, r.URL.Path)
+				user, pass, ok := r.BasicAuth()
+				assert.True(t, ok)
+				assert.Equal(t, "user", user)
+				assert.Equal(t, "pass", pass)
+				assert.Equal(t, "tenant", r.Header.Get("X-Scope-OrgID"))
+
+				got = r.URL.Query()
+				if tc.status != 0 {
+					w.WriteHeader(tc.status)
+				}
+				fmt.Fprint(w, tc.response)
+			}))
+			defer server.Close()
+
+			p, err := NewProvider(server.URL, WithBasicAuth("user", "pass"), WithTenantID("tenant"))
+			require.NoError(t, err)
+
+			result, reason, err := p.Evaluate(context.Background(), `{app="foo"} |= "error"`, tc.queryRange)
+			assert.Equal(t, tc.wantErr, err != nil, "%v", err)
+			if tc.wantErr {
+				return
+			}
+			assert.Equal(t, tc.expected, result)
+			for _, s := range tc.reasonContain {
+				assert.Contains(t, reason, s)
+			}
+			assert.NotContains(t, reason, "not shown")
+
+			assert.Equal(t, `{app="foo"} |= "error"`, got.Get("query"))
+			assert.Equal(t, fmt.Sprintf("%d", queryRange.From.UnixNano()), got.Get("start"))
+			assert.Equal(t, fmt.Sprintf("%d", queryRange.To.UnixNano()), got.Get("end"))
+			assert.Equal(t, "1000", got.Get("limit"))
+			assert.Equal(t, "backward", got.Get("direction"))
+		})
+	}
+}
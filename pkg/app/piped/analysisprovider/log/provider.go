@@ -16,13 +16,44 @@ package log
 
 import (
 	"context"
+	"fmt"
+	"time"
 )
 
+const timeFormat = "2006-01-02 15:04:05 MST"
+
 // Provider represents a client for log provider which provides logs for analysis.
 type Provider interface {
 	Type() string
-	// Evaluate runs the given query against the log provider,
+	// Evaluate runs the given query against the log provider within the given range,
 	// and then checks if there is at least one error log.
+	// The result is true only when no log entry matched the query.
 	// Returns the result reason if non-error occurred.
-	Evaluate(ctx context.Context, query string) (result bool, reason string, err error)
+	Evaluate(ctx context.Context, query string, queryRange QueryRange) (result bool, reason string, err error)
+}
+
+// QueryRange represents a sliced time range.
+type QueryRange struct {
+	// Required: Start of the queried time period
+	From time.Time
+	// End of the queried time period. Defaults to the current time.
+	To time.Time
+}
+
+func (q *QueryRange) String() string {
+	// Timestamps are shown in UTC.
+	return fmt.Sprintf("from: %q, to: %q", q.From.UTC().Format(timeFormat), q.To.UTC().Format(timeFormat))
+}
+
+func (q *QueryRange) Validate() error {
+	if q.From.IsZero() {
+		return fmt.Errorf("start of the query range is required")
+	}
+	if q.To.IsZero() {
+		q.To = time.Now()
+	}
+	if q.From.After(q.To) {
+		return fmt.Errorf("\"to\" should be after \"from\"")
+	}
+	return nil
 }
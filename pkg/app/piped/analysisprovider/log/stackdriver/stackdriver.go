@@ -16,28 +16,191 @@ package stackdriver
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
+
+	"go.uber.org/zap"
+	logging "google.golang.org/api/logging/v2"
+	"google.golang.org/api/option"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+)
+
+const (
+	ProviderType   = "StackdriverLogging"
+	defaultTimeout = 30 * time.Second
+
+	// maxCountedEntries is the maximum number of entries counted in one evaluation.
+	maxCountedEntries = 1000
+	// maxSampleEntries is the maximum number of entries shown in the reason.
+	maxSampleEntries = 3
+	// maxSampleLength is the maximum length of each entry shown in the reason.
+	maxSampleLength = 200
 )
 
-const ProviderType = "StackdriverLogging"
+var errTooManyEntries = errors.New("too many entries")
 
 // Provider is a client for stackdriver.
 type Provider struct {
-	serviceAccount []byte
+	service   *logging.Service
+	projectID string
+
+	endpoint string
+	timeout  time.Duration
+	logger   *zap.Logger
+}
+
+type Option func(*Provider)
+
+// WithEndpoint overrides the endpoint of Cloud Logging API.
+func WithEndpoint(endpoint string) Option {
+	return func(p *Provider) {
+		p.endpoint = endpoint
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("stackdriver-logging-provider")
+	}
+}
 
-	timeout time.Duration
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
 }
 
-func NewProvider(serviceAccount []byte) (*Provider, error) {
-	return &Provider{
-		serviceAccount: serviceAccount,
-	}, nil
+// NewProvider returns a provider which queries the log entries of
+// the GCP project that the given service account belongs to.
+func NewProvider(ctx context.Context, serviceAccount []byte, opts ...Option) (*Provider, error) {
+	var sa struct {
+		ProjectID string `json:"project_id"`
+	}
+	if err := json.Unmarshal(serviceAccount, &sa); err != nil {
+		return nil, fmt.Errorf("failed to parse the service account: %w", err)
+	}
+	if sa.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is missing in the service account")
+	}
+
+	p := &Provider{
+		projectID: sa.ProjectID,
+		timeout:   defaultTimeout,
+		logger:    zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	options := []option.ClientOption{
+		option.WithCredentialsJSON(serviceAccount),
+		option.WithScopes(logging.LoggingReadScope),
+	}
+	if p.endpoint != "" {
+		options = append(options, option.WithEndpoint(p.endpoint))
+	}
+	service, err := logging.NewService(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cloud logging client: %w", err)
+	}
+	p.service = service
+	return p, nil
 }
 
 func (p *Provider) Type() string {
 	return ProviderType
 }
 
-func (p *Provider) Evaluate(ctx context.Context, query string) (bool, string, error) {
-	return false, "", nil
+// Evaluate counts the log entries matched the given query within the given range.
+// The query must be written in the Logging query language.
+// ref: https://cloud.google.com/logging/docs/view/logging-query-language
+func (p *Provider) Evaluate(ctx context.Context, query string, queryRange log.QueryRange) (bool, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if err := queryRange.Validate(); err != nil {
+		return false, "", err
+	}
+
+	req := &logging.ListLogEntriesRequest{
+		ResourceNames: []string{fmt.Sprintf("projects/%s", p.projectID)},
+		Filter:        buildFilter(query, queryRange),
+		OrderBy:       "timestamp desc",
+		PageSize:      maxCountedEntries,
+	}
+
+	var (
+		count   int
+		samples = make([]string, 0, maxSampleEntries)
+	)
+	err := p.service.Entries.List(req).Pages(ctx, func(resp *logging.ListLogEntriesResponse) error {
+		for _, e := range resp.Entries {
+			if len(samples) < maxSampleEntries {
+				samples = append(samples, formatEntry(e))
+			}
+			count++
+		}
+		if count >= maxCountedEntries {
+			return errTooManyEntries
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errTooManyEntries) {
+		p.logger.Error("failed to list log entries", zap.String("query", query), zap.Error(err))
+		return false, "", fmt.Errorf("failed to list log entries: %w", err)
+	}
+
+	if count == 0 {
+		return true, fmt.Sprintf("no log entry matched the query within the range (%s)", queryRange.String()), nil
+	}
+
+	countStr := fmt.Sprintf("%d", count)
+	if errors.Is(err, errTooManyEntries) {
+		countStr = fmt.Sprintf("more than %d", maxCountedEntries)
+	}
+	reason := fmt.Sprintf("found %s log entries matched the query within the range (%s), latest entries: %s",
+		countStr, queryRange.String(), strings.Join(samples, ", "))
+	return false, reason, nil
+}
+
+// buildFilter limits the given query to the entries within the given range.
+func buildFilter(query string, queryRange log.QueryRange) string {
+	timeFilter := fmt.Sprintf(`timestamp >= "%s" AND timestamp <= "%s"`,
+		queryRange.From.UTC().Format(time.RFC3339),
+		queryRange.To.UTC().Format(time.RFC3339),
+	)
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return timeFilter
+	}
+	return fmt.Sprintf("(%s) AND %s", query, timeFilter)
+}
+
+// formatEntry returns a single line representation of the given entry.
+func formatEntry(e *logging.LogEntry) string {
+	var payload string
+	switch {
+	case e.TextPayload != "":
+		payload = e.TextPayload
+	case len(e.JsonPayload) > 0:
+		payload = string(e.JsonPayload)
+		var obj struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(e.JsonPayload, &obj); err == nil && obj.Message != "" {
+			payload = obj.Message
+		}
+	case len(e.ProtoPayload) > 0:
+		payload = string(e.ProtoPayload)
+	}
+
+	payload = strings.Join(strings.Fields(payload), " ")
+	if len(payload) > maxSampleLength {
+		payload = payload[:maxSampleLength] + "..."
+	}
+	return fmt.Sprintf("%q", fmt.Sprintf("%s %s %s", e.Timestamp, e.Severity, payload))
 }
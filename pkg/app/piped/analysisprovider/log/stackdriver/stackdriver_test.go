@@ -0,0 +1,266 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stackdriver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	logging "google.golang.org/api/logging/v2"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+)
+
+// fakeLoggingServer is a fake Cloud Logging API server
+// which returns the configured entries page by page.
+type fakeLoggingServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	pages    [][]*logging.LogEntry
+	status   int
+	requests []*logging.ListLogEntriesRequest
+}
+
+func newFakeLoggingServer(t *testing.T) *fakeLoggingServer {
+	s := &fakeLoggingServer{status: http.StatusOK}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"test-token","token_type":"Bearer","expires_in":3600}`)
+	})
+	mux.HandleFunc("/v2/entries:list", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			http.Error(w, "unauthenticated", http.StatusUnauthorized)
+			return
+		}
+		var req logging.ListLogEntriesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.requests = append(s.requests, &req)
+		if s.status != http.StatusOK {
+			w.WriteHeader(s.status)
+			fmt.Fprint(w, `{"error":{"code":400,"message":"invalid filter"}}`)
+			return
+		}
+
+		var page int
+		if req.PageToken != "" {
+			fmt.Sscanf(req.PageToken, "page-%d", &page)
+		}
+		resp := logging.ListLogEntriesResponse{}
+		if page < len(s.pages) {
+			resp.Entries = s.pages[page]
+		}
+		if page+1 < len(s.pages) {
+			resp.NextPageToken = fmt.Sprintf("page-%d", page+1)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&resp)
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newServiceAccount(t *testing.T, tokenURI string) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	sa, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "test-project",
+		"private_key_id": "key-id",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"client_email":   "piped@test-project.iam.gserviceaccount.com",
+		"client_id":      "1234",
+		"token_uri":      tokenURI,
+	})
+	require.NoError(t, err)
+	return sa
+}
+
+func makeEntries(n int) []*logging.LogEntry {
+	entries := make([]*logging.LogEntry, 0, n)
+	for i := 0; i < n; i++ {
+		entries = append(entries, &logging.LogEntry{
+			Timestamp:   fmt.Sprintf("2024-01-01T00:00:%02dZ", i%60),
+			Severity:    "ERROR",
+			TextPayload: fmt.Sprintf("error %d", i),
+		})
+	}
+	return entries
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewProvider(context.Background(), []byte("invalid"))
+	assert.Error(t, err)
+
+	_, err = NewProvider(context.Background(), []byte(`{"type":"service_account"}`))
+	assert.Error(t, err)
+}
+
+func TestProviderEvaluate(t *testing.T) {
+	t.Parallel()
+
+	queryRange := log.QueryRange{
+		From: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, time.January, 1, 0, 5, 0, 0, time.UTC),
+	}
+
+	testcases := []struct {
+		name          string
+		pages         [][]*logging.LogEntry
+		status        int
+		query         string
+		queryRange    log.QueryRange
+		expected      bool
+		reasonContain []string
+		wantFilter    string
+		wantErr       bool
+	}{
+		{
+			name:          "no entries matched",
+			query:         `severity>=ERROR`,
+			queryRange:    queryRange,
+			expected:      true,
+			reasonContain: []string{"no log entry matched"},
+			wantFilter:    `(severity>=ERROR) AND timestamp >= "2024-01-01T00:00:00Z" AND timestamp <= "2024-01-01T00:05:00Z"`,
+		},
+		{
+			name: "some entries matched",
+			pages: [][]*logging.LogEntry{
+				{
+					{
+						Timestamp:   "2024-01-01T00:01:00Z",
+						Severity:    "ERROR",
+						TextPayload: "connection refused",
+					},
+					{
+						Timestamp:   "2024-01-01T00:00:30Z",
+						Severity:    "CRITICAL",
+						JsonPayload: []byte(`{"message":"panic: nil pointer dereference","code":500}`),
+					},
+				},
+				{
+					{
+						Timestamp:    "2024-01-01T00:00:10Z",
+						Severity:     "ERROR",
+						ProtoPayload: []byte(`{"status":{"code":13}}`),
+					},
+					{
+						Timestamp:   "2024-01-01T00:00:05Z",
+						Severity:    "ERROR",
+						TextPayload: "not shown",
+					},
+				},
+			},
+			query:      `severity>=ERROR`,
+			queryRange: queryRange,
+			expected:   false,
+			reasonContain: []string{
+				"found 4 log entries",
+				"connection refused",
+				"panic: nil pointer dereference",
+				`{\"status\":{\"code\":13}}`,
+			},
+		},
+		{
+			name:          "too many entries matched",
+			pages:         [][]*logging.LogEntry{makeEntries(600), makeEntries(600), makeEntries(600)},
+			query:         `severity>=ERROR`,
+			queryRange:    queryRange,
+			expected:      false,
+			reasonContain: []string{"found more than 1000 log entries"},
+		},
+		{
+			name:       "empty query",
+			queryRange: queryRange,
+			expected:   true,
+			wantFilter: `timestamp >= "2024-01-01T00:00:00Z" AND timestamp <= "2024-01-01T00:05:00Z"`,
+		},
+		{
+			name:       "query failed",
+			status:     http.StatusBadRequest,
+			query:      `invalid query`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name:  "invalid query range",
+			query: `severity>=ERROR`,
+			queryRange: log.QueryRange{
+				From: queryRange.To,
+				To:   queryRange.From,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newFakeLoggingServer(t)
+			server.pages = tc.pages
+			if tc.status != 0 {
+				server.status = tc.status
+			}
+
+			p, err := NewProvider(context.Background(), newServiceAccount(t, server.URL+"/token"), WithEndpoint(server.URL))
+			require.NoError(t, err)
+
+			got, reason, err := p.Evaluate(context.Background(), tc.query, tc.queryRange)
+			assert.Equal(t, tc.wantErr, err != nil, "%v", err)
+			if tc.wantErr {
+				return
+			}
+			assert.Equal(t, tc.expected, got)
+			for _, s := range tc.reasonContain {
+				assert.Contains(t, reason, s)
+			}
+			assert.NotContains(t, reason, "not shown")
+
+			require.NotEmpty(t, server.requests)
+			assert.Equal(t, []string{"projects/test-project"}, server.requests[0].ResourceNames)
+			assert.Equal(t, "timestamp desc", server.requests[0].OrderBy)
+			if tc.wantFilter != "" {
+				assert.Equal(t, tc.wantFilter, server.requests[0].Filter)
+			}
+		})
+	}
+}
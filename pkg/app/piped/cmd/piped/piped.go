@@ -77,6 +77,8 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/cli"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/crypto"
+	"github.com/pipe-cd/pipecd/pkg/crypto/awskms"
+	"github.com/pipe-cd/pipecd/pkg/crypto/gcpkms"
 	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/model"
 	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
@@ -408,7 +410,7 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		})
 	}
 
-	decrypter, err := p.initializeSecretDecrypter(cfg)
+	decrypter, err := p.initializeSecretDecrypter(ctx, cfg)
 	if err != nil {
 		input.Logger.Error("failed to initialize secret decrypter", zap.Error(err))
 		return err
@@ -746,7 +748,7 @@ func (p *piped) loadConfig(ctx context.Context) (*config.PipedSpec, error) {
 	return extract(cfg)
 }
 
-func (p *piped) initializeSecretDecrypter(cfg *config.PipedSpec) (crypto.Decrypter, error) {
+func (p *piped) initializeSecretDecrypter(ctx context.Context, cfg *config.PipedSpec) (crypto.Decrypter, error) {
 	sm := cfg.SecretManagement
 	if sm == nil {
 		return nil, nil
@@ -768,16 +770,54 @@ func (p *piped) initializeSecretDecrypter(cfg *config.PipedSpec) (crypto.Decrypt
 		return decrypter, nil
 
 	case model.SecretManagementTypeGCPKMS:
-		return nil, fmt.Errorf("type %q is not implemented yet", sm.Type.String())
+		c, err := gcpkms.NewClient(ctx, sm.GCPKMS.KeyName, sm.GCPKMS.DecryptServiceAccountFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize GCP KMS client (%w)", err)
+		}
+		return crypto.NewHybridDecrypterWithKeyDecrypter(c), nil
+
+	case model.SecretManagementTypeAWSKMS:
+		c, err := newAWSKMSClient(ctx, sm.AWSKMS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize AWS KMS client (%w)", err)
+		}
+		return crypto.NewHybridDecrypterWithKeyDecrypter(c), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported secret management type: %s", sm.Type.String())
+	}
+}
+
+// loadSecretEncryptionKey returns the public key which is reported to the control-plane
+// to be used for encrypting the secrets of this piped.
+func loadSecretEncryptionKey(ctx context.Context, sm *config.SecretManagement) ([]byte, error) {
+	switch sm.Type {
+	case model.SecretManagementTypeKeyPair:
+		return sm.KeyPair.LoadPublicKey()
+
+	case model.SecretManagementTypeGCPKMS:
+		c, err := gcpkms.NewClient(ctx, sm.GCPKMS.KeyName, sm.GCPKMS.EncryptServiceAccountFile)
+		if err != nil {
+			return nil, err
+		}
+		return c.PublicKey(ctx)
 
 	case model.SecretManagementTypeAWSKMS:
-		return nil, fmt.Errorf("type %q is not implemented yet", sm.Type.String())
+		c, err := newAWSKMSClient(ctx, sm.AWSKMS)
+		if err != nil {
+			return nil, err
+		}
+		return c.PublicKey(ctx)
 
 	default:
 		return nil, fmt.Errorf("unsupported secret management type: %s", sm.Type.String())
 	}
 }
 
+func newAWSKMSClient(ctx context.Context, cfg *config.SecretManagementAWSKMS) (*awskms.Client, error) {
+	return awskms.NewClient(ctx, cfg.KeyID, cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile)
+}
+
 func (p *piped) sendPipedMeta(ctx context.Context, client pipedservice.Client, cfg *config.PipedSpec, logger *zap.Logger) error {
 	repos := make([]*model.ApplicationGitRepository, 0, len(cfg.Repositories))
 	for _, r := range cfg.Repositories {
@@ -818,8 +858,8 @@ func (p *piped) sendPipedMeta(ctx context.Context, client pipedservice.Client, c
 	}
 
 	// Configure secret management.
-	if sm := cfg.SecretManagement; sm != nil && sm.Type == model.SecretManagementTypeKeyPair {
-		publicKey, err := sm.KeyPair.LoadPublicKey()
+	if sm := cfg.SecretManagement; sm != nil && sm.Type != model.SecretManagementTypeNone {
+		publicKey, err := loadSecretEncryptionKey(ctx, sm)
 		if err != nil {
 			return fmt.Errorf("failed to read public key for secret management (%w)", err)
 		}
@@ -0,0 +1,102 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/analysis"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// startGateAnalysis starts running the analysis of the gate before the block of the given deployment
+// unless it is already running. The result is reported to the control-plane after the analysis finished.
+func (c *controller) startGateAnalysis(ctx context.Context, d *model.Deployment, gate *model.ChainBlockGate) {
+	key := fmt.Sprintf("%s/%d", d.DeploymentChainId, d.DeploymentChainBlockIndex)
+
+	c.gateAnalysesMu.Lock()
+	defer c.gateAnalysesMu.Unlock()
+	if _, ok := c.gateAnalyses[key]; ok {
+		return
+	}
+	c.gateAnalyses[key] = struct{}{}
+
+	logger := c.logger.With(
+		zap.String("deployment-chain", d.DeploymentChainId),
+		zap.Uint32("block-index", d.DeploymentChainBlockIndex),
+		zap.String("analysis-template", gate.AnalysisTemplate),
+	)
+	logger.Info("start running analysis of deployment chain gate")
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer func() {
+			c.gateAnalysesMu.Lock()
+			delete(c.gateAnalyses, key)
+			c.gateAnalysesMu.Unlock()
+		}()
+
+		passed, reason := true, ""
+		if err := c.runGateAnalysis(ctx, d, gate, logger); err != nil {
+			// The analysis will be started again at the next sync since the gate is still waiting for its result.
+			if ctx.Err() != nil {
+				return
+			}
+			passed, reason = false, err.Error()
+		}
+		if err := c.reportGateAnalysisResult(ctx, d, passed, reason); err != nil {
+			logger.Error("failed to report the analysis result of deployment chain gate", zap.Error(err))
+			return
+		}
+		logger.Info("reported the analysis result of deployment chain gate", zap.Bool("passed", passed))
+	}()
+}
+
+func (c *controller) runGateAnalysis(ctx context.Context, d *model.Deployment, gate *model.ChainBlockGate, logger *zap.Logger) error {
+	var options config.AnalysisStageOptions
+	if err := json.Unmarshal(gate.AnalysisConfig, &options); err != nil {
+		return fmt.Errorf("failed to decode the analysis config: %w", err)
+	}
+	return analysis.RunChainGateAnalysis(ctx, &options, c.pipedConfig, d.ApplicationName, logger)
+}
+
+func (c *controller) reportGateAnalysisResult(ctx context.Context, d *model.Deployment, passed bool, reason string) error {
+	var (
+		err error
+		req = &pipedservice.ReportDeploymentChainGateAnalysisResultRequest{
+			DeploymentId:              d.Id,
+			DeploymentChainId:         d.DeploymentChainId,
+			DeploymentChainBlockIndex: d.DeploymentChainBlockIndex,
+			Passed:                    passed,
+			Reason:                    reason,
+		}
+		retry = pipedservice.NewRetry(10)
+	)
+
+	for retry.WaitNext(ctx) {
+		if _, err = c.apiClient.ReportDeploymentChainGateAnalysisResult(ctx, req); err == nil {
+			return nil
+		}
+		err = fmt.Errorf("failed to report the analysis result to control-plane: %w", err)
+	}
+	return err
+}
@@ -58,6 +58,8 @@ type apiClient interface {
 	ReportStageLogsFromLastCheckpoint(ctx context.Context, in *pipedservice.ReportStageLogsFromLastCheckpointRequest, opts ...grpc.CallOption) (*pipedservice.ReportStageLogsFromLastCheckpointResponse, error)
 
 	InChainDeploymentPlannable(ctx context.Context, in *pipedservice.InChainDeploymentPlannableRequest, opts ...grpc.CallOption) (*pipedservice.InChainDeploymentPlannableResponse, error)
+	GetProjectFreezeWindows(ctx context.Context, in *pipedservice.GetProjectFreezeWindowsRequest, opts ...grpc.CallOption) (*pipedservice.GetProjectFreezeWindowsResponse, error)
+	ReportDeploymentChainGateAnalysisResult(ctx context.Context, in *pipedservice.ReportDeploymentChainGateAnalysisResultRequest, opts ...grpc.CallOption) (*pipedservice.ReportDeploymentChainGateAnalysisResultResponse, error)
 }
 
 type gitClient interface {
@@ -136,6 +138,9 @@ type controller struct {
 	// Map from application ID to its most recently successful commit hash.
 	mostRecentlySuccessfulCommits         map[string]string
 	mostRecentlySuccessfulConfigFilenames map[string]string
+	// Set of the keys of deployment chain gates whose analysis is running.
+	gateAnalyses   map[string]struct{}
+	gateAnalysesMu sync.Mutex
 	// WaitGroup for waiting the completions of all planners, schedulers.
 	wg sync.WaitGroup
 
@@ -191,6 +196,7 @@ func NewController(
 		doneSchedulers:                        make(map[string]time.Time),
 		mostRecentlySuccessfulCommits:         make(map[string]string),
 		mostRecentlySuccessfulConfigFilenames: make(map[string]string),
+		gateAnalyses:                          make(map[string]struct{}),
 
 		workingDirRemovalCh: make(chan string),
 
@@ -707,6 +713,9 @@ func (c *controller) shouldStartPlanningDeployment(ctx context.Context, d *model
 	if err != nil {
 		return
 	}
+	if resp.GateToAnalyze != nil {
+		c.startGateAnalysis(ctx, d, resp.GateToAnalyze)
+	}
 	plannable = resp.Plannable
 	cancel = resp.Cancel
 	cancelReason = resp.CancelReason
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"path/filepath"
 	"time"
 
@@ -208,6 +209,16 @@ func (p *planner) Run(ctx context.Context) error {
 		controllermetrics.UpdateDeploymentStatus(p.deployment, p.doneDeploymentStatus)
 	}()
 
+	// The deployment triggered before the freeze window started should not be planned while the window is active.
+	if !p.deployment.Trigger.IgnoreFreezeWindows {
+		if w, frozen := p.findActiveFreezeWindow(ctx, in.TargetDSP); frozen {
+			p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_CANCELLED
+			desc := fmt.Sprintf("Deployment was cancelled because it was going to be planned during a freeze window. %s", w.Description())
+			span.SetStatus(codes.Error, desc)
+			return p.reportDeploymentCancelled(ctx, "", desc)
+		}
+	}
+
 	planner, ok := p.plannerRegistry.Planner(p.deployment.Kind)
 	if !ok {
 		p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_FAILURE
@@ -259,16 +270,17 @@ func (p *planner) reportDeploymentPlanned(ctx context.Context, out pln.Output) e
 		}
 	)
 
-	users, groups, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED)
+	users, groups, teamsUsers, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED)
 
 	defer func() {
 		p.notifier.Notify(model.NotificationEvent{
 			Type: model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED,
 			Metadata: &model.NotificationEventDeploymentPlanned{
-				Deployment:        p.deployment,
-				Summary:           out.Summary,
-				MentionedAccounts: users,
-				MentionedGroups:   groups,
+				Deployment:          p.deployment,
+				Summary:             out.Summary,
+				MentionedAccounts:   users,
+				MentionedGroups:     groups,
+				MentionedTeamsUsers: teamsUsers,
 			},
 		})
 	}()
@@ -302,7 +314,7 @@ func (p *planner) reportDeploymentFailed(ctx context.Context, reason string) err
 		retry = pipedservice.NewRetry(10)
 	)
 
-	users, groups, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_FAILED)
+	users, groups, teamsUsers, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_FAILED)
 	if err != nil {
 		p.logger.Error("failed to get the list of users or groups", zap.Error(err))
 	}
@@ -311,10 +323,11 @@ func (p *planner) reportDeploymentFailed(ctx context.Context, reason string) err
 		p.notifier.Notify(model.NotificationEvent{
 			Type: model.NotificationEventType_EVENT_DEPLOYMENT_FAILED,
 			Metadata: &model.NotificationEventDeploymentFailed{
-				Deployment:        p.deployment,
-				Reason:            reason,
-				MentionedAccounts: users,
-				MentionedGroups:   groups,
+				Deployment:          p.deployment,
+				Reason:              reason,
+				MentionedAccounts:   users,
+				MentionedGroups:     groups,
+				MentionedTeamsUsers: teamsUsers,
 			},
 		})
 	}()
@@ -348,7 +361,7 @@ func (p *planner) reportDeploymentCancelled(ctx context.Context, commander, reas
 		retry = pipedservice.NewRetry(10)
 	)
 
-	users, groups, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
+	users, groups, teamsUsers, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
 	if err != nil {
 		p.logger.Error("failed to get the list of users or groups", zap.Error(err))
 	}
@@ -357,10 +370,11 @@ func (p *planner) reportDeploymentCancelled(ctx context.Context, commander, reas
 		p.notifier.Notify(model.NotificationEvent{
 			Type: model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED,
 			Metadata: &model.NotificationEventDeploymentCancelled{
-				Deployment:        p.deployment,
-				Commander:         commander,
-				MentionedAccounts: users,
-				MentionedGroups:   groups,
+				Deployment:          p.deployment,
+				Commander:           commander,
+				MentionedAccounts:   users,
+				MentionedGroups:     groups,
+				MentionedTeamsUsers: teamsUsers,
 			},
 		})
 	}()
@@ -378,17 +392,51 @@ func (p *planner) reportDeploymentCancelled(ctx context.Context, commander, reas
 	return err
 }
 
-// getApplicationNotificationMentions returns the list of users groups who should be mentioned in the notification.
-func (p *planner) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, error) {
+// findActiveFreezeWindow returns the freeze window configured in the project or the application
+// which is active at the moment.
+func (p *planner) findActiveFreezeWindow(ctx context.Context, dsp deploysource.Provider) (*config.DeploymentFreezeWindow, bool) {
+	now := p.nowFunc()
+	if w, ok := p.findActiveProjectFreezeWindow(ctx, now); ok {
+		return w, true
+	}
+
+	ds, err := dsp.GetReadOnly(ctx, io.Discard)
+	if err != nil {
+		// The error will be reported while planning the deployment.
+		p.logger.Warn("unable to load the application config to check its freeze windows", zap.Error(err))
+		return nil, false
+	}
+	return config.FindActiveFreezeWindow(ds.GenericApplicationConfig.FreezeWindows, now)
+}
+
+// findActiveProjectFreezeWindow returns the freeze window configured in the project
+// which is active at the given time.
+func (p *planner) findActiveProjectFreezeWindow(ctx context.Context, now time.Time) (*config.DeploymentFreezeWindow, bool) {
+	resp, err := p.apiClient.GetProjectFreezeWindows(ctx, &pipedservice.GetProjectFreezeWindowsRequest{})
+	if err != nil {
+		// The deployment was already checked by the trigger, so it is planned as usual.
+		p.logger.Warn("unable to get the freeze windows of the project", zap.Error(err))
+		return nil, false
+	}
+	windows, err := config.NewProjectFreezeWindows(resp.FreezeWindows)
+	if err != nil {
+		p.logger.Warn("invalid freeze windows are configured in the project", zap.Error(err))
+		return nil, false
+	}
+	return config.FindActiveFreezeWindow(windows, now)
+}
+
+// getApplicationNotificationMentions returns the list of users and groups who should be mentioned in the notification.
+func (p *planner) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, []string, error) {
 	n, ok := p.metadataStore.Shared().Get(model.MetadataKeyDeploymentNotification)
 	if !ok {
-		return []string{}, []string{}, nil
+		return []string{}, []string{}, []string{}, nil
 	}
 
 	var notification config.DeploymentNotification
 	if err := json.Unmarshal([]byte(n), &notification); err != nil {
-		return nil, nil, fmt.Errorf("could not extract mentions config: %w", err)
+		return nil, nil, nil, fmt.Errorf("could not extract mentions config: %w", err)
 	}
 
-	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), nil
+	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), notification.FindTeamsUsers(event), nil
 }
@@ -218,7 +218,7 @@ func (s *scheduler) Run(ctx context.Context) error {
 		controllermetrics.UpdateDeploymentStatus(s.deployment, model.DeploymentStatus_DEPLOYMENT_RUNNING)
 
 		// notify the deployment started event
-		users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_STARTED)
+		users, groups, teamsUsers, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_STARTED)
 		if err != nil {
 			s.logger.Error("failed to get the list of users or groups", zap.Error(err))
 		}
@@ -226,9 +226,10 @@ func (s *scheduler) Run(ctx context.Context) error {
 		s.notifier.Notify(model.NotificationEvent{
 			Type: model.NotificationEventType_EVENT_DEPLOYMENT_STARTED,
 			Metadata: &model.NotificationEventDeploymentStarted{
-				Deployment:        s.deployment,
-				MentionedAccounts: users,
-				MentionedGroups:   groups,
+				Deployment:          s.deployment,
+				MentionedAccounts:   users,
+				MentionedGroups:     groups,
+				MentionedTeamsUsers: teamsUsers,
 			},
 		})
 	}
@@ -733,7 +734,7 @@ func (s *scheduler) reportDeploymentCompleted(ctx context.Context, status model.
 	defer func() {
 		switch status {
 		case model.DeploymentStatus_DEPLOYMENT_SUCCESS:
-			users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED)
+			users, groups, teamsUsers, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED)
 			if err != nil {
 				s.logger.Error("failed to get the list of users or groups", zap.Error(err))
 			}
@@ -741,14 +742,15 @@ func (s *scheduler) reportDeploymentCompleted(ctx context.Context, status model.
 			s.notifier.Notify(model.NotificationEvent{
 				Type: model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED,
 				Metadata: &model.NotificationEventDeploymentSucceeded{
-					Deployment:        s.deployment,
-					MentionedAccounts: users,
-					MentionedGroups:   groups,
+					Deployment:          s.deployment,
+					MentionedAccounts:   users,
+					MentionedGroups:     groups,
+					MentionedTeamsUsers: teamsUsers,
 				},
 			})
 
 		case model.DeploymentStatus_DEPLOYMENT_FAILURE:
-			users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_FAILED)
+			users, groups, teamsUsers, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_FAILED)
 			if err != nil {
 				s.logger.Error("failed to get the list of users or groups", zap.Error(err))
 			}
@@ -756,15 +758,16 @@ func (s *scheduler) reportDeploymentCompleted(ctx context.Context, status model.
 			s.notifier.Notify(model.NotificationEvent{
 				Type: model.NotificationEventType_EVENT_DEPLOYMENT_FAILED,
 				Metadata: &model.NotificationEventDeploymentFailed{
-					Deployment:        s.deployment,
-					Reason:            desc,
-					MentionedAccounts: users,
-					MentionedGroups:   groups,
+					Deployment:          s.deployment,
+					Reason:              desc,
+					MentionedAccounts:   users,
+					MentionedGroups:     groups,
+					MentionedTeamsUsers: teamsUsers,
 				},
 			})
 
 		case model.DeploymentStatus_DEPLOYMENT_CANCELLED:
-			users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
+			users, groups, teamsUsers, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
 			if err != nil {
 				s.logger.Error("failed to get the list of users", zap.Error(err))
 			}
@@ -772,10 +775,11 @@ func (s *scheduler) reportDeploymentCompleted(ctx context.Context, status model.
 			s.notifier.Notify(model.NotificationEvent{
 				Type: model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED,
 				Metadata: &model.NotificationEventDeploymentCancelled{
-					Deployment:        s.deployment,
-					Commander:         cancelCommander,
-					MentionedAccounts: users,
-					MentionedGroups:   groups,
+					Deployment:          s.deployment,
+					Commander:           cancelCommander,
+					MentionedAccounts:   users,
+					MentionedGroups:     groups,
+					MentionedTeamsUsers: teamsUsers,
 				},
 			})
 		}
@@ -792,18 +796,18 @@ func (s *scheduler) reportDeploymentCompleted(ctx context.Context, status model.
 	return err
 }
 
-// getApplicationNotificationMentions returns the list of users groups who should be mentioned in the notification.
-func (s *scheduler) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, error) {
+// getApplicationNotificationMentions returns the list of users and groups who should be mentioned in the notification.
+func (s *scheduler) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, []string, error) {
 	n, ok := s.metadataStore.Shared().Get(model.MetadataKeyDeploymentNotification)
 	if !ok {
-		return []string{}, []string{}, nil
+		return []string{}, []string{}, []string{}, nil
 	}
 	var notification config.DeploymentNotification
 	if err := json.Unmarshal([]byte(n), &notification); err != nil {
-		return nil, nil, fmt.Errorf("could not extract mentions config: %w", err)
+		return nil, nil, nil, fmt.Errorf("could not extract mentions config: %w", err)
 	}
 
-	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), nil
+	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), notification.FindTeamsUsers(event), nil
 }
 
 func (s *scheduler) reportMostRecentlySuccessfulDeployment(ctx context.Context) error {
@@ -226,13 +226,20 @@ func (e *Executor) newAnalyzerForLog(i int, templatable *config.TemplatableAnaly
 	if err != nil {
 		return nil, err
 	}
-	provider, err := e.newLogProvider(cfg.Provider)
+	provider, err := e.newLogProvider(cfg)
 	if err != nil {
 		return nil, err
 	}
 	id := fmt.Sprintf("log-%d", i)
+	interval := time.Duration(cfg.Interval)
 	runner := func(ctx context.Context, query string) (bool, string, error) {
-		return provider.Evaluate(ctx, query)
+		// Evaluate the log entries written since the previous evaluation.
+		now := time.Now()
+		queryRange := log.QueryRange{
+			From: now.Add(-interval),
+			To:   now,
+		}
+		return provider.Evaluate(ctx, query, queryRange)
 	}
 	return newAnalyzer(id, provider.Type(), cfg.Query, runner, time.Duration(cfg.Interval), cfg.FailureLimit, cfg.SkipOnNoData, e.Logger, e.LogPersister), nil
 }
@@ -262,12 +269,12 @@ func (e *Executor) newMetricsProvider(providerName string, templatable config.Te
 	return provider, nil
 }
 
-func (e *Executor) newLogProvider(providerName string) (log.Provider, error) {
-	cfg, ok := e.PipedConfig.GetAnalysisProvider(providerName)
+func (e *Executor) newLogProvider(analysisCfg *config.AnalysisLog) (log.Provider, error) {
+	cfg, ok := e.PipedConfig.GetAnalysisProvider(analysisCfg.Provider)
 	if !ok {
-		return nil, fmt.Errorf("unknown provider name %s", providerName)
+		return nil, fmt.Errorf("unknown provider name %s", analysisCfg.Provider)
 	}
-	provider, err := logfactory.NewProvider(&cfg, e.Logger)
+	provider, err := logfactory.NewProvider(context.Background(), analysisCfg, &cfg, e.Logger)
 	if err != nil {
 		return nil, err
 	}
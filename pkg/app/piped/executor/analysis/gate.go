@@ -0,0 +1,140 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	httpprovider "github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/http"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log"
+	logfactory "github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log/factory"
+	metricsfactory "github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/factory"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// RunChainGateAnalysis runs the given analyses for the gate of a block in deployment chain
+// with the analysis providers configured in piped.
+// The analyses must be resolved from the analysis template beforehand,
+// and only the THRESHOLD strategy is available for the metrics analyses.
+// It returns an error when any of the analyses failed during the given duration.
+func RunChainGateAnalysis(ctx context.Context, options *config.AnalysisStageOptions, pipedCfg *config.PipedSpec, appName string, logger *zap.Logger) error {
+	var (
+		startTime    = time.Now()
+		logPersister = &gateLogPersister{logger: logger}
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, options.Duration.Duration())
+	defer cancel()
+	eg, ctx := errgroup.WithContext(ctx)
+
+	for i := range options.Metrics {
+		cfg := options.Metrics[i]
+		if cfg.Strategy != config.AnalysisStrategyThreshold {
+			return fmt.Errorf("strategy %s is not available for deployment chain gate", cfg.Strategy)
+		}
+		providerCfg, ok := pipedCfg.GetAnalysisProvider(cfg.Provider)
+		if !ok {
+			return fmt.Errorf("unknown provider name %s", cfg.Provider)
+		}
+		provider, err := metricsfactory.NewProvider(&cfg, &providerCfg, logger)
+		if err != nil {
+			return fmt.Errorf("failed to generate metrics provider: %w", err)
+		}
+		args := argsTemplate{
+			App:           appArgs{Name: appName},
+			AppCustomArgs: cfg.Template.AppArgs,
+		}
+		analyzer := newMetricsAnalyzer(fmt.Sprintf("metrics-%d", i), cfg.AnalysisMetrics, startTime, provider, nil, args, logger, logPersister)
+		eg.Go(func() error {
+			return analyzer.run(ctx)
+		})
+	}
+	for i := range options.Logs {
+		cfg := options.Logs[i].AnalysisLog
+		providerCfg, ok := pipedCfg.GetAnalysisProvider(cfg.Provider)
+		if !ok {
+			return fmt.Errorf("unknown provider name %s", cfg.Provider)
+		}
+		provider, err := logfactory.NewProvider(ctx, &cfg, &providerCfg, logger)
+		if err != nil {
+			return fmt.Errorf("failed to generate log provider: %w", err)
+		}
+		interval := time.Duration(cfg.Interval)
+		runner := func(ctx context.Context, query string) (bool, string, error) {
+			now := time.Now()
+			return provider.Evaluate(ctx, query, log.QueryRange{
+				From: now.Add(-interval),
+				To:   now,
+			})
+		}
+		analyzer := newAnalyzer(fmt.Sprintf("log-%d", i), provider.Type(), cfg.Query, runner, interval, cfg.FailureLimit, cfg.SkipOnNoData, logger, logPersister)
+		eg.Go(func() error {
+			return analyzer.run(ctx)
+		})
+	}
+	for i := range options.HTTPS {
+		cfg := options.HTTPS[i].AnalysisHTTP
+		provider := httpprovider.NewProvider(time.Duration(cfg.Timeout))
+		runner := func(ctx context.Context, _ string) (bool, string, error) {
+			return provider.Run(ctx, &cfg)
+		}
+		analyzer := newAnalyzer(fmt.Sprintf("http-%d", i), provider.Type(), "", runner, time.Duration(cfg.Interval), cfg.FailureLimit, cfg.SkipOnNoData, logger, logPersister)
+		eg.Go(func() error {
+			return analyzer.run(ctx)
+		})
+	}
+
+	return eg.Wait()
+}
+
+// gateLogPersister writes the logs of the analyzers into the piped logger
+// since the gate analysis does not belong to any stage.
+type gateLogPersister struct {
+	logger *zap.Logger
+}
+
+func (p *gateLogPersister) Write(log []byte) (int, error) {
+	p.logger.Info(string(log))
+	return len(log), nil
+}
+
+func (p *gateLogPersister) Info(log string) {
+	p.logger.Info(log)
+}
+
+func (p *gateLogPersister) Infof(format string, a ...interface{}) {
+	p.logger.Info(fmt.Sprintf(format, a...))
+}
+
+func (p *gateLogPersister) Success(log string) {
+	p.logger.Info(log)
+}
+
+func (p *gateLogPersister) Successf(format string, a ...interface{}) {
+	p.logger.Info(fmt.Sprintf(format, a...))
+}
+
+func (p *gateLogPersister) Error(log string) {
+	p.logger.Error(log)
+}
+
+func (p *gateLogPersister) Errorf(format string, a ...interface{}) {
+	p.logger.Error(fmt.Sprintf(format, a...))
+}
@@ -110,7 +110,7 @@ func (e *Executor) checkApproval(ctx context.Context, num int) bool {
 }
 
 func (e *Executor) reportApproved(approver string) {
-	users, groups, err := e.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED)
+	users, groups, teamsUsers, err := e.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED)
 	if err != nil {
 		e.Logger.Error("failed to get the list of users or groups", zap.Error(err))
 	}
@@ -118,16 +118,17 @@ func (e *Executor) reportApproved(approver string) {
 	e.Notifier.Notify(model.NotificationEvent{
 		Type: model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED,
 		Metadata: &model.NotificationEventDeploymentApproved{
-			Deployment:        e.Deployment,
-			Approver:          approver,
-			MentionedAccounts: users,
-			MentionedGroups:   groups,
+			Deployment:          e.Deployment,
+			Approver:            approver,
+			MentionedAccounts:   users,
+			MentionedGroups:     groups,
+			MentionedTeamsUsers: teamsUsers,
 		},
 	})
 }
 
 func (e *Executor) reportRequiringApproval() {
-	users, groups, err := e.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL)
+	users, groups, teamsUsers, err := e.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL)
 	if err != nil {
 		e.Logger.Error("failed to get the list of users or groups", zap.Error(err))
 	}
@@ -135,26 +136,27 @@ func (e *Executor) reportRequiringApproval() {
 	e.Notifier.Notify(model.NotificationEvent{
 		Type: model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL,
 		Metadata: &model.NotificationEventDeploymentWaitApproval{
-			Deployment:        e.Deployment,
-			MentionedAccounts: users,
-			MentionedGroups:   groups,
+			Deployment:          e.Deployment,
+			MentionedAccounts:   users,
+			MentionedGroups:     groups,
+			MentionedTeamsUsers: teamsUsers,
 		},
 	})
 }
 
 // getMentionedUsers returns the list of users groups who should be mentioned in the notification.
-func (e *Executor) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, error) {
+func (e *Executor) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, []string, error) {
 	n, ok := e.MetadataStore.Shared().Get(model.MetadataKeyDeploymentNotification)
 	if !ok {
-		return []string{}, []string{}, nil
+		return []string{}, []string{}, []string{}, nil
 	}
 
 	var notification config.DeploymentNotification
 	if err := json.Unmarshal([]byte(n), &notification); err != nil {
-		return nil, nil, fmt.Errorf("could not extract mentions users and groups config: %w", err)
+		return nil, nil, nil, fmt.Errorf("could not extract mentions users and groups config: %w", err)
 	}
 
-	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), nil
+	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), notification.FindTeamsUsers(event), nil
 }
 
 // validateApproverNum checks if number of approves is valid.
@@ -0,0 +1,493 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	teamsCardSchema  = "http://adaptivecards.io/schemas/adaptive-card.json"
+	teamsCardVersion = "1.4"
+	teamsContentType = "application/vnd.microsoft.card.adaptive"
+
+	teamsInfoColor    = "Default"
+	teamsSuccessColor = "Good"
+	teamsErrorColor   = "Attention"
+	teamsWarnColor    = "Warning"
+)
+
+type teams struct {
+	name       string
+	config     config.NotificationReceiverTeams
+	webURL     string
+	httpClient *http.Client
+	eventCh    chan model.NotificationEvent
+	logger     *zap.Logger
+}
+
+func newTeamsSender(name string, cfg config.NotificationReceiverTeams, webURL string, logger *zap.Logger) *teams {
+	return &teams{
+		name:   name,
+		config: cfg,
+		webURL: strings.TrimRight(webURL, "/"),
+		httpClient: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		eventCh: make(chan model.NotificationEvent, 100),
+		logger:  logger.Named("teams").With(zap.String("name", name)),
+	}
+}
+
+func (t *teams) Run(ctx context.Context) error {
+	for {
+		select {
+		case event, ok := <-t.eventCh:
+			if ok {
+				t.sendEvent(ctx, event)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (t *teams) Notify(event model.NotificationEvent) {
+	t.eventCh <- event
+}
+
+func (t *teams) Close(ctx context.Context) {
+	close(t.eventCh)
+
+	// Send all remaining events.
+	for {
+		select {
+		case event, ok := <-t.eventCh:
+			if !ok {
+				return
+			}
+			t.sendEvent(ctx, event)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *teams) sendEvent(ctx context.Context, event model.NotificationEvent) {
+	msg, ok := t.buildTeamsMessage(event, t.webURL)
+	if !ok {
+		t.logger.Info(fmt.Sprintf("ignore event %s", event.Type.String()))
+		return
+	}
+	if err := t.sendMessage(ctx, msg); err != nil {
+		t.logger.Error(fmt.Sprintf("unable to send notification to teams: %v", err))
+	}
+}
+
+func (t *teams) sendMessage(ctx context.Context, msg teamsMessage) error {
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(msg); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", t.config.HookURL, buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+		return fmt.Errorf("%s from Teams: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+func (t *teams) buildTeamsMessage(event model.NotificationEvent, webURL string) (teamsMessage, bool) {
+	var (
+		title, link, text string
+		color             = teamsInfoColor
+		facts             []teamsFact
+		mentions          []string
+	)
+
+	generateDeploymentEventData := func(d *model.Deployment, users []string) {
+		mentions = users
+		link = fmt.Sprintf("%s/deployments/%s?project=%s", webURL, d.Id, d.ProjectId)
+		facts = []teamsFact{
+			{"Project", truncateText(d.ProjectId, 8)},
+			{"Application", makeTeamsLink(d.ApplicationName, fmt.Sprintf("%s/applications/%s?project=%s", webURL, d.ApplicationId, d.ProjectId))},
+			{"Kind", strings.ToLower(d.Kind.String())},
+			{"Deployment", makeTeamsLink(truncateText(d.Id, 8), link)},
+			{"Triggered By", d.TriggeredBy()},
+			{"Mention To Users", getTeamsMentionsAsString(users)},
+			{"Started At", makeTeamsDate(d.CreatedAt)},
+		}
+	}
+
+	generateDeploymentEventDataForTriggerFailed := func(app *model.Application, hash string, msg string, users []string) {
+		mentions = users
+		link = fmt.Sprintf("%s/applications/%s?project=%s", webURL, app.Id, app.ProjectId)
+		commitURL, err := git.MakeCommitURL(app.GitPath.Repo.Remote, hash)
+		if err != nil {
+			t.logger.Error(fmt.Sprintf("failed to get the URL for the specified commit: %v", err))
+		}
+		facts = []teamsFact{
+			{"Project", truncateText(app.ProjectId, 8)},
+			{"Application", makeTeamsLink(app.Name, link)},
+			{"Kind", strings.ToLower(app.Kind.String())},
+			{"Mention To Users", getTeamsMentionsAsString(users)},
+		}
+		if commitURL != "" {
+			facts = append(facts, teamsFact{"Commit", makeTeamsLink(truncateText(msg, 8), commitURL)})
+		}
+	}
+
+	generateApplicationEventData := func(app *model.Application, users []string) {
+		mentions = users
+		link = fmt.Sprintf("%s/applications/%s?project=%s", webURL, app.Id, app.ProjectId)
+		facts = []teamsFact{
+			{"Project", truncateText(app.ProjectId, 8)},
+			{"Application", makeTeamsLink(app.Name, link)},
+			{"Kind", strings.ToLower(app.Kind.String())},
+			{"Mention To Users", getTeamsMentionsAsString(users)},
+		}
+	}
+
+	generatePipedEventData := func(id string, name string, version string, project string, users []string) {
+		mentions = users
+		link = fmt.Sprintf("%s/settings/piped?project=%s", webURL, project)
+		facts = []teamsFact{
+			{"Name", name},
+			{"Version", version},
+			{"Project", truncateText(project, 8)},
+			{"Id", id},
+			{"Mention To Users", getTeamsMentionsAsString(users)},
+		}
+	}
+
+	generateStageEventData := func(d *model.Deployment, s *model.PipelineStage, users []string) {
+		mentions = users
+		link = fmt.Sprintf("%s/deployments/%s?project=%s", webURL, d.Id, d.ProjectId)
+		facts = []teamsFact{
+			{"Project", truncateText(d.ProjectId, 8)},
+			{"Application", makeTeamsLink(d.ApplicationName, fmt.Sprintf("%s/applications/%s?project=%s", webURL, d.ApplicationId, d.ProjectId))},
+			{"Kind", strings.ToLower(d.Kind.String())},
+			{"Deployment", makeTeamsLink(truncateText(d.Id, 8), link)},
+			{"Stage", s.Name},
+			{"Triggered By", d.TriggeredBy()},
+			{"Mention To Users", getTeamsMentionsAsString(users)},
+		}
+	}
+
+	switch event.Type {
+	case model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED:
+		md := event.Metadata.(*model.NotificationEventDeploymentTriggered)
+		title = fmt.Sprintf("Triggered a new deployment for %q", md.Deployment.ApplicationName)
+		generateDeploymentEventData(md.Deployment, t.mentionedUsers(md.MentionedTeamsUsers))
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED:
+		md := event.Metadata.(*model.NotificationEventDeploymentPlanned)
+		title = fmt.Sprintf("Deployment for %q was planned", md.Deployment.ApplicationName)
+		text = md.Summary
+		generateDeploymentEventData(md.Deployment, t.mentionedUsers(md.MentionedTeamsUsers))
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_STARTED:
+		md := event.Metadata.(*model.NotificationEventDeploymentStarted)
+		title = fmt.Sprintf("Deployment for %q was started", md.Deployment.ApplicationName)
+		generateDeploymentEventData(md.Deployment, t.mentionedUsers(md.MentionedTeamsUsers))
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL:
+		md := event.Metadata.(*model.NotificationEventDeploymentWaitApproval)
+		title = fmt.Sprintf("Deployment for %q is waiting for an approval", md.Deployment.ApplicationName)
+		generateDeploymentEventData(md.Deployment, t.mentionedUsers(md.MentionedTeamsUsers))
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED:
+		md := event.Metadata.(*model.NotificationEventDeploymentApproved)
+		title = fmt.Sprintf("Deployment for %q was approved", md.Deployment.ApplicationName)
+		text = fmt.Sprintf("Approved by %s", md.Approver)
+		generateDeploymentEventData(md.Deployment, t.mentionedUsers(md.MentionedTeamsUsers))
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_ROLLING_BACK:
+		md := event.Metadata.(*model.NotificationEventDeploymentRollingBack)
+		title = fmt.Sprintf("Deployment for %q is rolling back", md.Deployment.ApplicationName)
+		color = teamsWarnColor
+		generateDeploymentEventData(md.Deployment, t.mentionedUsers(nil))
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED:
+		md := event.Metadata.(*model.NotificationEventDeploymentSucceeded)
+		title = fmt.Sprintf("Deployment for %q was completed successfully", md.Deployment.ApplicationName)
+		color = teamsSuccessColor
+		generateDeploymentEventData(md.Deployment, t.mentionedUsers(md.MentionedTeamsUsers))
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_FAILED:
+		md := event.Metadata.(*model.NotificationEventDeploymentFailed)
+		title = fmt.Sprintf("Deployment for %q was failed", md.Deployment.ApplicationName)
+		text = md.Reason
+		color = teamsErrorColor
+		generateDeploymentEventData(md.Deployment, t.mentionedUsers(md.MentionedTeamsUsers))
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED:
+		md := event.Metadata.(*model.NotificationEventDeploymentCancelled)
+		title = fmt.Sprintf("Deployment for %q was cancelled", md.Deployment.ApplicationName)
+		text = fmt.Sprintf("Cancelled by %s", md.Commander)
+		color = teamsWarnColor
+		generateDeploymentEventData(md.Deployment, t.mentionedUsers(md.MentionedTeamsUsers))
+
+	case model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGER_FAILED:
+		md := event.Metadata.(*model.NotificationEventDeploymentTriggerFailed)
+		title = fmt.Sprintf("Failed to trigger a new deployment for %s", md.Application.Name)
+		text = md.Reason
+		generateDeploymentEventDataForTriggerFailed(md.Application, md.CommitHash, md.CommitMessage, t.mentionedUsers(md.MentionedTeamsUsers))
+
+	case model.NotificationEventType_EVENT_APPLICATION_SYNCED:
+		md := event.Metadata.(*model.NotificationEventApplicationSynced)
+		title = fmt.Sprintf("Application %q was synced", md.Application.Name)
+		color = teamsSuccessColor
+		generateApplicationEventData(md.Application, t.mentionedUsers(nil))
+
+	case model.NotificationEventType_EVENT_APPLICATION_OUT_OF_SYNC:
+		md := event.Metadata.(*model.NotificationEventApplicationOutOfSync)
+		title = fmt.Sprintf("Application %q is out of sync", md.Application.Name)
+		text = md.State.GetShortReason()
+		color = teamsWarnColor
+		generateApplicationEventData(md.Application, t.mentionedUsers(nil))
+
+	case model.NotificationEventType_EVENT_PIPED_STARTED:
+		md := event.Metadata.(*model.NotificationEventPipedStarted)
+		title = "A piped has been started"
+		generatePipedEventData(md.Id, md.Name, md.Version, md.ProjectId, t.mentionedUsers(nil))
+
+	case model.NotificationEventType_EVENT_PIPED_STOPPED:
+		md := event.Metadata.(*model.NotificationEventPipedStopped)
+		title = "A piped has been stopped"
+		generatePipedEventData(md.Id, md.Name, md.Version, md.ProjectId, t.mentionedUsers(nil))
+
+	case model.NotificationEventType_EVENT_STAGE_STARTED:
+		md := event.Metadata.(*model.NotificationEventStageStarted)
+		title = fmt.Sprintf("Stage %q was started", md.Stage.Name)
+		generateStageEventData(md.Deployment, md.Stage, t.mentionedUsers(nil))
+
+	case model.NotificationEventType_EVENT_STAGE_SKIPPED:
+		md := event.Metadata.(*model.NotificationEventStageSkipped)
+		title = fmt.Sprintf("Stage %q was skipped", md.Stage.Name)
+		generateStageEventData(md.Deployment, md.Stage, t.mentionedUsers(nil))
+
+	case model.NotificationEventType_EVENT_STAGE_SUCCEEDED:
+		md := event.Metadata.(*model.NotificationEventStageSucceeded)
+		title = fmt.Sprintf("Stage %q was completed successfully", md.Stage.Name)
+		color = teamsSuccessColor
+		generateStageEventData(md.Deployment, md.Stage, t.mentionedUsers(nil))
+
+	case model.NotificationEventType_EVENT_STAGE_FAILED:
+		md := event.Metadata.(*model.NotificationEventStageFailed)
+		title = fmt.Sprintf("Stage %q was failed", md.Stage.Name)
+		text = md.Stage.StatusReason
+		color = teamsErrorColor
+		generateStageEventData(md.Deployment, md.Stage, t.mentionedUsers(nil))
+
+	case model.NotificationEventType_EVENT_STAGE_CANCELLED:
+		md := event.Metadata.(*model.NotificationEventStageCancelled)
+		title = fmt.Sprintf("Stage %q was cancelled", md.Stage.Name)
+		color = teamsWarnColor
+		generateStageEventData(md.Deployment, md.Stage, t.mentionedUsers(nil))
+
+	// EVENT_APPLICATION_HEALTHY has no metadata to be rendered yet.
+	default:
+		return teamsMessage{}, false
+	}
+
+	return makeTeamsMessage(title, link, text, color, mentions, facts...), true
+}
+
+// mentionedUsers returns the users mentioned by the event together with the ones configured in the receiver.
+func (t *teams) mentionedUsers(users []string) []string {
+	out := make([]string, 0, len(users)+len(t.config.MentionedAccounts))
+	seen := make(map[string]struct{}, cap(out))
+	for _, u := range append(users, t.config.MentionedAccounts...) {
+		if _, ok := seen[u]; ok {
+			continue
+		}
+		seen[u] = struct{}{}
+		out = append(out, u)
+	}
+	return out
+}
+
+// teamsMessage is the payload accepted by both the incoming webhook and the Workflows webhook trigger of Microsoft Teams.
+// See https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/connectors-using#send-adaptive-cards-using-an-incoming-webhook
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string    `json:"contentType"`
+	Content     teamsCard `json:"content"`
+}
+
+type teamsCard struct {
+	Schema  string             `json:"$schema"`
+	Type    string             `json:"type"`
+	Version string             `json:"version"`
+	Body    []teamsCardElement `json:"body"`
+	Actions []teamsCardAction  `json:"actions,omitempty"`
+	MSTeams teamsCardMSTeams   `json:"msteams"`
+}
+
+type teamsCardElement struct {
+	Type   string      `json:"type"`
+	Text   string      `json:"text,omitempty"`
+	Size   string      `json:"size,omitempty"`
+	Weight string      `json:"weight,omitempty"`
+	Color  string      `json:"color,omitempty"`
+	Wrap   bool        `json:"wrap,omitempty"`
+	Facts  []teamsFact `json:"facts,omitempty"`
+}
+
+type teamsFact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+type teamsCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+type teamsCardMSTeams struct {
+	Width    string         `json:"width,omitempty"`
+	Entities []teamsMention `json:"entities,omitempty"`
+}
+
+type teamsMention struct {
+	Type      string             `json:"type"`
+	Text      string             `json:"text"`
+	Mentioned teamsMentionedUser `json:"mentioned"`
+}
+
+type teamsMentionedUser struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func makeTeamsLink(title, url string) string {
+	return fmt.Sprintf("[%s](%s)", title, url)
+}
+
+func makeTeamsDate(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format(time.RFC1123)
+}
+
+func makeTeamsMentionText(user string) string {
+	return fmt.Sprintf("<at>%s</at>", user)
+}
+
+func getTeamsMentionsAsString(users []string) string {
+	if len(users) == 0 {
+		return ""
+	}
+	formattedUsers := make([]string, 0, len(users))
+	for _, u := range users {
+		formattedUsers = append(formattedUsers, makeTeamsMentionText(u))
+	}
+	return strings.Join(formattedUsers, " ")
+}
+
+func makeTeamsMessage(title, titleLink, text, color string, users []string, facts ...teamsFact) teamsMessage {
+	body := []teamsCardElement{{
+		Type:   "TextBlock",
+		Text:   title,
+		Size:   "Medium",
+		Weight: "Bolder",
+		Color:  color,
+		Wrap:   true,
+	}}
+	if text != "" {
+		body = append(body, teamsCardElement{
+			Type: "TextBlock",
+			Text: text,
+			Wrap: true,
+		})
+	}
+	if len(facts) > 0 {
+		body = append(body, teamsCardElement{
+			Type:  "FactSet",
+			Facts: facts,
+		})
+	}
+
+	var actions []teamsCardAction
+	if titleLink != "" {
+		actions = append(actions, teamsCardAction{
+			Type:  "Action.OpenUrl",
+			Title: "View in PipeCD",
+			URL:   titleLink,
+		})
+	}
+
+	// Every user written as <at>user</at> in the card must have a corresponding mention entity,
+	// otherwise Teams renders the text as it is.
+	var entities []teamsMention
+	for _, u := range users {
+		entities = append(entities, teamsMention{
+			Type: "mention",
+			Text: makeTeamsMentionText(u),
+			Mentioned: teamsMentionedUser{
+				ID:   u,
+				Name: u,
+			},
+		})
+	}
+
+	return teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: teamsContentType,
+			Content: teamsCard{
+				Schema:  teamsCardSchema,
+				Type:    "AdaptiveCard",
+				Version: teamsCardVersion,
+				Body:    body,
+				Actions: actions,
+				MSTeams: teamsCardMSTeams{
+					Width:    "Full",
+					Entities: entities,
+				},
+			},
+		}},
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func Test_getTeamsMentionsAsString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		users []string
+		want  string
+	}{
+		{
+			name:  "empty",
+			users: []string{},
+			want:  "",
+		},
+		{
+			name:  "single",
+			users: []string{"foo@example.com"},
+			want:  "<at>foo@example.com</at>",
+		},
+		{
+			name:  "multiple",
+			users: []string{"foo@example.com", "bar@example.com"},
+			want:  "<at>foo@example.com</at> <at>bar@example.com</at>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := getTeamsMentionsAsString(tt.users)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTeams_buildTeamsMessage(t *testing.T) {
+	t.Parallel()
+
+	s := newTeamsSender("teams", config.NotificationReceiverTeams{
+		HookURL:           "https://example.webhook.office.com/hook",
+		MentionedAccounts: []string{"foo@example.com", "bar@example.com"},
+	}, "https://pipecd.dev/", zap.NewNop())
+
+	deployment := &model.Deployment{
+		Id:              "deployment-id",
+		ApplicationId:   "app-id",
+		ApplicationName: "app",
+		ProjectId:       "project",
+		Trigger: &model.DeploymentTrigger{
+			Commit:    &model.Commit{Author: "author"},
+			Commander: "commander",
+		},
+	}
+
+	t.Run("deployment event", func(t *testing.T) {
+		t.Parallel()
+
+		msg, ok := s.buildTeamsMessage(model.NotificationEvent{
+			Type: model.NotificationEventType_EVENT_DEPLOYMENT_FAILED,
+			Metadata: &model.NotificationEventDeploymentFailed{
+				Deployment:          deployment,
+				Reason:              "failed to sync",
+				MentionedTeamsUsers: []string{"foo@example.com", "baz@example.com"},
+			},
+		}, s.webURL)
+		require.True(t, ok)
+		require.Len(t, msg.Attachments, 1)
+
+		card := msg.Attachments[0].Content
+		assert.Equal(t, "message", msg.Type)
+		assert.Equal(t, teamsContentType, msg.Attachments[0].ContentType)
+		assert.Equal(t, "AdaptiveCard", card.Type)
+		require.Len(t, card.Body, 3)
+		assert.Equal(t, `Deployment for "app" was failed`, card.Body[0].Text)
+		assert.Equal(t, teamsErrorColor, card.Body[0].Color)
+		assert.Equal(t, "failed to sync", card.Body[1].Text)
+		assert.Contains(t, card.Body[2].Facts, teamsFact{"Deployment", "[deployme...](https://pipecd.dev/deployments/deployment-id?project=project)"})
+		assert.Contains(t, card.Body[2].Facts, teamsFact{"Mention To Users", "<at>foo@example.com</at> <at>baz@example.com</at> <at>bar@example.com</at>"})
+		assert.Equal(t, []teamsCardAction{{Type: "Action.OpenUrl", Title: "View in PipeCD", URL: "https://pipecd.dev/deployments/deployment-id?project=project"}}, card.Actions)
+
+		ids := make([]string, 0, len(card.MSTeams.Entities))
+		for _, e := range card.MSTeams.Entities {
+			assert.Equal(t, "mention", e.Type)
+			assert.Equal(t, "<at>"+e.Mentioned.ID+"</at>", e.Text)
+			ids = append(ids, e.Mentioned.ID)
+		}
+		assert.Equal(t, []string{"foo@example.com", "baz@example.com", "bar@example.com"}, ids)
+	})
+
+	t.Run("stage event", func(t *testing.T) {
+		t.Parallel()
+
+		msg, ok := s.buildTeamsMessage(model.NotificationEvent{
+			Type: model.NotificationEventType_EVENT_STAGE_SUCCEEDED,
+			Metadata: &model.NotificationEventStageSucceeded{
+				Deployment: deployment,
+				Stage:      &model.PipelineStage{Name: "K8S_SYNC"},
+			},
+		}, s.webURL)
+		require.True(t, ok)
+
+		card := msg.Attachments[0].Content
+		require.Len(t, card.Body, 2)
+		assert.Equal(t, `Stage "K8S_SYNC" was completed successfully`, card.Body[0].Text)
+		assert.Equal(t, teamsSuccessColor, card.Body[0].Color)
+		assert.Len(t, card.MSTeams.Entities, 2)
+	})
+
+	t.Run("unsupported event", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok := s.buildTeamsMessage(model.NotificationEvent{
+			Type: model.NotificationEventType_EVENT_APPLICATION_HEALTHY,
+		}, s.webURL)
+		assert.False(t, ok)
+	})
+}
+
+func TestTeams_sendMessage(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "accepted",
+			status: http.StatusAccepted,
+		},
+		{
+			name:    "rejected",
+			status:  http.StatusBadRequest,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var received teamsMessage
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.NoError(t, json.Unmarshal(body, &received))
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			s := newTeamsSender("teams", config.NotificationReceiverTeams{HookURL: server.URL}, "https://pipecd.dev", zap.NewNop())
+			msg := makeTeamsMessage("title", "https://pipecd.dev", "", teamsInfoColor, nil)
+
+			err := s.sendMessage(t.Context(), msg)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, msg, received)
+		})
+	}
+}
@@ -47,6 +47,7 @@ func buildDeployment(
 	commander string,
 	syncStrategy model.SyncStrategy,
 	strategySummary string,
+	ignoreFreezeWindows bool,
 	now time.Time,
 	noti *config.DeploymentNotification,
 	deploymentChainID string,
@@ -87,10 +88,11 @@ func buildDeployment(
 				Url:       commitURL,
 				CreatedAt: int64(commit.CreatedAt),
 			},
-			Commander:       commander,
-			Timestamp:       now.Unix(),
-			SyncStrategy:    syncStrategy,
-			StrategySummary: strategySummary,
+			Commander:           commander,
+			Timestamp:           now.Unix(),
+			SyncStrategy:        syncStrategy,
+			StrategySummary:     strategySummary,
+			IgnoreFreezeWindows: ignoreFreezeWindows,
 		},
 		GitPath:                   app.GitPath,
 		CloudProvider:             app.CloudProvider,
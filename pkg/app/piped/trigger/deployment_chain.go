@@ -16,24 +16,68 @@ package trigger
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// shouldTriggerDeploymentChain checks whether the given upstream deployment satisfies
+// the trigger conditions of the deployment chain configured for the application.
+func shouldTriggerDeploymentChain(ctx context.Context, repo git.Repo, app *model.Application, appCfg *config.GenericApplicationSpec, upstream *model.Deployment) (bool, error) {
+	if appCfg.PostSync == nil || appCfg.PostSync.DeploymentChain == nil {
+		return false, nil
+	}
+	cond := appCfg.PostSync.DeploymentChain.Conditions
+	if cond == nil {
+		return true, nil
+	}
+
+	var changedFiles []string
+	if len(cond.Paths) != 0 {
+		preCommit := app.GetMostRecentlyTriggeredDeployment().GetTrigger().GetCommit().GetHash()
+		if preCommit == "" {
+			// There is no previous deployment so the paths condition is considered as satisfied.
+			c := *cond
+			c.Paths = nil
+			cond = &c
+		} else {
+			files, err := repo.ChangedFiles(ctx, preCommit, upstream.Trigger.Commit.Hash)
+			if err != nil {
+				return false, err
+			}
+			changedFiles = files
+		}
+	}
+
+	return cond.Match(upstream.Trigger.Commit.Message, upstream.Labels, changedFiles)
+}
+
 func (t *Trigger) triggerDeploymentChain(
 	ctx context.Context,
+	repoDir string,
 	dc *config.DeploymentChain,
 	firstDeployment *model.Deployment,
 ) error {
 	matchers := make([]*pipedservice.CreateDeploymentChainRequest_ApplicationMatcher, 0, len(dc.ApplicationMatchers))
 	for _, m := range dc.ApplicationMatchers {
+		var gate *model.ChainBlockGate
+		if m.Gate != nil {
+			g, err := buildChainBlockGate(repoDir, m.Gate)
+			if err != nil {
+				return err
+			}
+			gate = g
+		}
 		matchers = append(matchers, &pipedservice.CreateDeploymentChainRequest_ApplicationMatcher{
-			Name:   m.Name,
-			Kind:   m.Kind,
-			Labels: m.Labels,
+			Name:              m.Name,
+			Kind:              m.Kind,
+			Labels:            m.Labels,
+			ContinueOnFailure: m.ContinueOnFailure,
+			Gate:              gate,
 		})
 	}
 
@@ -45,3 +89,51 @@ func (t *Trigger) triggerDeploymentChain(
 	}
 	return nil
 }
+
+// buildChainBlockGate converts the given gate configuration into the model sent to the control-plane.
+// The referenced analysis template is resolved here since the analysis is run later by the piped
+// which handles the deployments of the gated block, and only its result is sent to the control-plane.
+func buildChainBlockGate(repoDir string, cfg *config.DeploymentChainGate) (*model.ChainBlockGate, error) {
+	gate := &model.ChainBlockGate{
+		WaitSeconds:     int64(cfg.Wait.Duration().Seconds()),
+		RequireApproval: cfg.ManualApproval,
+	}
+	if cfg.Analysis == nil {
+		return gate, nil
+	}
+
+	tpl, err := config.LoadAnalysisTemplate(repoDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load analysis template for deployment chain gate: %w", err)
+	}
+
+	var (
+		name    = cfg.Analysis.Name
+		options = config.AnalysisStageOptions{
+			Duration: cfg.AnalysisDuration,
+		}
+	)
+	if m, ok := tpl.Metrics[name]; ok {
+		if m.Strategy != config.AnalysisStrategyThreshold {
+			return nil, fmt.Errorf("strategy %s of analysis template %s is not available for deployment chain gate, only %s is available", m.Strategy, name, config.AnalysisStrategyThreshold)
+		}
+		options.Metrics = append(options.Metrics, config.TemplatableAnalysisMetrics{AnalysisMetrics: m, Template: *cfg.Analysis})
+	}
+	if l, ok := tpl.Logs[name]; ok {
+		options.Logs = append(options.Logs, config.TemplatableAnalysisLog{AnalysisLog: l, Template: *cfg.Analysis})
+	}
+	if h, ok := tpl.HTTPS[name]; ok {
+		options.HTTPS = append(options.HTTPS, config.TemplatableAnalysisHTTP{AnalysisHTTP: h, Template: *cfg.Analysis})
+	}
+	if len(options.Metrics)+len(options.Logs)+len(options.HTTPS) == 0 {
+		return nil, fmt.Errorf("analysis template %s for deployment chain gate was not found", name)
+	}
+
+	data, err := json.Marshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode analysis for deployment chain gate: %w", err)
+	}
+	gate.AnalysisTemplate = name
+	gate.AnalysisConfig = data
+	return gate, nil
+}
@@ -15,9 +15,18 @@
 package trigger
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git/gittest"
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestIsTouchedByChangedFiles(t *testing.T) {
@@ -150,3 +159,112 @@ func TestIsTouchedByChangedFiles(t *testing.T) {
 		})
 	}
 }
+
+func TestOnScheduleDeterminer(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from = time.Date(2025, 10, 6, 8, 59, 0, 0, time.UTC)
+		to   = time.Date(2025, 10, 6, 9, 0, 0, 0, time.UTC)
+	)
+
+	testcases := []struct {
+		name         string
+		onSchedule   config.OnSchedule
+		deployment   *model.ApplicationDeploymentReference
+		changedFiles []string
+		expected     bool
+	}{
+		{
+			name:       "no schedule",
+			onSchedule: config.OnSchedule{},
+			expected:   false,
+		},
+		{
+			name: "not scheduled in the range",
+			onSchedule: config.OnSchedule{
+				Crons: []string{"0 10 * * *"},
+			},
+			expected: false,
+		},
+		{
+			name: "scheduled",
+			onSchedule: config.OnSchedule{
+				Crons: []string{"0 9 * * *"},
+			},
+			deployment: &model.ApplicationDeploymentReference{
+				Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: "head"}},
+			},
+			expected: true,
+		},
+		{
+			name: "only undeployed commits: no deployment yet",
+			onSchedule: config.OnSchedule{
+				Crons:                 []string{"0 9 * * *"},
+				OnlyUndeployedCommits: true,
+			},
+			expected: true,
+		},
+		{
+			name: "only undeployed commits: head was already deployed",
+			onSchedule: config.OnSchedule{
+				Crons:                 []string{"0 9 * * *"},
+				OnlyUndeployedCommits: true,
+			},
+			deployment: &model.ApplicationDeploymentReference{
+				Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: "head"}},
+			},
+			expected: false,
+		},
+		{
+			name: "only undeployed commits: app was touched",
+			onSchedule: config.OnSchedule{
+				Crons:                 []string{"0 9 * * *"},
+				OnlyUndeployedCommits: true,
+			},
+			deployment: &model.ApplicationDeploymentReference{
+				Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: "previous"}},
+			},
+			changedFiles: []string{"app/demo/deployment.yaml"},
+			expected:     true,
+		},
+		{
+			name: "only undeployed commits: app was not touched",
+			onSchedule: config.OnSchedule{
+				Crons:                 []string{"0 9 * * *"},
+				OnlyUndeployedCommits: true,
+			},
+			deployment: &model.ApplicationDeploymentReference{
+				Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: "previous"}},
+			},
+			changedFiles: []string{"app/other/deployment.yaml"},
+			expected:     false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := gittest.NewMockRepo(ctrl)
+			if tc.changedFiles != nil {
+				repo.EXPECT().ChangedFiles(gomock.Any(), "previous", "head").Return(tc.changedFiles, nil)
+			}
+
+			app := &model.Application{
+				Id:                              "app-id",
+				Name:                            "demo",
+				GitPath:                         &model.ApplicationGitPath{Path: "app/demo"},
+				MostRecentlyTriggeredDeployment: tc.deployment,
+			}
+			appCfg := &config.GenericApplicationSpec{
+				Trigger: config.Trigger{OnSchedule: tc.onSchedule},
+			}
+
+			d := NewOnScheduleDeterminer(repo, "head", from, to, zap.NewNop())
+			got, err := d.ShouldTrigger(context.Background(), app, appCfg)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
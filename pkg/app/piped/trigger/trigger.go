@@ -44,6 +44,7 @@ type apiClient interface {
 	ReportApplicationMostRecentDeployment(ctx context.Context, req *pipedservice.ReportApplicationMostRecentDeploymentRequest, opts ...grpc.CallOption) (*pipedservice.ReportApplicationMostRecentDeploymentResponse, error)
 	CreateDeploymentChain(ctx context.Context, in *pipedservice.CreateDeploymentChainRequest, opts ...grpc.CallOption) (*pipedservice.CreateDeploymentChainResponse, error)
 	ReportApplicationSyncState(ctx context.Context, in *pipedservice.ReportApplicationSyncStateRequest, opts ...grpc.CallOption) (*pipedservice.ReportApplicationSyncStateResponse, error)
+	GetProjectFreezeWindows(ctx context.Context, in *pipedservice.GetProjectFreezeWindowsRequest, opts ...grpc.CallOption) (*pipedservice.GetProjectFreezeWindowsResponse, error)
 }
 
 type gitClient interface {
@@ -83,7 +84,12 @@ type Trigger struct {
 	commitStore       *lastTriggeredCommitStore
 	gitRepos          map[string]git.Repo
 	gracePeriod       time.Duration
-	logger            *zap.Logger
+	// The time until which the schedules have been checked for each repository.
+	scheduleCheckedAt map[string]time.Time
+	// The time until which the schedules are checked in the current iteration.
+	scheduleTo time.Time
+	nowFunc    func() time.Time
+	logger     *zap.Logger
 }
 
 func NewTrigger(
@@ -115,7 +121,9 @@ func NewTrigger(
 		config:            cfg,
 		commitStore:       commitStore,
 		gitRepos:          make(map[string]git.Repo, len(cfg.Repositories)),
+		scheduleCheckedAt: make(map[string]time.Time, len(cfg.Repositories)),
 		gracePeriod:       gracePeriod,
+		nowFunc:           time.Now,
 		logger:            logger.Named("trigger"),
 	}
 
@@ -138,6 +146,10 @@ func (t *Trigger) Run(ctx context.Context) error {
 
 	syncTicker := time.NewTicker(time.Duration(t.config.SyncInterval))
 	defer syncTicker.Stop()
+	now := t.nowFunc()
+	for _, r := range t.config.Repositories {
+		t.scheduleCheckedAt[r.RepoID] = now
+	}
 
 	ondemandTicker := time.NewTicker(ondemandCheckInterval)
 	defer ondemandTicker.Stop()
@@ -148,13 +160,19 @@ func (t *Trigger) Run(ctx context.Context) error {
 			var (
 				commitCandidates    = t.listCommitCandidates()
 				outOfSyncCandidates = t.listOutOfSyncCandidates()
-				candidates          = append(commitCandidates, outOfSyncCandidates...)
+				scheduleCandidates  = t.listScheduleCandidates()
+				candidates          = make([]candidate, 0, len(commitCandidates)+len(outOfSyncCandidates)+len(scheduleCandidates))
 			)
-			t.logger.Info(fmt.Sprintf("found %d candidates: %d commit candidates and %d out_of_sync candidates",
+			candidates = append(candidates, commitCandidates...)
+			candidates = append(candidates, outOfSyncCandidates...)
+			candidates = append(candidates, scheduleCandidates...)
+			t.logger.Info(fmt.Sprintf("found %d candidates: %d commit candidates, %d out_of_sync candidates and %d schedule candidates",
 				len(candidates),
 				len(commitCandidates),
 				len(outOfSyncCandidates),
+				len(scheduleCandidates),
 			))
+			t.scheduleTo = t.nowFunc()
 			t.checkCandidates(ctx, candidates)
 
 		case <-ondemandTicker.C:
@@ -174,6 +192,18 @@ func (t *Trigger) Run(ctx context.Context) error {
 }
 
 func (t *Trigger) checkCandidates(ctx context.Context, cs []candidate) (err error) {
+	if len(cs) == 0 {
+		return nil
+	}
+
+	// The freeze windows of the project are fetched once for all candidates.
+	// All candidates are checked again at the next iteration in case they could not be fetched.
+	projectFreezeWindows, err := t.getProjectFreezeWindows(ctx)
+	if err != nil {
+		t.logger.Error("failed to get the freeze windows of the project, the candidates will be checked again later", zap.Error(err))
+		return err
+	}
+
 	// Group candidates by repository to reduce the number of Git operations on each repo.
 	csm := make(map[string][]candidate)
 	for _, c := range cs {
@@ -188,7 +218,7 @@ func (t *Trigger) checkCandidates(ctx context.Context, cs []candidate) (err erro
 	// Iterate each repository and check its candidates.
 	// Only the last error will be returned.
 	for repoID, cs := range csm {
-		if e := t.checkRepoCandidates(ctx, repoID, cs); e != nil {
+		if e := t.checkRepoCandidates(ctx, repoID, cs, projectFreezeWindows); e != nil {
 			t.logger.Error(fmt.Sprintf("failed while checking applications in repo %s", repoID), zap.Error(e))
 			err = e
 		}
@@ -196,7 +226,7 @@ func (t *Trigger) checkCandidates(ctx context.Context, cs []candidate) (err erro
 	return
 }
 
-func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []candidate) error {
+func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []candidate, projectFreezeWindows []config.DeploymentFreezeWindow) error {
 	gitRepo, branch, headCommit, err := t.updateRepoToLatest(ctx, repoID)
 	if err != nil {
 		// TODO: Find a better way to skip the CANCELLED error log while shutting down.
@@ -206,16 +236,25 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		return err
 	}
 
+	scheduleFrom, ok := t.scheduleCheckedAt[repoID]
+	if !ok {
+		scheduleFrom = t.scheduleTo
+	}
 	ds := &determiners{
 		onCommand:   NewOnCommandDeterminer(),
 		onOutOfSync: NewOnOutOfSyncDeterminer(t.apiClient),
 		onCommit:    NewOnCommitDeterminer(gitRepo, headCommit.Hash, t.commitStore, t.logger),
 		onChain:     NewOnChainDeterminer(),
+		onSchedule:  NewOnScheduleDeterminer(gitRepo, headCommit.Hash, scheduleFrom, t.scheduleTo, t.logger),
 	}
 	triggered := make(map[string]struct{})
+	scheduleChecked := false
 
 	for _, c := range cs {
 		app := c.application
+		if c.kind == model.TriggerKind_ON_SCHEDULE {
+			scheduleChecked = true
+		}
 
 		// Avoid triggering multiple deployments for the same application in the same iteration.
 		if _, ok := triggered[app.Id]; ok {
@@ -258,10 +297,39 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		}
 
 		if !shouldTrigger {
-			t.commitStore.Put(app.Id, headCommit.Hash)
+			// The schedule candidate is not related to the new commits,
+			// so the last triggered commit must not be updated by it.
+			if c.kind != model.TriggerKind_ON_SCHEDULE {
+				t.commitStore.Put(app.Id, headCommit.Hash)
+			}
 			continue
 		}
 
+		// Hold the deployment while a freeze window is active.
+		// The last triggered commit is not updated, so it will be triggered again once the window has ended.
+		// Only the sync command explicitly requested to ignore the freeze windows can go through it.
+		ignoreFreezeWindows := c.kind == model.TriggerKind_ON_COMMAND && c.command.GetSyncApplication().GetIgnoreFreezeWindows()
+		if !ignoreFreezeWindows {
+			if w, frozen := findActiveFreezeWindow(projectFreezeWindows, appCfg, t.nowFunc()); frozen {
+				t.logger.Info("skipped triggering application because of an active freeze window",
+					zap.String("app", app.Name),
+					zap.String("app-id", app.Id),
+					zap.String("freeze-window", w.Name),
+				)
+				// The sync command is rejected instead of being held
+				// to avoid deploying unexpectedly after the window has ended.
+				if c.kind == model.TriggerKind_ON_COMMAND {
+					metadata := map[string]string{
+						model.MetadataKeyCommandFailureReason: w.Description(),
+					}
+					if err := c.command.Report(ctx, model.CommandStatus_COMMAND_FAILED, metadata, nil); err != nil {
+						t.logger.Error("failed to report command status", zap.Error(err))
+					}
+				}
+				continue
+			}
+		}
+
 		var (
 			commander                 string
 			strategy                  model.SyncStrategy
@@ -299,6 +367,7 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 			commander,
 			strategy,
 			strategySummary,
+			ignoreFreezeWindows,
 			time.Now(),
 			appCfg.DeploymentNotification,
 			deploymentChainID,
@@ -312,10 +381,17 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		}
 
 		// In case the triggered deployment is of application that can trigger a deployment chain
-		// create a new deployment chain with its configuration besides with the first deployment
-		// in that chain.
-		if appCfg.PostSync != nil && appCfg.PostSync.DeploymentChain != nil {
-			if err := t.triggerDeploymentChain(ctx, appCfg.PostSync.DeploymentChain, deployment); err != nil {
+		// and satisfies its trigger conditions, create a new deployment chain with its configuration
+		// besides with the first deployment in that chain.
+		triggerChain, err := shouldTriggerDeploymentChain(ctx, gitRepo, app, appCfg, deployment)
+		if err != nil {
+			msg := fmt.Sprintf("failed to check deployment chain trigger conditions for application %s: %v", app.Id, err)
+			t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+			t.logger.Error(msg, zap.Error(err))
+			continue
+		}
+		if triggerChain {
+			if err := t.triggerDeploymentChain(ctx, gitRepo.GetPath(), appCfg.PostSync.DeploymentChain, deployment); err != nil {
 				msg := fmt.Sprintf("failed to trigger application %s and its deployment chain: %v", app.Id, err)
 				t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
 				t.logger.Error(msg, zap.Error(err))
@@ -353,6 +429,12 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		}
 	}
 
+	// The schedules are moved forward only after they were checked for this repository,
+	// so the ones fired while the repository could not be updated are checked at the next iteration.
+	if scheduleChecked {
+		t.scheduleCheckedAt[repoID] = t.scheduleTo
+	}
+
 	return nil
 }
 
@@ -443,6 +525,23 @@ func (t *Trigger) listCommitCandidates() []candidate {
 	return apps
 }
 
+// listScheduleCandidates finds all applications that have potentiality
+// to be candidates by their schedules.
+// They are all applications managed by this Piped.
+func (t *Trigger) listScheduleCandidates() []candidate {
+	var (
+		list = t.applicationLister.List()
+		apps = make([]candidate, 0, len(list))
+	)
+	for _, app := range list {
+		apps = append(apps, candidate{
+			application: app,
+			kind:        model.TriggerKind_ON_SCHEDULE,
+		})
+	}
+	return apps
+}
+
 // updateRepoToLatest ensures that the local data of the given Git repository should be up-to-date.
 func (t *Trigger) updateRepoToLatest(ctx context.Context, repoID string) (repo git.Repo, branch string, headCommit git.Commit, err error) {
 	var ok bool
@@ -466,6 +565,24 @@ func (t *Trigger) updateRepoToLatest(ctx context.Context, repoID string) (repo g
 	return
 }
 
+// getProjectFreezeWindows fetches the freeze windows configured in the project from the control-plane.
+func (t *Trigger) getProjectFreezeWindows(ctx context.Context) ([]config.DeploymentFreezeWindow, error) {
+	resp, err := t.apiClient.GetProjectFreezeWindows(ctx, &pipedservice.GetProjectFreezeWindowsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the freeze windows of the project: %w", err)
+	}
+	return config.NewProjectFreezeWindows(resp.FreezeWindows)
+}
+
+// findActiveFreezeWindow returns the freeze window configured in the project or the application
+// which is active at the given time.
+func findActiveFreezeWindow(projectWindows []config.DeploymentFreezeWindow, appCfg *config.GenericApplicationSpec, now time.Time) (*config.DeploymentFreezeWindow, bool) {
+	if w, ok := config.FindActiveFreezeWindow(projectWindows, now); ok {
+		return w, true
+	}
+	return config.FindActiveFreezeWindow(appCfg.FreezeWindows, now)
+}
+
 func (t *Trigger) GetLastTriggeredCommitGetter() LastTriggeredCommitGetter {
 	return t.commitStore
 }
@@ -473,17 +590,20 @@ func (t *Trigger) GetLastTriggeredCommitGetter() LastTriggeredCommitGetter {
 func (t *Trigger) notifyDeploymentTriggered(_ context.Context, appCfg *config.GenericApplicationSpec, d *model.Deployment) {
 	var users []string
 	var groups []string
+	var teamsUsers []string
 	if n := appCfg.DeploymentNotification; n != nil {
 		users = n.FindSlackUsers(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED)
 		groups = n.FindSlackGroups(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED)
+		teamsUsers = n.FindTeamsUsers(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED)
 	}
 
 	t.notifier.Notify(model.NotificationEvent{
 		Type: model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED,
 		Metadata: &model.NotificationEventDeploymentTriggered{
-			Deployment:        d,
-			MentionedAccounts: users,
-			MentionedGroups:   groups,
+			Deployment:          d,
+			MentionedAccounts:   users,
+			MentionedGroups:     groups,
+			MentionedTeamsUsers: teamsUsers,
 		},
 	})
 }
@@ -491,20 +611,23 @@ func (t *Trigger) notifyDeploymentTriggered(_ context.Context, appCfg *config.Ge
 func (t *Trigger) notifyDeploymentTriggerFailed(app *model.Application, appCfg *config.GenericApplicationSpec, reason string, commit git.Commit) {
 	var users []string
 	var groups []string
+	var teamsUsers []string
 	if n := appCfg.DeploymentNotification; n != nil {
 		users = n.FindSlackUsers(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGER_FAILED)
 		groups = n.FindSlackGroups(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGER_FAILED)
+		teamsUsers = n.FindTeamsUsers(model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGER_FAILED)
 	}
 
 	t.notifier.Notify(model.NotificationEvent{
 		Type: model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGER_FAILED,
 		Metadata: &model.NotificationEventDeploymentTriggerFailed{
-			Application:       app,
-			CommitHash:        commit.Hash,
-			MentionedAccounts: users,
-			MentionedGroups:   groups,
-			CommitMessage:     commit.Message,
-			Reason:            reason,
+			Application:         app,
+			CommitHash:          commit.Hash,
+			MentionedAccounts:   users,
+			MentionedGroups:     groups,
+			MentionedTeamsUsers: teamsUsers,
+			CommitMessage:       commit.Message,
+			Reason:              reason,
 		},
 	})
 }
@@ -71,6 +71,8 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/cli"
 	config "github.com/pipe-cd/pipecd/pkg/configv1"
 	"github.com/pipe-cd/pipecd/pkg/crypto"
+	"github.com/pipe-cd/pipecd/pkg/crypto/awskms"
+	"github.com/pipe-cd/pipecd/pkg/crypto/gcpkms"
 	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/lifecycle"
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -386,7 +388,7 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 	}
 
 	// Initialize secret decrypter.
-	decrypter, err := p.initializeSecretDecrypter(cfg)
+	decrypter, err := p.initializeSecretDecrypter(ctx, cfg)
 	if err != nil {
 		input.Logger.Error("failed to initialize secret decrypter", zap.Error(err))
 		return err
@@ -415,6 +417,7 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 			notifier,
 			decrypter,
 			*metadataStoreRegistry,
+			cfg,
 			p.gracePeriod,
 			input.Logger,
 			tracerProvider,
@@ -731,7 +734,7 @@ func (p *piped) runPlugins(ctx context.Context, pluginsCfg []config.PipedPlugin,
 	return plugins, nil
 }
 
-func (p *piped) initializeSecretDecrypter(cfg *config.PipedSpec) (crypto.Decrypter, error) {
+func (p *piped) initializeSecretDecrypter(ctx context.Context, cfg *config.PipedSpec) (crypto.Decrypter, error) {
 	sm := cfg.SecretManagement
 	if sm == nil {
 		return nil, nil
@@ -753,16 +756,54 @@ func (p *piped) initializeSecretDecrypter(cfg *config.PipedSpec) (crypto.Decrypt
 		return decrypter, nil
 
 	case model.SecretManagementTypeGCPKMS:
-		return nil, fmt.Errorf("type %q is not implemented yet", sm.Type.String())
+		c, err := gcpkms.NewClient(ctx, sm.GCPKMS.KeyName, sm.GCPKMS.DecryptServiceAccountFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize GCP KMS client (%w)", err)
+		}
+		return crypto.NewHybridDecrypterWithKeyDecrypter(c), nil
 
 	case model.SecretManagementTypeAWSKMS:
-		return nil, fmt.Errorf("type %q is not implemented yet", sm.Type.String())
+		c, err := newAWSKMSClient(ctx, sm.AWSKMS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize AWS KMS client (%w)", err)
+		}
+		return crypto.NewHybridDecrypterWithKeyDecrypter(c), nil
 
 	default:
 		return nil, fmt.Errorf("unsupported secret management type: %s", sm.Type.String())
 	}
 }
 
+// loadSecretEncryptionKey returns the public key which is reported to the control-plane
+// to be used for encrypting the secrets of this piped.
+func loadSecretEncryptionKey(ctx context.Context, sm *config.SecretManagement) ([]byte, error) {
+	switch sm.Type {
+	case model.SecretManagementTypeKeyPair:
+		return sm.KeyPair.LoadPublicKey()
+
+	case model.SecretManagementTypeGCPKMS:
+		c, err := gcpkms.NewClient(ctx, sm.GCPKMS.KeyName, sm.GCPKMS.EncryptServiceAccountFile)
+		if err != nil {
+			return nil, err
+		}
+		return c.PublicKey(ctx)
+
+	case model.SecretManagementTypeAWSKMS:
+		c, err := newAWSKMSClient(ctx, sm.AWSKMS)
+		if err != nil {
+			return nil, err
+		}
+		return c.PublicKey(ctx)
+
+	default:
+		return nil, fmt.Errorf("unsupported secret management type: %s", sm.Type.String())
+	}
+}
+
+func newAWSKMSClient(ctx context.Context, cfg *config.SecretManagementAWSKMS) (*awskms.Client, error) {
+	return awskms.NewClient(ctx, cfg.KeyID, cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile)
+}
+
 func (p *piped) sendPipedMeta(ctx context.Context, client pipedservice.Client, cfg *config.PipedSpec, logger *zap.Logger) error {
 	repos := make([]*model.ApplicationGitRepository, 0, len(cfg.Repositories))
 	for _, r := range cfg.Repositories {
@@ -804,8 +845,8 @@ func (p *piped) sendPipedMeta(ctx context.Context, client pipedservice.Client, c
 	}
 
 	// Configure secret management.
-	if sm := cfg.SecretManagement; sm != nil && sm.Type == model.SecretManagementTypeKeyPair {
-		publicKey, err := sm.KeyPair.LoadPublicKey()
+	if sm := cfg.SecretManagement; sm != nil && sm.Type != model.SecretManagementTypeNone {
+		publicKey, err := loadSecretEncryptionKey(ctx, sm)
 		if err != nil {
 			return fmt.Errorf("failed to read public key for secret management (%w)", err)
 		}
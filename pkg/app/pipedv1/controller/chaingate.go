@@ -0,0 +1,158 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1/deployment"
+)
+
+const (
+	// The stage run by the analysis plugin to evaluate the gate of deployment chain.
+	gateAnalysisStageName = "ANALYSIS"
+	gateAnalysisStageID   = "chain-gate-analysis"
+)
+
+// startGateAnalysis starts running the analysis of the gate before the block of the given deployment
+// unless it is already running. The result is reported to the control-plane after the analysis finished.
+func (c *controller) startGateAnalysis(ctx context.Context, d *model.Deployment, gate *model.ChainBlockGate) {
+	key := fmt.Sprintf("%s/%d", d.DeploymentChainId, d.DeploymentChainBlockIndex)
+
+	c.gateAnalysesMu.Lock()
+	defer c.gateAnalysesMu.Unlock()
+	if _, ok := c.gateAnalyses[key]; ok {
+		return
+	}
+	c.gateAnalyses[key] = struct{}{}
+
+	logger := c.logger.With(
+		zap.String("deployment-chain", d.DeploymentChainId),
+		zap.Uint32("block-index", d.DeploymentChainBlockIndex),
+		zap.String("analysis-template", gate.AnalysisTemplate),
+	)
+	logger.Info("start running analysis of deployment chain gate")
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer func() {
+			c.gateAnalysesMu.Lock()
+			delete(c.gateAnalyses, key)
+			c.gateAnalysesMu.Unlock()
+		}()
+
+		passed, reason := true, ""
+		if err := c.runGateAnalysis(ctx, d, gate); err != nil {
+			// The analysis will be started again at the next sync since the gate is still waiting for its result.
+			if ctx.Err() != nil {
+				return
+			}
+			passed, reason = false, err.Error()
+		}
+		if err := c.reportGateAnalysisResult(ctx, d, passed, reason); err != nil {
+			logger.Error("failed to report the analysis result of deployment chain gate", zap.Error(err))
+			return
+		}
+		logger.Info("reported the analysis result of deployment chain gate", zap.Bool("passed", passed))
+	}()
+}
+
+// runGateAnalysis runs the analysis of the gate as an ANALYSIS stage of the analysis plugin
+// against the target deploy source of the given deployment.
+func (c *controller) runGateAnalysis(ctx context.Context, d *model.Deployment, gate *model.ChainBlockGate) error {
+	plugin, err := c.pluginRegistry.GetPluginClientByStageName(gateAnalysisStageName)
+	if err != nil {
+		return fmt.Errorf("failed to find the plugin to run the analysis: %w", err)
+	}
+
+	workingDir, err := os.MkdirTemp(c.workspaceDir, d.Id+"-gate-analysis-*")
+	if err != nil {
+		return fmt.Errorf("failed to create working directory: %w", err)
+	}
+	defer os.RemoveAll(workingDir)
+
+	repoCfg := config.PipedRepository{
+		RepoID: d.GitPath.Repo.Id,
+		Remote: d.GitPath.Repo.Remote,
+		Branch: d.GitPath.Repo.Branch,
+	}
+	targetDSP := deploysource.NewProvider(
+		filepath.Join(workingDir, "target-deploysource"),
+		deploysource.NewGitSourceCloner(c.gitClient, repoCfg, "target", d.Trigger.Commit.Hash),
+		d.GetGitPath(),
+		c.secretDecrypter,
+	)
+	tds, err := targetDSP.Get(ctx, io.Discard)
+	if err != nil {
+		return fmt.Errorf("failed to prepare target deploy source: %w", err)
+	}
+
+	// The plugin accesses the metadata of the deployment while running the stage.
+	c.metadataStoreRegistry.Register(d)
+	defer c.metadataStoreRegistry.Delete(d.Id)
+
+	res, err := plugin.ExecuteStage(ctx, &deployment.ExecuteStageRequest{
+		Input: &deployment.ExecutePluginInput{
+			Deployment: d,
+			Stage: &model.PipelineStage{
+				Id:     gateAnalysisStageID,
+				Name:   gateAnalysisStageName,
+				Status: model.StageStatus_STAGE_RUNNING,
+			},
+			StageConfig:            gate.AnalysisConfig,
+			TargetDeploymentSource: tds.ToPluginDeploySource(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to run the analysis: %w", err)
+	}
+	if res.Status != model.StageStatus_STAGE_SUCCESS {
+		return fmt.Errorf("the analysis finished with %s status: %s", res.Status, res.Message)
+	}
+	return nil
+}
+
+func (c *controller) reportGateAnalysisResult(ctx context.Context, d *model.Deployment, passed bool, reason string) error {
+	var (
+		err error
+		req = &pipedservice.ReportDeploymentChainGateAnalysisResultRequest{
+			DeploymentId:              d.Id,
+			DeploymentChainId:         d.DeploymentChainId,
+			DeploymentChainBlockIndex: d.DeploymentChainBlockIndex,
+			Passed:                    passed,
+			Reason:                    reason,
+		}
+		retry = pipedservice.NewRetry(10)
+	)
+
+	for retry.WaitNext(ctx) {
+		if _, err = c.apiClient.ReportDeploymentChainGateAnalysisResult(ctx, req); err == nil {
+			return nil
+		}
+		err = fmt.Errorf("failed to report the analysis result to control-plane: %w", err)
+	}
+	return err
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/metadatastore"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
 	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -55,6 +56,8 @@ type apiClient interface {
 	SaveStageMetadata(ctx context.Context, req *pipedservice.SaveStageMetadataRequest, opts ...grpc.CallOption) (*pipedservice.SaveStageMetadataResponse, error)
 
 	InChainDeploymentPlannable(ctx context.Context, in *pipedservice.InChainDeploymentPlannableRequest, opts ...grpc.CallOption) (*pipedservice.InChainDeploymentPlannableResponse, error)
+	GetProjectFreezeWindows(ctx context.Context, in *pipedservice.GetProjectFreezeWindowsRequest, opts ...grpc.CallOption) (*pipedservice.GetProjectFreezeWindowsResponse, error)
+	ReportDeploymentChainGateAnalysisResult(ctx context.Context, in *pipedservice.ReportDeploymentChainGateAnalysisResultRequest, opts ...grpc.CallOption) (*pipedservice.ReportDeploymentChainGateAnalysisResultResponse, error)
 }
 
 type gitClient interface {
@@ -97,6 +100,7 @@ type controller struct {
 	notifier              notifier
 	secretDecrypter       secretDecrypter
 	metadataStoreRegistry metadatastore.MetadataStoreRegistry
+	pipedConfig           *config.PipedSpec
 
 	// The registry of all plugins.
 	pluginRegistry plugin.PluginRegistry
@@ -118,6 +122,9 @@ type controller struct {
 	// Map from application ID to its most recently successful commit hash.
 	mostRecentlySuccessfulCommits         map[string]string
 	mostRecentlySuccessfulConfigFilenames map[string]string
+	// Set of the keys of deployment chain gates whose analysis is running.
+	gateAnalyses   map[string]struct{}
+	gateAnalysesMu sync.Mutex
 	// WaitGroup for waiting the completions of all planners, schedulers.
 	wg sync.WaitGroup
 
@@ -139,6 +146,7 @@ func NewController(
 	notifier notifier,
 	secretDecrypter secretDecrypter,
 	metadataStoreRegistry metadatastore.MetadataStoreRegistry,
+	pipedConfig *config.PipedSpec,
 	gracePeriod time.Duration,
 	logger *zap.Logger,
 	tracerProvider trace.TracerProvider,
@@ -154,6 +162,7 @@ func NewController(
 		notifier:              notifier,
 		secretDecrypter:       secretDecrypter,
 		metadataStoreRegistry: metadataStoreRegistry,
+		pipedConfig:           pipedConfig,
 
 		planners:                              make(map[string]*planner),
 		donePlanners:                          make(map[string]time.Time),
@@ -161,6 +170,7 @@ func NewController(
 		doneSchedulers:                        make(map[string]time.Time),
 		mostRecentlySuccessfulCommits:         make(map[string]string),
 		mostRecentlySuccessfulConfigFilenames: make(map[string]string),
+		gateAnalyses:                          make(map[string]struct{}),
 
 		syncInternal:   10 * time.Second,
 		gracePeriod:    gracePeriod,
@@ -294,7 +304,7 @@ func (c *controller) syncPlanners(ctx context.Context) {
 		zap.Int("count", len(c.planners)),
 	)
 
-	pendingByApp := make(map[string]*model.Deployment, len(pendings))
+	pendingsByApp := make(map[string][]*model.Deployment, len(pendings))
 	for _, d := range pendings {
 		appID := d.ApplicationId
 		// Ignore already processed one.
@@ -305,33 +315,84 @@ func (c *controller) syncPlanners(ctx context.Context) {
 			)
 			continue
 		}
-		// For each application, only one deployment can be planned at the same time.
+		// Ignore the one being planned.
+		if p, ok := c.planners[appID]; ok && p.ID() == d.Id {
+			continue
+		}
+		pendingsByApp[appID] = append(pendingsByApp[appID], d)
+	}
+
+	// Count all deployments being planned or run to apply the concurrency limits.
+	limiter := newConcurrencyLimiter(c.pipedConfig.DeploymentConcurrency)
+	for _, p := range c.planners {
+		limiter.add(p.deployment)
+	}
+	for _, s := range c.schedulers {
+		limiter.add(s.deployment)
+	}
+	for _, d := range append(c.deploymentLister.ListRunnings(), c.deploymentLister.ListPlanneds()...) {
+		if _, ok := c.doneSchedulers[d.Id]; !ok {
+			limiter.add(d)
+		}
+	}
+
+	candidates := make([]*model.Deployment, 0, len(pendingsByApp))
+	for appID, ds := range pendingsByApp {
+		sortByTrigger(ds)
+		ds = c.applyConcurrencyPolicy(ctx, appID, ds)
+		if len(ds) == 0 {
+			continue
+		}
+
+		// For each application, only one deployment can be planned or run at the same time.
+		var executing string
 		if p, ok := c.planners[appID]; ok {
+			executing = p.ID()
 			c.logger.Info("temporarily skip planning because another deployment is planning",
-				zap.String("deployment", d.Id),
-				zap.String("app", d.ApplicationId),
-				zap.String("executing-deployment", p.deployment.Id),
+				zap.String("deployment", ds[0].Id),
+				zap.String("app", appID),
+				zap.String("executing-deployment", executing),
 			)
-			continue
-		}
-		// If this application is deploying, no other deployments can be added to plan.
-		if s, ok := c.schedulers[appID]; ok {
+		} else if s, ok := c.schedulers[appID]; ok {
+			executing = s.ID()
 			c.logger.Info("temporarily skip planning because another deployment is running",
-				zap.String("deployment", d.Id),
-				zap.String("app", d.ApplicationId),
-				zap.String("handling-deployment", s.deployment.Id),
+				zap.String("deployment", ds[0].Id),
+				zap.String("app", appID),
+				zap.String("handling-deployment", executing),
 			)
-			continue
 		}
+
 		// Choose the oldest PENDING deployment of the application to plan.
-		if pre, ok := pendingByApp[appID]; ok && !d.TriggerBefore(pre) {
-			continue
+		queued := ds
+		if executing == "" {
+			executing, queued = ds[0].Id, ds[1:]
+			controllermetrics.UpdateDeploymentStatus(ds[0], ds[0].Status)
+			candidates = append(candidates, ds[0])
+		}
+		for i, d := range queued {
+			reason := fmt.Sprintf("Waiting for deployment %s of the same application to be completed (position %d in queue)", executing, i+1)
+			c.reportQueuedDeployment(ctx, d, reason)
 		}
-		controllermetrics.UpdateDeploymentStatus(d, d.Status)
-		pendingByApp[appID] = d
 	}
 
-	for appID, d := range pendingByApp {
+	// Start the older deployments first when the concurrency limits are reached.
+	sortByTrigger(candidates)
+
+	var queued int
+	for _, d := range candidates {
+		appID := d.ApplicationId
+		if limit := limiter.blockedBy(d); limit != "" {
+			queued++
+			c.logger.Info("temporarily skip planning because the concurrency limit was reached",
+				zap.String("deployment", d.Id),
+				zap.String("app", appID),
+				zap.String("limit", limit),
+			)
+			reason := fmt.Sprintf("Waiting for %s (position %d in queue)", limit, queued)
+			c.reportQueuedDeployment(ctx, d, reason)
+			continue
+		}
+
 		plannable, cancel, cancelReason, err := c.shouldStartPlanningDeployment(ctx, d)
 		if err != nil {
 			c.logger.Error("failed to check deployment plannability",
@@ -379,6 +440,7 @@ func (c *controller) syncPlanners(ctx context.Context) {
 			continue
 		}
 		c.planners[appID] = planner
+		limiter.add(d)
 
 		// Application will be marked as DEPLOYING after its planner was successfully created.
 		if err := reportApplicationDeployingStatus(ctx, c.apiClient, d.ApplicationId, true); err != nil {
@@ -650,6 +712,9 @@ func (c *controller) shouldStartPlanningDeployment(ctx context.Context, d *model
 	if err != nil {
 		return
 	}
+	if resp.GateToAnalyze != nil {
+		c.startGateAnalysis(ctx, d, resp.GateToAnalyze)
+	}
 	plannable = resp.Plannable
 	cancel = resp.Cancel
 	cancelReason = resp.CancelReason
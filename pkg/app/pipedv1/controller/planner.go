@@ -225,6 +225,11 @@ func (p *planner) Run(ctx context.Context) error {
 		runningDS = rds.ToPluginDeploySource()
 	}
 
+	// The deployment triggered before the freeze window started should not be planned while the window is active.
+	if cancelled, err := p.cancelIfFrozen(ctx, targetDS); cancelled {
+		return err
+	}
+
 	out, err := p.buildPlan(ctx, runningDS, targetDS)
 
 	// If the deployment was already cancelled, we ignore the plan result.
@@ -554,7 +559,7 @@ func validateStageIndexes(req []*deployment.BuildPipelineSyncStagesRequest_Stage
 }
 
 func (p *planner) reportDeploymentPlanned(ctx context.Context, out *plannerOutput) error {
-	users, groups, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED)
+	users, groups, teamsUsers, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED)
 	if err != nil {
 		p.logger.Error("failed to get the list of users or groups", zap.Error(err))
 	}
@@ -563,10 +568,11 @@ func (p *planner) reportDeploymentPlanned(ctx context.Context, out *plannerOutpu
 		p.notifier.Notify(model.NotificationEvent{
 			Type: model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED,
 			Metadata: &model.NotificationEventDeploymentPlanned{
-				Deployment:        p.deployment,
-				Summary:           out.Summary,
-				MentionedAccounts: users,
-				MentionedGroups:   groups,
+				Deployment:          p.deployment,
+				Summary:             out.Summary,
+				MentionedAccounts:   users,
+				MentionedGroups:     groups,
+				MentionedTeamsUsers: teamsUsers,
 			},
 		})
 	}()
@@ -598,7 +604,7 @@ func (p *planner) reportDeploymentPlanned(ctx context.Context, out *plannerOutpu
 }
 
 func (p *planner) reportDeploymentFailed(ctx context.Context, reason string) error {
-	users, groups, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_FAILED)
+	users, groups, teamsUsers, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_FAILED)
 	if err != nil {
 		p.logger.Error("failed to get the list of users or groups", zap.Error(err))
 	}
@@ -607,10 +613,11 @@ func (p *planner) reportDeploymentFailed(ctx context.Context, reason string) err
 		p.notifier.Notify(model.NotificationEvent{
 			Type: model.NotificationEventType_EVENT_DEPLOYMENT_FAILED,
 			Metadata: &model.NotificationEventDeploymentFailed{
-				Deployment:        p.deployment,
-				Reason:            reason,
-				MentionedAccounts: users,
-				MentionedGroups:   groups,
+				Deployment:          p.deployment,
+				Reason:              reason,
+				MentionedAccounts:   users,
+				MentionedGroups:     groups,
+				MentionedTeamsUsers: teamsUsers,
 			},
 		})
 	}()
@@ -640,7 +647,7 @@ func (p *planner) reportDeploymentFailed(ctx context.Context, reason string) err
 }
 
 func (p *planner) reportDeploymentCancelled(ctx context.Context, commander, reason string) error {
-	users, groups, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
+	users, groups, teamsUsers, err := p.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
 	if err != nil {
 		p.logger.Error("failed to get the list of users or groups", zap.Error(err))
 	}
@@ -649,10 +656,11 @@ func (p *planner) reportDeploymentCancelled(ctx context.Context, commander, reas
 		p.notifier.Notify(model.NotificationEvent{
 			Type: model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED,
 			Metadata: &model.NotificationEventDeploymentCancelled{
-				Deployment:        p.deployment,
-				Commander:         commander,
-				MentionedAccounts: users,
-				MentionedGroups:   groups,
+				Deployment:          p.deployment,
+				Commander:           commander,
+				MentionedAccounts:   users,
+				MentionedGroups:     groups,
+				MentionedTeamsUsers: teamsUsers,
 			},
 		})
 	}()
@@ -681,17 +689,68 @@ func (p *planner) reportDeploymentCancelled(ctx context.Context, commander, reas
 	return err
 }
 
-// getApplicationNotificationMentions returns the list of users groups who should be mentioned in the notification.
-func (p *planner) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, error) {
+// cancelIfFrozen cancels the deployment in case a freeze window is active at the moment
+// unless the deployment was triggered to ignore the freeze windows.
+// It returns true when the deployment was cancelled.
+func (p *planner) cancelIfFrozen(ctx context.Context, targetDS *common.DeploymentSource) (bool, error) {
+	if p.deployment.Trigger.IgnoreFreezeWindows {
+		return false, nil
+	}
+	w, frozen := p.findActiveFreezeWindow(ctx, targetDS)
+	if !frozen {
+		return false, nil
+	}
+
+	p.doneDeploymentStatus = model.DeploymentStatus_DEPLOYMENT_CANCELLED
+	desc := fmt.Sprintf("Deployment was cancelled because it was going to be planned during a freeze window. %s", w.Description())
+	return true, p.reportDeploymentCancelled(ctx, "", desc)
+}
+
+// findActiveFreezeWindow returns the freeze window configured in the project or the application
+// which is active at the moment.
+func (p *planner) findActiveFreezeWindow(ctx context.Context, targetDS *common.DeploymentSource) (*config.DeploymentFreezeWindow, bool) {
+	now := p.nowFunc()
+	if w, ok := p.findActiveProjectFreezeWindow(ctx, now); ok {
+		return w, true
+	}
+
+	cfg, err := config.DecodeYAML[*config.GenericApplicationSpec](targetDS.GetApplicationConfig())
+	if err != nil {
+		// The error will be reported while planning the deployment.
+		p.logger.Warn("unable to parse the application config to check its freeze windows", zap.Error(err))
+		return nil, false
+	}
+	return config.FindActiveFreezeWindow(cfg.Spec.FreezeWindows, now)
+}
+
+// findActiveProjectFreezeWindow returns the freeze window configured in the project
+// which is active at the given time.
+func (p *planner) findActiveProjectFreezeWindow(ctx context.Context, now time.Time) (*config.DeploymentFreezeWindow, bool) {
+	resp, err := p.apiClient.GetProjectFreezeWindows(ctx, &pipedservice.GetProjectFreezeWindowsRequest{})
+	if err != nil {
+		// The deployment was already checked by the trigger, so it is planned as usual.
+		p.logger.Warn("unable to get the freeze windows of the project", zap.Error(err))
+		return nil, false
+	}
+	windows, err := config.NewProjectFreezeWindows(resp.FreezeWindows)
+	if err != nil {
+		p.logger.Warn("invalid freeze windows are configured in the project", zap.Error(err))
+		return nil, false
+	}
+	return config.FindActiveFreezeWindow(windows, now)
+}
+
+// getApplicationNotificationMentions returns the list of users and groups who should be mentioned in the notification.
+func (p *planner) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, []string, error) {
 	n, ok := p.medatadaStore.SharedGet(model.MetadataKeyDeploymentNotification)
 	if !ok {
-		return []string{}, []string{}, nil
+		return []string{}, []string{}, []string{}, nil
 	}
 
 	var notification config.DeploymentNotification
 	if err := json.Unmarshal([]byte(n), &notification); err != nil {
-		return nil, nil, fmt.Errorf("could not extract mentions config: %w", err)
+		return nil, nil, nil, fmt.Errorf("could not extract mentions config: %w", err)
 	}
 
-	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), nil
+	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), notification.FindTeamsUsers(event), nil
 }
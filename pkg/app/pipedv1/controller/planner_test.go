@@ -28,7 +28,9 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/metadatastore"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin"
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	config "github.com/pipe-cd/pipecd/pkg/configv1"
 	"github.com/pipe-cd/pipecd/pkg/model"
 	pluginapi "github.com/pipe-cd/pipecd/pkg/plugin/api/v1alpha1"
@@ -1221,3 +1223,120 @@ func TestValidateStageIndexes(t *testing.T) {
 		})
 	}
 }
+
+type fakeFreezeWindowsAPIClient struct {
+	apiClient
+
+	freezeWindows []*model.ProjectFreezeWindow
+	completed     []*pipedservice.ReportDeploymentCompletedRequest
+}
+
+func (c *fakeFreezeWindowsAPIClient) GetProjectFreezeWindows(context.Context, *pipedservice.GetProjectFreezeWindowsRequest, ...grpc.CallOption) (*pipedservice.GetProjectFreezeWindowsResponse, error) {
+	return &pipedservice.GetProjectFreezeWindowsResponse{FreezeWindows: c.freezeWindows}, nil
+}
+
+func (c *fakeFreezeWindowsAPIClient) ReportDeploymentCompleted(_ context.Context, req *pipedservice.ReportDeploymentCompletedRequest, _ ...grpc.CallOption) (*pipedservice.ReportDeploymentCompletedResponse, error) {
+	c.completed = append(c.completed, req)
+	return &pipedservice.ReportDeploymentCompletedResponse{}, nil
+}
+
+type fakeNotifier struct{}
+
+func (fakeNotifier) Notify(model.NotificationEvent) {}
+
+func TestPlanner_CancelIfFrozen(t *testing.T) {
+	t.Parallel()
+
+	const appConfig = `
+apiVersion: pipecd.dev/v1beta1
+kind: Application
+spec:
+  name: app
+`
+	now := time.Date(2024, 6, 1, 0, 30, 0, 0, time.UTC)
+	projectWindow := &model.ProjectFreezeWindow{
+		Name:   "project-freeze",
+		Reason: "release freeze",
+		Start:  "2024-06-01T00:00:00Z",
+		End:    "2024-06-01T01:00:00Z",
+	}
+
+	testcases := []struct {
+		name                string
+		projectWindow       *model.ProjectFreezeWindow
+		appConfig           string
+		ignoreFreezeWindows bool
+		expectedReason      string
+	}{
+		{
+			name:      "no freeze window",
+			appConfig: appConfig,
+		},
+		{
+			name:           "cancelled by project freeze window",
+			projectWindow:  projectWindow,
+			appConfig:      appConfig,
+			expectedReason: `Deployment was cancelled because it was going to be planned during a freeze window. Deployments are frozen by the freeze window "project-freeze": release freeze`,
+		},
+		{
+			name: "cancelled by application freeze window",
+			appConfig: appConfig + `
+  freezeWindows:
+    - name: app-freeze
+      start: "2024-06-01 09:00"
+      end: "2024-06-01 10:00"
+      timeZone: Asia/Tokyo
+`,
+			expectedReason: `Deployment was cancelled because it was going to be planned during a freeze window. Deployments are frozen by the freeze window "app-freeze"`,
+		},
+		{
+			name:                "triggered to ignore freeze windows",
+			projectWindow:       projectWindow,
+			appConfig:           appConfig,
+			ignoreFreezeWindows: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &fakeFreezeWindowsAPIClient{}
+			if tc.projectWindow != nil {
+				client.freezeWindows = []*model.ProjectFreezeWindow{tc.projectWindow}
+			}
+			d := &model.Deployment{
+				Id:     "deployment-id",
+				Status: model.DeploymentStatus_DEPLOYMENT_PENDING,
+				Trigger: &model.DeploymentTrigger{
+					IgnoreFreezeWindows: tc.ignoreFreezeWindows,
+				},
+			}
+			p := &planner{
+				deployment:           d,
+				apiClient:            client,
+				notifier:             fakeNotifier{},
+				medatadaStore:        metadatastore.NewMetadataStoreRegistry(nil).Register(d),
+				doneDeploymentStatus: d.Status,
+				nowFunc:              func() time.Time { return now },
+				logger:               zap.NewNop(),
+			}
+
+			cancelled, err := p.cancelIfFrozen(context.Background(), &common.DeploymentSource{
+				ApplicationConfig: []byte(tc.appConfig),
+			})
+			require.NoError(t, err)
+
+			if tc.expectedReason == "" {
+				assert.False(t, cancelled)
+				assert.Equal(t, model.DeploymentStatus_DEPLOYMENT_PENDING, p.DoneDeploymentStatus())
+				assert.Empty(t, client.completed)
+				return
+			}
+			assert.True(t, cancelled)
+			assert.Equal(t, model.DeploymentStatus_DEPLOYMENT_CANCELLED, p.DoneDeploymentStatus())
+			require.Len(t, client.completed, 1)
+			assert.Equal(t, model.DeploymentStatus_DEPLOYMENT_CANCELLED, client.completed[0].Status)
+			assert.Equal(t, tc.expectedReason, client.completed[0].StatusReason)
+		})
+	}
+}
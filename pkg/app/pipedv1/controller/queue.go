@@ -0,0 +1,226 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// concurrencyLimiter keeps track of the deployments being planned or run
+// to decide whether a new deployment can be started under the configured limits.
+type concurrencyLimiter struct {
+	config *config.DeploymentConcurrency
+	// Set of the deployment IDs being planned or run.
+	running map[string]struct{}
+	// Map from label group name to the number of its deployments being planned or run.
+	groups map[string]int
+}
+
+func newConcurrencyLimiter(cfg *config.DeploymentConcurrency) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		config:  cfg,
+		running: make(map[string]struct{}),
+		groups:  make(map[string]int),
+	}
+}
+
+// add counts the given deployment as being planned or run.
+func (l *concurrencyLimiter) add(d *model.Deployment) {
+	if _, ok := l.running[d.Id]; ok {
+		return
+	}
+	l.running[d.Id] = struct{}{}
+	if l.config == nil {
+		return
+	}
+	for i := range l.config.LabelGroups {
+		if g := &l.config.LabelGroups[i]; g.Match(d.Labels) {
+			l.groups[g.Name]++
+		}
+	}
+}
+
+// blockedBy returns the description of the limit preventing the given deployment
+// from being started. Empty string means the deployment can be started.
+func (l *concurrencyLimiter) blockedBy(d *model.Deployment) string {
+	if l.config == nil {
+		return ""
+	}
+	if max := l.config.MaxRunningDeployments; max > 0 && len(l.running) >= max {
+		return fmt.Sprintf("the number of running deployments of this piped to be less than %d", max)
+	}
+	for i := range l.config.LabelGroups {
+		g := &l.config.LabelGroups[i]
+		if !g.Match(d.Labels) {
+			continue
+		}
+		if l.groups[g.Name] >= g.MaxRunningDeployments {
+			return fmt.Sprintf("the number of running deployments in label group %s to be less than %d", g.Name, g.MaxRunningDeployments)
+		}
+	}
+	return ""
+}
+
+// sortByTrigger sorts the given deployments from the oldest to the newest one.
+func sortByTrigger(ds []*model.Deployment) {
+	sort.SliceStable(ds, func(i, j int) bool {
+		return ds[i].TriggerBefore(ds[j]) && !ds[j].TriggerBefore(ds[i])
+	})
+}
+
+// findSupersededDeployments returns the newest deployment which is not in a deployment chain
+// and the older ones which should be cancelled by its concurrency policy.
+// The given deployments must be sorted from the oldest to the newest one.
+func findSupersededDeployments(ds []*model.Deployment) (newest *model.Deployment, superseded []*model.Deployment) {
+	for i := len(ds) - 1; i >= 0; i-- {
+		if !ds[i].IsInChainDeployment() {
+			newest = ds[i]
+			break
+		}
+	}
+	if newest == nil || newest.ConcurrencyPolicy == model.DeploymentConcurrencyPolicy_CONCURRENCY_QUEUE {
+		return newest, nil
+	}
+	for _, d := range ds {
+		if d == newest {
+			break
+		}
+		// The deployments in a deployment chain are controlled by their chain.
+		if d.IsInChainDeployment() {
+			continue
+		}
+		superseded = append(superseded, d)
+	}
+	return newest, superseded
+}
+
+// applyConcurrencyPolicy cancels the deployments superseded by the newest pending deployment
+// of the application and returns the remaining ones.
+// The given deployments must be sorted from the oldest to the newest one.
+func (c *controller) applyConcurrencyPolicy(ctx context.Context, appID string, ds []*model.Deployment) []*model.Deployment {
+	newest, superseded := findSupersededDeployments(ds)
+	if newest == nil {
+		return ds
+	}
+
+	cancelled := make(map[string]struct{}, len(superseded))
+	for _, d := range superseded {
+		reason := fmt.Sprintf("Superseded by the newer deployment %s", newest.Id)
+		if err := c.cancelDeployment(ctx, d, reason); err != nil {
+			c.logger.Error("failed to cancel superseded deployment",
+				zap.String("deployment", d.Id),
+				zap.String("app", appID),
+				zap.Error(err),
+			)
+			continue
+		}
+		c.logger.Info("cancelled deployment because it was superseded by the newer one",
+			zap.String("deployment", d.Id),
+			zap.String("app", appID),
+			zap.String("newer-deployment", newest.Id),
+		)
+		c.donePlanners[d.Id] = time.Now()
+		cancelled[d.Id] = struct{}{}
+	}
+
+	if newest.ConcurrencyPolicy == model.DeploymentConcurrencyPolicy_CONCURRENCY_CANCEL_RUNNING {
+		c.cancelRunningDeployment(appID, newest)
+	}
+
+	if len(cancelled) == 0 {
+		return ds
+	}
+	remains := make([]*model.Deployment, 0, len(ds)-len(cancelled))
+	for _, d := range ds {
+		if _, ok := cancelled[d.Id]; !ok {
+			remains = append(remains, d)
+		}
+	}
+	return remains
+}
+
+// cancelRunningDeployment cancels the deployment of the application being planned or run
+// to let the given newer deployment be started.
+func (c *controller) cancelRunningDeployment(appID string, newer *model.Deployment) {
+	var (
+		running *model.Deployment
+		cancel  func(model.ReportableCommand)
+	)
+	if p, ok := c.planners[appID]; ok {
+		running, cancel = p.deployment, p.Cancel
+	}
+	if s, ok := c.schedulers[appID]; ok {
+		running, cancel = s.deployment, s.Cancel
+	}
+	// The deployments in a deployment chain are controlled by their chain.
+	if running == nil || running.IsInChainDeployment() || !running.TriggerBefore(newer) {
+		return
+	}
+
+	cmd := model.ReportableCommand{
+		Command: &model.Command{
+			PipedId:       running.PipedId,
+			ApplicationId: appID,
+			DeploymentId:  running.Id,
+			ProjectId:     running.ProjectId,
+			Type:          model.Command_CANCEL_DEPLOYMENT,
+			Commander:     fmt.Sprintf("the newer deployment %s", newer.Id),
+			CancelDeployment: &model.Command_CancelDeployment{
+				DeploymentId: running.Id,
+			},
+		},
+		// This command was issued by piped itself so there is nothing to report.
+		Report: func(context.Context, model.CommandStatus, map[string]string, []byte) error {
+			return nil
+		},
+	}
+	cancel(cmd)
+	c.logger.Info("cancelling running deployment because a newer deployment was triggered",
+		zap.String("deployment", running.Id),
+		zap.String("app", appID),
+		zap.String("newer-deployment", newer.Id),
+	)
+}
+
+// reportQueuedDeployment updates the status reason of the given PENDING deployment
+// to show why it is waiting and its position in the queue.
+func (c *controller) reportQueuedDeployment(ctx context.Context, d *model.Deployment, reason string) {
+	if d.StatusReason == reason {
+		return
+	}
+	req := &pipedservice.ReportDeploymentStatusChangedRequest{
+		DeploymentId:              d.Id,
+		Status:                    model.DeploymentStatus_DEPLOYMENT_PENDING,
+		StatusReason:              reason,
+		DeploymentChainId:         d.DeploymentChainId,
+		DeploymentChainBlockIndex: d.DeploymentChainBlockIndex,
+	}
+	if _, err := c.apiClient.ReportDeploymentStatusChanged(ctx, req); err != nil {
+		c.logger.Error("failed to report the status reason of queued deployment",
+			zap.String("deployment", d.Id),
+			zap.String("app", d.ApplicationId),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func newQueueTestDeployment(id string, createdAt int64, labels map[string]string) *model.Deployment {
+	return &model.Deployment{
+		Id:     id,
+		Labels: labels,
+		Trigger: &model.DeploymentTrigger{
+			Commit:    &model.Commit{CreatedAt: createdAt},
+			Timestamp: createdAt,
+		},
+	}
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	t.Parallel()
+
+	prod := map[string]string{"env": "prod"}
+	dev := map[string]string{"env": "dev"}
+
+	testcases := []struct {
+		name    string
+		config  *config.DeploymentConcurrency
+		running []*model.Deployment
+		target  *model.Deployment
+		blocked bool
+	}{
+		{
+			name: "no limit",
+			running: []*model.Deployment{
+				newQueueTestDeployment("d1", 1, prod),
+			},
+			target: newQueueTestDeployment("d2", 2, prod),
+		},
+		{
+			name:   "under piped limit",
+			config: &config.DeploymentConcurrency{MaxRunningDeployments: 2},
+			running: []*model.Deployment{
+				newQueueTestDeployment("d1", 1, prod),
+			},
+			target: newQueueTestDeployment("d2", 2, prod),
+		},
+		{
+			name:   "reached piped limit",
+			config: &config.DeploymentConcurrency{MaxRunningDeployments: 2},
+			running: []*model.Deployment{
+				newQueueTestDeployment("d1", 1, prod),
+				newQueueTestDeployment("d2", 2, dev),
+				// Counted only once.
+				newQueueTestDeployment("d2", 2, dev),
+			},
+			target:  newQueueTestDeployment("d3", 3, dev),
+			blocked: true,
+		},
+		{
+			name: "reached label group limit",
+			config: &config.DeploymentConcurrency{
+				LabelGroups: []config.DeploymentConcurrencyLabelGroup{
+					{Name: "prod", Labels: prod, MaxRunningDeployments: 1},
+				},
+			},
+			running: []*model.Deployment{
+				newQueueTestDeployment("d1", 1, prod),
+			},
+			target:  newQueueTestDeployment("d2", 2, prod),
+			blocked: true,
+		},
+		{
+			name: "label group limit does not apply to other labels",
+			config: &config.DeploymentConcurrency{
+				LabelGroups: []config.DeploymentConcurrencyLabelGroup{
+					{Name: "prod", Labels: prod, MaxRunningDeployments: 1},
+				},
+			},
+			running: []*model.Deployment{
+				newQueueTestDeployment("d1", 1, prod),
+			},
+			target: newQueueTestDeployment("d2", 2, dev),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			l := newConcurrencyLimiter(tc.config)
+			for _, d := range tc.running {
+				l.add(d)
+			}
+			assert.Equal(t, tc.blocked, l.blockedBy(tc.target) != "")
+		})
+	}
+}
+
+func TestFindSupersededDeployments(t *testing.T) {
+	t.Parallel()
+
+	withPolicy := func(d *model.Deployment, p model.DeploymentConcurrencyPolicy) *model.Deployment {
+		d.ConcurrencyPolicy = p
+		return d
+	}
+	inChain := func(d *model.Deployment) *model.Deployment {
+		d.DeploymentChainId = "chain"
+		return d
+	}
+
+	testcases := []struct {
+		name           string
+		deployments    []*model.Deployment
+		wantNewest     string
+		wantSuperseded []string
+	}{
+		{
+			name:        "empty",
+			deployments: nil,
+		},
+		{
+			name: "queue policy",
+			deployments: []*model.Deployment{
+				newQueueTestDeployment("d1", 1, nil),
+				newQueueTestDeployment("d2", 2, nil),
+			},
+			wantNewest: "d2",
+		},
+		{
+			name: "supersede pending policy",
+			deployments: []*model.Deployment{
+				newQueueTestDeployment("d1", 1, nil),
+				inChain(newQueueTestDeployment("d2", 2, nil)),
+				newQueueTestDeployment("d3", 3, nil),
+				withPolicy(newQueueTestDeployment("d4", 4, nil), model.DeploymentConcurrencyPolicy_CONCURRENCY_SUPERSEDE_PENDING),
+			},
+			wantNewest:     "d4",
+			wantSuperseded: []string{"d1", "d3"},
+		},
+		{
+			name: "newest in chain is ignored",
+			deployments: []*model.Deployment{
+				newQueueTestDeployment("d1", 1, nil),
+				withPolicy(newQueueTestDeployment("d2", 2, nil), model.DeploymentConcurrencyPolicy_CONCURRENCY_CANCEL_RUNNING),
+				inChain(withPolicy(newQueueTestDeployment("d3", 3, nil), model.DeploymentConcurrencyPolicy_CONCURRENCY_QUEUE)),
+			},
+			wantNewest:     "d2",
+			wantSuperseded: []string{"d1"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			newest, superseded := findSupersededDeployments(tc.deployments)
+			if tc.wantNewest == "" {
+				assert.Nil(t, newest)
+			} else {
+				assert.Equal(t, tc.wantNewest, newest.Id)
+			}
+			ids := make([]string, 0, len(superseded))
+			for _, d := range superseded {
+				ids = append(ids, d.Id)
+			}
+			if len(tc.wantSuperseded) == 0 {
+				assert.Empty(t, ids)
+			} else {
+				assert.Equal(t, tc.wantSuperseded, ids)
+			}
+		})
+	}
+}
+
+func TestSortByTrigger(t *testing.T) {
+	t.Parallel()
+
+	ds := []*model.Deployment{
+		newQueueTestDeployment("d3", 3, nil),
+		newQueueTestDeployment("d1", 1, nil),
+		newQueueTestDeployment("d2", 2, nil),
+	}
+	sortByTrigger(ds)
+	assert.Equal(t, "d1", ds[0].Id)
+	assert.Equal(t, "d2", ds[1].Id)
+	assert.Equal(t, "d3", ds[2].Id)
+}
@@ -203,7 +203,7 @@ func (s *scheduler) Run(ctx context.Context) error {
 		controllermetrics.UpdateDeploymentStatus(s.deployment, model.DeploymentStatus_DEPLOYMENT_RUNNING)
 
 		// Notify the deployment started event
-		users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_STARTED)
+		users, groups, teamsUsers, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_STARTED)
 		if err != nil {
 			s.logger.Error("failed to get the list of users or groups", zap.Error(err))
 		}
@@ -211,9 +211,10 @@ func (s *scheduler) Run(ctx context.Context) error {
 		s.notifier.Notify(model.NotificationEvent{
 			Type: model.NotificationEventType_EVENT_DEPLOYMENT_STARTED,
 			Metadata: &model.NotificationEventDeploymentStarted{
-				Deployment:        s.deployment,
-				MentionedAccounts: users,
-				MentionedGroups:   groups,
+				Deployment:          s.deployment,
+				MentionedAccounts:   users,
+				MentionedGroups:     groups,
+				MentionedTeamsUsers: teamsUsers,
 			},
 		})
 	}
@@ -761,21 +762,22 @@ func (s *scheduler) reportDeploymentCompleted(ctx context.Context, status model.
 	defer func() {
 		switch status {
 		case model.DeploymentStatus_DEPLOYMENT_SUCCESS:
-			users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
+			users, groups, teamsUsers, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
 			if err != nil {
 				s.logger.Error("failed to get the list of users", zap.Error(err))
 			}
 			s.notifier.Notify(model.NotificationEvent{
 				Type: model.NotificationEventType_EVENT_DEPLOYMENT_SUCCEEDED,
 				Metadata: &model.NotificationEventDeploymentSucceeded{
-					Deployment:        s.deployment,
-					MentionedAccounts: users,
-					MentionedGroups:   groups,
+					Deployment:          s.deployment,
+					MentionedAccounts:   users,
+					MentionedGroups:     groups,
+					MentionedTeamsUsers: teamsUsers,
 				},
 			})
 
 		case model.DeploymentStatus_DEPLOYMENT_FAILURE:
-			users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
+			users, groups, teamsUsers, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
 			if err != nil {
 				s.logger.Error("failed to get the list of users", zap.Error(err))
 			}
@@ -783,25 +785,27 @@ func (s *scheduler) reportDeploymentCompleted(ctx context.Context, status model.
 			s.notifier.Notify(model.NotificationEvent{
 				Type: model.NotificationEventType_EVENT_DEPLOYMENT_FAILED,
 				Metadata: &model.NotificationEventDeploymentFailed{
-					Deployment:        s.deployment,
-					Reason:            desc,
-					MentionedAccounts: users,
-					MentionedGroups:   groups,
+					Deployment:          s.deployment,
+					Reason:              desc,
+					MentionedAccounts:   users,
+					MentionedGroups:     groups,
+					MentionedTeamsUsers: teamsUsers,
 				},
 			})
 
 		case model.DeploymentStatus_DEPLOYMENT_CANCELLED:
-			users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
+			users, groups, teamsUsers, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED)
 			if err != nil {
 				s.logger.Error("failed to get the list of users", zap.Error(err))
 			}
 			s.notifier.Notify(model.NotificationEvent{
 				Type: model.NotificationEventType_EVENT_DEPLOYMENT_CANCELLED,
 				Metadata: &model.NotificationEventDeploymentCancelled{
-					Deployment:        s.deployment,
-					Commander:         cancelCommander,
-					MentionedAccounts: users,
-					MentionedGroups:   groups,
+					Deployment:          s.deployment,
+					Commander:           cancelCommander,
+					MentionedAccounts:   users,
+					MentionedGroups:     groups,
+					MentionedTeamsUsers: teamsUsers,
 				},
 			})
 		}
@@ -819,19 +823,19 @@ func (s *scheduler) reportDeploymentCompleted(ctx context.Context, status model.
 	return err
 }
 
-// getApplicationNotificationMentions returns the list of users groups who should be mentioned in the notification.
-func (s *scheduler) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, error) {
+// getApplicationNotificationMentions returns the list of users and groups who should be mentioned in the notification.
+func (s *scheduler) getApplicationNotificationMentions(event model.NotificationEventType) ([]string, []string, []string, error) {
 	n, ok := s.metadataStore.SharedGet(model.MetadataKeyDeploymentNotification)
 	if !ok {
-		return []string{}, []string{}, nil
+		return []string{}, []string{}, []string{}, nil
 	}
 
 	var notification config.DeploymentNotification
 	if err := json.Unmarshal([]byte(n), &notification); err != nil {
-		return nil, nil, fmt.Errorf("could not extract mentions config: %w", err)
+		return nil, nil, nil, fmt.Errorf("could not extract mentions config: %w", err)
 	}
 
-	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), nil
+	return notification.FindSlackUsers(event), notification.FindSlackGroups(event), notification.FindTeamsUsers(event), nil
 }
 
 func (s *scheduler) reportMostRecentlySuccessfulDeployment(ctx context.Context) error {
@@ -874,16 +878,17 @@ func (s *scheduler) notifyStageStartEvent(stage *model.PipelineStage) {
 	})
 
 	if stage.AvailableOperation == model.ManualOperation_MANUAL_OPERATION_APPROVE {
-		users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL)
+		users, groups, teamsUsers, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL)
 		if err != nil {
 			s.logger.Error("failed to get the list of mentions", zap.Error(err))
 		}
 		s.notifier.Notify(model.NotificationEvent{
 			Type: model.NotificationEventType_EVENT_DEPLOYMENT_WAIT_APPROVAL,
 			Metadata: &model.NotificationEventDeploymentWaitApproval{
-				Deployment:        s.deployment,
-				MentionedAccounts: users,
-				MentionedGroups:   groups,
+				Deployment:          s.deployment,
+				MentionedAccounts:   users,
+				MentionedGroups:     groups,
+				MentionedTeamsUsers: teamsUsers,
 			},
 		})
 	}
@@ -895,7 +900,7 @@ func (s *scheduler) notifyStageEndEvent(stage *model.PipelineStage, result model
 	case model.StageStatus_STAGE_SUCCESS, model.StageStatus_STAGE_EXITED: // Exit stage is treated as success.
 
 		if stage.AvailableOperation == model.ManualOperation_MANUAL_OPERATION_APPROVE {
-			users, groups, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED)
+			users, groups, teamsUsers, err := s.getApplicationNotificationMentions(model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED)
 			if err != nil {
 				s.logger.Error("failed to get the list of users", zap.Error(err))
 			}
@@ -903,10 +908,11 @@ func (s *scheduler) notifyStageEndEvent(stage *model.PipelineStage, result model
 				s.notifier.Notify(model.NotificationEvent{
 					Type: model.NotificationEventType_EVENT_DEPLOYMENT_APPROVED,
 					Metadata: &model.NotificationEventDeploymentApproved{
-						Deployment:        s.deployment,
-						Approver:          approvers,
-						MentionedAccounts: users,
-						MentionedGroups:   groups,
+						Deployment:          s.deployment,
+						Approver:            approvers,
+						MentionedAccounts:   users,
+						MentionedGroups:     groups,
+						MentionedTeamsUsers: teamsUsers,
 					},
 				})
 			}
@@ -73,6 +73,8 @@ func NewNotifier(cfg *config.PipedSpec, logger *zap.Logger) (*Notifier, error) {
 			sd = slacksender
 		case receiver.Webhook != nil:
 			sd = newWebhookSender(receiver.Name, *receiver.Webhook, cfg.WebAddress, logger)
+		case receiver.Teams != nil:
+			sd = newTeamsSender(receiver.Name, *receiver.Teams, cfg.WebAddress, logger)
 		default:
 			continue
 		}
@@ -0,0 +1,222 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewProvider("", "logs-*")
+	assert.Error(t, err)
+
+	_, err = NewProvider("http://elasticsearch:9200", "")
+	assert.Error(t, err)
+
+	p, err := NewProvider("http://elasticsearch:9200/", "logs-*")
+	require.NoError(t, err)
+	assert.Equal(t, "http://elasticsearch:9200", p.address)
+	assert.Equal(t, "@timestamp", p.timestampField)
+	assert.Equal(t, "message", p.messageField)
+}
+
+func TestProviderEvaluate(t *testing.T) {
+	t.Parallel()
+
+	queryRange := log.QueryRange{
+		From: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, time.January, 1, 0, 5, 0, 0, time.UTC),
+	}
+
+	testcases := []struct {
+		name          string
+		opts          []Option
+		status        int
+		response      string
+		queryRange    log.QueryRange
+		expected      bool
+		reasonContain []string
+		wantErr       bool
+	}{
+		{
+			name:          "no documents matched",
+			response:      `{"hits":{"total":{"value":0,"relation":"eq"},"hits":[]}}`,
+			queryRange:    queryRange,
+			expected:      true,
+			reasonContain: []string{"no log entry matched"},
+		},
+		{
+			name: "some documents matched",
+			response: `{"hits":{"total":{"value":5,"relation":"eq"},"hits":[
+				{"_source":{"@timestamp":"2024-01-01T00:01:00Z","message":"connection refused"}},
+				{"_source":{"@timestamp":"2024-01-01T00:00:50Z","level":"error"}}
+			]}}`,
+			queryRange: queryRange,
+			expected:   false,
+			reasonContain: []string{
+				"found 5 log entries",
+				`"2024-01-01T00:01:00Z connection refused"`,
+				`"2024-01-01T00:00:50Z {\"@timestamp\":\"2024-01-01T00:00:50Z\",\"level\":\"error\"}"`,
+			},
+		},
+		{
+			name: "nested message field",
+			opts: []Option{WithTimestampField("time"), WithMessageField("log.message")},
+			response: `{"hits":{"total":{"value":1,"relation":"eq"},"hits":[
+				{"_source":{"time":"2024-01-01T00:01:00Z","log":{"message":"panic: nil pointer dereference"}}}
+			]}}`,
+			queryRange: queryRange,
+			expected:   false,
+			reasonContain: []string{
+				"found 1 log entries",
+				`"2024-01-01T00:01:00Z panic: nil pointer dereference"`,
+			},
+		},
+		{
+			name: "too many documents matched",
+			response: `{"hits":{"total":{"value":1000,"relation":"gte"},"hits":[
+				{"_source":{"@timestamp":"2024-01-01T00:01:00Z","message":"error"}}
+			]}}`,
+			queryRange:    queryRange,
+			expected:      false,
+			reasonContain: []string{"found more than 1000 log entries"},
+		},
+		{
+			name:       "query failed",
+			status:     http.StatusBadRequest,
+			response:   `{"error":{"type":"search_phase_execution_exception"},"status":400}`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name: "invalid query range",
+			queryRange: log.QueryRange{
+				From: queryRange.To,
+				To:   queryRange.From,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var body map[string]interface{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/logs-*/_search", r.URL.Path)
+				assert.Equal(t, "ApiKey key", r.Header.Get("Authorization"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+				if tc.status != 0 {
+					w.WriteHeader(tc.status)
+				}
+				fmt.Fprint(w, tc.response)
+			}))
+			defer server.Close()
+
+			opts := append([]Option{WithAPIKey("key")}, tc.opts...)
+			p, err := NewProvider(server.URL, "logs-*", opts...)
+			require.NoError(t, err)
+
+			result, reason, err := p.Evaluate(context.Background(), `level:error AND app:foo`, tc.queryRange)
+			assert.Equal(t, tc.wantErr, err != nil, "%v", err)
+			if tc.wantErr {
+				return
+			}
+			assert.Equal(t, tc.expected, result)
+			for _, s := range tc.reasonContain {
+				assert.Contains(t, reason, s)
+			}
+			assert.Equal(t, float64(maxSampleEntries), body["size"])
+			assert.Equal(t, float64(maxCountedEntries), body["track_total_hits"])
+		})
+	}
+}
+
+func TestBuildSearchBody(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewProvider("http://elasticsearch:9200", "logs-*")
+	require.NoError(t, err)
+
+	queryRange := log.QueryRange{
+		From: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, time.January, 1, 0, 5, 0, 0, time.UTC),
+	}
+	got, err := json.Marshal(p.buildSearchBody(" level:error ", queryRange))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"size": 3,
+		"track_total_hits": 1000,
+		"sort": [{"@timestamp": {"order": "desc"}}],
+		"query": {
+			"bool": {
+				"filter": [
+					{"range": {"@timestamp": {"gte": "2024-01-01T00:00:00Z", "lte": "2024-01-01T00:05:00Z", "format": "strict_date_optional_time"}}},
+					{"query_string": {"query": "level:error"}}
+				]
+			}
+		}
+	}`, string(got))
+
+	got, err = json.Marshal(p.buildSearchBody("", queryRange))
+	require.NoError(t, err)
+	assert.NotContains(t, string(got), "query_string")
+}
+
+func TestLookupField(t *testing.T) {
+	t.Parallel()
+
+	source := map[string]interface{}{
+		"message":   "top",
+		"log.level": "flattened",
+		"log": map[string]interface{}{
+			"message": "nested",
+		},
+	}
+	testcases := []struct {
+		field    string
+		expected interface{}
+		found    bool
+	}{
+		{field: "message", expected: "top", found: true},
+		{field: "log.level", expected: "flattened", found: true},
+		{field: "log.message", expected: "nested", found: true},
+		{field: "log.missing", found: false},
+		{field: "message.missing", found: false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.field, func(t *testing.T) {
+			got, found := lookupField(source, tc.field)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -15,18 +15,22 @@
 package factory
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log/elasticsearch"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log/loki"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log/stackdriver"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/config"
 )
 
 // NewProvider generates an appropriate provider according to analysis provider config.
-func NewProvider(providerCfg *config.PipedAnalysisProvider, logger *zap.Logger) (provider log.Provider, err error) {
+func NewProvider(ctx context.Context, analysisCfg *config.AnalysisLog, providerCfg *config.PipedAnalysisProvider, logger *zap.Logger) (provider log.Provider, err error) {
 	switch providerCfg.Type {
 	case config.AnalysisProviderStackdriver:
 		cfg := providerCfg.StackdriverConfig
@@ -34,7 +38,69 @@ func NewProvider(providerCfg *config.PipedAnalysisProvider, logger *zap.Logger)
 		if err != nil {
 			return nil, err
 		}
-		provider, err = stackdriver.NewProvider(sa)
+		options := []stackdriver.Option{
+			stackdriver.WithLogger(logger),
+		}
+		if timeout := analysisCfg.Timeout.Duration(); timeout > 0 {
+			options = append(options, stackdriver.WithTimeout(timeout))
+		}
+		provider, err = stackdriver.NewProvider(ctx, sa, options...)
+		if err != nil {
+			return nil, err
+		}
+
+	case config.AnalysisProviderLoki:
+		cfg := providerCfg.LokiConfig
+		options := []loki.Option{
+			loki.WithLogger(logger),
+		}
+		if timeout := analysisCfg.Timeout.Duration(); timeout > 0 {
+			options = append(options, loki.WithTimeout(timeout))
+		}
+		if cfg.UsernameFile != "" && cfg.PasswordFile != "" {
+			username, password, err := readBasicAuth(cfg.UsernameFile, cfg.PasswordFile)
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, loki.WithBasicAuth(username, password))
+		}
+		if cfg.TenantID != "" {
+			options = append(options, loki.WithTenantID(cfg.TenantID))
+		}
+		provider, err = loki.NewProvider(cfg.Address, options...)
+		if err != nil {
+			return nil, err
+		}
+
+	case config.AnalysisProviderElasticsearch:
+		cfg := providerCfg.ElasticsearchConfig
+		options := []elasticsearch.Option{
+			elasticsearch.WithLogger(logger),
+		}
+		if timeout := analysisCfg.Timeout.Duration(); timeout > 0 {
+			options = append(options, elasticsearch.WithTimeout(timeout))
+		}
+		if cfg.UsernameFile != "" && cfg.PasswordFile != "" {
+			username, password, err := readBasicAuth(cfg.UsernameFile, cfg.PasswordFile)
+			if err != nil {
+				return nil, err
+			}
+			options = append(options, elasticsearch.WithBasicAuth(username, password))
+		}
+		if cfg.APIKeyFile != "" {
+			apiKey, err := os.ReadFile(cfg.APIKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the api-key file: %w", err)
+			}
+			options = append(options, elasticsearch.WithAPIKey(strings.TrimSpace(string(apiKey))))
+		}
+		if cfg.TimestampField != "" {
+			options = append(options, elasticsearch.WithTimestampField(cfg.TimestampField))
+		}
+		if cfg.MessageField != "" {
+			options = append(options, elasticsearch.WithMessageField(cfg.MessageField))
+		}
+		provider, err = elasticsearch.NewProvider(cfg.Address, cfg.Index, options...)
 		if err != nil {
 			return nil, err
 		}
@@ -44,3 +110,15 @@ func NewProvider(providerCfg *config.PipedAnalysisProvider, logger *zap.Logger)
 	}
 	return provider, nil
 }
+
+func readBasicAuth(usernameFile, passwordFile string) (string, string, error) {
+	username, err := os.ReadFile(usernameFile)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read the username file: %w", err)
+	}
+	password, err := os.ReadFile(passwordFile)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read the password file: %w", err)
+	}
+	return strings.TrimSpace(string(username)), strings.TrimSpace(string(password)), nil
+}
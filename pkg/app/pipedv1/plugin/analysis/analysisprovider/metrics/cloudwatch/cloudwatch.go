@@ -0,0 +1,257 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+)
+
+const (
+	ProviderType   = "CloudWatch"
+	defaultTimeout = 30 * time.Second
+	// defaultPeriod is the granularity of the returned data points.
+	defaultPeriod = time.Minute
+
+	// queryID is the ID given to the expression query.
+	queryID = "q1"
+)
+
+// Provider is a client for Amazon CloudWatch Metrics.
+type Provider struct {
+	client cloudwatch.GetMetricDataAPIClient
+
+	credentialsFile string
+	profile         string
+	roleARN         string
+	tokenFile       string
+	period          time.Duration
+	timeout         time.Duration
+	logger          *zap.Logger
+}
+
+type Option func(*Provider)
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("cloudwatch-provider")
+	}
+}
+
+// WithCredentialsFile specifies the path to the shared credentials file.
+func WithCredentialsFile(path string) Option {
+	return func(p *Provider) {
+		p.credentialsFile = path
+	}
+}
+
+// WithProfile specifies the profile in the shared credentials file.
+func WithProfile(profile string) Option {
+	return func(p *Provider) {
+		p.profile = profile
+	}
+}
+
+// WithWebIdentity makes the provider assume the given role with the WebIdentity token.
+func WithWebIdentity(roleARN, tokenFile string) Option {
+	return func(p *Provider) {
+		p.roleARN = roleARN
+		p.tokenFile = tokenFile
+	}
+}
+
+// WithPeriod overrides the granularity of the returned data points.
+// It must be a multiple of 60 seconds unless the metric is a high-resolution one.
+func WithPeriod(period time.Duration) Option {
+	return func(p *Provider) {
+		p.period = period
+	}
+}
+
+func NewProvider(region string, opts ...Option) (*Provider, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region is required")
+	}
+
+	p := &Provider{
+		period:  defaultPeriod,
+		timeout: defaultTimeout,
+		logger:  zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	optFns := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if p.credentialsFile != "" {
+		optFns = append(optFns, config.WithSharedCredentialsFiles([]string{p.credentialsFile}))
+	}
+	if p.profile != "" {
+		optFns = append(optFns, config.WithSharedConfigProfile(p.profile))
+	}
+	if p.tokenFile != "" && p.roleARN != "" {
+		optFns = append(optFns, config.WithWebIdentityRoleCredentialOptions(func(v *stscreds.WebIdentityRoleOptions) {
+			v.RoleARN = p.roleARN
+			v.TokenRetriever = stscreds.IdentityTokenFile(p.tokenFile)
+		}))
+	}
+	cfg, err := config.LoadDefaultConfig(context.Background(), optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config to create cloudwatch client: %w", err)
+	}
+	p.client = cloudwatch.NewFromConfig(cfg)
+	return p, nil
+}
+
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// QueryPoints gives back the data points of the time series returned by the given query.
+// The query is either a metric math expression like
+// `SUM(METRICS())` or `SEARCH('{AWS/Lambda,FunctionName} MetricName="Errors"', 'Sum')`,
+// or a JSON array of MetricDataQuery in which exactly one query returns data.
+// ref: https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/using-metric-math.html
+func (p *Provider) QueryPoints(ctx context.Context, query string, queryRange metrics.QueryRange) ([]metrics.DataPoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if err := queryRange.Validate(); err != nil {
+		return nil, err
+	}
+	queries, err := p.buildMetricDataQueries(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Info("run query", zap.String("query", query))
+	input := &cloudwatch.GetMetricDataInput{
+		MetricDataQueries: queries,
+		StartTime:         aws.Time(queryRange.From),
+		EndTime:           aws.Time(queryRange.To),
+		ScanBy:            types.ScanByTimestampAscending,
+	}
+
+	// Results of the same query can be split into several pages.
+	var (
+		ids     []string
+		results = make(map[string]*types.MetricDataResult)
+	)
+	paginator := cloudwatch.NewGetMetricDataPaginator(p.client, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query for %s: %w", ProviderType, err)
+		}
+		for _, m := range out.Messages {
+			p.logger.Warn("non critical error occurred", zap.String("code", aws.ToString(m.Code)), zap.String("message", aws.ToString(m.Value)))
+		}
+		for i := range out.MetricDataResults {
+			r := out.MetricDataResults[i]
+			id := aws.ToString(r.Id)
+			if prev, ok := results[id]; ok {
+				prev.Timestamps = append(prev.Timestamps, r.Timestamps...)
+				prev.Values = append(prev.Values, r.Values...)
+				prev.StatusCode = r.StatusCode
+				prev.Messages = append(prev.Messages, r.Messages...)
+				continue
+			}
+			ids = append(ids, id)
+			results[id] = &r
+		}
+	}
+
+	if len(ids) != 1 {
+		return nil, fmt.Errorf("the query must return exactly one time series, but %d were returned", len(ids))
+	}
+	result := results[ids[0]]
+	switch result.StatusCode {
+	case types.StatusCodeInternalError, types.StatusCodeForbidden:
+		msgs := make([]string, 0, len(result.Messages))
+		for _, m := range result.Messages {
+			msgs = append(msgs, aws.ToString(m.Value))
+		}
+		return nil, fmt.Errorf("failed to run query for %s: status %s: %s", ProviderType, result.StatusCode, strings.Join(msgs, ", "))
+	}
+	if len(result.Values) == 0 {
+		return nil, fmt.Errorf("no data points found within the queried range: %w", metrics.ErrNoDataFound)
+	}
+	if len(result.Values) != len(result.Timestamps) {
+		return nil, fmt.Errorf("invalid response: the number of values and timestamps are different")
+	}
+
+	points := make([]metrics.DataPoint, 0, len(result.Values))
+	for i, v := range result.Values {
+		points = append(points, metrics.DataPoint{
+			Timestamp: result.Timestamps[i].Unix(),
+			Value:     v,
+		})
+	}
+	return points, nil
+}
+
+// buildMetricDataQueries converts the given query into MetricDataQueries.
+func (p *Provider) buildMetricDataQueries(query string) ([]types.MetricDataQuery, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("query is required")
+	}
+
+	if !strings.HasPrefix(query, "[") {
+		return []types.MetricDataQuery{
+			{
+				Id:         aws.String(queryID),
+				Expression: aws.String(query),
+				Period:     aws.Int32(int32(p.period.Seconds())),
+				ReturnData: aws.Bool(true),
+			},
+		}, nil
+	}
+
+	var queries []types.MetricDataQuery
+	if err := json.Unmarshal([]byte(query), &queries); err != nil {
+		return nil, fmt.Errorf("failed to parse the query as MetricDataQueries: %w", err)
+	}
+	if len(queries) == 0 {
+		return nil, fmt.Errorf("at least one MetricDataQuery is required")
+	}
+	for i := range queries {
+		if queries[i].Id == nil {
+			return nil, fmt.Errorf("id is required for each MetricDataQuery")
+		}
+		if queries[i].Expression != nil && queries[i].Period == nil {
+			queries[i].Period = aws.Int32(int32(p.period.Seconds()))
+		}
+	}
+	return queries, nil
+}
@@ -0,0 +1,301 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudwatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+)
+
+type fakeClient struct {
+	pages  []*cloudwatch.GetMetricDataOutput
+	err    error
+	inputs []*cloudwatch.GetMetricDataInput
+}
+
+func (c *fakeClient) GetMetricData(_ context.Context, in *cloudwatch.GetMetricDataInput, _ ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	c.inputs = append(c.inputs, in)
+	if c.err != nil {
+		return nil, c.err
+	}
+	page := 0
+	if in.NextToken != nil {
+		fmt.Sscanf(*in.NextToken, "page-%d", &page)
+	}
+	out := c.pages[page]
+	if page+1 < len(c.pages) {
+		out.NextToken = aws.String(fmt.Sprintf("page-%d", page+1))
+	}
+	return out, nil
+}
+
+func TestProviderQueryPoints(t *testing.T) {
+	t.Parallel()
+
+	queryRange := metrics.QueryRange{
+		From: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2009, time.January, 1, 0, 5, 0, 0, time.UTC),
+	}
+	at := func(min int) time.Time {
+		return queryRange.From.Add(time.Duration(min) * time.Minute)
+	}
+
+	testcases := []struct {
+		name       string
+		client     *fakeClient
+		query      string
+		queryRange metrics.QueryRange
+		want       []metrics.DataPoint
+		wantErr    bool
+		wantNoData bool
+	}{
+		{
+			name: "single page",
+			client: &fakeClient{
+				pages: []*cloudwatch.GetMetricDataOutput{
+					{
+						MetricDataResults: []types.MetricDataResult{
+							{
+								Id:         aws.String(queryID),
+								Timestamps: []time.Time{at(1), at(2)},
+								Values:     []float64{0.1, 0.2},
+								StatusCode: types.StatusCodeComplete,
+							},
+						},
+					},
+				},
+			},
+			query:      `SUM(SEARCH('{AWS/Lambda,FunctionName} MetricName="Errors"', 'Sum'))`,
+			queryRange: queryRange,
+			want: []metrics.DataPoint{
+				{Timestamp: at(1).Unix(), Value: 0.1},
+				{Timestamp: at(2).Unix(), Value: 0.2},
+			},
+		},
+		{
+			name: "multiple pages",
+			client: &fakeClient{
+				pages: []*cloudwatch.GetMetricDataOutput{
+					{
+						MetricDataResults: []types.MetricDataResult{
+							{
+								Id:         aws.String(queryID),
+								Timestamps: []time.Time{at(1)},
+								Values:     []float64{0.1},
+								StatusCode: types.StatusCodePartialData,
+							},
+						},
+					},
+					{
+						MetricDataResults: []types.MetricDataResult{
+							{
+								Id:         aws.String(queryID),
+								Timestamps: []time.Time{at(2)},
+								Values:     []float64{0.2},
+								StatusCode: types.StatusCodeComplete,
+							},
+						},
+					},
+				},
+			},
+			query:      `SUM(METRICS())`,
+			queryRange: queryRange,
+			want: []metrics.DataPoint{
+				{Timestamp: at(1).Unix(), Value: 0.1},
+				{Timestamp: at(2).Unix(), Value: 0.2},
+			},
+		},
+		{
+			name: "no data points",
+			client: &fakeClient{
+				pages: []*cloudwatch.GetMetricDataOutput{
+					{
+						MetricDataResults: []types.MetricDataResult{
+							{
+								Id:         aws.String(queryID),
+								StatusCode: types.StatusCodeComplete,
+							},
+						},
+					},
+				},
+			},
+			query:      `SUM(METRICS())`,
+			queryRange: queryRange,
+			wantErr:    true,
+			wantNoData: true,
+		},
+		{
+			name: "multiple time series returned",
+			client: &fakeClient{
+				pages: []*cloudwatch.GetMetricDataOutput{
+					{
+						MetricDataResults: []types.MetricDataResult{
+							{Id: aws.String("m1"), Timestamps: []time.Time{at(1)}, Values: []float64{1}},
+							{Id: aws.String("m2"), Timestamps: []time.Time{at(1)}, Values: []float64{2}},
+						},
+					},
+				},
+			},
+			query:      `[{"Id":"m1","Expression":"m2*2"},{"Id":"m2","Expression":"m1"}]`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name: "forbidden",
+			client: &fakeClient{
+				pages: []*cloudwatch.GetMetricDataOutput{
+					{
+						MetricDataResults: []types.MetricDataResult{
+							{
+								Id:         aws.String(queryID),
+								StatusCode: types.StatusCodeForbidden,
+								Messages:   []types.MessageData{{Value: aws.String("access denied")}},
+							},
+						},
+					},
+				},
+			},
+			query:      `SUM(METRICS())`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name:       "query failed",
+			client:     &fakeClient{err: fmt.Errorf("query failed")},
+			query:      `SUM(METRICS())`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name:       "empty query",
+			client:     &fakeClient{},
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p := Provider{
+				client:  tc.client,
+				period:  defaultPeriod,
+				timeout: defaultTimeout,
+				logger:  zap.NewNop(),
+			}
+			got, err := p.QueryPoints(context.Background(), tc.query, tc.queryRange)
+			assert.Equal(t, tc.wantErr, err != nil, "%v", err)
+			assert.Equal(t, tc.wantNoData, err != nil && errors.Is(err, metrics.ErrNoDataFound))
+			assert.Equal(t, tc.want, got)
+			for _, in := range tc.client.inputs {
+				assert.Equal(t, tc.queryRange.From, aws.ToTime(in.StartTime))
+				assert.Equal(t, tc.queryRange.To, aws.ToTime(in.EndTime))
+				assert.Equal(t, types.ScanByTimestampAscending, in.ScanBy)
+			}
+		})
+	}
+}
+
+func TestBuildMetricDataQueries(t *testing.T) {
+	t.Parallel()
+
+	p := Provider{period: defaultPeriod}
+	testcases := []struct {
+		name    string
+		query   string
+		want    []types.MetricDataQuery
+		wantErr bool
+	}{
+		{
+			name:  "expression",
+			query: ` SUM(METRICS()) `,
+			want: []types.MetricDataQuery{
+				{
+					Id:         aws.String(queryID),
+					Expression: aws.String("SUM(METRICS())"),
+					Period:     aws.Int32(60),
+					ReturnData: aws.Bool(true),
+				},
+			},
+		},
+		{
+			name: "metric data queries",
+			query: `[
+  {"Id": "errors", "MetricStat": {"Metric": {"Namespace": "AWS/Lambda", "MetricName": "Errors", "Dimensions": [{"Name": "FunctionName", "Value": "foo"}]}, "Period": 300, "Stat": "Sum"}, "ReturnData": false},
+  {"Id": "rate", "Expression": "errors / 300"}
+]`,
+			want: []types.MetricDataQuery{
+				{
+					Id: aws.String("errors"),
+					MetricStat: &types.MetricStat{
+						Metric: &types.Metric{
+							Namespace:  aws.String("AWS/Lambda"),
+							MetricName: aws.String("Errors"),
+							Dimensions: []types.Dimension{
+								{Name: aws.String("FunctionName"), Value: aws.String("foo")},
+							},
+						},
+						Period: aws.Int32(300),
+						Stat:   aws.String("Sum"),
+					},
+					ReturnData: aws.Bool(false),
+				},
+				{
+					Id:         aws.String("rate"),
+					Expression: aws.String("errors / 300"),
+					Period:     aws.Int32(60),
+				},
+			},
+		},
+		{
+			name:    "missing id",
+			query:   `[{"Expression": "SUM(METRICS())"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "empty array",
+			query:   `[]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			query:   `[{`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := p.buildMetricDataQueries(tc.query)
+			assert.Equal(t, tc.wantErr, err != nil, "%v", err)
+			if tc.wantErr {
+				return
+			}
+			require.Len(t, got, len(tc.want))
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -23,7 +23,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics/cloudwatch"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics/datadog"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics/newrelic"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics/prometheus"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/config"
 )
@@ -88,6 +90,36 @@ func NewProvider(analysisTempCfg *config.TemplatableAnalysisMetrics, providerCfg
 			options = append(options, datadog.WithAddress(cfg.Address))
 		}
 		return datadog.NewProvider(apiKey, applicationKey, options...)
+	case config.AnalysisProviderCloudWatch:
+		cfg := providerCfg.CloudWatchConfig
+		options := []cloudwatch.Option{
+			cloudwatch.WithLogger(logger),
+			cloudwatch.WithTimeout(analysisTempCfg.Timeout.Duration()),
+		}
+		if cfg.CredentialsFile != "" {
+			options = append(options, cloudwatch.WithCredentialsFile(cfg.CredentialsFile))
+		}
+		if cfg.Profile != "" {
+			options = append(options, cloudwatch.WithProfile(cfg.Profile))
+		}
+		if cfg.RoleARN != "" && cfg.TokenFile != "" {
+			options = append(options, cloudwatch.WithWebIdentity(cfg.RoleARN, cfg.TokenFile))
+		}
+		return cloudwatch.NewProvider(cfg.Region, options...)
+	case config.AnalysisProviderNewRelic:
+		cfg := providerCfg.NewRelicConfig
+		a, err := os.ReadFile(cfg.APIKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the api-key file: %w", err)
+		}
+		options := []newrelic.Option{
+			newrelic.WithLogger(logger),
+			newrelic.WithTimeout(analysisTempCfg.Timeout.Duration()),
+		}
+		if cfg.Region == "EU" {
+			options = append(options, newrelic.WithAddress(newrelic.EUAddress))
+		}
+		return newrelic.NewProvider(cfg.AccountID, strings.TrimSpace(string(a)), options...)
 	default:
 		return nil, fmt.Errorf("any of providers config not found")
 	}
@@ -0,0 +1,276 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package newrelic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+)
+
+const (
+	ProviderType   = "NewRelic"
+	defaultTimeout = 30 * time.Second
+
+	// The NerdGraph endpoints for each region.
+	USAddress = "https://api.newrelic.com/graphql"
+	EUAddress = "https://api.eu.newrelic.com/graphql"
+
+	nrqlQuery = `query($accountId: Int!, $nrql: Nrql!) {
+  actor {
+    account(id: $accountId) {
+      nrql(query: $nrql) {
+        results
+      }
+    }
+  }
+}`
+)
+
+// Provider works as a NerdGraph client for New Relic.
+type Provider struct {
+	client    *http.Client
+	address   string
+	accountID int64
+	apiKey    string
+
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+type Option func(*Provider)
+
+// WithAddress overrides the NerdGraph endpoint.
+// Defaults to the endpoint for the US region.
+func WithAddress(address string) Option {
+	return func(p *Provider) {
+		p.address = address
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *Provider) {
+		p.logger = logger.Named("newrelic-provider")
+	}
+}
+
+func WithTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.timeout = timeout
+	}
+}
+
+func NewProvider(accountID int64, apiKey string, opts ...Option) (*Provider, error) {
+	if accountID == 0 {
+		return nil, fmt.Errorf("account-id is required")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("api-key is required")
+	}
+
+	p := &Provider{
+		client:    &http.Client{},
+		address:   USAddress,
+		accountID: accountID,
+		apiKey:    apiKey,
+		timeout:   defaultTimeout,
+		logger:    zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p, nil
+}
+
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+type graphQLResponse struct {
+	Data struct {
+		Actor struct {
+			Account struct {
+				NRQL *struct {
+					Results []map[string]interface{} `json:"results"`
+				} `json:"nrql"`
+			} `json:"account"`
+		} `json:"actor"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// QueryPoints runs the given NRQL query within the given range.
+// The query must return a single aggregated value per row, and must not contain
+// SINCE and UNTIL clauses since they are appended automatically.
+// Use TIMESERIES clause to get multiple data points.
+// ref: https://docs.newrelic.com/docs/nrql/get-started/introduction-nrql-new-relics-query-language/
+func (p *Provider) QueryPoints(ctx context.Context, query string, queryRange metrics.QueryRange) ([]metrics.DataPoint, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	if err := queryRange.Validate(); err != nil {
+		return nil, err
+	}
+
+	nrql := buildNRQL(query, queryRange)
+	p.logger.Info("run query", zap.String("query", nrql))
+	results, err := p.runNRQL(ctx, nrql)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query for %s: %w", ProviderType, err)
+	}
+
+	points := make([]metrics.DataPoint, 0, len(results))
+	for _, r := range results {
+		if _, ok := r["facet"]; ok {
+			return nil, fmt.Errorf("invalid response: FACET clause is not supported")
+		}
+		value, ok, err := extractValue(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid response: %w", err)
+		}
+		if !ok {
+			// No data in this time window.
+			continue
+		}
+		timestamp := queryRange.To.Unix()
+		if v, ok := r["endTimeSeconds"].(float64); ok {
+			timestamp = int64(v)
+		}
+		points = append(points, metrics.DataPoint{
+			Timestamp: timestamp,
+			Value:     value,
+		})
+	}
+	if len(points) == 0 {
+		return nil, fmt.Errorf("no data points found within the queried range: %w", metrics.ErrNoDataFound)
+	}
+	return points, nil
+}
+
+func (p *Provider) runNRQL(ctx context.Context, nrql string) ([]map[string]interface{}, error) {
+	body, err := json.Marshal(&graphQLRequest{
+		Query: nrqlQuery,
+		Variables: map[string]interface{}{
+			"accountId": p.accountID,
+			"nrql":      nrql,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.address, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("API-Key", p.apiKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var out graphQLResponse
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse the response: %w", err)
+	}
+	if len(out.Errors) > 0 {
+		msgs := make([]string, 0, len(out.Errors))
+		for _, e := range out.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return nil, fmt.Errorf("%s", strings.Join(msgs, ", "))
+	}
+	if out.Data.Actor.Account.NRQL == nil {
+		return nil, fmt.Errorf("invalid response: no nrql result found")
+	}
+	return out.Data.Actor.Account.NRQL.Results, nil
+}
+
+// buildNRQL limits the given query to the given range.
+func buildNRQL(query string, queryRange metrics.QueryRange) string {
+	return fmt.Sprintf("%s SINCE %d UNTIL %d",
+		strings.TrimSpace(query),
+		queryRange.From.UnixMilli(),
+		queryRange.To.UnixMilli(),
+	)
+}
+
+// extractValue returns the aggregated value of the given row.
+// The row must contain exactly one aggregated value in addition to the time window fields.
+// A nested value like {"percentile.duration": {"95": 0.1}} is also accepted.
+// False is returned when the value is null.
+func extractValue(row map[string]interface{}) (float64, bool, error) {
+	keys := make([]string, 0, len(row))
+	for k := range row {
+		switch k {
+		case "beginTimeSeconds", "endTimeSeconds", "timestamp":
+			continue
+		}
+		keys = append(keys, k)
+	}
+	if len(keys) != 1 {
+		sort.Strings(keys)
+		return 0, false, fmt.Errorf("exactly one value is expected in each result, but got %v", keys)
+	}
+
+	value := row[keys[0]]
+	if nested, ok := value.(map[string]interface{}); ok {
+		if len(nested) != 1 {
+			return 0, false, fmt.Errorf("exactly one value is expected in %q", keys[0])
+		}
+		for _, v := range nested {
+			value = v
+		}
+	}
+	switch v := value.(type) {
+	case nil:
+		return 0, false, nil
+	case float64:
+		if math.IsNaN(v) {
+			return 0, false, nil
+		}
+		return v, true, nil
+	default:
+		return 0, false, fmt.Errorf("the value of %q is not a number: %v", keys[0], value)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/metrics"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewProvider(0, "key")
+	assert.Error(t, err)
+
+	_, err = NewProvider(1234, "")
+	assert.Error(t, err)
+
+	p, err := NewProvider(1234, "key")
+	require.NoError(t, err)
+	assert.Equal(t, USAddress, p.address)
+}
+
+func TestProviderQueryPoints(t *testing.T) {
+	t.Parallel()
+
+	queryRange := metrics.QueryRange{
+		From: time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2009, time.January, 1, 0, 5, 0, 0, time.UTC),
+	}
+	from := queryRange.From.Unix()
+
+	testcases := []struct {
+		name       string
+		status     int
+		response   string
+		queryRange metrics.QueryRange
+		want       []metrics.DataPoint
+		wantErr    bool
+		wantNoData bool
+	}{
+		{
+			name: "timeseries",
+			response: fmt.Sprintf(`{"data":{"actor":{"account":{"nrql":{"results":[
+				{"beginTimeSeconds":%d,"endTimeSeconds":%d,"average.duration":0.1},
+				{"beginTimeSeconds":%d,"endTimeSeconds":%d,"average.duration":null},
+				{"beginTimeSeconds":%d,"endTimeSeconds":%d,"average.duration":0.3}
+			]}}}}}`, from, from+60, from+60, from+120, from+120, from+180),
+			queryRange: queryRange,
+			want: []metrics.DataPoint{
+				{Timestamp: from + 60, Value: 0.1},
+				{Timestamp: from + 180, Value: 0.3},
+			},
+		},
+		{
+			name:       "single value",
+			response:   `{"data":{"actor":{"account":{"nrql":{"results":[{"count":12}]}}}}}`,
+			queryRange: queryRange,
+			want: []metrics.DataPoint{
+				{Timestamp: queryRange.To.Unix(), Value: 12},
+			},
+		},
+		{
+			name:       "nested value",
+			response:   `{"data":{"actor":{"account":{"nrql":{"results":[{"percentile.duration":{"95":0.5}}]}}}}}`,
+			queryRange: queryRange,
+			want: []metrics.DataPoint{
+				{Timestamp: queryRange.To.Unix(), Value: 0.5},
+			},
+		},
+		{
+			name:       "no data points",
+			response:   `{"data":{"actor":{"account":{"nrql":{"results":[{"average.duration":null}]}}}}}`,
+			queryRange: queryRange,
+			wantErr:    true,
+			wantNoData: true,
+		},
+		{
+			name:       "multiple values",
+			response:   `{"data":{"actor":{"account":{"nrql":{"results":[{"average.duration":0.1,"count":12}]}}}}}`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name:       "facet is not supported",
+			response:   `{"data":{"actor":{"account":{"nrql":{"results":[{"facet":"foo","count":12}]}}}}}`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name:       "graphql error",
+			response:   `{"data":{"actor":{"account":{"nrql":null}}},"errors":[{"message":"NRQL Syntax Error"}]}`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+		{
+			name:       "unexpected HTTP status given",
+			status:     http.StatusUnauthorized,
+			response:   `unauthorized`,
+			queryRange: queryRange,
+			wantErr:    true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var req graphQLRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "key", r.Header.Get("API-Key"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				if tc.status != 0 {
+					w.WriteHeader(tc.status)
+				}
+				fmt.Fprint(w, tc.response)
+			}))
+			defer server.Close()
+
+			p, err := NewProvider(1234, "key", WithAddress(server.URL))
+			require.NoError(t, err)
+
+			got, err := p.QueryPoints(context.Background(), "SELECT average(duration) FROM Transaction TIMESERIES 1 minute", tc.queryRange)
+			assert.Equal(t, tc.wantErr, err != nil, "%v", err)
+			assert.Equal(t, tc.wantNoData, err != nil && errors.Is(err, metrics.ErrNoDataFound))
+			assert.Equal(t, tc.want, got)
+
+			assert.Equal(t, float64(1234), req.Variables["accountId"])
+			assert.Equal(t, "SELECT average(duration) FROM Transaction TIMESERIES 1 minute SINCE 1230768000000 UNTIL 1230768300000", req.Variables["nrql"])
+		})
+	}
+}
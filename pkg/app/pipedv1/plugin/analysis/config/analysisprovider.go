@@ -17,9 +17,13 @@ package config
 type AnalysisProviderType string
 
 const (
-	AnalysisProviderPrometheus  AnalysisProviderType = "PROMETHEUS"
-	AnalysisProviderDatadog     AnalysisProviderType = "DATADOG"
-	AnalysisProviderStackdriver AnalysisProviderType = "STACKDRIVER"
+	AnalysisProviderPrometheus    AnalysisProviderType = "PROMETHEUS"
+	AnalysisProviderDatadog       AnalysisProviderType = "DATADOG"
+	AnalysisProviderStackdriver   AnalysisProviderType = "STACKDRIVER"
+	AnalysisProviderLoki          AnalysisProviderType = "LOKI"
+	AnalysisProviderElasticsearch AnalysisProviderType = "ELASTICSEARCH"
+	AnalysisProviderCloudWatch    AnalysisProviderType = "CLOUDWATCH"
+	AnalysisProviderNewRelic      AnalysisProviderType = "NEWRELIC"
 )
 
 func (t AnalysisProviderType) String() string {
@@ -143,6 +143,8 @@ type AnalysisLog struct {
 	FailureLimit int `json:"failureLimit"`
 	// If true, it considers as success when no data returned from the analysis provider.
 	// Default is false.
+	// This does not affect the log analysis since no log entry matched the query
+	// is not treated as no data but as the expected result.
 	SkipOnNoData bool `json:"skipOnNoData"`
 	// How long after which the query times out.
 	Timeout  unit.Duration `json:"timeout"`
@@ -32,9 +32,13 @@ type PipedAnalysisProvider struct {
 	Name string               `json:"name"`
 	Type AnalysisProviderType `json:"type"`
 
-	PrometheusConfig  *AnalysisProviderPrometheusConfig
-	DatadogConfig     *AnalysisProviderDatadogConfig
-	StackdriverConfig *AnalysisProviderStackdriverConfig
+	PrometheusConfig    *AnalysisProviderPrometheusConfig
+	DatadogConfig       *AnalysisProviderDatadogConfig
+	StackdriverConfig   *AnalysisProviderStackdriverConfig
+	LokiConfig          *AnalysisProviderLokiConfig
+	ElasticsearchConfig *AnalysisProviderElasticsearchConfig
+	CloudWatchConfig    *AnalysisProviderCloudWatchConfig
+	NewRelicConfig      *AnalysisProviderNewRelicConfig
 }
 
 func (p *PipedAnalysisProvider) Mask() {
@@ -47,6 +51,18 @@ func (p *PipedAnalysisProvider) Mask() {
 	if p.StackdriverConfig != nil {
 		p.StackdriverConfig.Mask()
 	}
+	if p.LokiConfig != nil {
+		p.LokiConfig.Mask()
+	}
+	if p.ElasticsearchConfig != nil {
+		p.ElasticsearchConfig.Mask()
+	}
+	if p.CloudWatchConfig != nil {
+		p.CloudWatchConfig.Mask()
+	}
+	if p.NewRelicConfig != nil {
+		p.NewRelicConfig.Mask()
+	}
 }
 
 type genericPipedAnalysisProvider struct {
@@ -68,6 +84,14 @@ func (p *PipedAnalysisProvider) MarshalJSON() ([]byte, error) {
 		config, err = json.Marshal(p.PrometheusConfig)
 	case AnalysisProviderStackdriver:
 		config, err = json.Marshal(p.StackdriverConfig)
+	case AnalysisProviderLoki:
+		config, err = json.Marshal(p.LokiConfig)
+	case AnalysisProviderElasticsearch:
+		config, err = json.Marshal(p.ElasticsearchConfig)
+	case AnalysisProviderCloudWatch:
+		config, err = json.Marshal(p.CloudWatchConfig)
+	case AnalysisProviderNewRelic:
+		config, err = json.Marshal(p.NewRelicConfig)
 	default:
 		err = fmt.Errorf("unsupported analysis provider type: %s", p.Name)
 	}
@@ -108,6 +132,26 @@ func (p *PipedAnalysisProvider) UnmarshalJSON(data []byte) error {
 		if len(gp.Config) > 0 {
 			err = json.Unmarshal(gp.Config, p.StackdriverConfig)
 		}
+	case AnalysisProviderLoki:
+		p.LokiConfig = &AnalysisProviderLokiConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.LokiConfig)
+		}
+	case AnalysisProviderElasticsearch:
+		p.ElasticsearchConfig = &AnalysisProviderElasticsearchConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.ElasticsearchConfig)
+		}
+	case AnalysisProviderCloudWatch:
+		p.CloudWatchConfig = &AnalysisProviderCloudWatchConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.CloudWatchConfig)
+		}
+	case AnalysisProviderNewRelic:
+		p.NewRelicConfig = &AnalysisProviderNewRelicConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.NewRelicConfig)
+		}
 	default:
 		err = fmt.Errorf("unsupported analysis provider type: %s", p.Name)
 	}
@@ -122,6 +166,14 @@ func (p *PipedAnalysisProvider) Validate() error {
 		return p.DatadogConfig.Validate()
 	case AnalysisProviderStackdriver:
 		return p.StackdriverConfig.Validate()
+	case AnalysisProviderLoki:
+		return p.LokiConfig.Validate()
+	case AnalysisProviderElasticsearch:
+		return p.ElasticsearchConfig.Validate()
+	case AnalysisProviderCloudWatch:
+		return p.CloudWatchConfig.Validate()
+	case AnalysisProviderNewRelic:
+		return p.NewRelicConfig.Validate()
 	default:
 		return fmt.Errorf("unknow provider type: %s", p.Type)
 	}
@@ -211,6 +263,147 @@ func (a *AnalysisProviderStackdriverConfig) Validate() error {
 	return nil
 }
 
+type AnalysisProviderLokiConfig struct {
+	// The address of Loki server.
+	Address string `json:"address"`
+	// The path to the username file.
+	UsernameFile string `json:"usernameFile,omitempty"`
+	// The path to the password file.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// The tenant ID sent as the X-Scope-OrgID header.
+	// Required only when Loki runs in the multi-tenant mode.
+	TenantID string `json:"tenantID,omitempty"`
+}
+
+func (a *AnalysisProviderLokiConfig) Validate() error {
+	if a.Address == "" {
+		return fmt.Errorf("loki analysis provider requires the address")
+	}
+	if (a.UsernameFile == "") != (a.PasswordFile == "") {
+		return fmt.Errorf("both loki usernameFile and passwordFile must be set")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderLokiConfig) Mask() {
+	if len(a.PasswordFile) != 0 {
+		a.PasswordFile = maskString
+	}
+}
+
+type AnalysisProviderElasticsearchConfig struct {
+	// The address of Elasticsearch or OpenSearch server.
+	Address string `json:"address"`
+	// The index or index pattern to be searched. e.g. "logs-*"
+	Index string `json:"index"`
+	// The field used to filter the documents by time.
+	// Defaults to "@timestamp".
+	TimestampField string `json:"timestampField,omitempty"`
+	// The field shown as the message of the matched documents.
+	// Defaults to "message".
+	MessageField string `json:"messageField,omitempty"`
+	// The path to the username file.
+	UsernameFile string `json:"usernameFile,omitempty"`
+	// The path to the password file.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// The path to the API key file.
+	APIKeyFile string `json:"apiKeyFile,omitempty"`
+}
+
+func (a *AnalysisProviderElasticsearchConfig) Validate() error {
+	if a.Address == "" {
+		return fmt.Errorf("elasticsearch analysis provider requires the address")
+	}
+	if a.Index == "" {
+		return fmt.Errorf("elasticsearch analysis provider requires the index")
+	}
+	if (a.UsernameFile == "") != (a.PasswordFile == "") {
+		return fmt.Errorf("both elasticsearch usernameFile and passwordFile must be set")
+	}
+	if a.APIKeyFile != "" && a.UsernameFile != "" {
+		return fmt.Errorf("only elasticsearch apiKeyFile or usernameFile/passwordFile can be set")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderElasticsearchConfig) Mask() {
+	if len(a.PasswordFile) != 0 {
+		a.PasswordFile = maskString
+	}
+	if len(a.APIKeyFile) != 0 {
+		a.APIKeyFile = maskString
+	}
+}
+
+type AnalysisProviderCloudWatchConfig struct {
+	// The region to send requests to. This parameter is required.
+	// e.g. "us-west-2"
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (a *AnalysisProviderCloudWatchConfig) Validate() error {
+	if a.Region == "" {
+		return fmt.Errorf("cloudwatch analysis provider requires the region")
+	}
+	if (a.RoleARN == "") != (a.TokenFile == "") {
+		return fmt.Errorf("both cloudwatch roleARN and tokenFile must be set")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderCloudWatchConfig) Mask() {
+	if len(a.CredentialsFile) != 0 {
+		a.CredentialsFile = maskString
+	}
+	if len(a.RoleARN) != 0 {
+		a.RoleARN = maskString
+	}
+	if len(a.TokenFile) != 0 {
+		a.TokenFile = maskString
+	}
+}
+
+type AnalysisProviderNewRelicConfig struct {
+	// The ID of the New Relic account that the queries run against.
+	AccountID int64 `json:"accountID"`
+	// The region of the account. One of "US" or "EU" is available.
+	// Defaults to "US".
+	Region string `json:"region,omitempty"`
+	// The path to the user API key file.
+	APIKeyFile string `json:"apiKeyFile"`
+}
+
+func (a *AnalysisProviderNewRelicConfig) Validate() error {
+	if a.AccountID <= 0 {
+		return fmt.Errorf("newrelic analysis provider requires the accountID")
+	}
+	if a.APIKeyFile == "" {
+		return fmt.Errorf("newrelic analysis provider requires the apiKeyFile")
+	}
+	switch a.Region {
+	case "", "US", "EU":
+	default:
+		return fmt.Errorf("newrelic region must be one of US or EU, but %q was given", a.Region)
+	}
+	return nil
+}
+
+func (a *AnalysisProviderNewRelicConfig) Mask() {
+	if len(a.APIKeyFile) != 0 {
+		a.APIKeyFile = maskString
+	}
+}
+
 // GetAnalysisProvider finds and returns an Analysis Provider config whose name is the given string.
 func (p *PluginConfig) GetAnalysisProvider(name string) (PipedAnalysisProvider, bool) {
 	for _, prv := range p.AnalysisProviders {
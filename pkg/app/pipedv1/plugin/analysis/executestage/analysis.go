@@ -254,13 +254,20 @@ func (e *executor) newAnalyzerForLog(i int, templatable *config.TemplatableAnaly
 	if err != nil {
 		return nil, err
 	}
-	provider, err := e.newLogProvider(cfg.Provider)
+	provider, err := e.newLogProvider(cfg)
 	if err != nil {
 		return nil, err
 	}
 	id := fmt.Sprintf("log-%d", i)
+	interval := time.Duration(cfg.Interval)
 	runner := func(ctx context.Context, query string) (bool, string, error) {
-		return provider.Evaluate(ctx, query)
+		// Evaluate the log entries written since the previous evaluation.
+		now := time.Now()
+		queryRange := log.QueryRange{
+			From: now.Add(-interval),
+			To:   now,
+		}
+		return provider.Evaluate(ctx, query, queryRange)
 	}
 	return newAnalyzer(id, provider.Type(), cfg.Query, runner, time.Duration(cfg.Interval), cfg.FailureLimit, cfg.SkipOnNoData, e.logger, e.logPersister), nil
 }
@@ -290,12 +297,12 @@ func (e *executor) newMetricsProvider(providerName string, templatable config.Te
 	return provider, nil
 }
 
-func (e *executor) newLogProvider(providerName string) (log.Provider, error) {
-	cfg, ok := e.pluginConfig.GetAnalysisProvider(providerName)
+func (e *executor) newLogProvider(analysisCfg *config.AnalysisLog) (log.Provider, error) {
+	cfg, ok := e.pluginConfig.GetAnalysisProvider(analysisCfg.Provider)
 	if !ok {
-		return nil, fmt.Errorf("unknown provider name %s", providerName)
+		return nil, fmt.Errorf("unknown provider name %s", analysisCfg.Provider)
 	}
-	provider, err := logfactory.NewProvider(&cfg, e.logger)
+	provider, err := logfactory.NewProvider(context.Background(), analysisCfg, &cfg, e.logger)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,154 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executestage
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	logging "google.golang.org/api/logging/v2"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/analysis/analysisprovider/log/stackdriver"
+)
+
+// recordingLogPersister records the number of succeeded and failed evaluations.
+type recordingLogPersister struct {
+	fakeLogPersister
+
+	mu        sync.Mutex
+	successes int
+	errors    int
+}
+
+func (l *recordingLogPersister) Successf(_ string, _ ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.successes++
+}
+
+func (l *recordingLogPersister) Errorf(_ string, _ ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.errors++
+}
+
+// newFakeLoggingServer returns a fake Cloud Logging API server which always returns the given entries
+// and a service account to access it.
+func newFakeLoggingServer(t *testing.T, entries []*logging.LogEntry) (*httptest.Server, []byte) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token":"test-token","token_type":"Bearer","expires_in":3600}`)
+	})
+	mux.HandleFunc("/v2/entries:list", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(&logging.ListLogEntriesResponse{Entries: entries})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	sa, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "test-project",
+		"private_key_id": "key-id",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		"client_email":   "piped@test-project.iam.gserviceaccount.com",
+		"client_id":      "1234",
+		"token_uri":      server.URL + "/token",
+	})
+	require.NoError(t, err)
+	return server, sa
+}
+
+func TestAnalyzer_runWithStackdriverLogging(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		entries      []*logging.LogEntry
+		skipOnNoData bool
+		wantErr      bool
+	}{
+		{
+			name:         "no entry matched without skipOnNoData",
+			skipOnNoData: false,
+			wantErr:      false,
+		},
+		{
+			name:         "no entry matched with skipOnNoData",
+			skipOnNoData: true,
+			wantErr:      false,
+		},
+		{
+			name: "entries matched with skipOnNoData",
+			entries: []*logging.LogEntry{
+				{Timestamp: "2024-01-01T00:00:00Z", Severity: "ERROR", TextPayload: "connection refused"},
+			},
+			skipOnNoData: true,
+			wantErr:      true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, sa := newFakeLoggingServer(t, tc.entries)
+			provider, err := stackdriver.NewProvider(context.Background(), sa, stackdriver.WithEndpoint(server.URL))
+			require.NoError(t, err)
+
+			const interval = 10 * time.Millisecond
+			runner := func(ctx context.Context, query string) (bool, string, error) {
+				now := time.Now()
+				return provider.Evaluate(ctx, query, log.QueryRange{From: now.Add(-interval), To: now})
+			}
+			logPersister := &recordingLogPersister{}
+			a := newAnalyzer("log-0", provider.Type(), "severity>=ERROR", runner, interval, 0, tc.skipOnNoData, zap.NewNop(), logPersister)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			err = a.run(ctx)
+
+			logPersister.mu.Lock()
+			defer logPersister.mu.Unlock()
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, 1, logPersister.errors)
+				return
+			}
+			// No entry matched is the expected result regardless of skipOnNoData.
+			assert.NoError(t, err)
+			assert.Zero(t, logPersister.errors)
+			assert.Positive(t, logPersister.successes)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
+)
+
+const (
+	revisionCheckDuration = 10 * time.Second
+	revisionCheckTimeout  = 2 * time.Minute
+)
+
+func loadServiceManifest(ds sdk.DeploymentSource[config.CloudRunApplicationSpec], lp sdk.StageLogPersister) (provider.ServiceManifest, bool) {
+	lp.Infof("Loading service manifest at commit %s", ds.CommitHash)
+
+	appCfg, err := ds.AppConfig()
+	if err != nil {
+		lp.Errorf("Failed to load application config (%v)", err)
+		return provider.ServiceManifest{}, false
+	}
+
+	sm, err := provider.LoadServiceManifest(ds.ApplicationDirectory, appCfg.Spec.Input.ServiceManifestFile)
+	if err != nil {
+		lp.Errorf("Failed to load service manifest (%v)", err)
+		return provider.ServiceManifest{}, false
+	}
+
+	lp.Infof("Successfully loaded the service manifest at commit %s", ds.CommitHash)
+	return sm, true
+}
+
+func decideRevisionName(sm provider.ServiceManifest, commit string, lp sdk.StageLogPersister) (revision string, ok bool) {
+	var err error
+	revision, err = provider.DecideRevisionName(sm, commit)
+	if err != nil {
+		lp.Errorf("Unable to decide revision name for the commit %s (%v)", commit, err)
+		return
+	}
+
+	ok = true
+	return
+}
+
+func configureServiceManifest(sm provider.ServiceManifest, revision string, traffics []provider.RevisionTraffic, lp sdk.StageLogPersister) bool {
+	if revision != "" {
+		if err := sm.SetRevision(revision); err != nil {
+			lp.Errorf("Unable to set revision name to service manifest (%v)", err)
+			return false
+		}
+	}
+
+	if err := sm.UpdateTraffic(traffics); err != nil {
+		lp.Errorf("Unable to configure traffic percentages to service manifest (%v)", err)
+		return false
+	}
+
+	lp.Info("Successfully prepared service manifest with traffic percentages as below:")
+	for _, t := range traffics {
+		lp.Infof("  %s: %d", t.RevisionName, t.Percent)
+	}
+
+	return true
+}
+
+func apply(ctx context.Context, client provider.Client, sm provider.ServiceManifest, lp sdk.StageLogPersister) bool {
+	lp.Info("Start applying the service manifest")
+
+	_, err := client.Update(ctx, sm)
+	if err == nil {
+		lp.Infof("Successfully updated the service %s", sm.Name)
+		return true
+	}
+
+	if !errors.Is(err, provider.ErrServiceNotFound) {
+		lp.Errorf("Failed to update the service %s (%v)", sm.Name, err)
+		return false
+	}
+
+	lp.Infof("Service %s was not found, a new service will be created", sm.Name)
+
+	if _, err := client.Create(ctx, sm); err != nil {
+		lp.Errorf("Failed to create the service %s (%v)", sm.Name, err)
+		return false
+	}
+
+	lp.Infof("Successfully created the service %s", sm.Name)
+	return true
+}
+
+func waitRevisionReady(ctx context.Context, client provider.Client, revisionName string, retryDuration, retryTimeout time.Duration, lp sdk.StageLogPersister) error {
+	shouldCheckConditions := map[string]struct{}{
+		"Active":              {},
+		"Ready":               {},
+		"ConfigurationsReady": {},
+		"RoutesReady":         {},
+		"ContainerHealthy":    {},
+		"ResourcesAvailable":  {},
+	}
+	mustPassConditions := map[string]struct{}{
+		"Ready":  {},
+		"Active": {},
+	}
+
+	doCheck := func() (bool, error) {
+		rvs, err := client.GetRevision(ctx, revisionName)
+		// NotFound should be a retriable error.
+		if errors.Is(err, provider.ErrRevisionNotFound) {
+			return true, err
+		}
+		if err != nil {
+			return false, err
+		}
+
+		var (
+			trueConds    = make(map[string]struct{}, 0)
+			falseConds   = make([]string, 0, len(shouldCheckConditions))
+			unknownConds = make([]string, 0, len(shouldCheckConditions))
+		)
+		if rvs.Status != nil {
+			for _, cond := range rvs.Status.Conditions {
+				if _, ok := shouldCheckConditions[cond.Type]; !ok {
+					continue
+				}
+				switch cond.Status {
+				case "True":
+					trueConds[cond.Type] = struct{}{}
+				case "False":
+					falseConds = append(falseConds, cond.Message)
+				default:
+					unknownConds = append(unknownConds, cond.Message)
+				}
+			}
+		}
+
+		if len(falseConds) > 0 {
+			return false, fmt.Errorf("%s", strings.Join(falseConds, "\n"))
+		}
+		if len(unknownConds) > 0 {
+			return true, fmt.Errorf("%s", strings.Join(unknownConds, "\n"))
+		}
+		for k := range mustPassConditions {
+			if _, ok := trueConds[k]; !ok {
+				return true, fmt.Errorf("could not check status field %q", k)
+			}
+		}
+		return false, nil
+	}
+
+	start := time.Now()
+	for {
+		retry, err := doCheck()
+		if !retry {
+			if err != nil {
+				lp.Errorf("Revision %s was not ready: %v", revisionName, err)
+				return err
+			}
+			lp.Infof("Revision %s is ready to receive traffic", revisionName)
+			return nil
+		}
+
+		if time.Since(start) > retryTimeout {
+			lp.Errorf("Revision %s was not ready: %v", revisionName, err)
+			return err
+		}
+
+		lp.Infof("Revision %s is still not ready (%v), will retry after %v", revisionName, err, retryDuration)
+		select {
+		case <-ctx.Done():
+			lp.Errorf("Revision %s was not ready: %v", revisionName, ctx.Err())
+			return ctx.Err()
+		case <-time.After(retryDuration):
+		}
+	}
+}
+
+func revisionExists(ctx context.Context, client provider.Client, revisionName string, lp sdk.StageLogPersister) (bool, error) {
+	_, err := client.GetRevision(ctx, revisionName)
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.Is(err, provider.ErrRevisionNotFound) {
+		return false, nil
+	}
+
+	lp.Errorf("Failed while checking the existence of revision %s (%v)", revisionName, err)
+	return false, err
+}
+
+func addBuiltinLabels(sm provider.ServiceManifest, hash, pipedID, appID, revisionName string, lp sdk.StageLogPersister) bool {
+	labels := map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByCloudRunPlugin,
+		provider.LabelPiped:       pipedID,
+		provider.LabelApplication: appID,
+		provider.LabelCommitHash:  hash,
+	}
+	// Set builtinLabels for Service.
+	sm.AddLabels(labels)
+
+	if revisionName == "" {
+		return true
+	}
+	// Set builtinLabels for Revision.
+	labels[provider.LabelRevisionName] = revisionName
+	if err := sm.AddRevisionLabels(labels); err != nil {
+		lp.Errorf("Unable to add revision labels for the service manifest %s (%v)", sm.Name, err)
+		return false
+	}
+	return true
+}
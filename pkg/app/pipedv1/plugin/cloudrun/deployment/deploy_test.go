@@ -0,0 +1,183 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister/logpersistertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/run/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider/providertest"
+)
+
+const testProject = "test-project"
+
+func newTestClient(t *testing.T, server *providertest.Server) provider.Client {
+	t.Helper()
+
+	client, err := provider.NewClient(t.Context(), config.CloudRunDeployTargetConfig{
+		Project: testProject,
+		Region:  "asia-northeast1",
+	}, server.ClientOptions()...)
+	require.NoError(t, err)
+	return client
+}
+
+func newTestDeploymentSource(t *testing.T, dir, commit string) sdk.DeploymentSource[config.CloudRunApplicationSpec] {
+	t.Helper()
+
+	appDir := filepath.Join("testdata", dir)
+	return sdk.DeploymentSource[config.CloudRunApplicationSpec]{
+		ApplicationDirectory:      appDir,
+		CommitHash:                commit,
+		ApplicationConfig:         sdk.LoadApplicationConfigForTest[config.CloudRunApplicationSpec](t, filepath.Join(appDir, "app.pipecd.yaml"), "cloudrun"),
+		ApplicationConfigFilename: "app.pipecd.yaml",
+	}
+}
+
+func newTestRequest(t *testing.T, stage string, stageConfig []byte, running, target sdk.DeploymentSource[config.CloudRunApplicationSpec]) sdk.ExecuteStageRequest[config.CloudRunApplicationSpec] {
+	t.Helper()
+
+	return sdk.ExecuteStageRequest[config.CloudRunApplicationSpec]{
+		StageName:               stage,
+		StageConfig:             stageConfig,
+		RunningDeploymentSource: running,
+		TargetDeploymentSource:  target,
+		Deployment: sdk.Deployment{
+			ID:            "deployment-id",
+			PipedID:       "piped-id",
+			ApplicationID: "app-id",
+		},
+	}
+}
+
+// trafficOf returns the traffic percentages of the given service keyed by revision name.
+func trafficOf(t *testing.T, server *providertest.Server, name string) map[string]int64 {
+	t.Helper()
+
+	svc, ok := server.Service(name)
+	require.True(t, ok, "service %s must exist", name)
+
+	out := make(map[string]int64, len(svc.Status.Traffic))
+	for _, tf := range svc.Status.Traffic {
+		out[tf.RevisionName] += tf.Percent
+	}
+	return out
+}
+
+func TestWaitRevisionReady(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		revision   *run.Revision
+		revName    string
+		wantErrMsg string
+	}{
+		{
+			name:    "ready",
+			revName: "helloworld-v1",
+			revision: &run.Revision{
+				Metadata: &run.ObjectMeta{Name: "helloworld-v1"},
+				Status: &run.RevisionStatus{Conditions: []*run.GoogleCloudRunV1Condition{
+					{Type: "Ready", Status: "True"},
+					{Type: "Active", Status: "True"},
+				}},
+			},
+		},
+		{
+			name:    "failed",
+			revName: "helloworld-v1",
+			revision: &run.Revision{
+				Metadata: &run.ObjectMeta{Name: "helloworld-v1"},
+				Status: &run.RevisionStatus{Conditions: []*run.GoogleCloudRunV1Condition{
+					{Type: "Ready", Status: "False", Message: "container failed to start"},
+					{Type: "Active", Status: "True"},
+				}},
+			},
+			wantErrMsg: "container failed to start",
+		},
+		{
+			name:    "still unknown after timeout",
+			revName: "helloworld-v1",
+			revision: &run.Revision{
+				Metadata: &run.ObjectMeta{Name: "helloworld-v1"},
+				Status: &run.RevisionStatus{Conditions: []*run.GoogleCloudRunV1Condition{
+					{Type: "Ready", Status: "Unknown", Message: "deploying revision"},
+				}},
+			},
+			wantErrMsg: "deploying revision",
+		},
+		{
+			name:       "not found after timeout",
+			revName:    "helloworld-v2",
+			wantErrMsg: provider.ErrRevisionNotFound.Error(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := providertest.NewServer(t, testProject)
+			if tc.revision != nil {
+				server.PutRevision(tc.revision)
+			}
+			client := newTestClient(t, server)
+
+			err := waitRevisionReady(t.Context(), client, tc.revName, time.Millisecond, 10*time.Millisecond, logpersistertest.NewTestLogPersister(t))
+			if tc.wantErrMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	server := providertest.NewServer(t, testProject)
+	client := newTestClient(t, server)
+	lp := logpersistertest.NewTestLogPersister(t)
+
+	sm, err := provider.LoadServiceManifest(filepath.Join("testdata", "v1"), "service.yaml")
+	require.NoError(t, err)
+	require.NoError(t, sm.SetRevision("helloworld-v050-0123456"))
+	require.NoError(t, sm.UpdateAllTraffic("helloworld-v050-0123456"))
+
+	// The service is created since it does not exist yet.
+	require.True(t, apply(context.Background(), client, sm, lp))
+	svc, ok := server.Service("helloworld")
+	require.True(t, ok)
+	assert.Equal(t, int64(1), svc.Metadata.Generation)
+
+	// The service is updated after that.
+	require.True(t, apply(context.Background(), client, sm, lp))
+	svc, ok = server.Service("helloworld")
+	require.True(t, ok)
+	assert.Equal(t, int64(2), svc.Metadata.Generation)
+}
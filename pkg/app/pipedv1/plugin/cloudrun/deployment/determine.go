@@ -0,0 +1,69 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"fmt"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
+)
+
+// loadServiceManifestFromSource loads the service manifest placed in the given deployment source.
+func loadServiceManifestFromSource(ds sdk.DeploymentSource[config.CloudRunApplicationSpec]) (provider.ServiceManifest, error) {
+	appCfg, err := ds.AppConfig()
+	if err != nil {
+		return provider.ServiceManifest{}, err
+	}
+	return provider.LoadServiceManifest(ds.ApplicationDirectory, appCfg.Spec.Input.ServiceManifestFile)
+}
+
+// determineStrategy compares the container images of the running and target service manifests
+// and returns the appropriate sync strategy:
+//
+// Use PipelineSync if the image was changed.
+//
+// Use QuickSync if no image difference.
+func determineStrategy(running, target provider.ServiceManifest) (*sdk.DetermineStrategyResponse, error) {
+	runningVersions, err := provider.FindArtifactVersions(running)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine the running version: %w", err)
+	}
+	targetVersions, err := provider.FindArtifactVersions(target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine the target version: %w", err)
+	}
+
+	r, t := runningVersions[0], targetVersions[0]
+	if r.URL == t.URL {
+		return &sdk.DetermineStrategyResponse{
+			Strategy: sdk.SyncStrategyQuickSync,
+			Summary:  fmt.Sprintf("Quick sync to deploy image %s and configure all traffic to it (no container image change was detected)", t.Version),
+		}, nil
+	}
+
+	if r.Name == t.Name {
+		return &sdk.DetermineStrategyResponse{
+			Strategy: sdk.SyncStrategyPipelineSync,
+			Summary:  fmt.Sprintf("Sync with pipeline to update image %s from %s to %s", t.Name, r.Version, t.Version),
+		}, nil
+	}
+	return &sdk.DetermineStrategyResponse{
+		Strategy: sdk.SyncStrategyPipelineSync,
+		Summary:  fmt.Sprintf("Sync with pipeline to update image from %s to %s", r.URL, t.URL),
+	}, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
+)
+
+func TestPlugin_DetermineVersions(t *testing.T) {
+	t.Parallel()
+
+	p := &Plugin{}
+	resp, err := p.DetermineVersions(t.Context(), nil, &sdk.DetermineVersionsInput[config.CloudRunApplicationSpec]{
+		Request: sdk.DetermineVersionsRequest[config.CloudRunApplicationSpec]{
+			DeploymentSource: newTestDeploymentSource(t, "v2", "2222222bbbb"),
+		},
+		Logger: zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []sdk.ArtifactVersion{
+		{
+			Version: "v0.6.0",
+			Name:    "helloworld",
+			URL:     "gcr.io/pipecd/helloworld:v0.6.0",
+		},
+	}, resp.Versions)
+}
+
+func TestPlugin_DetermineStrategy(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		running string
+		target  string
+		want    sdk.SyncStrategy
+	}{
+		{
+			name:   "first deployment",
+			target: "v1",
+			want:   sdk.SyncStrategyQuickSync,
+		},
+		{
+			name:    "image was not changed",
+			running: "v1",
+			target:  "v1",
+			want:    sdk.SyncStrategyQuickSync,
+		},
+		{
+			name:    "image was changed",
+			running: "v1",
+			target:  "v2",
+			want:    sdk.SyncStrategyPipelineSync,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var running sdk.DeploymentSource[config.CloudRunApplicationSpec]
+			if tc.running != "" {
+				running = newTestDeploymentSource(t, tc.running, "1111111aaaa")
+			}
+
+			p := &Plugin{}
+			resp, err := p.DetermineStrategy(t.Context(), nil, &sdk.DetermineStrategyInput[config.CloudRunApplicationSpec]{
+				Request: sdk.DetermineStrategyRequest[config.CloudRunApplicationSpec]{
+					RunningDeploymentSource: running,
+					TargetDeploymentSource:  newTestDeploymentSource(t, tc.target, "2222222bbbb"),
+				},
+				Logger: zaptest.NewLogger(t),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, resp.Strategy)
+		})
+	}
+}
+
+func TestDetermineStrategy_Summary(t *testing.T) {
+	t.Parallel()
+
+	running, err := provider.ParseServiceManifest([]byte(`
+metadata:
+  name: helloworld
+spec:
+  template:
+    spec:
+      containers:
+      - image: gcr.io/pipecd/helloworld:v0.5.0
+`))
+	require.NoError(t, err)
+	target, err := provider.ParseServiceManifest([]byte(`
+metadata:
+  name: helloworld
+spec:
+  template:
+    spec:
+      containers:
+      - image: gcr.io/pipecd/helloworld:v0.6.0
+`))
+	require.NoError(t, err)
+
+	resp, err := determineStrategy(running, target)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.SyncStrategyPipelineSync, resp.Strategy)
+	assert.Equal(t, "Sync with pipeline to update image helloworld from v0.5.0 to v0.6.0", resp.Summary)
+}
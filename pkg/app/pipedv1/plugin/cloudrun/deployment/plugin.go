@@ -20,13 +20,19 @@ import (
 	"slices"
 
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
 )
 
 type Plugin struct{}
 
-var errRollbackRequiresStages = errors.New("rollback requires at least one stage")
+var (
+	errRollbackRequiresStages = errors.New("rollback requires at least one stage")
+	errNoDeployTarget         = errors.New("no deploy target was specified")
+	errUnsupportedStage       = errors.New("unsupported stage")
+)
 
 const (
 	// StageCloudRunSync does quick sync by rolling out the new version
@@ -56,18 +62,77 @@ func (p *Plugin) BuildPipelineSyncStages(ctx context.Context, _ *sdk.ConfigNone,
 }
 
 func (p *Plugin) ExecuteStage(ctx context.Context, _ *sdk.ConfigNone, dts []*sdk.DeployTarget[config.CloudRunDeployTargetConfig], input *sdk.ExecuteStageInput[config.CloudRunApplicationSpec]) (*sdk.ExecuteStageResponse, error) {
-	// TODO implement me
-	panic("implement me")
+	if len(dts) == 0 {
+		return nil, errNoDeployTarget
+	}
+
+	switch input.Request.StageName {
+	case StageCloudRunSync:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeSyncStage(ctx, input, dts[0]),
+		}, nil
+	case StageCloudRunPromote:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executePromoteStage(ctx, input, dts[0]),
+		}, nil
+	case StageRollback:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeRollbackStage(ctx, input, dts[0]),
+		}, nil
+	default:
+		return nil, errUnsupportedStage
+	}
 }
 
+// DetermineVersions determines the versions of the container image which will be deployed.
 func (p *Plugin) DetermineVersions(ctx context.Context, _ *sdk.ConfigNone, input *sdk.DetermineVersionsInput[config.CloudRunApplicationSpec]) (*sdk.DetermineVersionsResponse, error) {
-	// TODO implement me
-	panic("implement me")
+	sm, err := loadServiceManifestFromSource(input.Request.DeploymentSource)
+	if err != nil {
+		input.Logger.Error("failed to load service manifest", zap.Error(err))
+		return nil, err
+	}
+
+	versions, err := provider.FindArtifactVersions(sm)
+	if err != nil {
+		input.Logger.Error("failed to determine artifact versions", zap.Error(err))
+		return nil, err
+	}
+
+	return &sdk.DetermineVersionsResponse{
+		Versions: versions,
+	}, nil
 }
 
+// DetermineStrategy determines the strategy to deploy the service.
+//
+// Use QuickSync for the first deployment or when the container image was not changed.
+//
+// Use PipelineSync otherwise.
 func (p *Plugin) DetermineStrategy(ctx context.Context, _ *sdk.ConfigNone, input *sdk.DetermineStrategyInput[config.CloudRunApplicationSpec]) (*sdk.DetermineStrategyResponse, error) {
-	// TODO implement me
-	panic("implement me")
+	target, err := loadServiceManifestFromSource(input.Request.TargetDeploymentSource)
+	if err != nil {
+		input.Logger.Error("failed to load target service manifest", zap.Error(err))
+		return nil, err
+	}
+
+	// This is the first time to deploy this application, so we just do the quick sync.
+	if input.Request.RunningDeploymentSource.CommitHash == "" {
+		return &sdk.DetermineStrategyResponse{
+			Strategy: sdk.SyncStrategyQuickSync,
+			Summary:  "Quick sync to deploy the service and configure all traffic to it (it seems this is the first deployment)",
+		}, nil
+	}
+
+	running, err := loadServiceManifestFromSource(input.Request.RunningDeploymentSource)
+	if err != nil {
+		input.Logger.Warn("failed to load running service manifest, falling back to pipeline sync", zap.Error(err))
+		return &sdk.DetermineStrategyResponse{
+			Strategy: sdk.SyncStrategyPipelineSync,
+			Summary:  "Sync with the specified pipeline (unable to load running service manifest)",
+		}, nil
+	}
+
+	return determineStrategy(running, target)
 }
 
 func (p *Plugin) BuildQuickSyncStages(ctx context.Context, _ *sdk.ConfigNone, input *sdk.BuildQuickSyncStagesInput) (*sdk.BuildQuickSyncStagesResponse, error) {
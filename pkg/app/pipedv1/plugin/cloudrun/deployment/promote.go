@@ -0,0 +1,142 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
+)
+
+const (
+	promotePercentageMetadataKey = "promote-percentage"
+)
+
+// stageMetadataStore is the subset of sdk.Client used to store the stage metadata.
+type stageMetadataStore interface {
+	PutStageMetadataMulti(ctx context.Context, metadata map[string]string) error
+}
+
+func (p *Plugin) executePromoteStage(
+	ctx context.Context,
+	input *sdk.ExecuteStageInput[config.CloudRunApplicationSpec],
+	deployTarget *sdk.DeployTarget[config.CloudRunDeployTargetConfig],
+) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	client, err := provider.DefaultRegistry().Client(ctx, deployTarget.Name, deployTarget.Config)
+	if err != nil {
+		lp.Errorf("Unable to create Cloud Run client for the deploy target %s (%v)", deployTarget.Name, err)
+		return sdk.StageStatusFailure
+	}
+
+	return promote(ctx, client, input.Client, input.Request, lp)
+}
+
+// promote routes the configured percentage of traffic to the revision at the target commit
+// and the rest to the revision at the running commit.
+func promote(ctx context.Context, client provider.Client, store stageMetadataStore, request sdk.ExecuteStageRequest[config.CloudRunApplicationSpec], lp sdk.StageLogPersister) sdk.StageStatus {
+	var options config.CloudRunPromoteStageOptions
+	if err := json.Unmarshal(request.StageConfig, &options); err != nil {
+		lp.Errorf("Malformed configuration for stage %s (%v)", request.StageName, err)
+		return sdk.StageStatusFailure
+	}
+	percent := options.Percent.Int()
+
+	metadata := map[string]string{
+		promotePercentageMetadataKey: strconv.FormatInt(int64(percent), 10),
+	}
+	if err := store.PutStageMetadataMulti(ctx, metadata); err != nil {
+		lp.Errorf("Failed to save routing percentages to metadata (%v)", err)
+	}
+
+	// Load the last deployed data.
+	runningDS := request.RunningDeploymentSource
+	if runningDS.CommitHash == "" {
+		lp.Error("Unable to determine the last deployed commit")
+		return sdk.StageStatusFailure
+	}
+
+	lastDeployedSM, ok := loadServiceManifest(runningDS, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	lastDeployedRevision, ok := decideRevisionName(lastDeployedSM, runningDS.CommitHash, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	// Load the service manifest at the target commit.
+	targetDS := request.TargetDeploymentSource
+	sm, ok := loadServiceManifest(targetDS, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	revision, ok := decideRevisionName(sm, targetDS.CommitHash, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	traffics := []provider.RevisionTraffic{
+		{
+			RevisionName: revision,
+			Percent:      percent,
+		},
+		{
+			RevisionName: lastDeployedRevision,
+			Percent:      100 - percent,
+		},
+	}
+
+	exist, err := revisionExists(ctx, client, revision, lp)
+	if err != nil {
+		return sdk.StageStatusFailure
+	}
+
+	// Cloud Run does not allow to create a revision with the same name as an existing one,
+	// so leave the revision name empty to keep the current template when it was already created.
+	revisionName := revision
+	if exist {
+		revisionName = ""
+		lp.Infof("Revision %s was already registered", revision)
+	}
+
+	if !configureServiceManifest(sm, revisionName, traffics, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	// Add builtin labels for tracking application live state.
+	if !addBuiltinLabels(sm, targetDS.CommitHash, request.Deployment.PipedID, request.Deployment.ApplicationID, revisionName, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	if !apply(ctx, client, sm, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	if err := waitRevisionReady(ctx, client, revision, revisionCheckDuration, revisionCheckTimeout, lp); err != nil {
+		return sdk.StageStatusFailure
+	}
+
+	lp.Successf("Successfully promoted %d%% of traffic to the revision %s", percent, revision)
+	return sdk.StageStatusSuccess
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister/logpersistertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider/providertest"
+)
+
+type fakeMetadataStore struct {
+	metadata map[string]string
+	err      error
+}
+
+func (s *fakeMetadataStore) PutStageMetadataMulti(_ context.Context, metadata map[string]string) error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.metadata == nil {
+		s.metadata = make(map[string]string, len(metadata))
+	}
+	for k, v := range metadata {
+		s.metadata[k] = v
+	}
+	return nil
+}
+
+func TestPromote(t *testing.T) {
+	t.Parallel()
+
+	const (
+		runningRevision = "helloworld-v050-1111111"
+		targetRevision  = "helloworld-v060-2222222"
+	)
+
+	testcases := []struct {
+		name     string
+		percents []int
+		want     map[string]int64
+	}{
+		{
+			name:     "promote partially",
+			percents: []int{10},
+			want:     map[string]int64{targetRevision: 10, runningRevision: 90},
+		},
+		{
+			name:     "promote step by step",
+			percents: []int{10, 50, 100},
+			want:     map[string]int64{targetRevision: 100, runningRevision: 0},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := providertest.NewServer(t, testProject)
+			client := newTestClient(t, server)
+
+			running := newTestDeploymentSource(t, "v1", "1111111aaaa")
+			target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+			require.Equal(t, sdk.StageStatusSuccess, sync(t.Context(), client, newTestRequest(t, StageCloudRunSync, nil, sdk.DeploymentSource[config.CloudRunApplicationSpec]{}, running), logpersistertest.NewTestLogPersister(t)))
+
+			store := &fakeMetadataStore{}
+			for _, p := range tc.percents {
+				stageConfig := []byte(`{"percent": ` + strconv.Itoa(p) + `}`)
+				status := promote(t.Context(), client, store, newTestRequest(t, StageCloudRunPromote, stageConfig, running, target), logpersistertest.NewTestLogPersister(t))
+				require.Equal(t, sdk.StageStatusSuccess, status)
+				assert.Equal(t, strconv.Itoa(p), store.metadata[promotePercentageMetadataKey])
+			}
+
+			assert.Equal(t, tc.want, trafficOf(t, server, "helloworld"))
+		})
+	}
+}
+
+func TestPromote_Failure(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		stageConfig []byte
+		hasRunning  bool
+	}{
+		{
+			name:        "malformed stage config",
+			stageConfig: []byte(`{"percent": "abc"}`),
+			hasRunning:  true,
+		},
+		{
+			name:        "no running deployment",
+			stageConfig: []byte(`{"percent": 10}`),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := providertest.NewServer(t, testProject)
+			client := newTestClient(t, server)
+
+			var running sdk.DeploymentSource[config.CloudRunApplicationSpec]
+			if tc.hasRunning {
+				running = newTestDeploymentSource(t, "v1", "1111111aaaa")
+			}
+			target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+
+			status := promote(t.Context(), client, &fakeMetadataStore{}, newTestRequest(t, StageCloudRunPromote, tc.stageConfig, running, target), logpersistertest.NewTestLogPersister(t))
+			assert.Equal(t, sdk.StageStatusFailure, status)
+		})
+	}
+}
+
+func TestPromote_MetadataStoreError(t *testing.T) {
+	t.Parallel()
+
+	server := providertest.NewServer(t, testProject)
+	client := newTestClient(t, server)
+
+	running := newTestDeploymentSource(t, "v1", "1111111aaaa")
+	target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+	require.Equal(t, sdk.StageStatusSuccess, sync(t.Context(), client, newTestRequest(t, StageCloudRunSync, nil, sdk.DeploymentSource[config.CloudRunApplicationSpec]{}, running), logpersistertest.NewTestLogPersister(t)))
+
+	// Failing to store the metadata should not fail the stage.
+	store := &fakeMetadataStore{err: errors.New("unavailable")}
+	status := promote(t.Context(), client, store, newTestRequest(t, StageCloudRunPromote, []byte(`{"percent": 30}`), running, target), logpersistertest.NewTestLogPersister(t))
+	require.Equal(t, sdk.StageStatusSuccess, status)
+	assert.Equal(t, map[string]int64{"helloworld-v060-2222222": 30, "helloworld-v050-1111111": 70}, trafficOf(t, server, "helloworld"))
+}
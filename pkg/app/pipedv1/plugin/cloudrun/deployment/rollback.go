@@ -0,0 +1,82 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
+)
+
+func (p *Plugin) executeRollbackStage(
+	ctx context.Context,
+	input *sdk.ExecuteStageInput[config.CloudRunApplicationSpec],
+	deployTarget *sdk.DeployTarget[config.CloudRunDeployTargetConfig],
+) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	client, err := provider.DefaultRegistry().Client(ctx, deployTarget.Name, deployTarget.Config)
+	if err != nil {
+		lp.Errorf("Unable to create Cloud Run client for the deploy target %s (%v)", deployTarget.Name, err)
+		return sdk.StageStatusFailure
+	}
+
+	return rollback(ctx, client, input.Request, lp)
+}
+
+// rollback configures all traffic back to the revision at the running commit.
+func rollback(ctx context.Context, client provider.Client, request sdk.ExecuteStageRequest[config.CloudRunApplicationSpec], lp sdk.StageLogPersister) sdk.StageStatus {
+	// There is nothing to do if this is the first deployment.
+	runningDS := request.RunningDeploymentSource
+	if runningDS.CommitHash == "" {
+		lp.Error("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return sdk.StageStatusFailure
+	}
+
+	sm, ok := loadServiceManifest(runningDS, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	revision, ok := decideRevisionName(sm, runningDS.CommitHash, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	traffics := []provider.RevisionTraffic{
+		{
+			RevisionName: revision,
+			Percent:      100,
+		},
+	}
+	if !configureServiceManifest(sm, revision, traffics, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	// Add builtin labels for tracking application live state.
+	if !addBuiltinLabels(sm, runningDS.CommitHash, request.Deployment.PipedID, request.Deployment.ApplicationID, revision, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	if !apply(ctx, client, sm, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	lp.Successf("Successfully rolled back all traffic to the revision %s", revision)
+	return sdk.StageStatusSuccess
+}
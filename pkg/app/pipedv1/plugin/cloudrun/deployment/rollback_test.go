@@ -0,0 +1,62 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister/logpersistertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider/providertest"
+)
+
+func TestRollback(t *testing.T) {
+	t.Parallel()
+
+	t.Run("route all traffic back to the running revision", func(t *testing.T) {
+		t.Parallel()
+
+		server := providertest.NewServer(t, testProject)
+		client := newTestClient(t, server)
+
+		running := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+		require.Equal(t, sdk.StageStatusSuccess, sync(t.Context(), client, newTestRequest(t, StageCloudRunSync, nil, sdk.DeploymentSource[config.CloudRunApplicationSpec]{}, running), logpersistertest.NewTestLogPersister(t)))
+		require.Equal(t, sdk.StageStatusSuccess, promote(t.Context(), client, &fakeMetadataStore{}, newTestRequest(t, StageCloudRunPromote, []byte(`{"percent": 50}`), running, target), logpersistertest.NewTestLogPersister(t)))
+
+		status := rollback(t.Context(), client, newTestRequest(t, StageRollback, nil, running, target), logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+
+		assert.Equal(t, map[string]int64{"helloworld-v050-1111111": 100}, trafficOf(t, server, "helloworld"))
+		svc, ok := server.Service("helloworld")
+		require.True(t, ok)
+		assert.Equal(t, "1111111aaaa", svc.Metadata.Labels["pipecd-dev-commit-hash"])
+	})
+
+	t.Run("fail on the first deployment", func(t *testing.T) {
+		t.Parallel()
+
+		server := providertest.NewServer(t, testProject)
+		client := newTestClient(t, server)
+
+		target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+		status := rollback(t.Context(), client, newTestRequest(t, StageRollback, nil, sdk.DeploymentSource[config.CloudRunApplicationSpec]{}, target), logpersistertest.NewTestLogPersister(t))
+		assert.Equal(t, sdk.StageStatusFailure, status)
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
+)
+
+func (p *Plugin) executeSyncStage(
+	ctx context.Context,
+	input *sdk.ExecuteStageInput[config.CloudRunApplicationSpec],
+	deployTarget *sdk.DeployTarget[config.CloudRunDeployTargetConfig],
+) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	client, err := provider.DefaultRegistry().Client(ctx, deployTarget.Name, deployTarget.Config)
+	if err != nil {
+		lp.Errorf("Unable to create Cloud Run client for the deploy target %s (%v)", deployTarget.Name, err)
+		return sdk.StageStatusFailure
+	}
+
+	return sync(ctx, client, input.Request, lp)
+}
+
+// sync deploys the service manifest at the target commit and configures all traffic to its revision.
+func sync(ctx context.Context, client provider.Client, request sdk.ExecuteStageRequest[config.CloudRunApplicationSpec], lp sdk.StageLogPersister) sdk.StageStatus {
+	ds := request.TargetDeploymentSource
+	sm, ok := loadServiceManifest(ds, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	revision, ok := decideRevisionName(sm, ds.CommitHash, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	traffics := []provider.RevisionTraffic{
+		{
+			RevisionName: revision,
+			Percent:      100,
+		},
+	}
+	if !configureServiceManifest(sm, revision, traffics, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	// Add builtin labels for tracking application live state.
+	if !addBuiltinLabels(sm, ds.CommitHash, request.Deployment.PipedID, request.Deployment.ApplicationID, revision, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	if !apply(ctx, client, sm, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	if err := waitRevisionReady(ctx, client, revision, revisionCheckDuration, revisionCheckTimeout, lp); err != nil {
+		return sdk.StageStatusFailure
+	}
+
+	lp.Success("Successfully synced the service")
+	return sdk.StageStatusSuccess
+}
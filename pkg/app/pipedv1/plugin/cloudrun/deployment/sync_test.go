@@ -0,0 +1,93 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister/logpersistertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider/providertest"
+)
+
+func TestSync(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create the service on the first deployment", func(t *testing.T) {
+		t.Parallel()
+
+		server := providertest.NewServer(t, testProject)
+		client := newTestClient(t, server)
+
+		target := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		request := newTestRequest(t, StageCloudRunSync, nil, sdk.DeploymentSource[config.CloudRunApplicationSpec]{}, target)
+
+		status := sync(t.Context(), client, request, logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+
+		svc, ok := server.Service("helloworld")
+		require.True(t, ok)
+		assert.Equal(t, "helloworld-v050-1111111", svc.Spec.Template.Metadata.Name)
+		assert.Equal(t, map[string]string{
+			provider.LabelManagedBy:   provider.ManagedByCloudRunPlugin,
+			provider.LabelPiped:       "piped-id",
+			provider.LabelApplication: "app-id",
+			provider.LabelCommitHash:  "1111111aaaa",
+		}, svc.Metadata.Labels)
+		assert.Equal(t, map[string]int64{"helloworld-v050-1111111": 100}, trafficOf(t, server, "helloworld"))
+
+		rev, ok := server.Revision("helloworld-v050-1111111")
+		require.True(t, ok)
+		assert.Equal(t, "helloworld-v050-1111111", rev.Metadata.Labels[provider.LabelRevisionName])
+		assert.Equal(t, "gcr.io/pipecd/helloworld:v0.5.0", rev.Spec.Containers[0].Image)
+	})
+
+	t.Run("switch all traffic to the new revision", func(t *testing.T) {
+		t.Parallel()
+
+		server := providertest.NewServer(t, testProject)
+		client := newTestClient(t, server)
+
+		running := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		require.Equal(t, sdk.StageStatusSuccess, sync(t.Context(), client, newTestRequest(t, StageCloudRunSync, nil, sdk.DeploymentSource[config.CloudRunApplicationSpec]{}, running), logpersistertest.NewTestLogPersister(t)))
+
+		target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+		status := sync(t.Context(), client, newTestRequest(t, StageCloudRunSync, nil, running, target), logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+
+		assert.Equal(t, map[string]int64{"helloworld-v060-2222222": 100}, trafficOf(t, server, "helloworld"))
+		_, ok := server.Revision("helloworld-v050-1111111")
+		assert.True(t, ok, "the old revision should be kept for rolling back")
+	})
+
+	t.Run("fail when the new revision is not ready", func(t *testing.T) {
+		t.Parallel()
+
+		server := providertest.NewServer(t, testProject)
+		server.FailNewRevisions("container failed to start")
+		client := newTestClient(t, server)
+
+		target := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		request := newTestRequest(t, StageCloudRunSync, nil, sdk.DeploymentSource[config.CloudRunApplicationSpec]{}, target)
+
+		status := sync(t.Context(), client, request, logpersistertest.NewTestLogPersister(t))
+		assert.Equal(t, sdk.StageStatusFailure, status)
+	})
+}
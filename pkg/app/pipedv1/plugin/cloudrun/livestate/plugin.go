@@ -16,15 +16,167 @@ package livestate
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
 
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
 )
 
 type Plugin struct{}
 
+// GetLivestate returns the live state of the service and its active revisions,
+// and whether the service is in sync with the manifest declared in Git.
 func (p Plugin) GetLivestate(ctx context.Context, _ *sdk.ConfigNone, dts []*sdk.DeployTarget[config.CloudRunDeployTargetConfig], input *sdk.GetLivestateInput[config.CloudRunApplicationSpec]) (*sdk.GetLivestateResponse, error) {
-	// TODO implement me
-	panic("implement me")
+	if len(dts) == 0 {
+		return nil, errors.New("no deploy target was specified")
+	}
+	dt := dts[0]
+
+	client, err := provider.DefaultRegistry().Client(ctx, dt.Name, dt.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Cloud Run client for the deploy target %s: %w", dt.Name, err)
+	}
+
+	return getLivestate(ctx, client, dt.Name, input.Request)
+}
+
+func getLivestate(ctx context.Context, client provider.Client, deployTarget string, request sdk.GetLivestateRequest[config.CloudRunApplicationSpec]) (*sdk.GetLivestateResponse, error) {
+	appCfg, err := request.DeploymentSource.AppConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app config: %w", err)
+	}
+
+	sm, err := provider.LoadServiceManifest(request.DeploymentSource.ApplicationDirectory, appCfg.Spec.Input.ServiceManifestFile)
+	if err != nil {
+		return &sdk.GetLivestateResponse{
+			SyncState: sdk.ApplicationSyncState{
+				Status:      sdk.ApplicationSyncStateInvalidConfig,
+				ShortReason: "Unable to load the service manifest",
+				Reason:      err.Error(),
+			},
+		}, nil
+	}
+
+	svc, err := client.Get(ctx, sm.Name)
+	if errors.Is(err, provider.ErrServiceNotFound) {
+		return &sdk.GetLivestateResponse{
+			SyncState: sdk.ApplicationSyncState{
+				Status:      sdk.ApplicationSyncStateOutOfSync,
+				ShortReason: fmt.Sprintf("Service %s was not found", sm.Name),
+			},
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s: %w", sm.Name, err)
+	}
+
+	revs, err := listActiveRevisions(ctx, client, svc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.GetLivestateResponse{
+		LiveState: sdk.ApplicationLiveState{
+			Resources: provider.MakeResourceStates(svc, revs, deployTarget),
+		},
+		SyncState: calculateSyncState(sm, svc),
+	}, nil
+}
+
+// listActiveRevisions returns the revisions which are receiving the traffic of the given service.
+func listActiveRevisions(ctx context.Context, client provider.Client, svc *provider.Service) ([]*provider.Revision, error) {
+	names := svc.ActiveRevisionNames()
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	var (
+		revs    []*provider.Revision
+		options = &provider.ListRevisionsOptions{
+			LabelSelector: fmt.Sprintf("serving.knative.dev/service=%s", svc.Metadata.Name),
+		}
+		active = make(map[string]struct{}, len(names))
+	)
+	for _, n := range names {
+		active[n] = struct{}{}
+	}
+	for {
+		items, cursor, err := client.ListRevisions(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list revisions of service %s: %w", svc.Metadata.Name, err)
+		}
+		for _, r := range items {
+			if r.Metadata == nil {
+				continue
+			}
+			if _, ok := active[r.Metadata.Name]; ok {
+				revs = append(revs, r)
+			}
+		}
+		if cursor == "" {
+			return revs, nil
+		}
+		options.Cursor = cursor
+	}
+}
+
+// calculateSyncState compares the container image declared in Git with the live one
+// and checks whether all traffic is routed to a single revision.
+func calculateSyncState(desired provider.ServiceManifest, live *provider.Service) sdk.ApplicationSyncState {
+	liveSM, err := live.ServiceManifest()
+	if err != nil {
+		return sdk.ApplicationSyncState{
+			Status:      sdk.ApplicationSyncStateUnknown,
+			ShortReason: "Unable to parse the live service",
+			Reason:      err.Error(),
+		}
+	}
+
+	desiredVersions, err := provider.FindArtifactVersions(desired)
+	if err != nil {
+		return sdk.ApplicationSyncState{
+			Status:      sdk.ApplicationSyncStateInvalidConfig,
+			ShortReason: "Unable to find the container image in the service manifest",
+			Reason:      err.Error(),
+		}
+	}
+	liveVersions, err := provider.FindArtifactVersions(liveSM)
+	if err != nil {
+		return sdk.ApplicationSyncState{
+			Status:      sdk.ApplicationSyncStateUnknown,
+			ShortReason: "Unable to find the container image of the live service",
+			Reason:      err.Error(),
+		}
+	}
+
+	if d, l := desiredVersions[0].URL, liveVersions[0].URL; d != l {
+		return sdk.ApplicationSyncState{
+			Status:      sdk.ApplicationSyncStateOutOfSync,
+			ShortReason: "The container image is different from the one declared in Git",
+			Reason:      fmt.Sprintf("image: live %s, desired %s", l, d),
+		}
+	}
+
+	var names []string
+	if live.Status != nil {
+		for _, t := range live.Status.Traffic {
+			if t.Percent > 0 {
+				names = append(names, t.RevisionName)
+			}
+		}
+	}
+	if len(names) > 1 {
+		return sdk.ApplicationSyncState{
+			Status:      sdk.ApplicationSyncStateOutOfSync,
+			ShortReason: "The traffic is split into multiple revisions",
+			Reason:      fmt.Sprintf("active revisions: %v", names),
+		}
+	}
+
+	return sdk.ApplicationSyncState{
+		Status: sdk.ApplicationSyncStateSynced,
+	}
 }
@@ -0,0 +1,204 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livestate
+
+import (
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/run/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider/providertest"
+)
+
+const testProject = "test-project"
+
+func newTestRequest(t *testing.T) sdk.GetLivestateRequest[config.CloudRunApplicationSpec] {
+	t.Helper()
+
+	appDir := filepath.Join("testdata", "app")
+	return sdk.GetLivestateRequest[config.CloudRunApplicationSpec]{
+		PipedID:         "piped-id",
+		ApplicationID:   "app-id",
+		ApplicationName: "helloworld",
+		DeploymentSource: sdk.DeploymentSource[config.CloudRunApplicationSpec]{
+			ApplicationDirectory:      appDir,
+			CommitHash:                "1111111aaaa",
+			ApplicationConfig:         sdk.LoadApplicationConfigForTest[config.CloudRunApplicationSpec](t, filepath.Join(appDir, "app.pipecd.yaml"), "cloudrun"),
+			ApplicationConfigFilename: "app.pipecd.yaml",
+		},
+	}
+}
+
+func makeService(image string, traffic ...*run.TrafficTarget) *run.Service {
+	return &run.Service{
+		Metadata: &run.ObjectMeta{
+			Name:              "helloworld",
+			Namespace:         testProject,
+			Uid:               "service-uid",
+			CreationTimestamp: "2025-01-02T03:04:05Z",
+		},
+		Spec: &run.ServiceSpec{
+			Template: &run.RevisionTemplate{
+				Spec: &run.RevisionSpec{
+					Containers: []*run.Container{{Image: image}},
+				},
+			},
+		},
+		Status: &run.ServiceStatus{
+			Traffic: traffic,
+			Conditions: []*run.GoogleCloudRunV1Condition{
+				{Type: "Ready", Status: "True"},
+				{Type: "ConfigurationsReady", Status: "True"},
+				{Type: "RoutesReady", Status: "True"},
+			},
+		},
+	}
+}
+
+func makeRevision(name string, conditions ...*run.GoogleCloudRunV1Condition) *run.Revision {
+	return &run.Revision{
+		Metadata: &run.ObjectMeta{
+			Name:      name,
+			Namespace: testProject,
+			Uid:       name + "-uid",
+			Labels: map[string]string{
+				"serving.knative.dev/service": "helloworld",
+				provider.LabelCommitHash:      "1111111aaaa",
+			},
+		},
+		Status: &run.RevisionStatus{Conditions: conditions},
+	}
+}
+
+func healthyRevisionConditions() []*run.GoogleCloudRunV1Condition {
+	return []*run.GoogleCloudRunV1Condition{
+		{Type: "Ready", Status: "True"},
+		{Type: "Active", Status: "True"},
+		{Type: "ContainerHealthy", Status: "True"},
+		{Type: "ResourcesAvailable", Status: "True"},
+	}
+}
+
+func TestGetLivestate(t *testing.T) {
+	t.Parallel()
+
+	server := providertest.NewServer(t, testProject)
+	server.PutService(makeService("gcr.io/pipecd/helloworld:v0.5.0",
+		&run.TrafficTarget{RevisionName: "helloworld-v050-1111111", Percent: 100},
+	))
+	server.PutRevision(makeRevision("helloworld-v050-1111111", healthyRevisionConditions()...))
+	// The inactive revision must not be included in the live state.
+	server.PutRevision(makeRevision("helloworld-v040-0000000", healthyRevisionConditions()...))
+
+	client, err := provider.NewClient(t.Context(), config.CloudRunDeployTargetConfig{Project: testProject, Region: "asia-northeast1"}, server.ClientOptions()...)
+	require.NoError(t, err)
+
+	resp, err := getLivestate(t.Context(), client, "dt", newTestRequest(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, sdk.ApplicationSyncStateSynced, resp.SyncState.Status)
+	require.Len(t, resp.LiveState.Resources, 2)
+
+	svc := resp.LiveState.Resources[0]
+	assert.Equal(t, "service-uid", svc.ID)
+	assert.Equal(t, "helloworld", svc.Name)
+	assert.Equal(t, provider.ResourceTypeService, svc.ResourceType)
+	assert.Equal(t, sdk.ResourceHealthStateHealthy, svc.HealthStatus)
+	assert.Equal(t, "dt", svc.DeployTarget)
+	assert.Equal(t, 2025, svc.CreatedAt.Year())
+
+	rev := resp.LiveState.Resources[1]
+	assert.Equal(t, "helloworld-v050-1111111", rev.Name)
+	assert.Equal(t, provider.ResourceTypeRevision, rev.ResourceType)
+	assert.Equal(t, []string{"service-uid"}, rev.ParentIDs)
+	assert.Equal(t, sdk.ResourceHealthStateHealthy, rev.HealthStatus)
+	assert.Equal(t, "100%", rev.ResourceMetadata["traffic"])
+	assert.Equal(t, "1111111aaaa", rev.ResourceMetadata["commit"])
+}
+
+func TestGetLivestate_SyncState(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		service   *run.Service
+		revisions []*run.Revision
+		want      sdk.ApplicationSyncStatus
+	}{
+		{
+			name: "service not found",
+			want: sdk.ApplicationSyncStateOutOfSync,
+		},
+		{
+			name: "image is different",
+			service: makeService("gcr.io/pipecd/helloworld:v0.6.0",
+				&run.TrafficTarget{RevisionName: "helloworld-v060-2222222", Percent: 100},
+			),
+			revisions: []*run.Revision{makeRevision("helloworld-v060-2222222", healthyRevisionConditions()...)},
+			want:      sdk.ApplicationSyncStateOutOfSync,
+		},
+		{
+			name: "traffic is split",
+			service: makeService("gcr.io/pipecd/helloworld:v0.5.0",
+				&run.TrafficTarget{RevisionName: "helloworld-v050-1111111", Percent: 50},
+				&run.TrafficTarget{RevisionName: "helloworld-v040-0000000", Percent: 50},
+			),
+			revisions: []*run.Revision{
+				makeRevision("helloworld-v050-1111111", healthyRevisionConditions()...),
+				makeRevision("helloworld-v040-0000000", healthyRevisionConditions()...),
+			},
+			want: sdk.ApplicationSyncStateOutOfSync,
+		},
+		{
+			name: "revision without traffic is ignored",
+			service: makeService("gcr.io/pipecd/helloworld:v0.5.0",
+				&run.TrafficTarget{RevisionName: "helloworld-v050-1111111", Percent: 100},
+				&run.TrafficTarget{RevisionName: "helloworld-v040-0000000", Percent: 0},
+			),
+			revisions: []*run.Revision{
+				makeRevision("helloworld-v050-1111111", healthyRevisionConditions()...),
+				makeRevision("helloworld-v040-0000000", healthyRevisionConditions()...),
+			},
+			want: sdk.ApplicationSyncStateSynced,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := providertest.NewServer(t, testProject)
+			if tc.service != nil {
+				server.PutService(tc.service)
+			}
+			for _, r := range tc.revisions {
+				server.PutRevision(r)
+			}
+
+			client, err := provider.NewClient(t.Context(), config.CloudRunDeployTargetConfig{Project: testProject, Region: "asia-northeast1"}, server.ClientOptions()...)
+			require.NoError(t, err)
+
+			resp, err := getLivestate(t.Context(), client, "dt", newTestRequest(t))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, resp.SyncState.Status, resp.SyncState.ShortReason)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/cloudrunservice/provider/providertest"
+)
+
+const manifest = `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  template:
+    metadata:
+      name: helloworld-v010-1234567
+    spec:
+      containers:
+      - image: gcr.io/pipecd/helloworld:v0.1.0
+  traffic:
+  - revisionName: helloworld-v010-1234567
+    percent: 100
+`
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	_, err := provider.NewClient(t.Context(), config.CloudRunDeployTargetConfig{Region: "asia-northeast1"})
+	assert.Error(t, err)
+
+	_, err = provider.NewClient(t.Context(), config.CloudRunDeployTargetConfig{Project: "project"})
+	assert.Error(t, err)
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	server := providertest.NewServer(t, "project")
+	client, err := provider.NewClient(t.Context(), config.CloudRunDeployTargetConfig{
+		Project: "project",
+		Region:  "asia-northeast1",
+	}, server.ClientOptions()...)
+	require.NoError(t, err)
+
+	sm, err := provider.ParseServiceManifest([]byte(manifest))
+	require.NoError(t, err)
+	sm.AddLabels(map[string]string{provider.LabelApplication: "app-id"})
+
+	// Update returns ErrServiceNotFound when the service does not exist.
+	_, err = client.Update(t.Context(), sm)
+	require.ErrorIs(t, err, provider.ErrServiceNotFound)
+
+	_, err = client.Get(t.Context(), "helloworld")
+	require.ErrorIs(t, err, provider.ErrServiceNotFound)
+
+	// Create
+	created, err := client.Create(t.Context(), sm)
+	require.NoError(t, err)
+	assert.Equal(t, "helloworld", created.Metadata.Name)
+
+	// Get
+	got, err := client.Get(t.Context(), "helloworld")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"helloworld-v010-1234567"}, got.ActiveRevisionNames())
+
+	// List
+	svcs, cursor, err := client.List(t.Context(), &provider.ListOptions{LabelSelector: provider.MakeApplicationSelector("app-id")})
+	require.NoError(t, err)
+	assert.Empty(t, cursor)
+	assert.Len(t, svcs, 1)
+
+	svcs, _, err = client.List(t.Context(), &provider.ListOptions{LabelSelector: provider.MakeApplicationSelector("other")})
+	require.NoError(t, err)
+	assert.Empty(t, svcs)
+
+	// GetRevision
+	rev, err := client.GetRevision(t.Context(), "helloworld-v010-1234567")
+	require.NoError(t, err)
+	assert.Equal(t, "helloworld-v010-1234567", rev.Metadata.Name)
+
+	_, err = client.GetRevision(t.Context(), "not-found")
+	require.ErrorIs(t, err, provider.ErrRevisionNotFound)
+
+	// ListRevisions
+	revs, _, err := client.ListRevisions(t.Context(), &provider.ListRevisionsOptions{LabelSelector: "serving.knative.dev/service=helloworld"})
+	require.NoError(t, err)
+	assert.Len(t, revs, 1)
+
+	// Update
+	require.NoError(t, sm.SetRevision("helloworld-v011-2345678"))
+	require.NoError(t, sm.UpdateTraffic([]provider.RevisionTraffic{
+		{RevisionName: "helloworld-v010-1234567", Percent: 50},
+		{RevisionName: "helloworld-v011-2345678", Percent: 50},
+	}))
+	updated, err := client.Update(t.Context(), sm)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"helloworld-v010-1234567", "helloworld-v011-2345678"}, updated.ActiveRevisionNames())
+
+	// Create fails when the service already exists.
+	_, err = client.Create(t.Context(), sm)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "code=409")
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/run/v1"
+)
+
+func TestMakeRevisionNamesSelector(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"helloworld-v010-1234567", "helloworld-v011-2345678"}
+	got := MakeRevisionNamesSelector(names)
+	assert.Equal(t, "pipecd-dev-revision-name in (helloworld-v010-1234567,helloworld-v011-2345678)", got)
+}
+
+func TestService_ActiveRevisionNames(t *testing.T) {
+	t.Parallel()
+
+	sm, err := ParseServiceManifest([]byte(serviceManifest))
+	require.NoError(t, err)
+	svc := (*Service)(sm.svc)
+
+	assert.Equal(t, []string{"helloworld-v010-1234567"}, svc.ActiveRevisionNames())
+
+	uid, ok := svc.UID()
+	assert.True(t, ok)
+	assert.Equal(t, "service-uid", uid)
+}
+
+func TestStatusConditions_HealthStatus(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		kind       Kind
+		conditions []*run.GoogleCloudRunV1Condition
+		want       sdk.ResourceHealthStatus
+		wantDesc   string
+	}{
+		{
+			name: "healthy service",
+			kind: KindService,
+			conditions: []*run.GoogleCloudRunV1Condition{
+				{Type: "Ready", Status: "True"},
+				{Type: "ConfigurationsReady", Status: "True"},
+				{Type: "RoutesReady", Status: "True"},
+			},
+			want: sdk.ResourceHealthStateHealthy,
+		},
+		{
+			name: "unhealthy service",
+			kind: KindService,
+			conditions: []*run.GoogleCloudRunV1Condition{
+				{Type: "Ready", Status: "False", Reason: "RevisionFailed", Message: "Revision failed"},
+				{Type: "ConfigurationsReady", Status: "True"},
+				{Type: "RoutesReady", Status: "True"},
+			},
+			want:     sdk.ResourceHealthStateUnhealthy,
+			wantDesc: "Revision failed",
+		},
+		{
+			name: "unknown service",
+			kind: KindService,
+			conditions: []*run.GoogleCloudRunV1Condition{
+				{Type: "Ready", Status: "Unknown", Reason: "Deploying", Message: "Deploying revision"},
+			},
+			want:     sdk.ResourceHealthStateUnknown,
+			wantDesc: "Deploying revision",
+		},
+		{
+			name: "missing condition of revision",
+			kind: KindRevision,
+			conditions: []*run.GoogleCloudRunV1Condition{
+				{Type: "Ready", Status: "True"},
+				{Type: "Active", Status: "True"},
+				{Type: "ContainerHealthy", Status: "True"},
+			},
+			want:     sdk.ResourceHealthStateUnknown,
+			wantDesc: `Could not check status field "ResourcesAvailable"`,
+		},
+		{
+			name: "healthy revision",
+			kind: KindRevision,
+			conditions: []*run.GoogleCloudRunV1Condition{
+				{Type: "Ready", Status: "True"},
+				{Type: "Active", Status: "True"},
+				{Type: "ContainerHealthy", Status: "True"},
+				{Type: "ResourcesAvailable", Status: "True"},
+			},
+			want: sdk.ResourceHealthStateHealthy,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var sc *StatusConditions
+			if tc.kind == KindService {
+				sc = (&Service{Status: &run.ServiceStatus{Conditions: tc.conditions}}).StatusConditions()
+			} else {
+				sc = (&Revision{Status: &run.RevisionStatus{Conditions: tc.conditions}}).StatusConditions()
+			}
+			got, desc := sc.HealthStatus()
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantDesc, desc)
+		})
+	}
+
+	t.Run("no status", func(t *testing.T) {
+		t.Parallel()
+
+		got, _ := (&Service{}).StatusConditions().HealthStatus()
+		assert.Equal(t, sdk.ResourceHealthStateUnknown, got)
+	})
+}
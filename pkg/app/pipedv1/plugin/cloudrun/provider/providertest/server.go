@@ -0,0 +1,495 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package providertest provides a fake Cloud Run Admin API server for testing.
+package providertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/run/v1"
+)
+
+const apiPrefix = "/apis/serving.knative.dev/v1/namespaces/"
+
+// Server is an in-memory fake of the Cloud Run Admin API v1.
+// It supports creating, replacing, getting and listing services and getting and listing revisions.
+// A revision is materialized from the service template every time the service is created or replaced.
+type Server struct {
+	Project string
+
+	server *httptest.Server
+
+	mu              sync.Mutex
+	services        map[string]*run.Service
+	revisions       map[string]*run.Revision
+	revisionFailure string
+	uidCounter      int
+}
+
+// NewServer starts a fake Cloud Run Admin API server for the given project.
+// The server is closed when the test finishes.
+func NewServer(t testing.TB, project string) *Server {
+	t.Helper()
+
+	s := &Server{
+		Project:   project,
+		services:  make(map[string]*run.Service),
+		revisions: make(map[string]*run.Revision),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+// URL returns the base URL of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// ClientOptions returns the options to make the Cloud Run client talk to this server.
+func (s *Server) ClientOptions() []option.ClientOption {
+	return []option.ClientOption{
+		option.WithEndpoint(s.server.URL + "/"),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(s.server.Client()),
+	}
+}
+
+// FailNewRevisions makes every revision created after this call not ready with the given message.
+// Passing an empty message makes the new revisions ready again.
+func (s *Server) FailNewRevisions(message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.revisionFailure = message
+}
+
+// Service returns a copy of the stored service.
+func (s *Server) Service(name string) (*run.Service, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	svc, ok := s.services[name]
+	if !ok {
+		return nil, false
+	}
+	return clone(svc), true
+}
+
+// Revision returns a copy of the stored revision.
+func (s *Server) Revision(name string) (*run.Revision, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rev, ok := s.revisions[name]
+	if !ok {
+		return nil, false
+	}
+	return clone(rev), true
+}
+
+// PutService stores the given service as it is, without materializing any revision.
+// This is useful to prepare the state before running a test.
+func (s *Server) PutService(svc *run.Service) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.services[svc.Metadata.Name] = clone(svc)
+}
+
+// PutRevision stores the given revision as it is.
+// This is useful to prepare the state before running a test.
+func (s *Server) PutRevision(rev *run.Revision) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.revisions[rev.Metadata.Name] = clone(rev)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	path, ok := strings.CutPrefix(r.URL.Path, apiPrefix)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unknown path %s", r.URL.Path))
+		return
+	}
+
+	parts := strings.Split(path, "/")
+	if parts[0] != s.Project {
+		writeError(w, http.StatusForbidden, fmt.Sprintf("permission denied on project %s", parts[0]))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case len(parts) == 2 && parts[1] == "services" && r.Method == http.MethodGet:
+		s.listServices(w, r)
+	case len(parts) == 2 && parts[1] == "services" && r.Method == http.MethodPost:
+		s.createService(w, r)
+	case len(parts) == 3 && parts[1] == "services" && r.Method == http.MethodGet:
+		s.getService(w, parts[2])
+	case len(parts) == 3 && parts[1] == "services" && r.Method == http.MethodPut:
+		s.replaceService(w, r, parts[2])
+	case len(parts) == 2 && parts[1] == "revisions" && r.Method == http.MethodGet:
+		s.listRevisions(w, r)
+	case len(parts) == 3 && parts[1] == "revisions" && r.Method == http.MethodGet:
+		s.getRevision(w, parts[2])
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("unsupported request %s %s", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) listServices(w http.ResponseWriter, r *http.Request) {
+	selector, err := parseSelector(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	names := make([]string, 0, len(s.services))
+	for name := range s.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resp := &run.ListServicesResponse{Metadata: &run.ListMeta{}}
+	for _, name := range names {
+		svc := s.services[name]
+		if selector.matches(svc.Metadata.Labels) {
+			resp.Items = append(resp.Items, svc)
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) listRevisions(w http.ResponseWriter, r *http.Request) {
+	selector, err := parseSelector(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	names := make([]string, 0, len(s.revisions))
+	for name := range s.revisions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resp := &run.ListRevisionsResponse{Metadata: &run.ListMeta{}}
+	for _, name := range names {
+		rev := s.revisions[name]
+		if selector.matches(rev.Metadata.Labels) {
+			resp.Items = append(resp.Items, rev)
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) getService(w http.ResponseWriter, name string) {
+	svc, ok := s.services[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("service %s was not found", name))
+		return
+	}
+	writeJSON(w, http.StatusOK, svc)
+}
+
+func (s *Server) getRevision(w http.ResponseWriter, name string) {
+	rev, ok := s.revisions[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("revision %s was not found", name))
+		return
+	}
+	writeJSON(w, http.StatusOK, rev)
+}
+
+func (s *Server) createService(w http.ResponseWriter, r *http.Request) {
+	var svc run.Service
+	if err := json.NewDecoder(r.Body).Decode(&svc); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if svc.Metadata == nil || svc.Metadata.Name == "" {
+		writeError(w, http.StatusBadRequest, "metadata.name is required")
+		return
+	}
+	if _, ok := s.services[svc.Metadata.Name]; ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("service %s already exists", svc.Metadata.Name))
+		return
+	}
+
+	if code, err := s.apply(&svc, nil); err != nil {
+		writeError(w, code, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, &svc)
+}
+
+func (s *Server) replaceService(w http.ResponseWriter, r *http.Request, name string) {
+	var svc run.Service
+	if err := json.NewDecoder(r.Body).Decode(&svc); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if svc.Metadata == nil || svc.Metadata.Name != name {
+		writeError(w, http.StatusBadRequest, "metadata.name must match the service name in the path")
+		return
+	}
+
+	current, ok := s.services[name]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("service %s was not found", name))
+		return
+	}
+
+	if code, err := s.apply(&svc, current); err != nil {
+		writeError(w, code, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, &svc)
+}
+
+// apply materializes the revision from the template of the given service,
+// resolves the traffic targets and stores the service.
+func (s *Server) apply(svc *run.Service, current *run.Service) (int, error) {
+	if svc.Spec == nil || svc.Spec.Template == nil {
+		return http.StatusBadRequest, fmt.Errorf("spec.template is required")
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	svc.Metadata.Namespace = s.Project
+	if current != nil {
+		svc.Metadata.Uid = current.Metadata.Uid
+		svc.Metadata.CreationTimestamp = current.Metadata.CreationTimestamp
+		svc.Metadata.Generation = current.Metadata.Generation + 1
+	} else {
+		svc.Metadata.Uid = s.newUID()
+		svc.Metadata.CreationTimestamp = now
+		svc.Metadata.Generation = 1
+	}
+
+	// Decide the revision name.
+	tmplMeta := svc.Spec.Template.Metadata
+	if tmplMeta == nil {
+		tmplMeta = &run.ObjectMeta{}
+		svc.Spec.Template.Metadata = tmplMeta
+	}
+	revName := tmplMeta.Name
+	if revName == "" {
+		revName = fmt.Sprintf("%s-%05d", svc.Metadata.Name, svc.Metadata.Generation)
+	}
+
+	newRevision := false
+	if _, ok := s.revisions[revName]; !ok {
+		newRevision = true
+	}
+
+	// Resolve and validate the traffic targets.
+	traffic := svc.Spec.Traffic
+	if len(traffic) == 0 {
+		traffic = []*run.TrafficTarget{{LatestRevision: true, Percent: 100}}
+	}
+	var (
+		total    int64
+		resolved = make([]*run.TrafficTarget, 0, len(traffic))
+	)
+	for _, t := range traffic {
+		name := t.RevisionName
+		if t.LatestRevision {
+			name = revName
+		}
+		if name != revName {
+			if _, ok := s.revisions[name]; !ok {
+				return http.StatusBadRequest, fmt.Errorf("revision %s referenced in traffic was not found", name)
+			}
+		}
+		total += t.Percent
+		resolved = append(resolved, &run.TrafficTarget{
+			RevisionName:   name,
+			Percent:        t.Percent,
+			LatestRevision: t.LatestRevision,
+		})
+	}
+	if total != 100 {
+		return http.StatusBadRequest, fmt.Errorf("traffic percentages must add up to 100 but got %d", total)
+	}
+
+	if newRevision {
+		labels := make(map[string]string, len(tmplMeta.Labels)+1)
+		for k, v := range tmplMeta.Labels {
+			labels[k] = v
+		}
+		labels["serving.knative.dev/service"] = svc.Metadata.Name
+
+		rev := &run.Revision{
+			ApiVersion: "serving.knative.dev/v1",
+			Kind:       "Revision",
+			Metadata: &run.ObjectMeta{
+				Name:              revName,
+				Namespace:         s.Project,
+				Uid:               s.newUID(),
+				CreationTimestamp: now,
+				Labels:            labels,
+			},
+			Spec:   svc.Spec.Template.Spec,
+			Status: &run.RevisionStatus{Conditions: revisionConditions(s.revisionFailure)},
+		}
+		s.revisions[revName] = rev
+	}
+
+	svc.ApiVersion = "serving.knative.dev/v1"
+	svc.Kind = "Service"
+	svc.Status = &run.ServiceStatus{
+		ObservedGeneration:        svc.Metadata.Generation,
+		LatestCreatedRevisionName: revName,
+		LatestReadyRevisionName:   revName,
+		Traffic:                   resolved,
+		Conditions: []*run.GoogleCloudRunV1Condition{
+			{Type: "Ready", Status: "True"},
+			{Type: "ConfigurationsReady", Status: "True"},
+			{Type: "RoutesReady", Status: "True"},
+		},
+	}
+	s.services[svc.Metadata.Name] = clone(svc)
+	return http.StatusOK, nil
+}
+
+func (s *Server) newUID() string {
+	s.uidCounter++
+	return fmt.Sprintf("00000000-0000-0000-0000-%012d", s.uidCounter)
+}
+
+func revisionConditions(failure string) []*run.GoogleCloudRunV1Condition {
+	if failure != "" {
+		return []*run.GoogleCloudRunV1Condition{
+			{Type: "Ready", Status: "False", Reason: "ContainerFailed", Message: failure},
+			{Type: "Active", Status: "False", Reason: "ContainerFailed", Message: failure},
+		}
+	}
+	return []*run.GoogleCloudRunV1Condition{
+		{Type: "Ready", Status: "True"},
+		{Type: "Active", Status: "True"},
+		{Type: "ContainerHealthy", Status: "True"},
+		{Type: "ResourcesAvailable", Status: "True"},
+	}
+}
+
+// selector is a parsed label selector.
+// Only the equality-based requirements and the "in" operator are supported.
+type selector []func(labels map[string]string) bool
+
+func parseSelector(s string) (selector, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var out selector
+	for _, req := range splitRequirements(s) {
+		req = strings.TrimSpace(req)
+		if key, values, ok := strings.Cut(req, " in "); ok {
+			values = strings.TrimSpace(values)
+			if !strings.HasPrefix(values, "(") || !strings.HasSuffix(values, ")") {
+				return nil, fmt.Errorf("invalid label selector %q", req)
+			}
+			set := make(map[string]struct{})
+			for _, v := range strings.Split(values[1:len(values)-1], ",") {
+				set[strings.TrimSpace(v)] = struct{}{}
+			}
+			key = strings.TrimSpace(key)
+			out = append(out, func(labels map[string]string) bool {
+				v, ok := labels[key]
+				if !ok {
+					return false
+				}
+				_, ok = set[v]
+				return ok
+			})
+			continue
+		}
+		key, value, ok := strings.Cut(req, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid label selector %q", req)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		out = append(out, func(labels map[string]string) bool {
+			return labels[key] == value
+		})
+	}
+	return out, nil
+}
+
+// splitRequirements splits the selector by commas which are not inside parentheses.
+func splitRequirements(s string) []string {
+	var (
+		out   []string
+		depth int
+		start int
+	)
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				out = append(out, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(out, s[start:])
+}
+
+func (s selector) matches(labels map[string]string) bool {
+	for _, m := range s {
+		if !m(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func clone[T any](v *T) *T {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	var out T
+	if err := json.Unmarshal(data, &out); err != nil {
+		panic(err)
+	}
+	return &out
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]any{
+		"error": map[string]any{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
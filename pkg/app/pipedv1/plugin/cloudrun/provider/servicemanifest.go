@@ -0,0 +1,258 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"google.golang.org/api/run/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// ServiceManifest represents the Knative-compatible service manifest of Cloud Run.
+type ServiceManifest struct {
+	Name string
+	svc  *run.Service
+}
+
+type RevisionTraffic struct {
+	RevisionName string `json:"revisionName"`
+	Percent      int    `json:"percent"`
+}
+
+// SetRevision sets the name of the revision which will be created from the template.
+func (m ServiceManifest) SetRevision(name string) error {
+	if m.svc.Spec == nil {
+		m.svc.Spec = &run.ServiceSpec{}
+	}
+	if m.svc.Spec.Template == nil {
+		return fmt.Errorf("spec.template was missing")
+	}
+	if m.svc.Spec.Template.Metadata == nil {
+		m.svc.Spec.Template.Metadata = &run.ObjectMeta{}
+	}
+	m.svc.Spec.Template.Metadata.Name = name
+	return nil
+}
+
+// Revision returns the name of the revision specified in the template.
+func (m ServiceManifest) Revision() string {
+	if m.svc.Spec == nil || m.svc.Spec.Template == nil || m.svc.Spec.Template.Metadata == nil {
+		return ""
+	}
+	return m.svc.Spec.Template.Metadata.Name
+}
+
+// UpdateTraffic replaces the traffic targets of the service.
+func (m ServiceManifest) UpdateTraffic(revisions []RevisionTraffic) error {
+	if m.svc.Spec == nil {
+		m.svc.Spec = &run.ServiceSpec{}
+	}
+
+	traffic := make([]*run.TrafficTarget, 0, len(revisions))
+	for _, r := range revisions {
+		if r.Percent < 0 || r.Percent > 100 {
+			return fmt.Errorf("invalid traffic percentage %d for revision %s", r.Percent, r.RevisionName)
+		}
+		traffic = append(traffic, &run.TrafficTarget{
+			RevisionName: r.RevisionName,
+			Percent:      int64(r.Percent),
+			// Keep zero percentage in the request to make the target explicit.
+			ForceSendFields: []string{"Percent"},
+		})
+	}
+	m.svc.Spec.Traffic = traffic
+	return nil
+}
+
+func (m ServiceManifest) UpdateAllTraffic(revision string) error {
+	return m.UpdateTraffic([]RevisionTraffic{
+		{
+			RevisionName: revision,
+			Percent:      100,
+		},
+	})
+}
+
+func (m ServiceManifest) YamlBytes() ([]byte, error) {
+	data, err := m.svc.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(data)
+}
+
+func (m ServiceManifest) Labels() map[string]string {
+	if m.svc.Metadata == nil {
+		return nil
+	}
+	return m.svc.Metadata.Labels
+}
+
+func (m ServiceManifest) RevisionLabels() map[string]string {
+	if m.svc.Spec == nil || m.svc.Spec.Template == nil || m.svc.Spec.Template.Metadata == nil {
+		return nil
+	}
+	return m.svc.Spec.Template.Metadata.Labels
+}
+
+func (m ServiceManifest) AppID() (string, bool) {
+	v := m.Labels()
+	if v == nil || v[LabelApplication] == "" {
+		return "", false
+	}
+	return v[LabelApplication], true
+}
+
+func (m ServiceManifest) AddLabels(labels map[string]string) {
+	if len(labels) == 0 {
+		return
+	}
+
+	if m.svc.Metadata == nil {
+		m.svc.Metadata = &run.ObjectMeta{}
+	}
+	if m.svc.Metadata.Labels == nil {
+		m.svc.Metadata.Labels = make(map[string]string, len(labels))
+	}
+	for k, v := range labels {
+		m.svc.Metadata.Labels[k] = v
+	}
+}
+
+func (m ServiceManifest) AddRevisionLabels(labels map[string]string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	if m.svc.Spec == nil || m.svc.Spec.Template == nil {
+		return fmt.Errorf("spec.template was missing")
+	}
+	if m.svc.Spec.Template.Metadata == nil {
+		m.svc.Spec.Template.Metadata = &run.ObjectMeta{}
+	}
+	if m.svc.Spec.Template.Metadata.Labels == nil {
+		m.svc.Spec.Template.Metadata.Labels = make(map[string]string, len(labels))
+	}
+	for k, v := range labels {
+		m.svc.Spec.Template.Metadata.Labels[k] = v
+	}
+	return nil
+}
+
+// RunService returns a copy of the manifest as the Cloud Run API object.
+func (m ServiceManifest) RunService() (*run.Service, error) {
+	data, err := m.svc.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var s run.Service
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func loadServiceManifest(path string) (ServiceManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ServiceManifest{}, err
+	}
+	return ParseServiceManifest(data)
+}
+
+// ParseServiceManifest parses the given YAML or JSON data into ServiceManifest.
+func ParseServiceManifest(data []byte) (ServiceManifest, error) {
+	var svc run.Service
+	if err := yaml.Unmarshal(data, &svc); err != nil {
+		return ServiceManifest{}, err
+	}
+
+	var name string
+	if svc.Metadata != nil {
+		name = svc.Metadata.Name
+	}
+	return ServiceManifest{
+		Name: name,
+		svc:  &svc,
+	}, nil
+}
+
+// DecideRevisionName returns the revision name built from the service name, the image tag and the commit hash.
+func DecideRevisionName(sm ServiceManifest, commit string) (string, error) {
+	tag, err := FindImageTag(sm)
+	if err != nil {
+		return "", err
+	}
+	tag = strings.ReplaceAll(tag, ".", "")
+
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	return fmt.Sprintf("%s-%s-%s", sm.Name, tag, commit), nil
+}
+
+func FindImageTag(sm ServiceManifest) (string, error) {
+	image, err := findImage(sm)
+	if err != nil {
+		return "", err
+	}
+	_, tag := parseContainerImage(image)
+	return tag, nil
+}
+
+// FindArtifactVersions returns the container image used by the service as the artifact version.
+func FindArtifactVersions(sm ServiceManifest) ([]sdk.ArtifactVersion, error) {
+	image, err := findImage(sm)
+	if err != nil {
+		return nil, err
+	}
+	name, tag := parseContainerImage(image)
+
+	return []sdk.ArtifactVersion{
+		{
+			Version: tag,
+			Name:    name,
+			URL:     image,
+		},
+	}, nil
+}
+
+func findImage(sm ServiceManifest) (string, error) {
+	if sm.svc.Spec == nil || sm.svc.Spec.Template == nil || sm.svc.Spec.Template.Spec == nil || len(sm.svc.Spec.Template.Spec.Containers) == 0 {
+		return "", fmt.Errorf("spec.template.spec.containers was missing")
+	}
+
+	image := sm.svc.Spec.Template.Spec.Containers[0].Image
+	if image == "" {
+		return "", fmt.Errorf("image was missing")
+	}
+	return image, nil
+}
+
+func parseContainerImage(image string) (name, tag string) {
+	parts := strings.Split(image, ":")
+	if len(parts) == 2 {
+		tag = parts[1]
+	}
+	paths := strings.Split(parts[0], "/")
+	name = paths[len(paths)-1]
+	return
+}
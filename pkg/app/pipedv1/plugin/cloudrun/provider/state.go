@@ -0,0 +1,89 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"google.golang.org/api/run/v1"
+)
+
+const (
+	ResourceTypeService  = "CloudRun:Service"
+	ResourceTypeRevision = "CloudRun:Revision"
+)
+
+// MakeResourceStates converts the given service and its revisions into the resource states.
+func MakeResourceStates(svc *Service, revs []*Revision, deployTarget string) []sdk.ResourceState {
+	states := make([]sdk.ResourceState, 0, len(revs)+1)
+
+	// Set service state.
+	status, desc := svc.StatusConditions().HealthStatus()
+	states = append(states, makeResourceState(svc.Metadata, ResourceTypeService, nil, status, desc, deployTarget))
+
+	// Revisions are actually owned by the configuration of the service,
+	// but we show them directly under the service since the configuration is not exposed.
+	var parentIDs []string
+	if uid, ok := svc.UID(); ok {
+		parentIDs = []string{uid}
+	}
+
+	percents := make(map[string]int64, len(revs))
+	if svc.Status != nil {
+		for _, t := range svc.Status.Traffic {
+			percents[t.RevisionName] += t.Percent
+		}
+	}
+
+	// Set active revision states.
+	for _, r := range revs {
+		status, desc := r.StatusConditions().HealthStatus()
+		state := makeResourceState(r.Metadata, ResourceTypeRevision, parentIDs, status, desc, deployTarget)
+		if r.Metadata != nil {
+			state.ResourceMetadata["traffic"] = strconv.FormatInt(percents[r.Metadata.Name], 10) + "%"
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
+func makeResourceState(meta *run.ObjectMeta, resourceType string, parentIDs []string, status sdk.ResourceHealthStatus, desc, deployTarget string) sdk.ResourceState {
+	if meta == nil {
+		meta = &run.ObjectMeta{}
+	}
+
+	var createdAt time.Time
+	if meta.CreationTimestamp != "" {
+		// Ignore the parse error and leave the zero value since this is only for displaying.
+		createdAt, _ = time.Parse(time.RFC3339, meta.CreationTimestamp)
+	}
+
+	return sdk.ResourceState{
+		ID:           meta.Uid,
+		ParentIDs:    parentIDs,
+		Name:         meta.Name,
+		ResourceType: resourceType,
+		ResourceMetadata: map[string]string{
+			"namespace": meta.Namespace,
+			"commit":    meta.Labels[LabelCommitHash],
+		},
+		HealthStatus:      status,
+		HealthDescription: desc,
+		DeployTarget:      deployTarget,
+		CreatedAt:         createdAt,
+	}
+}
@@ -16,6 +16,7 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/creasty/defaults"
 )
@@ -30,6 +31,9 @@ type KubernetesDeployTargetConfig struct {
 	KubeConfigPath string `json:"kubeConfigPath,omitempty"`
 	// Version of kubectl will be used.
 	KubectlVersion string `json:"kubectlVersion"`
+	// The engine used to operate the resources in the cluster.
+	// Default is kubectl.
+	ApplyEngine KubernetesApplyEngine `json:"applyEngine,omitempty"`
 	// Configuration for application resource informer.
 	AppStateInformer KubernetesAppStateInformer `json:"appStateInformer"`
 }
@@ -47,9 +51,26 @@ func (k *KubernetesDeployTargetConfig) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	switch k.ApplyEngine {
+	case "", KubernetesApplyEngineKubectl, KubernetesApplyEngineClientGo:
+	default:
+		return fmt.Errorf("unsupported applyEngine %q, it must be one of %q or %q", k.ApplyEngine, KubernetesApplyEngineKubectl, KubernetesApplyEngineClientGo)
+	}
+
 	return nil
 }
 
+// KubernetesApplyEngine represents the engine used to operate the resources in the cluster.
+type KubernetesApplyEngine string
+
+const (
+	// KubernetesApplyEngineKubectl runs the kubectl binary for each operation.
+	KubernetesApplyEngineKubectl KubernetesApplyEngine = "kubectl"
+	// KubernetesApplyEngineClientGo operates the resources in-process by using client-go,
+	// and applies manifests by using server-side apply.
+	KubernetesApplyEngineClientGo KubernetesApplyEngine = "client-go"
+)
+
 // KubernetesAppStateInformer represents the configuration for application resource informer.
 type KubernetesAppStateInformer struct {
 	// Only watches the specified namespace.
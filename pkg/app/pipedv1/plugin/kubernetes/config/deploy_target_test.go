@@ -0,0 +1,70 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubernetesDeployTargetConfig_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    string
+		want    KubernetesApplyEngine
+		wantErr bool
+	}{
+		{
+			name: "apply engine is not set",
+			data: `{"kubeConfigPath": "/path/to/kubeconfig"}`,
+			want: "",
+		},
+		{
+			name: "kubectl apply engine",
+			data: `{"applyEngine": "kubectl"}`,
+			want: KubernetesApplyEngineKubectl,
+		},
+		{
+			name: "client-go apply engine",
+			data: `{"applyEngine": "client-go"}`,
+			want: KubernetesApplyEngineClientGo,
+		},
+		{
+			name:    "unsupported apply engine",
+			data:    `{"applyEngine": "helm"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got KubernetesDeployTargetConfig
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.ApplyEngine)
+		})
+	}
+}
@@ -16,6 +16,8 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	"github.com/creasty/defaults"
 )
@@ -25,6 +27,9 @@ type KubernetesPluginConfig struct {
 	ChartRepositories []HelmChartRepository `json:"chartRepositories,omitempty"`
 	// List of helm chart registries that should be logged in while starting up.
 	ChartRegistries []HelmChartRegistry `json:"chartRegistries,omitempty"`
+	// List of the rules to assess the health of the resources such as CRDs.
+	// They take precedence over the built-in health checks.
+	HealthRules []ResourceHealthRule `json:"healthRules,omitempty"`
 }
 
 func (c *KubernetesPluginConfig) UnmarshalJSON(data []byte) error {
@@ -39,6 +44,11 @@ func (c *KubernetesPluginConfig) UnmarshalJSON(data []byte) error {
 	if err := defaults.Set(c); err != nil {
 		return err
 	}
+	for i := range c.HealthRules {
+		if err := c.HealthRules[i].Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -105,3 +115,34 @@ type HelmChartRegistry struct {
 func (r *HelmChartRegistry) IsOCI() bool {
 	return r.Type == OCIHelmChartRegistry
 }
+
+// ResourceHealthRule represents the rule to assess the health of the resources of a specific kind.
+// The expressions are written in CEL and evaluated with the resource object as `self`.
+type ResourceHealthRule struct {
+	// The API group of the resources. Empty means the core group.
+	Group string `json:"group,omitempty"`
+	// The kind of the resources.
+	Kind string `json:"kind"`
+	// The expression which returns true when the resource is healthy.
+	// The resource is unhealthy when it returns false and the unhealthy expression is not given.
+	Healthy string `json:"healthy"`
+	// The expression which returns true when the resource is unhealthy.
+	// It is evaluated before the healthy expression.
+	// When it is given, the resource matching neither expression is reported with the unknown health.
+	Unhealthy string `json:"unhealthy,omitempty"`
+	// The expression which returns the string describing the health of the resource.
+	// It is evaluated after the health is determined, and a default message is used when it fails.
+	Message string `json:"message,omitempty"`
+}
+
+// Validate checks the required fields of the rule.
+// The expressions are validated when they are compiled.
+func (r *ResourceHealthRule) Validate() error {
+	if r.Kind == "" {
+		return errors.New("kind of health rule must be set")
+	}
+	if r.Healthy == "" {
+		return fmt.Errorf("healthy expression of health rule for %s must be set", r.Kind)
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 
 	"github.com/creasty/defaults"
+	"github.com/pipe-cd/piped-plugin-sdk-go/unit"
 )
 
 // K8sPrimaryRolloutStageOptions contains all configurable values for a K8S_PRIMARY_ROLLOUT stage.
@@ -31,6 +32,12 @@ type K8sPrimaryRolloutStageOptions struct {
 	AddVariantLabelToSelector bool `json:"addVariantLabelToSelector"`
 	// Whether the resources that are no longer defined in Git should be removed or not.
 	Prune bool `json:"prune"`
+	// Whether to wait for the applied Deployments, StatefulSets, DaemonSets and Jobs to be ready.
+	// The stage fails when they are not ready within readyTimeout.
+	WaitForReady bool `json:"waitForReady"`
+	// How long to wait for the applied workloads to be ready.
+	// Default is 10m.
+	ReadyTimeout unit.Duration `json:"readyTimeout,omitempty"`
 }
 
 func (o *K8sPrimaryRolloutStageOptions) UnmarshalJSON(data []byte) error {
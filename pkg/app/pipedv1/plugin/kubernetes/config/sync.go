@@ -14,10 +14,20 @@
 
 package config
 
+import (
+	"github.com/pipe-cd/piped-plugin-sdk-go/unit"
+)
+
 // K8sSyncStageOptions contains all configurable values for a K8S_SYNC stage.
 type K8sSyncStageOptions struct {
 	// Whether the PRIMARY variant label should be added to manifests if they were missing.
 	AddVariantLabelToSelector bool `json:"addVariantLabelToSelector"`
 	// Whether the resources that are no longer defined in Git should be removed or not.
 	Prune bool `json:"prune"`
+	// Whether to wait for the applied Deployments, StatefulSets, DaemonSets and Jobs to be ready.
+	// The stage fails when they are not ready within readyTimeout.
+	WaitForReady bool `json:"waitForReady"`
+	// How long to wait for the applied workloads to be ready.
+	// Default is 10m.
+	ReadyTimeout unit.Duration `json:"readyTimeout,omitempty"`
 }
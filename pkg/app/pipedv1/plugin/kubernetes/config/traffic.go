@@ -15,6 +15,7 @@
 package config
 
 import (
+	"cmp"
 	"fmt"
 
 	"github.com/pipe-cd/piped-plugin-sdk-go/unit"
@@ -27,6 +28,8 @@ const (
 	KubernetesTrafficRoutingMethodPodSelector KubernetesTrafficRoutingMethod = "podselector"
 	// KubernetesTrafficRoutingMethodIstio is the way by updating the VirtualService to update traffic routing.
 	KubernetesTrafficRoutingMethodIstio KubernetesTrafficRoutingMethod = "istio"
+	// KubernetesTrafficRoutingMethodGateway is the way by updating the backendRefs of Gateway API HTTPRoute or GRPCRoute to update traffic routing.
+	KubernetesTrafficRoutingMethodGateway KubernetesTrafficRoutingMethod = "gateway"
 )
 
 // KubernetesTrafficRouting represents the traffic routing configuration for a Kubernetes application.
@@ -36,6 +39,8 @@ type KubernetesTrafficRouting struct {
 	Method KubernetesTrafficRoutingMethod `json:"method"`
 	// The Istio-specific configuration for traffic routing.
 	Istio *IstioTrafficRouting `json:"istio"`
+	// The Gateway API specific configuration for traffic routing.
+	Gateway *GatewayTrafficRouting `json:"gateway"`
 }
 
 // DetermineKubernetesTrafficRoutingMethod determines the routing method should be used based on the TrafficRouting config.
@@ -60,6 +65,37 @@ type IstioTrafficRouting struct {
 	VirtualService K8sResourceReference `json:"virtualService"`
 }
 
+// GatewayTrafficRouting represents the Gateway API specific configuration for traffic routing.
+type GatewayTrafficRouting struct {
+	// The reference to HTTPRoute or GRPCRoute manifest.
+	// Empty means the first HTTPRoute or GRPCRoute resource will be used.
+	Route K8sResourceReference `json:"route"`
+	// List of names of the rules in the route that can be changed to update traffic routing.
+	// Empty means all rules should be updated.
+	EditableRules []string `json:"editableRules"`
+	// The name of the Service receiving the traffic of PRIMARY variant.
+	// Default is the name of the application Service.
+	PrimaryService string `json:"primaryService"`
+	// The name of the Service receiving the traffic of CANARY variant.
+	// Default is the name of the application Service suffixed by "-canary".
+	CanaryService string `json:"canaryService"`
+	// The name of the Service receiving the traffic of BASELINE variant.
+	// Default is the name of the application Service suffixed by "-baseline".
+	BaselineService string `json:"baselineService"`
+}
+
+// ServiceNames returns the names of the Services receiving the traffic of PRIMARY, CANARY and BASELINE variants.
+// The given service name is used to build the default ones.
+func (g *GatewayTrafficRouting) ServiceNames(service string) (primary, canary, baseline string) {
+	if g == nil {
+		g = &GatewayTrafficRouting{}
+	}
+	primary = cmp.Or(g.PrimaryService, service)
+	canary = cmp.Or(g.CanaryService, service+"-canary")
+	baseline = cmp.Or(g.BaselineService, service+"-baseline")
+	return
+}
+
 // K8sTrafficRoutingStageOptions contains all configurable values for a K8S_TRAFFIC_ROUTING stage.
 type K8sTrafficRoutingStageOptions struct {
 	// Which variant should receive all traffic.
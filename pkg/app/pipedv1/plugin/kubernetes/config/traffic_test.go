@@ -56,6 +56,13 @@ func TestDetermineKubernetesTrafficRoutingMethod(t *testing.T) {
 			},
 			want: KubernetesTrafficRoutingMethodIstio,
 		},
+		{
+			name: "gateway method should be returned when specified",
+			cfg: &KubernetesTrafficRouting{
+				Method: KubernetesTrafficRoutingMethodGateway,
+			},
+			want: KubernetesTrafficRoutingMethodGateway,
+		},
 	}
 
 	for _, tt := range tests {
@@ -245,3 +252,54 @@ func TestK8sTrafficRoutingStageOptions_DisplayString(t *testing.T) {
 		})
 	}
 }
+
+func TestGatewayTrafficRouting_ServiceNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		cfg          *GatewayTrafficRouting
+		wantPrimary  string
+		wantCanary   string
+		wantBaseline string
+	}{
+		{
+			name:         "nil config should return the default names",
+			cfg:          nil,
+			wantPrimary:  "svc",
+			wantCanary:   "svc-canary",
+			wantBaseline: "svc-baseline",
+		},
+		{
+			name: "configured names should be returned",
+			cfg: &GatewayTrafficRouting{
+				PrimaryService:  "svc-primary",
+				CanaryService:   "svc-next",
+				BaselineService: "svc-base",
+			},
+			wantPrimary:  "svc-primary",
+			wantCanary:   "svc-next",
+			wantBaseline: "svc-base",
+		},
+		{
+			name: "only unset names should be defaulted",
+			cfg: &GatewayTrafficRouting{
+				CanaryService: "svc-next",
+			},
+			wantPrimary:  "svc",
+			wantCanary:   "svc-next",
+			wantBaseline: "svc-baseline",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			primary, canary, baseline := tt.cfg.ServiceNames("svc")
+			assert.Equal(t, tt.wantPrimary, primary)
+			assert.Equal(t, tt.wantCanary, canary)
+			assert.Equal(t, tt.wantBaseline, baseline)
+		})
+	}
+}
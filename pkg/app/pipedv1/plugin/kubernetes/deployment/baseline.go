@@ -15,7 +15,6 @@
 package deployment
 
 import (
-	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -80,18 +79,15 @@ func (p *Plugin) executeK8sBaselineRolloutStage(ctx context.Context, input *sdk.
 
 	addVariantLabelsAndAnnotations(baselineManifests, variantLabel, baselineVariant)
 
-	// Get the kubectl tool path.
-	kubectlPath, err := toolRegistry.Kubectl(ctx, cmp.Or(appCfg.Input.KubectlVersion, deployTargetConfig.KubectlVersion))
+	// Create the client for the target cluster.
+	client, err := p.kubernetesClient(ctx, toolRegistry, appCfg.Input.KubectlVersion, deployTargetConfig)
 	if err != nil {
-		lp.Errorf("Failed while getting kubectl tool (%v)", err)
+		lp.Errorf("Failed while creating the Kubernetes client (%v)", err)
 		return sdk.StageStatusFailure
 	}
 
-	// Create the kubectl wrapper for the target cluster.
-	kubectl := provider.NewKubectl(kubectlPath)
-
 	// Create the applier for the target cluster.
-	applier := provider.NewApplier(kubectl, appCfg.Input, deployTargetConfig, input.Logger)
+	applier := provider.NewApplier(client, appCfg.Input, deployTargetConfig, input.Logger)
 
 	lp.Infof("Start rolling out BASELINE variant...")
 	if err := applyManifests(ctx, applier, baselineManifests, appCfg.Input.Namespace, lp); err != nil {
@@ -127,20 +123,17 @@ func (p *Plugin) executeK8sBaselineCleanStage(ctx context.Context, input *sdk.Ex
 
 	toolRegistry := toolregistry.NewRegistry(input.Client.ToolRegistry())
 
-	// Get the kubectl tool path.
-	kubectlPath, err := toolRegistry.Kubectl(ctx, cmp.Or(appCfg.Input.KubectlVersion, deployTargetConfig.KubectlVersion))
+	// Create the client for the target cluster.
+	client, err := p.kubernetesClient(ctx, toolRegistry, appCfg.Input.KubectlVersion, deployTargetConfig)
 	if err != nil {
-		lp.Errorf("Failed while getting kubectl tool (%v)", err)
+		lp.Errorf("Failed while creating the Kubernetes client (%v)", err)
 		return sdk.StageStatusFailure
 	}
 
-	// Create the kubectl wrapper for the target cluster.
-	kubectl := provider.NewKubectl(kubectlPath)
-
 	// Create the applier for the target cluster.
-	applier := provider.NewApplier(kubectl, appCfg.Input, deployTargetConfig, input.Logger)
+	applier := provider.NewApplier(client, appCfg.Input, deployTargetConfig, input.Logger)
 
-	if err := deleteVariantResources(ctx, lp, kubectl, deployTargetConfig.KubeConfigPath, applier, input.Request.Deployment.ApplicationID, variantLabel, baselineVariant); err != nil {
+	if err := deleteVariantResources(ctx, lp, client, deployTargetConfig.KubeConfigPath, applier, input.Request.Deployment.ApplicationID, variantLabel, baselineVariant); err != nil {
 		lp.Errorf("Failed while deleting variant resources (%v)", err)
 		return sdk.StageStatusFailure
 	}
@@ -15,7 +15,6 @@
 package deployment
 
 import (
-	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -94,18 +93,15 @@ func (p *Plugin) executeK8sCanaryRolloutStage(ctx context.Context, input *sdk.Ex
 
 	addVariantLabelsAndAnnotations(canaryManifests, variantLabel, canaryVariant)
 
-	// Get the kubectl tool path.
-	kubectlPath, err := toolRegistry.Kubectl(ctx, cmp.Or(cfg.Spec.Input.KubectlVersion, deployTargetConfig.KubectlVersion))
+	// Create the client for the target cluster.
+	client, err := p.kubernetesClient(ctx, toolRegistry, cfg.Spec.Input.KubectlVersion, deployTargetConfig)
 	if err != nil {
-		lp.Errorf("Failed while getting kubectl tool (%v)", err)
+		lp.Errorf("Failed while creating the Kubernetes client (%v)", err)
 		return sdk.StageStatusFailure
 	}
 
-	// Create the kubectl wrapper for the target cluster.
-	kubectl := provider.NewKubectl(kubectlPath)
-
 	// Create the applier for the target cluster.
-	applier := provider.NewApplier(kubectl, cfg.Spec.Input, deployTargetConfig, input.Logger)
+	applier := provider.NewApplier(client, cfg.Spec.Input, deployTargetConfig, input.Logger)
 
 	// Start rolling out the resources for CANARY variant.
 	lp.Info("Start rolling out CANARY variant...")
@@ -204,20 +200,17 @@ func (p *Plugin) executeK8sCanaryCleanStage(ctx context.Context, input *sdk.Exec
 
 	toolRegistry := toolregistry.NewRegistry(input.Client.ToolRegistry())
 
-	// Get the kubectl tool path.
-	kubectlPath, err := toolRegistry.Kubectl(ctx, cmp.Or(appCfg.Input.KubectlVersion, deployTargetConfig.KubectlVersion))
+	// Create the client for the target cluster.
+	client, err := p.kubernetesClient(ctx, toolRegistry, appCfg.Input.KubectlVersion, deployTargetConfig)
 	if err != nil {
-		lp.Errorf("Failed while getting kubectl tool (%v)", err)
+		lp.Errorf("Failed while creating the Kubernetes client (%v)", err)
 		return sdk.StageStatusFailure
 	}
 
-	// Create the kubectl wrapper for the target cluster.
-	kubectl := provider.NewKubectl(kubectlPath)
-
 	// Create the applier for the target cluster.
-	applier := provider.NewApplier(kubectl, appCfg.Input, deployTargetConfig, input.Logger)
+	applier := provider.NewApplier(client, appCfg.Input, deployTargetConfig, input.Logger)
 
-	if err := deleteVariantResources(ctx, lp, kubectl, deployTargetConfig.KubeConfigPath, applier, input.Request.Deployment.ApplicationID, variantLabel, canaryVariant); err != nil {
+	if err := deleteVariantResources(ctx, lp, client, deployTargetConfig.KubeConfigPath, applier, input.Request.Deployment.ApplicationID, variantLabel, canaryVariant); err != nil {
 		lp.Errorf("Unable to remove canary resources: (%v)", err)
 		return sdk.StageStatusFailure
 	}
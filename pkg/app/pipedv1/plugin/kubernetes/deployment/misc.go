@@ -15,6 +15,7 @@
 package deployment
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -25,7 +26,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/toolregistry"
 )
 
 func ensureVariantSelectorInWorkload(m provider.Manifest, variantLabel, variant string) error {
@@ -271,11 +274,37 @@ func deleteResources(ctx context.Context, lp sdk.StageLogPersister, applier *pro
 	return deletedCount
 }
 
+// kubernetesClient returns the client to operate the resources in the given deploy target.
+// kubectl is installed only when the deploy target uses it as the apply engine.
+// The client-go based client is cached per master URL, and it caches its clients per kubeconfig by itself.
+func (p *Plugin) kubernetesClient(ctx context.Context, toolRegistry *toolregistry.Registry, kubectlVersion string, deployTarget kubeconfig.KubernetesDeployTargetConfig) (provider.Client, error) {
+	if deployTarget.ApplyEngine == kubeconfig.KubernetesApplyEngineClientGo {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if c, ok := p.clientGos[deployTarget.MasterURL]; ok {
+			return c, nil
+		}
+		if p.clientGos == nil {
+			p.clientGos = make(map[string]*provider.ClientGo)
+		}
+		c := provider.NewClientGo(deployTarget.MasterURL)
+		p.clientGos[deployTarget.MasterURL] = c
+		return c, nil
+	}
+
+	kubectlPath, err := toolRegistry.Kubectl(ctx, cmp.Or(kubectlVersion, deployTarget.KubectlVersion))
+	if err != nil {
+		return nil, fmt.Errorf("failed while getting kubectl tool: %w", err)
+	}
+	return provider.NewKubectl(kubectlPath), nil
+}
+
 // deleteVariantResources deletes the resources of the specified variant.
 // It finds the resources of the specified variant and deletes them.
 // It deletes the resources in the order of Service -> Workload -> Others -> Cluster-scoped resources.
-func deleteVariantResources(ctx context.Context, lp sdk.StageLogPersister, kubectl *provider.Kubectl, kubeConfig string, applier *provider.Applier, applicationID, variantLabel, variant string) error {
-	namespacedLiveResources, clusterScopedLiveResources, err := provider.GetLiveResources(ctx, kubectl, kubeConfig, applicationID, fmt.Sprintf("%s=%s", variantLabel, variant))
+func deleteVariantResources(ctx context.Context, lp sdk.StageLogPersister, client provider.Client, kubeConfig string, applier *provider.Applier, applicationID, variantLabel, variant string) error {
+	namespacedLiveResources, clusterScopedLiveResources, err := provider.GetLiveResources(ctx, client, kubeConfig, applicationID, fmt.Sprintf("%s=%s", variantLabel, variant))
 	if err != nil {
 		return err
 	}
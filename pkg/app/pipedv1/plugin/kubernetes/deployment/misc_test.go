@@ -15,12 +15,14 @@
 package deployment
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 
+	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
 )
 
@@ -459,3 +461,25 @@ metadata:
 	assert.Equal(t, "bar", origCfg.Labels["foo"], "original label should remain unchanged")
 	assert.Equal(t, "changed", copiedCfg.Labels["foo"], "copied label should be updated")
 }
+
+func TestPluginKubernetesClient(t *testing.T) {
+	t.Parallel()
+
+	plugin := &Plugin{}
+	ctx := context.Background()
+	newClient := func(masterURL, kubeConfigPath string) provider.Client {
+		c, err := plugin.kubernetesClient(ctx, nil, "", kubeconfig.KubernetesDeployTargetConfig{
+			ApplyEngine:    kubeconfig.KubernetesApplyEngineClientGo,
+			MasterURL:      masterURL,
+			KubeConfigPath: kubeConfigPath,
+		})
+		require.NoError(t, err)
+		return c
+	}
+
+	// The client-go based client is shared by the stages running on the same cluster.
+	c := newClient("https://cluster-1", "")
+	assert.Same(t, c, newClient("https://cluster-1", ""))
+	assert.Same(t, c, newClient("https://cluster-1", "/path/to/kubeconfig"))
+	assert.NotSame(t, c, newClient("https://cluster-2", ""))
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"go.uber.org/zap"
 
@@ -30,6 +31,10 @@ import (
 
 // Plugin implements the sdk.DeploymentPlugin interface.
 type Plugin struct {
+	mu sync.Mutex
+	// The client-go based clients keyed by the master URL of the deploy target.
+	// They are shared across stages to reuse their discovery cache and REST mapper.
+	clientGos map[string]*provider.ClientGo
 }
 
 type loader interface {
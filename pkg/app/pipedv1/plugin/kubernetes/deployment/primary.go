@@ -98,6 +98,29 @@ func (p *Plugin) executeK8sPrimaryRolloutStage(ctx context.Context, input *sdk.E
 				primaryManifests = append(primaryManifests, m)
 			}
 		}
+	case kubeconfig.KubernetesTrafficRoutingMethodGateway:
+		// In case of routing by Gateway API,
+		// HTTPRoute or GRPCRoute manifest will be used to manipulate the traffic ratio.
+		// Other manifests can be used as primary manifests.
+		gatewayCfg := appCfg.TrafficRouting.Gateway
+		if gatewayCfg == nil {
+			gatewayCfg = &kubeconfig.GatewayTrafficRouting{}
+		}
+		trafficRoutingManifests, err := findGatewayRouteManifests(manifests, gatewayCfg.Route)
+		if err != nil {
+			lp.Errorf("Failed while finding traffic routing manifest: (%v)", err)
+			return sdk.StageStatusFailure
+		}
+		primaryManifests = manifests
+		if len(trafficRoutingManifests) > 0 {
+			primaryManifests = make([]provider.Manifest, 0, len(manifests)-1)
+			for _, m := range manifests {
+				if m.Key() == trafficRoutingManifests[0].Key() {
+					continue
+				}
+				primaryManifests = append(primaryManifests, m)
+			}
+		}
 	default:
 		lp.Errorf("Traffic routing method %v is not supported", routingMethod)
 		return sdk.StageStatusFailure
@@ -135,18 +158,15 @@ func (p *Plugin) executeK8sPrimaryRolloutStage(ctx context.Context, input *sdk.E
 		return sdk.StageStatusFailure
 	}
 
-	// Get the kubectl tool path.
-	kubectlPath, err := toolRegistry.Kubectl(ctx, cmp.Or(cfg.Spec.Input.KubectlVersion, deployTargetConfig.KubectlVersion))
+	// Create the client for the target cluster.
+	client, err := p.kubernetesClient(ctx, toolRegistry, cfg.Spec.Input.KubectlVersion, deployTargetConfig)
 	if err != nil {
-		lp.Errorf("Failed while getting kubectl tool (%v)", err)
+		lp.Errorf("Failed while creating the Kubernetes client (%v)", err)
 		return sdk.StageStatusFailure
 	}
 
-	// Create the kubectl wrapper for the target cluster.
-	kubectl := provider.NewKubectl(kubectlPath)
-
 	// Create the applier for the target cluster.
-	applier := provider.NewApplier(kubectl, cfg.Spec.Input, deployTargetConfig, input.Logger)
+	applier := provider.NewApplier(client, cfg.Spec.Input, deployTargetConfig, input.Logger)
 
 	// Start applying all manifests to add or update running resources.
 	if err := applyManifests(ctx, applier, primaryManifests, cfg.Spec.Input.Namespace, lp); err != nil {
@@ -154,6 +174,14 @@ func (p *Plugin) executeK8sPrimaryRolloutStage(ctx context.Context, input *sdk.E
 		return sdk.StageStatusFailure
 	}
 
+	if stageCfg.WaitForReady {
+		timeout := cmp.Or(stageCfg.ReadyTimeout.Duration(), defaultReadyTimeout)
+		if err := waitForReady(ctx, applier, primaryManifests, timeout, readyCheckInterval, lp); err != nil {
+			lp.Errorf("Failed while waiting for the applied workloads to be ready (%v)", err)
+			return sdk.StageStatusFailure
+		}
+	}
+
 	if !stageCfg.Prune {
 		lp.Info("Resource GC was skipped because sync.prune was not configured")
 		return sdk.StageStatusSuccess
@@ -172,7 +200,7 @@ func (p *Plugin) executeK8sPrimaryRolloutStage(ctx context.Context, input *sdk.E
 
 	// Find the running resources that are not defined in Git.
 	lp.Info("Start finding all running PRIMARY resources but no longer defined in Git")
-	namespacedLiveResources, clusterScopedLiveResources, err := provider.GetLiveResources(ctx, kubectl, deployTargetConfig.KubeConfigPath, input.Request.Deployment.ApplicationID, fmt.Sprintf("%s=%s", variantLabel, primaryVariant))
+	namespacedLiveResources, clusterScopedLiveResources, err := provider.GetLiveResources(ctx, client, deployTargetConfig.KubeConfigPath, input.Request.Deployment.ApplicationID, fmt.Sprintf("%s=%s", variantLabel, primaryVariant))
 	if err != nil {
 		lp.Errorf("Failed while getting live resources (%v)", err)
 		return sdk.StageStatusFailure
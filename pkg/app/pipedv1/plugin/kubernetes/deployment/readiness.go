@@ -0,0 +1,99 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
+)
+
+const (
+	defaultReadyTimeout = 10 * time.Minute
+	readyCheckInterval  = 5 * time.Second
+)
+
+type manifestGetter interface {
+	// GetManifest returns the live manifest of the given resource.
+	GetManifest(ctx context.Context, k provider.ResourceKey) (provider.Manifest, error)
+}
+
+// waitForReady waits until all of the Deployments, StatefulSets, DaemonSets and Jobs
+// in the given manifests are ready by checking their live manifests periodically.
+// It returns an error when they are not ready within the given timeout,
+// or as soon as any of them has failed such as a failed Job.
+func waitForReady(ctx context.Context, getter manifestGetter, manifests []provider.Manifest, timeout, interval time.Duration, lp sdk.StageLogPersister) error {
+	waitings := make([]provider.ResourceKey, 0, len(manifests))
+	for _, m := range manifests {
+		if m.IsDeployment() || m.IsStatefulSet() || m.IsDaemonSet() || m.IsJob() {
+			waitings = append(waitings, m.Key())
+		}
+	}
+	if len(waitings) == 0 {
+		lp.Info("There are no workloads to wait for being ready")
+		return nil
+	}
+	lp.Infof("Waiting for %d workloads to be ready (timeout: %v)", len(waitings), timeout)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	reasons := make(map[provider.ResourceKey]string, len(waitings))
+	for {
+		remains := waitings[:0]
+		for _, k := range waitings {
+			m, err := getter.GetManifest(ctx, k)
+			if err != nil {
+				reasons[k] = fmt.Sprintf("failed to get the live manifest (%v)", err)
+				remains = append(remains, k)
+				continue
+			}
+			if failed, reason := m.IsFailed(); failed {
+				lp.Errorf("- %s failed: %s", k.ReadableString(), reason)
+				return fmt.Errorf("%s failed: %s", k.ReadableString(), reason)
+			}
+			if ready, reason := m.IsReady(); !ready {
+				if reasons[k] != reason {
+					lp.Infof("- %s is not ready yet: %s", k.ReadableString(), reason)
+				}
+				reasons[k] = reason
+				remains = append(remains, k)
+				continue
+			}
+			lp.Successf("- %s is ready", k.ReadableString())
+		}
+		waitings = remains
+		if len(waitings) == 0 {
+			lp.Success("All workloads are ready")
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			for _, k := range waitings {
+				lp.Errorf("- %s was not ready: %s", k.ReadableString(), reasons[k])
+			}
+			return fmt.Errorf("%d workloads were not ready within %v", len(waitings), timeout)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
+)
+
+type fakeManifestGetter struct {
+	// Map from resource name to the live manifests returned in order.
+	lives map[string][]provider.Manifest
+	calls map[string]int
+}
+
+func (f *fakeManifestGetter) GetManifest(_ context.Context, k provider.ResourceKey) (provider.Manifest, error) {
+	lives := f.lives[k.Name()]
+	i := min(f.calls[k.Name()], len(lives)-1)
+	f.calls[k.Name()]++
+	return lives[i], nil
+}
+
+func TestWaitForReady(t *testing.T) {
+	t.Parallel()
+
+	const (
+		desired = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  generation: 2
+spec:
+  replicas: 2
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+`
+		rollingDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  replicas: 2
+  updatedReplicas: 1
+  availableReplicas: 1
+`
+		availableDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  replicas: 2
+  updatedReplicas: 2
+  availableReplicas: 2
+`
+		completedJob = `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  succeeded: 1
+  conditions:
+  - type: Complete
+    status: "True"
+`
+		deadlineExceededDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  generation: 2
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  replicas: 2
+  updatedReplicas: 1
+  availableReplicas: 1
+  conditions:
+  - type: Progressing
+    status: "False"
+    reason: ProgressDeadlineExceeded
+`
+		failedJob = `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  failed: 1
+  conditions:
+  - type: Failed
+    status: "True"
+    reason: BackoffLimitExceeded
+    message: Job has reached the specified backoff limit
+`
+	)
+
+	testcases := []struct {
+		name      string
+		lives     map[string][]string
+		wantErr   bool
+		wantCalls map[string]int
+	}{
+		{
+			name: "ready after rollout",
+			lives: map[string][]string{
+				"simple":  {rollingDeployment, rollingDeployment, availableDeployment},
+				"migrate": {completedJob},
+			},
+			wantCalls: map[string]int{"simple": 3, "migrate": 1},
+		},
+		{
+			name: "not ready within timeout",
+			lives: map[string][]string{
+				"simple":  {rollingDeployment},
+				"migrate": {completedJob},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fail fast on failed job",
+			lives: map[string][]string{
+				"simple":  {rollingDeployment},
+				"migrate": {failedJob},
+			},
+			wantErr:   true,
+			wantCalls: map[string]int{"simple": 1, "migrate": 1},
+		},
+		{
+			name: "fail fast on deployment exceeding progress deadline",
+			lives: map[string][]string{
+				"simple":  {rollingDeployment, deadlineExceededDeployment},
+				"migrate": {completedJob},
+			},
+			wantErr:   true,
+			wantCalls: map[string]int{"simple": 2, "migrate": 1},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			getter := &fakeManifestGetter{
+				lives: make(map[string][]provider.Manifest, len(tc.lives)),
+				calls: make(map[string]int),
+			}
+			for name, lives := range tc.lives {
+				for _, l := range lives {
+					getter.lives[name] = append(getter.lives[name], mustParseManifests(t, l)...)
+				}
+			}
+			manifests := mustParseManifests(t, desired)
+
+			err := waitForReady(context.Background(), getter, manifests, 500*time.Millisecond, 10*time.Millisecond, &mockStageLogPersister{})
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantCalls != nil {
+				assert.Equal(t, tc.wantCalls, getter.calls)
+			}
+		})
+	}
+}
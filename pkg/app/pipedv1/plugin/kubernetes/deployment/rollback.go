@@ -15,7 +15,6 @@
 package deployment
 
 import (
-	"cmp"
 	"context"
 
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
@@ -76,6 +75,26 @@ func (p *Plugin) executeK8sRollbackStage(ctx context.Context, input *sdk.Execute
 
 	addVariantLabelsAndAnnotations(manifests, variantLabel, primaryVariant)
 
+	// The route manifest manipulated by K8S_TRAFFIC_ROUTING stages is restored to the one defined in Git
+	// before the other manifests, so that all traffic is routed back to the PRIMARY variant first.
+	var route *provider.Manifest
+	if kubeconfig.DetermineKubernetesTrafficRoutingMethod(cfg.Spec.TrafficRouting) == kubeconfig.KubernetesTrafficRoutingMethodGateway {
+		var ref kubeconfig.K8sResourceReference
+		if cfg.Spec.TrafficRouting.Gateway != nil {
+			ref = cfg.Spec.TrafficRouting.Gateway.Route
+		}
+		routes, err := findGatewayRouteManifests(manifests, ref)
+		if err != nil {
+			lp.Errorf("Failed while finding traffic routing manifest: (%v)", err)
+			return sdk.StageStatusFailure
+		}
+		if len(routes) == 0 {
+			lp.Info("Unable to find any HTTPRoute or GRPCRoute manifest to restore the traffic routing")
+		} else {
+			route = &routes[0]
+		}
+	}
+
 	if err := annotateConfigHash(manifests); err != nil {
 		lp.Errorf("Unable to set %q annotation into the workload manifest (%v)", provider.AnnotationConfigHash, err)
 		return sdk.StageStatusFailure
@@ -88,17 +107,31 @@ func (p *Plugin) executeK8sRollbackStage(ctx context.Context, input *sdk.Execute
 	}
 	deployTargetConfig := dts[0].Config
 
-	// Get the kubectl tool path.
-	kubectlPath, err := toolRegistry.Kubectl(ctx, cmp.Or(cfg.Spec.Input.KubectlVersion, deployTargetConfig.KubectlVersion))
+	// Create the client for the target cluster.
+	client, err := p.kubernetesClient(ctx, toolRegistry, cfg.Spec.Input.KubectlVersion, deployTargetConfig)
 	if err != nil {
-		lp.Errorf("Failed while getting kubectl tool (%v)", err)
+		lp.Errorf("Failed while creating the Kubernetes client (%v)", err)
 		return sdk.StageStatusFailure
 	}
 
-	kubectl := provider.NewKubectl(kubectlPath)
-
 	// Create the applier for the target cluster.
-	applier := provider.NewApplier(kubectl, cfg.Spec.Input, deployTargetConfig, input.Logger)
+	applier := provider.NewApplier(client, cfg.Spec.Input, deployTargetConfig, input.Logger)
+
+	if route != nil {
+		lp.Infof("Restoring the traffic routing of %s to the one defined at commit %s", route.Key().ReadableString(), input.Request.RunningDeploymentSource.CommitHash)
+		if err := applyManifests(ctx, applier, []provider.Manifest{*route}, cfg.Spec.Input.Namespace, lp); err != nil {
+			lp.Errorf("Failed while restoring the traffic routing (%v)", err)
+			return sdk.StageStatusFailure
+		}
+
+		rest := make([]provider.Manifest, 0, len(manifests)-1)
+		for _, m := range manifests {
+			if m.Key() != route.Key() {
+				rest = append(rest, m)
+			}
+		}
+		manifests = rest
+	}
 
 	// Start applying all manifests to add or update running resources.
 	if err := applyManifests(ctx, applier, manifests, cfg.Spec.Input.Namespace, lp); err != nil {
@@ -113,13 +146,13 @@ func (p *Plugin) executeK8sRollbackStage(ctx context.Context, input *sdk.Execute
 	// This feature is not implemented in pipedv0, but it's nice to have it in this plugin.
 
 	lp.Info("Start removing CANARY variant resources if exists")
-	if err := deleteVariantResources(ctx, lp, kubectl, deployTargetConfig.KubeConfigPath, applier, input.Request.Deployment.ApplicationID, variantLabel, canaryVariant); err != nil {
+	if err := deleteVariantResources(ctx, lp, client, deployTargetConfig.KubeConfigPath, applier, input.Request.Deployment.ApplicationID, variantLabel, canaryVariant); err != nil {
 		lp.Errorf("Failed while deleting variant resources (%v)", err)
 		failed = true
 	}
 
 	lp.Info("Start removing BASELINE variant resources if exists")
-	if err := deleteVariantResources(ctx, lp, kubectl, deployTargetConfig.KubeConfigPath, applier, input.Request.Deployment.ApplicationID, variantLabel, baselineVariant); err != nil {
+	if err := deleteVariantResources(ctx, lp, client, deployTargetConfig.KubeConfigPath, applier, input.Request.Deployment.ApplicationID, variantLabel, baselineVariant); err != nil {
 		lp.Errorf("Failed while deleting variant resources (%v)", err)
 		failed = true
 	}
@@ -97,18 +97,15 @@ func (p *Plugin) executeK8sSyncStage(ctx context.Context, input *sdk.ExecuteStag
 	}
 	deployTargetConfig := dts[0].Config
 
-	// Get the kubectl tool path.
-	kubectlPath, err := toolRegistry.Kubectl(ctx, cmp.Or(cfg.Spec.Input.KubectlVersion, deployTargetConfig.KubectlVersion))
+	// Create the client for the target cluster.
+	client, err := p.kubernetesClient(ctx, toolRegistry, cfg.Spec.Input.KubectlVersion, deployTargetConfig)
 	if err != nil {
-		lp.Errorf("Failed while getting kubectl tool (%v)", err)
+		lp.Errorf("Failed while creating the Kubernetes client (%v)", err)
 		return sdk.StageStatusFailure
 	}
 
-	// Create the kubectl wrapper for the target cluster.
-	kubectl := provider.NewKubectl(kubectlPath)
-
 	// Create the applier for the target cluster.
-	applier := provider.NewApplier(kubectl, cfg.Spec.Input, deployTargetConfig, input.Logger)
+	applier := provider.NewApplier(client, cfg.Spec.Input, deployTargetConfig, input.Logger)
 
 	// Start applying all manifests to add or update running resources.
 	// TODO: use applyManifests instead of applyManifestsSDK
@@ -117,6 +114,14 @@ func (p *Plugin) executeK8sSyncStage(ctx context.Context, input *sdk.ExecuteStag
 		return sdk.StageStatusFailure
 	}
 
+	if stageCfg.WaitForReady {
+		timeout := cmp.Or(stageCfg.ReadyTimeout.Duration(), defaultReadyTimeout)
+		if err := waitForReady(ctx, applier, manifests, timeout, readyCheckInterval, lp); err != nil {
+			lp.Errorf("Failed while waiting for the applied workloads to be ready (%v)", err)
+			return sdk.StageStatusFailure
+		}
+	}
+
 	if !stageCfg.Prune {
 		lp.Info("Resource GC was skipped because sync.prune was not configured")
 		return sdk.StageStatusSuccess
@@ -135,7 +140,7 @@ func (p *Plugin) executeK8sSyncStage(ctx context.Context, input *sdk.ExecuteStag
 
 	lp.Info("Start finding all running resources but no longer defined in Git")
 
-	namespacedLiveResources, clusterScopedLiveResources, err := provider.GetLiveResources(ctx, kubectl, deployTargetConfig.KubeConfigPath, input.Request.Deployment.ApplicationID)
+	namespacedLiveResources, clusterScopedLiveResources, err := provider.GetLiveResources(ctx, client, deployTargetConfig.KubeConfigPath, input.Request.Deployment.ApplicationID)
 	if err != nil {
 		lp.Errorf("Failed while getting live resources (%v)", err)
 		return sdk.StageStatusFailure
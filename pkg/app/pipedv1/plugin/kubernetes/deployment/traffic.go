@@ -15,7 +15,6 @@
 package deployment
 
 import (
-	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -23,6 +22,7 @@ import (
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
 	istiov1 "istio.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
 	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
@@ -44,6 +44,8 @@ func (p *Plugin) executeK8sTrafficRoutingStage(ctx context.Context, input *sdk.E
 		return p.executeK8sTrafficRoutingStagePodSelector(ctx, input, dts, cfg)
 	case kubeconfig.KubernetesTrafficRoutingMethodIstio:
 		return p.executeK8sTrafficRoutingStageIstio(ctx, input, dts, cfg)
+	case kubeconfig.KubernetesTrafficRoutingMethodGateway:
+		return p.executeK8sTrafficRoutingStageGateway(ctx, input, dts, cfg)
 	default:
 		lp.Errorf("Unknown traffic routing method: %s", cfg.Spec.TrafficRouting.Method)
 		return sdk.StageStatusFailure
@@ -140,15 +142,12 @@ func (p *Plugin) executeK8sTrafficRoutingStagePodSelector(ctx context.Context, i
 	}
 	deployTargetConfig := dts[0].Config
 
-	kubectlPath, err := toolRegistry.Kubectl(ctx,
-		cmp.Or(cfg.Spec.Input.KubectlVersion, deployTargetConfig.KubectlVersion))
+	client, err := p.kubernetesClient(ctx, toolRegistry, cfg.Spec.Input.KubectlVersion, deployTargetConfig)
 	if err != nil {
-		lp.Errorf("Failed while getting kubectl tool (%v)", err)
+		lp.Errorf("Failed while creating the Kubernetes client (%v)", err)
 		return sdk.StageStatusFailure
 	}
-
-	kubectl := provider.NewKubectl(kubectlPath)
-	applier := provider.NewApplier(kubectl, cfg.Spec.Input, deployTargetConfig, input.Logger)
+	applier := provider.NewApplier(client, cfg.Spec.Input, deployTargetConfig, input.Logger)
 
 	// 12. Apply the updated Service
 	lp.Infof("Updating Service to route traffic to %s variant", targetVariant)
@@ -229,15 +228,12 @@ func (p *Plugin) executeK8sTrafficRoutingStageIstio(ctx context.Context, input *
 	}
 	deployTargetConfig := dts[0].Config
 
-	kubectlPath, err := toolRegistry.Kubectl(ctx,
-		cmp.Or(cfg.Spec.Input.KubectlVersion, deployTargetConfig.KubectlVersion))
+	client, err := p.kubernetesClient(ctx, toolRegistry, cfg.Spec.Input.KubectlVersion, deployTargetConfig)
 	if err != nil {
-		lp.Errorf("Failed while getting kubectl tool (%v)", err)
+		lp.Errorf("Failed while creating the Kubernetes client (%v)", err)
 		return sdk.StageStatusFailure
 	}
-
-	kubectl := provider.NewKubectl(kubectlPath)
-	applier := provider.NewApplier(kubectl, cfg.Spec.Input, deployTargetConfig, input.Logger)
+	applier := provider.NewApplier(client, cfg.Spec.Input, deployTargetConfig, input.Logger)
 
 	lp.Infof("Start updating traffic routing to be percentages: primary=%d, canary=%d, baseline=%d",
 		primaryPercent,
@@ -255,6 +251,73 @@ func (p *Plugin) executeK8sTrafficRoutingStageIstio(ctx context.Context, input *
 	return sdk.StageStatusSuccess
 }
 
+func (p *Plugin) executeK8sTrafficRoutingStageGateway(ctx context.Context, input *sdk.ExecuteStageInput[kubeconfig.KubernetesApplicationSpec], dts []*sdk.DeployTarget[kubeconfig.KubernetesDeployTargetConfig], cfg *sdk.ApplicationConfig[kubeconfig.KubernetesApplicationSpec]) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	var stageCfg kubeconfig.K8sTrafficRoutingStageOptions
+	if len(input.Request.StageConfig) == 0 {
+		lp.Error("Stage config is empty, this should not happen")
+		return sdk.StageStatusFailure
+	}
+	if err := json.Unmarshal(input.Request.StageConfig, &stageCfg); err != nil {
+		lp.Errorf("Failed while unmarshalling stage config (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	toolRegistry := toolregistry.NewRegistry(input.Client.ToolRegistry())
+	loader := provider.NewLoader(toolRegistry)
+
+	lp.Infof("Loading manifests at commit %s", input.Request.TargetDeploymentSource.CommitHash)
+	manifests, err := p.loadManifests(ctx, &input.Request.Deployment, cfg.Spec,
+		&input.Request.TargetDeploymentSource, loader, input.Logger)
+	if err != nil {
+		lp.Errorf("Failed while loading manifests (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	lp.Successf("Successfully loaded %d manifests", len(manifests))
+
+	if len(manifests) == 0 {
+		lp.Error("There are no kubernetes manifests to handle")
+		return sdk.StageStatusFailure
+	}
+
+	primaryPercent, canaryPercent, baselinePercent := stageCfg.Percentages()
+
+	_, route, err := generateGatewayRoute(manifests, cfg.Spec, stageCfg, lp)
+	if err != nil {
+		lp.Errorf("Failed while generating traffic routing manifest: (%v)", err)
+		return sdk.StageStatusFailure
+	}
+
+	if len(dts) == 0 {
+		lp.Error("No deploy target was found")
+		return sdk.StageStatusFailure
+	}
+	deployTargetConfig := dts[0].Config
+
+	client, err := p.kubernetesClient(ctx, toolRegistry, cfg.Spec.Input.KubectlVersion, deployTargetConfig)
+	if err != nil {
+		lp.Errorf("Failed while creating the Kubernetes client (%v)", err)
+		return sdk.StageStatusFailure
+	}
+	applier := provider.NewApplier(client, cfg.Spec.Input, deployTargetConfig, input.Logger)
+
+	lp.Infof("Start updating traffic routing to be percentages: primary=%d, canary=%d, baseline=%d",
+		primaryPercent,
+		canaryPercent,
+		baselinePercent,
+	)
+
+	if err := applyManifests(ctx, applier, []provider.Manifest{route},
+		cfg.Spec.Input.Namespace, lp); err != nil {
+		lp.Errorf("Failed while applying %s manifest (%v)", route.Kind(), err)
+		return sdk.StageStatusFailure
+	}
+
+	lp.Success("Successfully updated traffic routing")
+	return sdk.StageStatusSuccess
+}
+
 func checkVariantSelectorInService(m provider.Manifest, variantLabel, variant string) error {
 	value, ok, err := m.NestedString("spec", "selector", variantLabel)
 	if err != nil {
@@ -410,3 +473,237 @@ func generateVirtualServiceManifest(m provider.Manifest, host string, editableRo
 
 	return vs.toManifest()
 }
+
+const (
+	gatewayNetworkingGroup = "gateway.networking.k8s.io"
+	gatewayHTTPRouteKind   = "HTTPRoute"
+	gatewayGRPCRouteKind   = "GRPCRoute"
+)
+
+func findGatewayRouteManifests(manifests []provider.Manifest, ref kubeconfig.K8sResourceReference) ([]provider.Manifest, error) {
+	if ref.Kind != "" && ref.Kind != gatewayHTTPRouteKind && ref.Kind != gatewayGRPCRouteKind {
+		return nil, fmt.Errorf("support only %q and %q kinds for Gateway API route reference", gatewayHTTPRouteKind, gatewayGRPCRouteKind)
+	}
+
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if m.GroupVersionKind().Group != gatewayNetworkingGroup {
+			continue
+		}
+		if m.Kind() != gatewayHTTPRouteKind && m.Kind() != gatewayGRPCRouteKind {
+			continue
+		}
+		if ref.Kind != "" && m.Kind() != ref.Kind {
+			continue
+		}
+		if ref.Name != "" && m.Name() != ref.Name {
+			continue
+		}
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
+// GenerateGatewayRoute generates the HTTPRoute or GRPCRoute manifest which will be applied
+// by K8S_TRAFFIC_ROUTING stage with the given options when the gateway method is used.
+// It returns the route found in the given manifests together with the generated one.
+func GenerateGatewayRoute(manifests []provider.Manifest, spec *kubeconfig.KubernetesApplicationSpec, opts kubeconfig.K8sTrafficRoutingStageOptions) (original, generated provider.Manifest, err error) {
+	return generateGatewayRoute(manifests, spec, opts, discardLogPersister{})
+}
+
+func generateGatewayRoute(manifests []provider.Manifest, spec *kubeconfig.KubernetesApplicationSpec, opts kubeconfig.K8sTrafficRoutingStageOptions, lp sdk.StageLogPersister) (original, generated provider.Manifest, err error) {
+	gatewayCfg := &kubeconfig.GatewayTrafficRouting{}
+	if spec.TrafficRouting != nil && spec.TrafficRouting.Gateway != nil {
+		gatewayCfg = spec.TrafficRouting.Gateway
+	}
+
+	routes, err := findGatewayRouteManifests(manifests, gatewayCfg.Route)
+	if err != nil {
+		return provider.Manifest{}, provider.Manifest{}, err
+	}
+	if len(routes) == 0 {
+		return provider.Manifest{}, provider.Manifest{}, fmt.Errorf("unable to find any HTTPRoute or GRPCRoute manifest")
+	}
+	if len(routes) > 1 {
+		lp.Infof("Found %d HTTPRoute or GRPCRoute manifests, using the first one", len(routes))
+	}
+
+	services := findManifests(provider.KindService, spec.Service.Name, manifests)
+	if len(services) == 0 {
+		return provider.Manifest{}, provider.Manifest{}, fmt.Errorf("unable to find any Service manifest")
+	}
+	primaryService, canaryService, baselineService := gatewayCfg.ServiceNames(services[0].Name())
+
+	_, canaryPercent, baselinePercent := opts.Percentages()
+	generated, err = generateGatewayRouteManifest(routes[0], gatewayBackends{
+		primary:  primaryService,
+		canary:   canaryService,
+		baseline: baselineService,
+	}, gatewayCfg.EditableRules, int64(canaryPercent), int64(baselinePercent), lp)
+	if err != nil {
+		return provider.Manifest{}, provider.Manifest{}, fmt.Errorf("failed to generate %s manifest: %w", routes[0].Kind(), err)
+	}
+	return routes[0], generated, nil
+}
+
+// discardLogPersister is a StageLogPersister which discards all logs.
+// It is used to generate the manifests outside of the stages, e.g. for plan preview.
+type discardLogPersister struct{}
+
+func (discardLogPersister) Write(log []byte) (int, error)   { return len(log), nil }
+func (discardLogPersister) Info(string)                     {}
+func (discardLogPersister) Infof(string, ...interface{})    {}
+func (discardLogPersister) Success(string)                  {}
+func (discardLogPersister) Successf(string, ...interface{}) {}
+func (discardLogPersister) Error(string)                    {}
+func (discardLogPersister) Errorf(string, ...interface{})   {}
+
+// gatewayBackends holds the names of the Services used as backends of each variant.
+type gatewayBackends struct {
+	primary  string
+	canary   string
+	baseline string
+}
+
+func (b gatewayBackends) has(name string) bool {
+	return name == b.primary || name == b.canary || name == b.baseline
+}
+
+// generateGatewayRouteManifest generates a new HTTPRoute or GRPCRoute manifest
+// whose backendRefs route traffic to the variant Services with the given percentages.
+// The backendRefs pointing to other Services are kept as is, and the rest of 100
+// is shared between the variants.
+// It also supports the editableRules parameter to specify the rules that
+// can be edited by the user.
+func generateGatewayRouteManifest(m provider.Manifest, backends gatewayBackends, editableRules []string, canaryPercent, baselinePercent int64, lp sdk.StageLogPersister) (provider.Manifest, error) {
+	route := m.DeepCopy()
+
+	rules, ok, err := route.NestedSlice("spec", "rules")
+	if err != nil {
+		return provider.Manifest{}, fmt.Errorf("failed to get spec.rules: %w", err)
+	}
+	if !ok {
+		return provider.Manifest{}, fmt.Errorf("missing spec.rules in %s", m.Key().ReadableString())
+	}
+
+	editableMap := make(map[string]struct{}, len(editableRules))
+	for _, r := range editableRules {
+		editableMap[r] = struct{}{}
+	}
+
+	for i, r := range rules {
+		rule, ok := r.(map[string]any)
+		if !ok {
+			return provider.Manifest{}, fmt.Errorf("spec.rules[%d] is not an object", i)
+		}
+		name, _ := rule["name"].(string)
+		if len(editableMap) > 0 {
+			if _, ok := editableMap[name]; !ok {
+				lp.Infof("Skipping rule %q (not in editableRules)", name)
+				continue
+			}
+		}
+
+		refs, _ := rule["backendRefs"].([]any)
+
+		// Calculate the weight of the other backends
+		// and pick the backendRef used as the template of the variant ones.
+		var (
+			template         map[string]any
+			otherWeight      int64
+			otherBackendRefs = make([]any, 0, len(refs))
+		)
+		for j, rr := range refs {
+			ref, ok := rr.(map[string]any)
+			if !ok {
+				return provider.Manifest{}, fmt.Errorf("spec.rules[%d].backendRefs[%d] is not an object", i, j)
+			}
+			refName, _ := ref["name"].(string)
+			if isServiceBackendRef(ref) && backends.has(refName) {
+				if template == nil {
+					template = ref
+				}
+				continue
+			}
+			weight, err := backendRefWeight(ref)
+			if err != nil {
+				return provider.Manifest{}, fmt.Errorf("invalid weight at spec.rules[%d].backendRefs[%d]: %w", i, j, err)
+			}
+			otherWeight += weight
+			otherBackendRefs = append(otherBackendRefs, ref)
+		}
+		if template == nil {
+			lp.Infof("Skipping rule %q (no backendRef to the application Services)", name)
+			continue
+		}
+		if otherWeight > 100 {
+			return provider.Manifest{}, fmt.Errorf("the total weight of the other backendRefs in spec.rules[%d] must not exceed 100 but got %d", i, otherWeight)
+		}
+
+		// Calculate the weight of the variants
+		var (
+			variantsWeight = 100 - otherWeight
+			canaryWeight   = canaryPercent * variantsWeight / 100
+			baselineWeight = baselinePercent * variantsWeight / 100
+			primaryWeight  = variantsWeight - canaryWeight - baselineWeight
+			newRefs        = make([]any, 0, len(otherBackendRefs)+3)
+		)
+
+		// Add the primary backendRef
+		newRefs = append(newRefs, makeVariantBackendRef(template, backends.primary, primaryWeight))
+
+		// Add the canary backendRef
+		if canaryWeight > 0 {
+			newRefs = append(newRefs, makeVariantBackendRef(template, backends.canary, canaryWeight))
+		}
+
+		// Add the baseline backendRef
+		if baselineWeight > 0 {
+			newRefs = append(newRefs, makeVariantBackendRef(template, backends.baseline, baselineWeight))
+		}
+
+		rule["backendRefs"] = append(newRefs, otherBackendRefs...)
+		lp.Infof("Updated rule %q: primary=%d%%, canary=%d%%, baseline=%d%%", name, primaryWeight, canaryWeight, baselineWeight)
+	}
+
+	if err := route.SetNestedSlice(rules, "spec", "rules"); err != nil {
+		return provider.Manifest{}, fmt.Errorf("failed to set spec.rules: %w", err)
+	}
+	return route, nil
+}
+
+// isServiceBackendRef returns true if the given backendRef refers to a Service.
+// Both group and kind are optional and default to the core group and Service.
+func isServiceBackendRef(ref map[string]any) bool {
+	group, _ := ref["group"].(string)
+	kind, _ := ref["kind"].(string)
+	return group == "" && (kind == "" || kind == provider.KindService)
+}
+
+// backendRefWeight returns the weight of the given backendRef.
+// The weight defaults to 1 when it is not specified.
+func backendRefWeight(ref map[string]any) (int64, error) {
+	v, ok := ref["weight"]
+	if !ok {
+		return 1, nil
+	}
+	switch w := v.(type) {
+	case int64:
+		return w, nil
+	case float64:
+		return int64(w), nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+func makeVariantBackendRef(template map[string]any, name string, weight int64) map[string]any {
+	ref := make(map[string]any, len(template))
+	for k, v := range template {
+		ref[k] = runtime.DeepCopyJSONValue(v)
+	}
+	ref["name"] = name
+	ref["weight"] = weight
+	return ref
+}
@@ -1842,3 +1842,392 @@ func verifyVirtualServiceEditableRoutes(t *testing.T, dynamicClient dynamic.Inte
 	webDestination := webRoutes[0].(map[string]interface{})["destination"].(map[string]interface{})
 	assert.Equal(t, "primary", webDestination["subset"], "web-route should still point to primary only")
 }
+
+func Test_findGatewayRouteManifests(t *testing.T) {
+	t.Parallel()
+
+	const manifestsYAML = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: http-route
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: GRPCRoute
+metadata:
+  name: grpc-route
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gateway
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: http-route
+`
+
+	tests := []struct {
+		name      string
+		ref       kubeconfig.K8sResourceReference
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name:      "finds all routes when ref is empty",
+			ref:       kubeconfig.K8sResourceReference{},
+			wantNames: []string{"http-route", "grpc-route"},
+		},
+		{
+			name:      "finds route by name",
+			ref:       kubeconfig.K8sResourceReference{Name: "grpc-route"},
+			wantNames: []string{"grpc-route"},
+		},
+		{
+			name:      "finds route by kind",
+			ref:       kubeconfig.K8sResourceReference{Kind: "HTTPRoute"},
+			wantNames: []string{"http-route"},
+		},
+		{
+			name:      "returns nothing when kind and name do not match",
+			ref:       kubeconfig.K8sResourceReference{Kind: "HTTPRoute", Name: "grpc-route"},
+			wantNames: []string{},
+		},
+		{
+			name:    "unsupported kind",
+			ref:     kubeconfig.K8sResourceReference{Kind: "Gateway"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := findGatewayRouteManifests(mustParseManifests(t, manifestsYAML), tt.ref)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			gotNames := make([]string, 0, len(got))
+			for _, m := range got {
+				gotNames = append(gotNames, m.Name())
+			}
+			assert.Equal(t, tt.wantNames, gotNames)
+		})
+	}
+}
+
+func Test_generateGatewayRouteManifest(t *testing.T) {
+	t.Parallel()
+
+	backends := gatewayBackends{
+		primary:  "test-service",
+		canary:   "test-service-canary",
+		baseline: "test-service-baseline",
+	}
+
+	tests := []struct {
+		name            string
+		inputYAML       string
+		editableRules   []string
+		canaryPercent   int64
+		baselinePercent int64
+		wantYAML        string
+		wantErr         bool
+	}{
+		{
+			name: "route traffic to canary",
+			inputYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /
+    backendRefs:
+    - name: test-service
+      port: 80
+`,
+			canaryPercent: 30,
+			wantYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /
+    backendRefs:
+    - name: test-service
+      port: 80
+      weight: 70
+    - name: test-service-canary
+      port: 80
+      weight: 30
+`,
+		},
+		{
+			name: "route traffic to canary and baseline from the previous state",
+			inputYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: GRPCRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - backendRefs:
+    - name: test-service
+      port: 9090
+      weight: 90
+    - name: test-service-canary
+      port: 9090
+      weight: 10
+`,
+			canaryPercent:   20,
+			baselinePercent: 20,
+			wantYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: GRPCRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - backendRefs:
+    - name: test-service
+      port: 9090
+      weight: 60
+    - name: test-service-canary
+      port: 9090
+      weight: 20
+    - name: test-service-baseline
+      port: 9090
+      weight: 20
+`,
+		},
+		{
+			name: "route all traffic back to primary",
+			inputYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - backendRefs:
+    - name: test-service-canary
+      port: 80
+      weight: 50
+    - name: test-service
+      port: 80
+      weight: 50
+`,
+			wantYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - backendRefs:
+    - name: test-service
+      port: 80
+      weight: 100
+`,
+		},
+		{
+			name: "keep the weight of other backends",
+			inputYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - backendRefs:
+    - name: test-service
+      port: 80
+      weight: 80
+    - name: other-service
+      port: 8080
+      weight: 20
+`,
+			canaryPercent: 50,
+			wantYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - backendRefs:
+    - name: test-service
+      port: 80
+      weight: 40
+    - name: test-service-canary
+      port: 80
+      weight: 40
+    - name: other-service
+      port: 8080
+      weight: 20
+`,
+		},
+		{
+			name: "only editable rules are updated",
+			inputYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - name: editable
+    backendRefs:
+    - name: test-service
+      port: 80
+  - name: fixed
+    backendRefs:
+    - name: test-service
+      port: 80
+  - name: other
+    backendRefs:
+    - name: other-service
+      port: 80
+`,
+			editableRules: []string{"editable", "other"},
+			canaryPercent: 10,
+			wantYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - name: editable
+    backendRefs:
+    - name: test-service
+      port: 80
+      weight: 90
+    - name: test-service-canary
+      port: 80
+      weight: 10
+  - name: fixed
+    backendRefs:
+    - name: test-service
+      port: 80
+  - name: other
+    backendRefs:
+    - name: other-service
+      port: 80
+`,
+		},
+		{
+			name: "backends of other kinds are kept",
+			inputYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - backendRefs:
+    - group: example.com
+      kind: Backend
+      name: test-service-canary
+      weight: 0
+    - name: test-service
+      port: 80
+`,
+			canaryPercent: 100,
+			wantYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - backendRefs:
+    - name: test-service
+      port: 80
+      weight: 0
+    - name: test-service-canary
+      port: 80
+      weight: 100
+    - group: example.com
+      kind: Backend
+      name: test-service-canary
+      weight: 0
+`,
+		},
+		{
+			name: "total weight of other backends exceeds 100",
+			inputYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  rules:
+  - backendRefs:
+    - name: test-service
+      port: 80
+    - name: other-service
+      port: 80
+      weight: 200
+`,
+			canaryPercent: 10,
+			wantErr:       true,
+		},
+		{
+			name: "missing rules",
+			inputYAML: `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: test-route
+spec:
+  parentRefs:
+  - name: gateway
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := mustParseManifests(t, tt.inputYAML)
+			require.Len(t, input, 1)
+			original := input[0].DeepCopy()
+
+			got, err := generateGatewayRouteManifest(input[0], backends, tt.editableRules, tt.canaryPercent, tt.baselinePercent, logpersistertest.NewTestLogPersister(t))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			want := mustParseManifests(t, tt.wantYAML)
+			require.Len(t, want, 1)
+			assert.Equal(t, want[0], got)
+
+			// The given manifest must not be modified.
+			assert.Equal(t, original, input[0])
+		})
+	}
+}
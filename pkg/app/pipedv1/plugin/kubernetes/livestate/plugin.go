@@ -39,6 +39,7 @@ var (
 
 type Plugin struct {
 	store       *store.Store
+	healthRules *provider.HealthRules
 	initialized sync.Once
 }
 
@@ -47,6 +48,11 @@ func (p *Plugin) Initialize(ctx context.Context, input *sdk.InitializeInput[kube
 	var err error
 
 	p.initialized.Do(func() {
+		p.healthRules, err = provider.NewHealthRules(input.Config.HealthRules)
+		if err != nil {
+			err = fmt.Errorf("failed to load health rules: %w", err)
+			return
+		}
 		p.store, err = store.Run(ctx, input.DeployTargets, input.Logger)
 		if err != nil {
 			err = fmt.Errorf("failed to run livestate store: %w", err)
@@ -115,7 +121,7 @@ func (p *Plugin) GetLivestate(ctx context.Context, _ *kubeconfig.KubernetesPlugi
 
 	resourceStates := make([]sdk.ResourceState, 0, len(liveManifests))
 	for _, manifest := range liveManifests {
-		resourceStates = append(resourceStates, manifest.ToResourceState(deployTarget.Name))
+		resourceStates = append(resourceStates, manifest.ToResourceState(deployTarget.Name, p.healthRules))
 	}
 
 	syncState := calculateSyncState(diffResult, input.Request.DeploymentSource.CommitHash)
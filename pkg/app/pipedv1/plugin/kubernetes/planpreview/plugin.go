@@ -110,10 +110,19 @@ func (p *Plugin) GetPlanPreview(ctx context.Context, _ *kubeconfig.KubernetesPlu
 		return nil, err
 	}
 
-	return toResponse(result, dts[0].Name), nil
+	// The weights of the route are not defined in Git but generated by the stages,
+	// so they are shown separately from the diff of the manifests.
+	var traffic string
+	if stages, err := loadTrafficRoutingStages(targetDS.ApplicationDirectory, targetDS.ApplicationConfigFilename); err != nil {
+		traffic = fmt.Sprintf("An error occurred while loading traffic routing stages (%v)\n", err)
+	} else if traffic, err = renderGatewayTrafficRouting(stages, tagetSpec, newManifests, input.Logger); err != nil {
+		traffic = fmt.Sprintf("An error occurred while rendering traffic routing (%v)\n", err)
+	}
+
+	return toResponse(result, traffic, dts[0].Name), nil
 }
 
-func toResponse(result *provider.DiffListResult, deployTarget string) *sdk.GetPlanPreviewResponse {
+func toResponse(result *provider.DiffListResult, traffic, deployTarget string) *sdk.GetPlanPreviewResponse {
 	if result.NoChanges() {
 		return &sdk.GetPlanPreviewResponse{
 			Results: []sdk.PlanPreviewResult{
@@ -131,6 +140,9 @@ func toResponse(result *provider.DiffListResult, deployTarget string) *sdk.GetPl
 		MaskSecret:     true,
 		UseDiffCommand: true,
 	})
+	if traffic != "" {
+		details += "\n" + traffic
+	}
 
 	// return result
 	return &sdk.GetPlanPreviewResponse{
@@ -0,0 +1,109 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/piped-plugin-sdk-go/diff"
+
+	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/deployment"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
+)
+
+// pipelineConfig is the part of the application config which holds the pipeline stages.
+// The SDK does not expose the options of the stages, so they are read from the config file.
+type pipelineConfig struct {
+	Spec struct {
+		Pipeline struct {
+			Stages []struct {
+				Name string          `json:"name"`
+				With json.RawMessage `json:"with"`
+			} `json:"stages"`
+		} `json:"pipeline"`
+	} `json:"spec"`
+}
+
+// loadTrafficRoutingStages returns the options of the K8S_TRAFFIC_ROUTING stages
+// in the pipeline of the given application config file.
+func loadTrafficRoutingStages(appDir, configFilename string) ([]kubeconfig.K8sTrafficRoutingStageOptions, error) {
+	data, err := os.ReadFile(filepath.Join(appDir, configFilename))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read application config: %w", err)
+	}
+	var cfg pipelineConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse application config: %w", err)
+	}
+
+	var stages []kubeconfig.K8sTrafficRoutingStageOptions
+	for i, s := range cfg.Spec.Pipeline.Stages {
+		if s.Name != deployment.StageK8sTrafficRouting {
+			continue
+		}
+		var opts kubeconfig.K8sTrafficRoutingStageOptions
+		if len(s.With) > 0 {
+			if err := json.Unmarshal(s.With, &opts); err != nil {
+				return nil, fmt.Errorf("failed to parse the options of stage %d: %w", i, err)
+			}
+		}
+		stages = append(stages, opts)
+	}
+	return stages, nil
+}
+
+// renderGatewayTrafficRouting renders the backendRefs weights which will be set by
+// the K8S_TRAFFIC_ROUTING stages as the diffs from the route defined in Git.
+// It returns an empty string when the gateway method is not used or there is no such stage.
+func renderGatewayTrafficRouting(stages []kubeconfig.K8sTrafficRoutingStageOptions, spec *kubeconfig.KubernetesApplicationSpec, manifests []provider.Manifest, logger *zap.Logger) (string, error) {
+	if len(stages) == 0 || kubeconfig.DetermineKubernetesTrafficRoutingMethod(spec.TrafficRouting) != kubeconfig.KubernetesTrafficRoutingMethodGateway {
+		return "", nil
+	}
+
+	var (
+		b        strings.Builder
+		renderer = diff.NewRenderer(diff.WithLeftPadding(1))
+	)
+	for i, opts := range stages {
+		route, generated, err := deployment.GenerateGatewayRoute(manifests, spec, opts)
+		if err != nil {
+			return "", err
+		}
+		if i == 0 {
+			fmt.Fprintf(&b, "# Traffic routing of %s by %s stages\n\n", route.Key().ReadableString(), deployment.StageK8sTrafficRouting)
+		}
+
+		result, err := provider.Diff(route, generated, logger, diff.WithEquateEmpty(), diff.WithCompareNumberAndNumericString())
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, "## %d. %s\n\n", i+1, opts.DisplayString())
+		if !result.HasDiff() {
+			b.WriteString(" No changes from the route defined in Git\n\n")
+			continue
+		}
+		b.WriteString(renderer.Render(result.Nodes()))
+		b.WriteString("\n")
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	kubeconfig "github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/provider"
+)
+
+func TestLoadTrafficRoutingStages(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	const appConfig = `
+apiVersion: pipecd.dev/v1beta1
+kind: Application
+spec:
+  pipeline:
+    stages:
+      - name: K8S_CANARY_ROLLOUT
+        with:
+          createService: true
+      - name: K8S_TRAFFIC_ROUTING
+        with:
+          canary: 20
+          primary: 80
+      - name: K8S_PRIMARY_ROLLOUT
+      - name: K8S_TRAFFIC_ROUTING
+        with:
+          all: primary
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.pipecd.yaml"), []byte(appConfig), 0o644))
+
+	stages, err := loadTrafficRoutingStages(dir, "app.pipecd.yaml")
+	require.NoError(t, err)
+	require.Len(t, stages, 2)
+	assert.Equal(t, "Primary: 80%, Canary: 20%, Baseline: 0%", stages[0].DisplayString())
+	assert.Equal(t, "Primary: 100%, Canary: 0%, Baseline: 0%", stages[1].DisplayString())
+
+	_, err = loadTrafficRoutingStages(dir, "not-found.pipecd.yaml")
+	assert.Error(t, err)
+}
+
+func TestRenderGatewayTrafficRouting(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  selector:
+    app: helloworld
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: helloworld
+spec:
+  rules:
+  - backendRefs:
+    - name: helloworld
+      port: 80
+`)
+	require.NoError(t, err)
+
+	stages := []kubeconfig.K8sTrafficRoutingStageOptions{
+		{All: "canary"},
+		{All: "primary"},
+	}
+
+	testcases := []struct {
+		name    string
+		spec    *kubeconfig.KubernetesApplicationSpec
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "not gateway method",
+			spec: &kubeconfig.KubernetesApplicationSpec{},
+			want: "",
+		},
+		{
+			name: "gateway method",
+			spec: &kubeconfig.KubernetesApplicationSpec{
+				TrafficRouting: &kubeconfig.KubernetesTrafficRouting{
+					Method: kubeconfig.KubernetesTrafficRoutingMethodGateway,
+				},
+			},
+			want: `# Traffic routing of name="helloworld", kind="HTTPRoute", namespace="", apiGroup="gateway.networking.k8s.io" by K8S_TRAFFIC_ROUTING stages
+
+## 1. Primary: 0%, Canary: 100%, Baseline: 0%
+
+  spec:
+    rules:
+      - backendRefs:
+          #spec.rules.0.backendRefs.1
++         - name: helloworld-canary
++           port: 80
++           weight: 100
+
+
+## 2. Primary: 100%, Canary: 0%, Baseline: 0%
+
+  spec:
+    rules:
+      - backendRefs:
+          -
+            #spec.rules.0.backendRefs.0.weight
++           weight: 100
+
+
+`,
+		},
+		{
+			name: "route not found",
+			spec: &kubeconfig.KubernetesApplicationSpec{
+				TrafficRouting: &kubeconfig.KubernetesTrafficRouting{
+					Method: kubeconfig.KubernetesTrafficRoutingMethodGateway,
+					Gateway: &kubeconfig.GatewayTrafficRouting{
+						Route: kubeconfig.K8sResourceReference{Name: "not-found"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := renderGatewayTrafficRouting(stages, tc.spec, manifests, zap.NewNop())
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
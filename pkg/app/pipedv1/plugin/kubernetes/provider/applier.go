@@ -33,6 +33,19 @@ type kubectl interface {
 	CreateNamespace(ctx context.Context, kubeconfig, namespace string) error
 }
 
+// Client operates the resources in Kubernetes cluster.
+// It is implemented by Kubectl which runs the kubectl binary, and ClientGo which uses client-go in-process.
+type Client interface {
+	kubectl
+	GetAll(ctx context.Context, kubeconfig, namespace string, selector ...string) ([]Manifest, error)
+	GetAllClusterScoped(ctx context.Context, kubeconfig string, selector ...string) ([]Manifest, error)
+}
+
+var (
+	_ Client = (*Kubectl)(nil)
+	_ Client = (*ClientGo)(nil)
+)
+
 type Applier struct {
 	kubectl kubectl
 
@@ -130,6 +143,16 @@ func (a *Applier) ForceReplaceManifest(ctx context.Context, manifest Manifest) e
 	return err
 }
 
+// GetManifest returns the live manifest of the given resource from Kubernetes cluster.
+func (a *Applier) GetManifest(ctx context.Context, k ResourceKey) (Manifest, error) {
+	return a.kubectl.Get(
+		ctx,
+		a.deployTarget.KubeConfigPath,
+		k.Namespace(),
+		k,
+	)
+}
+
 // Delete deletes the given resource from Kubernetes cluster.
 // If the resource key is different, this returns ErrNotFound.
 func (a *Applier) Delete(ctx context.Context, k ResourceKey) (err error) {
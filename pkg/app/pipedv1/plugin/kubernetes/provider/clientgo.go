@@ -0,0 +1,374 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// clientGoFieldManager is the field manager used for server-side apply.
+	clientGoFieldManager = "piped"
+	// forceReplaceCheckInterval is the interval to check whether the resource was deleted while force replacing it.
+	forceReplaceCheckInterval = time.Second
+)
+
+// ClientGo operates the resources in Kubernetes cluster in-process by using client-go.
+// Unlike Kubectl, it applies manifests by using server-side apply and does not need any binary to be installed.
+type ClientGo struct {
+	masterURL string
+	// The function to build the rest config from the given kubeconfig path.
+	// This is replaceable for testing.
+	restConfig func(kubeconfig string) (*rest.Config, error)
+
+	mu      sync.Mutex
+	clients map[string]*clientGoClients
+}
+
+type clientGoClients struct {
+	dynamic   dynamic.Interface
+	discovery discovery.CachedDiscoveryInterface
+	mapper    *restmapper.DeferredDiscoveryRESTMapper
+}
+
+// NewClientGo creates a new ClientGo instance.
+// Empty masterURL and kubeconfig mean in-cluster.
+func NewClientGo(masterURL string) *ClientGo {
+	c := &ClientGo{
+		masterURL: masterURL,
+		clients:   make(map[string]*clientGoClients),
+	}
+	c.restConfig = func(kubeconfig string) (*rest.Config, error) {
+		return clientcmd.BuildConfigFromFlags(c.masterURL, kubeconfig)
+	}
+	return c
+}
+
+func (c *ClientGo) clientsFor(kubeconfig string) (*clientGoClients, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cs, ok := c.clients[kubeconfig]; ok {
+		return cs, nil
+	}
+
+	cfg, err := c.restConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the rest config: %w", err)
+	}
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the discovery client: %w", err)
+	}
+	dyn, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the dynamic client: %w", err)
+	}
+
+	cached := memory.NewMemCacheClient(dc)
+	cs := &clientGoClients{
+		dynamic:   dyn,
+		discovery: cached,
+		mapper:    restmapper.NewDeferredDiscoveryRESTMapper(cached),
+	}
+	c.clients[kubeconfig] = cs
+	return cs, nil
+}
+
+// resource returns the client for the resource of the given GroupKind.
+// The namespace is used only when the resource is namespace-scoped, and defaults to "default".
+func (c *ClientGo) resource(kubeconfig string, gk schema.GroupKind, version, namespace string) (dynamic.ResourceInterface, bool, error) {
+	cs, err := c.clientsFor(kubeconfig)
+	if err != nil {
+		return nil, false, err
+	}
+
+	versions := make([]string, 0, 1)
+	if version != "" {
+		versions = append(versions, version)
+	}
+	mapping, err := cs.mapper.RESTMapping(gk, versions...)
+	if meta.IsNoMatchError(err) {
+		// The resource might be registered after the discovery information was cached, e.g. the CRD was applied just before.
+		cs.mapper.Reset()
+		mapping, err = cs.mapper.RESTMapping(gk, versions...)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find the resource for %s: %w", gk, err)
+	}
+
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return cs.dynamic.Resource(mapping.Resource), false, nil
+	}
+	return cs.dynamic.Resource(mapping.Resource).Namespace(cmp.Or(namespace, DefaultNamespace)), true, nil
+}
+
+func (c *ClientGo) manifestResource(kubeconfig, namespace string, manifest Manifest) (dynamic.ResourceInterface, *unstructured.Unstructured, error) {
+	obj := manifest.body.DeepCopy()
+	gvk := obj.GroupVersionKind()
+	if obj.GetNamespace() != "" {
+		namespace = obj.GetNamespace()
+	}
+
+	ri, namespaced, err := c.resource(kubeconfig, gvk.GroupKind(), gvk.Version, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	if namespaced && obj.GetNamespace() == "" {
+		obj.SetNamespace(cmp.Or(namespace, DefaultNamespace))
+	}
+	return ri, obj, nil
+}
+
+// Apply applies the given manifest by using server-side apply.
+func (c *ClientGo) Apply(ctx context.Context, kubeconfig, namespace string, manifest Manifest) error {
+	ri, obj, err := c.manifestResource(kubeconfig, namespace, manifest)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ri.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{FieldManager: clientGoFieldManager, Force: true}); err != nil {
+		return fmt.Errorf("failed to apply %s: %w", manifest.Key().ReadableString(), err)
+	}
+	return nil
+}
+
+// Create creates the given manifest.
+func (c *ClientGo) Create(ctx context.Context, kubeconfig, namespace string, manifest Manifest) error {
+	ri, obj, err := c.manifestResource(kubeconfig, namespace, manifest)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ri.Create(ctx, obj, metav1.CreateOptions{FieldManager: clientGoFieldManager}); err != nil {
+		return fmt.Errorf("failed to create %s: %w", manifest.Key().ReadableString(), err)
+	}
+	return nil
+}
+
+// Replace replaces the live resource with the given manifest.
+func (c *ClientGo) Replace(ctx context.Context, kubeconfig, namespace string, manifest Manifest) error {
+	ri, obj, err := c.manifestResource(kubeconfig, namespace, manifest)
+	if err != nil {
+		return err
+	}
+
+	live, err := ri.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return errorReplaceNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", manifest.Key().ReadableString(), err)
+	}
+
+	obj.SetResourceVersion(live.GetResourceVersion())
+	_, err = ri.Update(ctx, obj, metav1.UpdateOptions{FieldManager: clientGoFieldManager})
+	if apierrors.IsNotFound(err) {
+		return errorReplaceNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to replace %s: %w", manifest.Key().ReadableString(), err)
+	}
+	return nil
+}
+
+// ForceReplace deletes the live resource and then re-creates it with the given manifest.
+func (c *ClientGo) ForceReplace(ctx context.Context, kubeconfig, namespace string, manifest Manifest) error {
+	ri, obj, err := c.manifestResource(kubeconfig, namespace, manifest)
+	if err != nil {
+		return err
+	}
+
+	err = ri.Delete(ctx, obj.GetName(), metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return errorReplaceNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete %s: %w", manifest.Key().ReadableString(), err)
+	}
+
+	// Wait until the resource was completely deleted to be able to create the new one.
+	ticker := time.NewTicker(forceReplaceCheckInterval)
+	defer ticker.Stop()
+	for {
+		_, err := ri.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get %s: %w", manifest.Key().ReadableString(), err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	if _, err := ri.Create(ctx, obj, metav1.CreateOptions{FieldManager: clientGoFieldManager}); err != nil {
+		return fmt.Errorf("failed to create %s: %w", manifest.Key().ReadableString(), err)
+	}
+	return nil
+}
+
+// Delete deletes the resource of the given key.
+func (c *ClientGo) Delete(ctx context.Context, kubeconfig, namespace string, r ResourceKey) error {
+	ri, _, err := c.resource(kubeconfig, r.groupKind, "", namespace)
+	if err != nil {
+		return err
+	}
+
+	err = ri.Delete(ctx, r.Name(), metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %v, (%w), %v", r, ErrNotFound, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete %v: %w", r, err)
+	}
+	return nil
+}
+
+// Get returns the live manifest of the resource of the given key.
+func (c *ClientGo) Get(ctx context.Context, kubeconfig, namespace string, r ResourceKey) (Manifest, error) {
+	ri, _, err := c.resource(kubeconfig, r.groupKind, "", namespace)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	obj, err := ri.Get(ctx, r.Name(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return Manifest{}, fmt.Errorf("not found manifest %v, (%w), %v", r, ErrNotFound, err)
+	}
+	if err != nil {
+		return Manifest{}, fmt.Errorf("failed to get %v: %w", r, err)
+	}
+	return FromUnstructured(obj), nil
+}
+
+// CreateNamespace creates the given namespace.
+func (c *ClientGo) CreateNamespace(ctx context.Context, kubeconfig, namespace string) error {
+	cs, err := c.clientsFor(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	ns := &unstructured.Unstructured{}
+	ns.SetAPIVersion("v1")
+	ns.SetKind("Namespace")
+	ns.SetName(namespace)
+
+	_, err = cs.dynamic.Resource(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}).Create(ctx, ns, metav1.CreateOptions{FieldManager: clientGoFieldManager})
+	if apierrors.IsAlreadyExists(err) {
+		return errResourceAlreadyExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create namespace %s: %w", namespace, err)
+	}
+	return nil
+}
+
+// GetAll retrieves all namespace-scoped resources in the specified namespace and matching the given selector.
+// Empty namespace means all namespaces.
+func (c *ClientGo) GetAll(ctx context.Context, kubeconfig, namespace string, selector ...string) ([]Manifest, error) {
+	return c.list(ctx, kubeconfig, true, namespace, selector...)
+}
+
+// GetAllClusterScoped retrieves all cluster-scoped resources matching the given selector.
+func (c *ClientGo) GetAllClusterScoped(ctx context.Context, kubeconfig string, selector ...string) ([]Manifest, error) {
+	return c.list(ctx, kubeconfig, false, "", selector...)
+}
+
+func (c *ClientGo) list(ctx context.Context, kubeconfig string, namespaced bool, namespace string, selector ...string) ([]Manifest, error) {
+	cs, err := c.clientsFor(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := listableResources(cs.discovery, namespaced)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := metav1.ListOptions{LabelSelector: strings.Join(selector, ",")}
+	ms := make([]Manifest, 0)
+	for _, gvr := range resources {
+		var ri dynamic.ResourceInterface = cs.dynamic.Resource(gvr)
+		if namespaced && namespace != "" {
+			ri = cs.dynamic.Resource(gvr).Namespace(namespace)
+		}
+
+		list, err := ri.List(ctx, opts)
+		if apierrors.IsNotFound(err) || apierrors.IsMethodNotSupported(err) {
+			// The resource might be removed after the discovery information was cached.
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", gvr, err)
+		}
+		for i := range list.Items {
+			ms = append(ms, FromUnstructured(&list.Items[i]))
+		}
+	}
+
+	return ms, nil
+}
+
+// listableResources returns the preferred version of the resources which support the "list", "get", and "delete" verbs,
+// and are namespace-scoped or cluster-scoped as specified.
+func listableResources(dc discovery.DiscoveryInterface, namespaced bool) ([]schema.GroupVersionResource, error) {
+	// Ignore the groups failed to be discovered like kubectl api-resources does.
+	lists, err := dc.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("failed to get API resources: %w", err)
+	}
+
+	out := make([]schema.GroupVersionResource, 0)
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			// Skip the subresources like pods/log.
+			if strings.Contains(r.Name, "/") || r.Namespaced != namespaced {
+				continue
+			}
+			if !sets.New(r.Verbs...).HasAll("list", "get", "delete") {
+				continue
+			}
+			out = append(out, gv.WithResource(r.Name))
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+func newTestClientGo(t *testing.T) *ClientGo {
+	t.Helper()
+
+	cfg := setupEnvTest(t)
+	c := NewClientGo("")
+	c.restConfig = func(string) (*rest.Config, error) {
+		return cfg, nil
+	}
+	return c
+}
+
+func TestClientGo_ApplyAndGet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := newTestClientGo(t)
+
+	m := mustParseManifests(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+data:
+  key: value
+`)[0]
+	require.NoError(t, c.Apply(ctx, "", "", m))
+
+	got, err := c.Get(ctx, "", "", m.Key())
+	require.NoError(t, err)
+	value, _, err := got.NestedString("data", "key")
+	require.NoError(t, err)
+	assert.Equal(t, "value", value)
+	assert.Equal(t, DefaultNamespace, got.Key().Namespace())
+
+	updated := mustParseManifests(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+data:
+  key: updated
+`)[0]
+	require.NoError(t, c.Apply(ctx, "", "", updated))
+
+	got, err = c.Get(ctx, "", "", m.Key())
+	require.NoError(t, err)
+	value, _, err = got.NestedString("data", "key")
+	require.NoError(t, err)
+	assert.Equal(t, "updated", value)
+
+	_, err = c.Get(ctx, "", "", ResourceKey{groupKind: schema.GroupKind{Kind: KindConfigMap}, name: "not-found"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestClientGo_Replace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := newTestClientGo(t)
+
+	m := mustParseManifests(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+  namespace: test
+data:
+  key: value
+`)[0]
+
+	require.NoError(t, c.CreateNamespace(ctx, "", "test"))
+	assert.ErrorIs(t, c.CreateNamespace(ctx, "", "test"), errResourceAlreadyExists)
+
+	assert.ErrorIs(t, c.Replace(ctx, "", "", m), errorReplaceNotFound)
+	assert.ErrorIs(t, c.ForceReplace(ctx, "", "", m), errorReplaceNotFound)
+
+	require.NoError(t, c.Create(ctx, "", "", m))
+	assert.Error(t, c.Create(ctx, "", "", m))
+
+	replaced := mustParseManifests(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+  namespace: test
+data:
+  key: replaced
+`)[0]
+	require.NoError(t, c.Replace(ctx, "", "", replaced))
+
+	got, err := c.Get(ctx, "", "test", m.Key())
+	require.NoError(t, err)
+	value, _, err := got.NestedString("data", "key")
+	require.NoError(t, err)
+	assert.Equal(t, "replaced", value)
+
+	forceReplaced := mustParseManifests(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+  namespace: test
+data:
+  key: force-replaced
+`)[0]
+	require.NoError(t, c.ForceReplace(ctx, "", "", forceReplaced))
+
+	got, err = c.Get(ctx, "", "test", m.Key())
+	require.NoError(t, err)
+	value, _, err = got.NestedString("data", "key")
+	require.NoError(t, err)
+	assert.Equal(t, "force-replaced", value)
+}
+
+func TestClientGo_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := newTestClientGo(t)
+
+	m := mustParseManifests(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+data:
+  key: value
+`)[0]
+	require.NoError(t, c.Apply(ctx, "", "", m))
+
+	require.NoError(t, c.Delete(ctx, "", "", m.Key()))
+
+	_, err := c.Get(ctx, "", "", m.Key())
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, c.Delete(ctx, "", "", m.Key()), ErrNotFound)
+}
+
+func TestClientGo_GetAll(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := newTestClientGo(t)
+
+	require.NoError(t, c.CreateNamespace(ctx, "", "test"))
+	manifests := mustParseManifests(t, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: default-config
+  labels:
+    env: test
+data:
+  key: value
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: test-service
+  namespace: test
+  labels:
+    env: test
+spec:
+  ports:
+  - port: 80
+    targetPort: 8080
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: other-config
+  labels:
+    env: other
+data:
+  key: value
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: test-role
+  labels:
+    env: test
+rules: []
+`)
+	for _, m := range manifests {
+		require.NoError(t, c.Apply(ctx, "", "", m))
+	}
+
+	keys := func(ms []Manifest) []string {
+		out := make([]string, 0, len(ms))
+		for _, m := range ms {
+			out = append(out, m.Key().String())
+		}
+		return out
+	}
+
+	got, err := c.GetAll(ctx, "", "default", "env=test")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{":ConfigMap:default:default-config"}, keys(got))
+
+	got, err = c.GetAll(ctx, "", "", "env=test")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{":ConfigMap:default:default-config", ":Service:test:test-service"}, keys(got))
+
+	got, err = c.GetAllClusterScoped(ctx, "", "env=test")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"rbac.authorization.k8s.io:ClusterRole::test-role"}, keys(got))
+}
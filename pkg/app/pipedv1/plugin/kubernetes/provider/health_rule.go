@@ -0,0 +1,148 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+)
+
+// HealthRules assesses the health of the resources by the user defined rules.
+type HealthRules struct {
+	// Map from the group and kind of the resources to their rule.
+	rules map[string]*healthRule
+}
+
+type healthRule struct {
+	healthy   cel.Program
+	unhealthy cel.Program
+	message   cel.Program
+}
+
+// NewHealthRules compiles the given rules.
+// It returns an error when any of their expressions is invalid.
+func NewHealthRules(rules []config.ResourceHealthRule) (*HealthRules, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("self", cel.DynType),
+		ext.Strings(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	compile := func(expr string, out *cel.Type) (cel.Program, error) {
+		if expr == "" {
+			return nil, nil
+		}
+		ast, iss := env.Compile(expr)
+		if iss.Err() != nil {
+			return nil, fmt.Errorf("failed to compile %q: %w", expr, iss.Err())
+		}
+		if ast.OutputType() != cel.DynType && !ast.OutputType().IsExactType(out) {
+			return nil, fmt.Errorf("expression %q must return %s but returns %s", expr, out, ast.OutputType())
+		}
+		return env.Program(ast)
+	}
+
+	hr := &HealthRules{rules: make(map[string]*healthRule, len(rules))}
+	for _, r := range rules {
+		var (
+			rule healthRule
+			err  error
+		)
+		if rule.healthy, err = compile(r.Healthy, cel.BoolType); err != nil {
+			return nil, fmt.Errorf("invalid healthy expression for %s: %w", r.Kind, err)
+		}
+		if rule.unhealthy, err = compile(r.Unhealthy, cel.BoolType); err != nil {
+			return nil, fmt.Errorf("invalid unhealthy expression for %s: %w", r.Kind, err)
+		}
+		if rule.message, err = compile(r.Message, cel.StringType); err != nil {
+			return nil, fmt.Errorf("invalid message expression for %s: %w", r.Kind, err)
+		}
+		hr.rules[healthRuleKey(r.Group, r.Kind)] = &rule
+	}
+	return hr, nil
+}
+
+func healthRuleKey(group, kind string) string {
+	return group + "/" + kind
+}
+
+// assess returns the health of the given resource assessed by the rule for its kind.
+// The last return value is false when there is no rule for the kind.
+func (h *HealthRules) assess(m Manifest) (sdk.ResourceHealthStatus, string, bool) {
+	if h == nil {
+		return sdk.ResourceHealthStateUnknown, "", false
+	}
+	gvk := m.body.GroupVersionKind()
+	rule, ok := h.rules[healthRuleKey(gvk.Group, gvk.Kind)]
+	if !ok {
+		return sdk.ResourceHealthStateUnknown, "", false
+	}
+
+	vars := map[string]any{"self": m.body.Object}
+	status, defaultMsg, ok := rule.evalStatus(vars)
+	if !ok {
+		return status, defaultMsg, true
+	}
+	if rule.message == nil {
+		return status, "", true
+	}
+
+	// The message is only additional information so failing to evaluate it
+	// should not hide the status which has been already determined.
+	// For example, the status field may not be filled yet by the controller.
+	out, _, err := rule.message.Eval(vars)
+	if err != nil {
+		return status, defaultMsg, true
+	}
+	msg, _ := out.Value().(string)
+	return status, msg, true
+}
+
+// evalStatus evaluates the unhealthy and healthy expressions in that order.
+// It also returns the message describing which expression has decided the status.
+// The last return value is false when failed to evaluate them.
+func (r *healthRule) evalStatus(vars map[string]any) (sdk.ResourceHealthStatus, string, bool) {
+	if r.unhealthy != nil {
+		out, _, err := r.unhealthy.Eval(vars)
+		if err != nil {
+			return sdk.ResourceHealthStateUnknown, fmt.Sprintf("Failed to evaluate the unhealthy expression (%v)", err), false
+		}
+		if out == types.True {
+			return sdk.ResourceHealthStateUnhealthy, "Matched the unhealthy expression", true
+		}
+	}
+
+	out, _, err := r.healthy.Eval(vars)
+	if err != nil {
+		return sdk.ResourceHealthStateUnknown, fmt.Sprintf("Failed to evaluate the healthy expression (%v)", err), false
+	}
+	if out == types.True {
+		return sdk.ResourceHealthStateHealthy, "Matched the healthy expression", true
+	}
+	// When the unhealthy expression is given, the resource matching neither is still in progress.
+	if r.unhealthy != nil {
+		return sdk.ResourceHealthStateUnknown, "Matched neither the healthy nor the unhealthy expression", true
+	}
+	return sdk.ResourceHealthStateUnhealthy, "Did not match the healthy expression", true
+}
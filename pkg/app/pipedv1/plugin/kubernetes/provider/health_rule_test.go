@@ -0,0 +1,233 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/kubernetes/config"
+)
+
+func TestNewHealthRules(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		rules   []config.ResourceHealthRule
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			rules: []config.ResourceHealthRule{
+				{
+					Group:     "cert-manager.io",
+					Kind:      "Certificate",
+					Healthy:   `self.status.conditions.exists(c, c.type == "Ready" && c.status == "True")`,
+					Unhealthy: `self.status.conditions.exists(c, c.type == "Ready" && c.status == "False")`,
+					Message:   `self.status.conditions.filter(c, c.type == "Ready").map(c, c.message).join(", ")`,
+				},
+			},
+		},
+		{
+			name: "syntax error",
+			rules: []config.ResourceHealthRule{
+				{Kind: "Certificate", Healthy: `self.status.conditions.exists(c,`},
+			},
+			wantErr: true,
+		},
+		{
+			name: "healthy expression returns non bool",
+			rules: []config.ResourceHealthRule{
+				{Kind: "Certificate", Healthy: `"healthy"`},
+			},
+			wantErr: true,
+		},
+		{
+			name: "message expression returns non string",
+			rules: []config.ResourceHealthRule{
+				{Kind: "Certificate", Healthy: `true`, Message: `1`},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewHealthRules(tc.rules)
+			assert.Equal(t, tc.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestHealthRules_assess(t *testing.T) {
+	t.Parallel()
+
+	rules, err := NewHealthRules([]config.ResourceHealthRule{
+		{
+			Group:     "argoproj.io",
+			Kind:      "Rollout",
+			Healthy:   `has(self.status) && self.status.phase == "Healthy"`,
+			Unhealthy: `has(self.status) && self.status.phase == "Degraded"`,
+			Message:   `has(self.status) && has(self.status.message) ? self.status.message : ""`,
+		},
+		{
+			Group:   "pkg.crossplane.io",
+			Kind:    "Provider",
+			Healthy: `self.status.conditions.all(c, c.status == "True")`,
+		},
+		{
+			Group:     "cert-manager.io",
+			Kind:      "Certificate",
+			Healthy:   `has(self.status) && self.status.conditions.exists(c, c.type == "Ready" && c.status == "True")`,
+			Unhealthy: `has(self.status) && self.status.conditions.exists(c, c.type == "Ready" && c.status == "False")`,
+			Message:   `self.status.conditions.filter(c, c.type == "Ready").map(c, c.message).join(", ")`,
+		},
+	})
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name       string
+		manifest   string
+		wantStatus sdk.ResourceHealthStatus
+		wantDesc   string
+		wantOK     bool
+	}{
+		{
+			name: "healthy",
+			manifest: `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: simple
+status:
+  phase: Healthy
+`,
+			wantStatus: sdk.ResourceHealthStateHealthy,
+			wantOK:     true,
+		},
+		{
+			name: "unhealthy with message",
+			manifest: `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: simple
+status:
+  phase: Degraded
+  message: ProgressDeadlineExceeded
+`,
+			wantStatus: sdk.ResourceHealthStateUnhealthy,
+			wantDesc:   "ProgressDeadlineExceeded",
+			wantOK:     true,
+		},
+		{
+			name: "in progress",
+			manifest: `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: simple
+status:
+  phase: Progressing
+`,
+			wantStatus: sdk.ResourceHealthStateUnknown,
+			wantOK:     true,
+		},
+		{
+			name: "not healthy without unhealthy expression",
+			manifest: `
+apiVersion: pkg.crossplane.io/v1
+kind: Provider
+metadata:
+  name: provider-aws
+status:
+  conditions:
+  - type: Installed
+    status: "True"
+  - type: Healthy
+    status: "False"
+`,
+			wantStatus: sdk.ResourceHealthStateUnhealthy,
+			wantOK:     true,
+		},
+		{
+			name: "evaluation error",
+			manifest: `
+apiVersion: pkg.crossplane.io/v1
+kind: Provider
+metadata:
+  name: provider-aws
+`,
+			wantStatus: sdk.ResourceHealthStateUnknown,
+			wantDesc:   "Failed to evaluate the healthy expression (no such key: status)",
+			wantOK:     true,
+		},
+		{
+			name: "message evaluation error does not hide the status",
+			manifest: `
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: simple
+`,
+			wantStatus: sdk.ResourceHealthStateUnknown,
+			wantDesc:   "Matched neither the healthy nor the unhealthy expression",
+			wantOK:     true,
+		},
+		{
+			name: "healthy with message",
+			manifest: `
+apiVersion: cert-manager.io/v1
+kind: Certificate
+metadata:
+  name: simple
+status:
+  conditions:
+  - type: Ready
+    status: "True"
+    message: Certificate is up to date and has not expired
+`,
+			wantStatus: sdk.ResourceHealthStateHealthy,
+			wantDesc:   "Certificate is up to date and has not expired",
+			wantOK:     true,
+		},
+		{
+			name: "no rule",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+`,
+			wantStatus: sdk.ResourceHealthStateUnknown,
+			wantOK:     false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := mustParseManifests(t, tc.manifest)[0]
+			status, desc, ok := rules.assess(m)
+			assert.Equal(t, tc.wantStatus, status)
+			assert.Equal(t, tc.wantDesc, desc)
+			assert.Equal(t, tc.wantOK, ok)
+		})
+	}
+}
@@ -19,12 +19,55 @@ import (
 	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
 )
 
-func (m Manifest) calculateHealthStatus() (sdk.ResourceHealthStatus, string) {
+// IsReady reports whether the workload represented by the manifest has been rolled out
+// and is available. The second return value describes why it is not ready yet.
+// Only Deployment, StatefulSet, DaemonSet and Job are supported, and the other kinds are always ready.
+func (m Manifest) IsReady() (bool, string) {
+	if !m.IsDeployment() && !m.IsStatefulSet() && !m.IsDaemonSet() && !m.IsJob() {
+		return true, ""
+	}
+	status, desc := m.calculateHealthStatus(nil)
+	return status == sdk.ResourceHealthStateHealthy, desc
+}
+
+// IsFailed reports whether the workload represented by the manifest has already failed
+// and will not become ready without being updated, e.g. a failed Job or a Deployment
+// which exceeded its progress deadline. The second return value describes the failure.
+func (m Manifest) IsFailed() (bool, string) {
+	switch {
+	case m.IsDeployment():
+		obj := &appsv1.Deployment{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return false, ""
+		}
+		// The condition may be left from the previous rollout until the new spec is observed.
+		if obj.Generation > obj.Status.ObservedGeneration {
+			return false, ""
+		}
+		return deploymentProgressDeadlineExceeded(obj)
+	case m.IsJob():
+		obj := &batchv1.Job{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return false, ""
+		}
+		return jobFailed(obj)
+	default:
+		return false, ""
+	}
+}
+
+func (m Manifest) calculateHealthStatus(rules *HealthRules) (sdk.ResourceHealthStatus, string) {
+	if status, desc, ok := rules.assess(m); ok {
+		return status, desc
+	}
+
 	switch {
 	case m.IsDeployment():
 		obj := &appsv1.Deployment{}
@@ -56,6 +99,33 @@ func (m Manifest) calculateHealthStatus() (sdk.ResourceHealthStatus, string) {
 			return sdk.ResourceHealthStateUnknown, ""
 		}
 		return podHealthStatus(obj)
+	case m.IsJob():
+		obj := &batchv1.Job{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return sdk.ResourceHealthStateUnknown, ""
+		}
+		return jobHealthStatus(obj)
+	case m.IsService():
+		obj := &corev1.Service{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return sdk.ResourceHealthStateUnknown, ""
+		}
+		return serviceHealthStatus(obj)
+	case m.IsIngress():
+		obj := &networkingv1.Ingress{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return sdk.ResourceHealthStateUnknown, ""
+		}
+		return ingressHealthStatus(obj)
+	case m.IsPersistentVolumeClaim():
+		obj := &corev1.PersistentVolumeClaim{}
+		if err := m.ConvertToStructuredObject(obj); err != nil {
+			return sdk.ResourceHealthStateUnknown, ""
+		}
+		return persistentVolumeClaimHealthStatus(obj)
+	case m.IsConfigMap(), m.IsSecret():
+		// They are ready to be used once they exist.
+		return sdk.ResourceHealthStateHealthy, ""
 	default:
 		// TODO: Implement health status calculation for other resource types.
 		return sdk.ResourceHealthStateUnknown, fmt.Sprintf("Unimplemented or unknown resource: %s", m.body.GroupVersionKind())
@@ -69,13 +139,8 @@ func deploymentHealthStatus(obj *appsv1.Deployment) (sdk.ResourceHealthStatus, s
 	if obj.Generation > obj.Status.ObservedGeneration {
 		return sdk.ResourceHealthStateUnknown, "Waiting for rollout to finish because observed deployment generation less than desired generation"
 	}
-	const (
-		reasonTimeout = "ProgressDeadlineExceeded"
-	)
-	for _, cond := range obj.Status.Conditions {
-		if cond.Type == appsv1.DeploymentProgressing && cond.Reason == reasonTimeout {
-			return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("Deployment %q exceeded its progress deadline", obj.GetName())
-		}
+	if exceeded, desc := deploymentProgressDeadlineExceeded(obj); exceeded {
+		return sdk.ResourceHealthStateUnhealthy, desc
 	}
 
 	if obj.Spec.Replicas == nil {
@@ -93,6 +158,18 @@ func deploymentHealthStatus(obj *appsv1.Deployment) (sdk.ResourceHealthStatus, s
 	return sdk.ResourceHealthStateHealthy, ""
 }
 
+func deploymentProgressDeadlineExceeded(obj *appsv1.Deployment) (bool, string) {
+	const (
+		reasonTimeout = "ProgressDeadlineExceeded"
+	)
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Reason == reasonTimeout {
+			return true, fmt.Sprintf("Deployment %q exceeded its progress deadline", obj.GetName())
+		}
+	}
+	return false, ""
+}
+
 func statefulSetHealthStatus(obj *appsv1.StatefulSet) (sdk.ResourceHealthStatus, string) {
 	// Referred to:
 	//   https://github.com/kubernetes/kubernetes/blob/7942dca975b7be9386540df3c17e309c3cb2de60/staging/src/k8s.io/kubectl/pkg/polymorphichelpers/rollout_status.go#L130-L149
@@ -179,6 +256,55 @@ func daemonSetHealthStatus(obj *appsv1.DaemonSet) (sdk.ResourceHealthStatus, str
 	return sdk.ResourceHealthStateHealthy, ""
 }
 
+func jobHealthStatus(obj *batchv1.Job) (sdk.ResourceHealthStatus, string) {
+	if failed, desc := jobFailed(obj); failed {
+		return sdk.ResourceHealthStateUnhealthy, desc
+	}
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == batchv1.JobComplete && cond.Status == corev1.ConditionTrue {
+			return sdk.ResourceHealthStateHealthy, ""
+		}
+	}
+	return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("Waiting for job %q to complete, %d active, %d succeeded and %d failed pods", obj.GetName(), obj.Status.Active, obj.Status.Succeeded, obj.Status.Failed)
+}
+
+func jobFailed(obj *batchv1.Job) (bool, string) {
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return true, fmt.Sprintf("Job %q failed: %s", obj.GetName(), cond.Message)
+		}
+	}
+	return false, ""
+}
+
+func serviceHealthStatus(obj *corev1.Service) (sdk.ResourceHealthStatus, string) {
+	if obj.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return sdk.ResourceHealthStateHealthy, ""
+	}
+	if len(obj.Status.LoadBalancer.Ingress) == 0 {
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("Waiting for the load balancer of service %q to be provisioned", obj.GetName())
+	}
+	return sdk.ResourceHealthStateHealthy, ""
+}
+
+func ingressHealthStatus(obj *networkingv1.Ingress) (sdk.ResourceHealthStatus, string) {
+	if len(obj.Status.LoadBalancer.Ingress) == 0 {
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("Waiting for the load balancer of ingress %q to be provisioned", obj.GetName())
+	}
+	return sdk.ResourceHealthStateHealthy, ""
+}
+
+func persistentVolumeClaimHealthStatus(obj *corev1.PersistentVolumeClaim) (sdk.ResourceHealthStatus, string) {
+	switch obj.Status.Phase {
+	case corev1.ClaimBound:
+		return sdk.ResourceHealthStateHealthy, ""
+	case corev1.ClaimLost:
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("The volume bound to persistent volume claim %q was lost", obj.GetName())
+	default:
+		return sdk.ResourceHealthStateUnhealthy, fmt.Sprintf("Waiting for persistent volume claim %q to be bound", obj.GetName())
+	}
+}
+
 func podHealthStatus(obj *corev1.Pod) (sdk.ResourceHealthStatus, string) {
 	if obj.Spec.RestartPolicy == corev1.RestartPolicyAlways {
 		var messages []string
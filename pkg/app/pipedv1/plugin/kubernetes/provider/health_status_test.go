@@ -20,7 +20,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
@@ -914,3 +916,288 @@ func TestPodHealthStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestJobHealthStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		obj    *batchv1.Job
+		health sdk.ResourceHealthStatus
+		msg    string
+	}{
+		{
+			name: "completed",
+			obj: &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-job"},
+				Status: batchv1.JobStatus{
+					Succeeded: 1,
+					Conditions: []batchv1.JobCondition{
+						{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+					},
+				},
+			},
+			health: sdk.ResourceHealthStateHealthy,
+			msg:    "",
+		},
+		{
+			name: "failed",
+			obj: &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-job"},
+				Status: batchv1.JobStatus{
+					Failed: 6,
+					Conditions: []batchv1.JobCondition{
+						{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "Job has reached the specified backoff limit"},
+					},
+				},
+			},
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    `Job "test-job" failed: Job has reached the specified backoff limit`,
+		},
+		{
+			name: "running",
+			obj: &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-job"},
+				Status:     batchv1.JobStatus{Active: 1},
+			},
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    `Waiting for job "test-job" to complete, 1 active, 0 succeeded and 0 failed pods`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotMsg := jobHealthStatus(tt.obj)
+			assert.Equal(t, tt.health, got)
+			assert.Equal(t, tt.msg, gotMsg)
+		})
+	}
+}
+
+func TestServiceHealthStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		obj    *corev1.Service
+		health sdk.ResourceHealthStatus
+		msg    string
+	}{
+		{
+			name: "cluster ip service",
+			obj: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-service"},
+				Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+			},
+			health: sdk.ResourceHealthStateHealthy,
+		},
+		{
+			name: "load balancer is not provisioned",
+			obj: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-service"},
+				Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+			},
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    `Waiting for the load balancer of service "test-service" to be provisioned`,
+		},
+		{
+			name: "load balancer is provisioned",
+			obj: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-service"},
+				Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+				Status: corev1.ServiceStatus{
+					LoadBalancer: corev1.LoadBalancerStatus{
+						Ingress: []corev1.LoadBalancerIngress{{IP: "10.0.0.1"}},
+					},
+				},
+			},
+			health: sdk.ResourceHealthStateHealthy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotMsg := serviceHealthStatus(tt.obj)
+			assert.Equal(t, tt.health, got)
+			assert.Equal(t, tt.msg, gotMsg)
+		})
+	}
+}
+
+func TestIngressHealthStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		obj    *networkingv1.Ingress
+		health sdk.ResourceHealthStatus
+		msg    string
+	}{
+		{
+			name: "load balancer is not provisioned",
+			obj: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-ingress"},
+			},
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    `Waiting for the load balancer of ingress "test-ingress" to be provisioned`,
+		},
+		{
+			name: "load balancer is provisioned",
+			obj: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-ingress"},
+				Status: networkingv1.IngressStatus{
+					LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+						Ingress: []networkingv1.IngressLoadBalancerIngress{{Hostname: "example.com"}},
+					},
+				},
+			},
+			health: sdk.ResourceHealthStateHealthy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotMsg := ingressHealthStatus(tt.obj)
+			assert.Equal(t, tt.health, got)
+			assert.Equal(t, tt.msg, gotMsg)
+		})
+	}
+}
+
+func TestPersistentVolumeClaimHealthStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		obj    *corev1.PersistentVolumeClaim
+		health sdk.ResourceHealthStatus
+		msg    string
+	}{
+		{
+			name: "bound",
+			obj: &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pvc"},
+				Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+			},
+			health: sdk.ResourceHealthStateHealthy,
+		},
+		{
+			name: "pending",
+			obj: &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pvc"},
+				Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+			},
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    `Waiting for persistent volume claim "test-pvc" to be bound`,
+		},
+		{
+			name: "lost",
+			obj: &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pvc"},
+				Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimLost},
+			},
+			health: sdk.ResourceHealthStateUnhealthy,
+			msg:    `The volume bound to persistent volume claim "test-pvc" was lost`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotMsg := persistentVolumeClaimHealthStatus(tt.obj)
+			assert.Equal(t, tt.health, got)
+			assert.Equal(t, tt.msg, gotMsg)
+		})
+	}
+}
+
+func TestManifest_IsFailed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		manifest   string
+		wantFailed bool
+		wantMsg    string
+	}{
+		{
+			name: "deployment exceeded its progress deadline",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  generation: 2
+status:
+  observedGeneration: 2
+  conditions:
+  - type: Progressing
+    status: "False"
+    reason: ProgressDeadlineExceeded
+`,
+			wantFailed: true,
+			wantMsg:    `Deployment "simple" exceeded its progress deadline`,
+		},
+		{
+			name: "deployment whose new generation is not observed yet",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  generation: 3
+status:
+  observedGeneration: 2
+  conditions:
+  - type: Progressing
+    status: "False"
+    reason: ProgressDeadlineExceeded
+`,
+		},
+		{
+			name: "failed job",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  conditions:
+  - type: Failed
+    status: "True"
+    message: Job has reached the specified backoff limit
+`,
+			wantFailed: true,
+			wantMsg:    `Job "migrate" failed: Job has reached the specified backoff limit`,
+		},
+		{
+			name: "running job",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  active: 1
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mustParseManifests(t, tt.manifest)[0]
+			failed, msg := m.IsFailed()
+			assert.Equal(t, tt.wantFailed, failed)
+			assert.Equal(t, tt.wantMsg, msg)
+		})
+	}
+}
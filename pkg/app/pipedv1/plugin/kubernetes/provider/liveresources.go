@@ -20,7 +20,7 @@ import (
 )
 
 // GetLiveResources returns all live resources that belong to the given application.
-func GetLiveResources(ctx context.Context, kubectl *Kubectl, kubeconfig string, appID string, selector ...string) (namespaceScoped []Manifest, clusterScoped []Manifest, _ error) {
+func GetLiveResources(ctx context.Context, client Client, kubeconfig string, appID string, selector ...string) (namespaceScoped []Manifest, clusterScoped []Manifest, _ error) {
 	selectors := make([]string, 0, len(selector)+2)
 	selectors = append(selectors,
 		fmt.Sprintf("%s=%s", LabelManagedBy, ManagedByPiped),
@@ -31,12 +31,12 @@ func GetLiveResources(ctx context.Context, kubectl *Kubectl, kubeconfig string,
 	}
 
 	// pass empty namespace to get all namespace-scoped resources
-	namespacedLiveResources, err := kubectl.GetAll(ctx, kubeconfig, "", selectors...)
+	namespacedLiveResources, err := client.GetAll(ctx, kubeconfig, "", selectors...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed while listing all namespace-scoped resources (%v)", err)
 	}
 
-	clusterScopedLiveResources, err := kubectl.GetAllClusterScoped(ctx, kubeconfig, selectors...)
+	clusterScopedLiveResources, err := client.GetAllClusterScoped(ctx, kubeconfig, selectors...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed while listing all cluster-scoped resources (%v)", err)
 	}
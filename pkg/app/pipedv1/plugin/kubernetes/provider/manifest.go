@@ -194,6 +194,13 @@ func (m Manifest) IsPod() bool {
 	return isBuiltinAPIGroup(m.body.GroupVersionKind().Group) && m.body.GetKind() == KindPod
 }
 
+// IsJob returns true if the manifest is a Job.
+// It checks the API group and the kind of the manifest.
+func (m Manifest) IsJob() bool {
+	// TODO: check the API group more strictly.
+	return isBuiltinAPIGroup(m.body.GroupVersionKind().Group) && m.body.GetKind() == KindJob
+}
+
 // IsSecret returns true if the manifest is a Secret.
 // It checks the API group and the kind of the manifest.
 func (m Manifest) IsSecret() bool {
@@ -208,6 +215,20 @@ func (m Manifest) IsConfigMap() bool {
 	return isBuiltinAPIGroup(m.body.GroupVersionKind().Group) && m.body.GetKind() == KindConfigMap
 }
 
+// IsIngress returns true if the manifest is an Ingress.
+// It checks the API group and the kind of the manifest.
+func (m Manifest) IsIngress() bool {
+	// TODO: check the API group more strictly.
+	return isBuiltinAPIGroup(m.body.GroupVersionKind().Group) && m.body.GetKind() == KindIngress
+}
+
+// IsPersistentVolumeClaim returns true if the manifest is a PersistentVolumeClaim.
+// It checks the API group and the kind of the manifest.
+func (m Manifest) IsPersistentVolumeClaim() bool {
+	// TODO: check the API group more strictly.
+	return isBuiltinAPIGroup(m.body.GroupVersionKind().Group) && m.body.GetKind() == KindPersistentVolumeClaim
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (m *Manifest) UnmarshalJSON(data []byte) error {
 	m.body = new(unstructured.Unstructured)
@@ -243,6 +264,16 @@ func (m Manifest) NestedString(fields ...string) (string, bool, error) {
 	return unstructured.NestedString(m.body.Object, fields...)
 }
 
+func (m Manifest) NestedSlice(fields ...string) ([]any, bool, error) {
+	return unstructured.NestedSlice(m.body.Object, fields...)
+}
+
+// SetNestedSlice sets the given slice into the specified fields.
+// The slice must contain only JSON-compatible values.
+func (m Manifest) SetNestedSlice(value []any, fields ...string) error {
+	return unstructured.SetNestedSlice(m.body.Object, value, fields...)
+}
+
 func (m Manifest) AddLabels(labels map[string]string) {
 	if len(labels) == 0 {
 		return
@@ -296,7 +327,8 @@ func (m Manifest) AddStringMapValues(values map[string]string, fields ...string)
 }
 
 // ToResourceState converts the manifest into a sdk.ResourceState.
-func (m Manifest) ToResourceState(deployTarget string) sdk.ResourceState {
+// The given health rules are used to assess the health of the resource before the built-in checks.
+func (m Manifest) ToResourceState(deployTarget string, rules *HealthRules) sdk.ResourceState {
 	var parents []string // default as nil
 	if len(m.body.GetOwnerReferences()) > 0 {
 		parents = make([]string, 0, len(m.body.GetOwnerReferences()))
@@ -305,7 +337,7 @@ func (m Manifest) ToResourceState(deployTarget string) sdk.ResourceState {
 		}
 	}
 
-	status, desc := m.calculateHealthStatus()
+	status, desc := m.calculateHealthStatus(rules)
 
 	return sdk.ResourceState{
 		ID:                string(m.body.GetUID()),
@@ -1266,7 +1266,7 @@ func TestManifest_ToResourceState(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got := tt.manifest.ToResourceState(tt.deployTarget)
+			got := tt.manifest.ToResourceState(tt.deployTarget, nil)
 			assert.Equal(t, tt.want, got)
 		})
 	}
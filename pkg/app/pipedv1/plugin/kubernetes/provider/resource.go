@@ -32,11 +32,16 @@ const (
 	KindDaemonSet   = "DaemonSet"
 	KindPod         = "Pod"
 	KindStatefulSet = "StatefulSet"
+	KindJob         = "Job"
 
 	// ConfigMap and Secret
 	KindSecret    = "Secret"
 	KindConfigMap = "ConfigMap"
 
+	// Networking and storage
+	KindIngress               = "Ingress"
+	KindPersistentVolumeClaim = "PersistentVolumeClaim"
+
 	DefaultNamespace = "default"
 )
 
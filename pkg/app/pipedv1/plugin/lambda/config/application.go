@@ -0,0 +1,66 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/creasty/defaults"
+	"github.com/pipe-cd/piped-plugin-sdk-go/unit"
+)
+
+// LambdaApplicationSpec represents an application configuration for Lambda application.
+type LambdaApplicationSpec struct {
+	// Input for Lambda deployment such as where to fetch source code...
+	Input LambdaDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync LambdaSyncStageOptions `json:"quickSync"`
+}
+
+func (s *LambdaApplicationSpec) UnmarshalJSON(data []byte) error {
+	type alias LambdaApplicationSpec
+
+	var a alias
+	if err := json.Unmarshal(data, &a); err != nil {
+		return err
+	}
+
+	*s = LambdaApplicationSpec(a)
+	if err := defaults.Set(s); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type LambdaDeploymentInput struct {
+	// The name of function manifest file placing in application directory.
+	// Default is function.yaml
+	FunctionManifestFile string `json:"functionManifestFile" default:"function.yaml"`
+}
+
+// LambdaSyncStageOptions contains all configurable values for a LAMBDA_SYNC stage.
+type LambdaSyncStageOptions struct {
+}
+
+// LambdaCanaryRolloutStageOptions contains all configurable values for a LAMBDA_CANARY_ROLLOUT stage.
+type LambdaCanaryRolloutStageOptions struct {
+}
+
+// LambdaPromoteStageOptions contains all configurable values for a LAMBDA_PROMOTE stage.
+type LambdaPromoteStageOptions struct {
+	// Percentage of traffic should be routed to the new version.
+	Percent unit.Percentage `json:"percent"`
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+func (p *Plugin) executeCanaryRolloutStage(
+	ctx context.Context,
+	input *sdk.ExecuteStageInput[config.LambdaApplicationSpec],
+	deployTarget *sdk.DeployTarget[config.LambdaDeployTargetConfig],
+) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	client, err := provider.DefaultRegistry().Client(deployTarget.Name, deployTarget.Config)
+	if err != nil {
+		lp.Errorf("Unable to create Lambda client for the deploy target %s: %v", deployTarget.Name, err)
+		return sdk.StageStatusFailure
+	}
+
+	gc, err := p.getGitClient(input.Logger)
+	if err != nil {
+		lp.Errorf("Unable to create git client: %v", err)
+		return sdk.StageStatusFailure
+	}
+
+	return rollout(ctx, client, gc, input.Client, input.Request, lp)
+}
+
+// rollout publishes a new version of the function at the target commit without routing any traffic to it.
+// The published version is stored to be promoted by the following LAMBDA_PROMOTE stages.
+func rollout(ctx context.Context, client provider.Client, gc gitClient, store metadataStore, request sdk.ExecuteStageRequest[config.LambdaApplicationSpec], lp sdk.StageLogPersister) sdk.StageStatus {
+	fm, ok := loadFunctionManifest(request.TargetDeploymentSource, request.Deployment, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	lp.Infof("Start rolling out the lambda function: %s", fm.Spec.Name)
+
+	// Build and publish new version of Lambda function.
+	version, ok := build(ctx, client, gc, fm, lp)
+	if !ok {
+		lp.Errorf("Failed to build new version for Lambda function %s", fm.Spec.Name)
+		return sdk.StageStatusFailure
+	}
+
+	// Update rolled out version name to metadata store.
+	if err := store.PutDeploymentPluginMetadata(ctx, rolloutVersionKey, version); err != nil {
+		lp.Errorf("Failed to update latest version name to metadata store for Lambda function %s: %v", fm.Spec.Name, err)
+		return sdk.StageStatusFailure
+	}
+
+	// Store current traffic config for rollback if necessary.
+	if trafficCfg, err := client.GetTrafficConfig(ctx, fm); err == nil {
+		if !storeTrafficConfig(ctx, store, originalTrafficKey, trafficCfg, lp) {
+			return sdk.StageStatusFailure
+		}
+	}
+
+	lp.Successf("Successfully rolled out new version (v%s) of Lambda function %s", version, fm.Spec.Name)
+	return sdk.StageStatusSuccess
+}
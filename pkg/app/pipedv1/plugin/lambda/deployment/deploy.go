@@ -0,0 +1,253 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+	"github.com/pipe-cd/pipecd/pkg/backoff"
+	"github.com/pipe-cd/pipecd/pkg/git"
+)
+
+const (
+	// originalTrafficKey is the metadata key to store the traffic config before the deployment.
+	originalTrafficKey = "original-traffic"
+	// promoteTrafficKey is the metadata key to store the traffic config applied by the latest promote stage.
+	promoteTrafficKey = "latest-promote-traffic"
+	// rolloutVersionKey is the metadata key to store the version published by the canary rollout stage.
+	rolloutVersionKey = "rollout-version"
+
+	promotePercentageMetadataKey = "promote-percentage"
+)
+
+// metadataStore is the subset of sdk.Client used to store the metadata of the deployment.
+type metadataStore interface {
+	GetDeploymentPluginMetadata(ctx context.Context, key string) (string, bool, error)
+	PutDeploymentPluginMetadata(ctx context.Context, key, value string) error
+	PutStageMetadataMulti(ctx context.Context, metadata map[string]string) error
+}
+
+// gitClient is the subset of git.Client used to fetch the function source code.
+type gitClient interface {
+	Clone(ctx context.Context, repoID, remote, branch, destination string) (git.Repo, error)
+}
+
+func loadFunctionManifest(ds sdk.DeploymentSource[config.LambdaApplicationSpec], deployment sdk.Deployment, lp sdk.StageLogPersister) (provider.FunctionManifest, bool) {
+	lp.Infof("Loading lambda function manifest at commit %s", ds.CommitHash)
+
+	fm, err := loadFunctionManifestFromSource(ds)
+	if err != nil {
+		lp.Errorf("Failed to load lambda function manifest (%v)", err)
+		return provider.FunctionManifest{}, false
+	}
+
+	if fm.Spec.Tags == nil {
+		fm.Spec.Tags = make(map[string]string)
+	}
+	fm.Spec.Tags[provider.LabelManagedBy] = provider.ManagedByLambdaPlugin
+	fm.Spec.Tags[provider.LabelPiped] = deployment.PipedID
+	fm.Spec.Tags[provider.LabelApplication] = deployment.ApplicationID
+	fm.Spec.Tags[provider.LabelCommitHash] = ds.CommitHash
+
+	lp.Infof("Successfully loaded the lambda function manifest at commit %s", ds.CommitHash)
+	return fm, true
+}
+
+// storeTrafficConfig encodes the given traffic config and stores it to the deployment metadata.
+func storeTrafficConfig(ctx context.Context, store metadataStore, key string, cfg provider.RoutingTrafficConfig, lp sdk.StageLogPersister) bool {
+	data, err := cfg.Encode()
+	if err != nil {
+		lp.Errorf("Unable to store traffic config for rollback: encode failed: %v", err)
+		return false
+	}
+	if err := store.PutDeploymentPluginMetadata(ctx, key, data); err != nil {
+		lp.Errorf("Unable to store traffic config for rollback: %v", err)
+		return false
+	}
+	return true
+}
+
+func configureTrafficRouting(trafficCfg provider.RoutingTrafficConfig, version string, percent int) bool {
+	// The primary version has to be set on trafficCfg.
+	primary, ok := trafficCfg[provider.TrafficPrimaryVersionKeyName]
+	if !ok {
+		return false
+	}
+	// Set built version by rollout stage as new primary.
+	trafficCfg[provider.TrafficPrimaryVersionKeyName] = provider.VersionTraffic{
+		Version: version,
+		Percent: float64(percent),
+	}
+	// Make the current primary version as new secondary version in case it's not the latest built version by rollout stage.
+	if primary.Version != version {
+		trafficCfg[provider.TrafficSecondaryVersionKeyName] = provider.VersionTraffic{
+			Version: primary.Version,
+			Percent: float64(100 - percent),
+		}
+	} else {
+		// Update traffic to the secondary and keep it as new secondary.
+		if secondary, ok := trafficCfg[provider.TrafficSecondaryVersionKeyName]; ok {
+			trafficCfg[provider.TrafficSecondaryVersionKeyName] = provider.VersionTraffic{
+				Version: secondary.Version,
+				Percent: float64(100 - percent),
+			}
+		}
+	}
+	return true
+}
+
+// build applies the given function manifest and publishes a new version of the function.
+func build(ctx context.Context, client provider.Client, gc gitClient, fm provider.FunctionManifest, lp sdk.StageLogPersister) (version string, ok bool) {
+	found, err := client.IsFunctionExist(ctx, fm.Spec.Name)
+	if err != nil {
+		lp.Errorf("Unable to validate function name %s: %v", fm.Spec.Name, err)
+		return
+	}
+	if found {
+		if err := updateFunction(ctx, client, gc, fm, lp); err != nil {
+			lp.Errorf("Failed to update lambda function %s: %v", fm.Spec.Name, err)
+			return
+		}
+	} else {
+		if err := createFunction(ctx, client, gc, fm, lp); err != nil {
+			lp.Errorf("Failed to create lambda function %s: %v", fm.Spec.Name, err)
+			return
+		}
+	}
+
+	lp.Info("Waiting to update lambda function in progress...")
+	retry := backoff.NewRetry(provider.RequestRetryTime, backoff.NewConstant(provider.RetryIntervalDuration))
+	publishFunctionSucceed := false
+	startWaitingStamp := time.Now()
+	for retry.WaitNext(ctx) {
+		// Commit version for applied Lambda function.
+		// Note: via the current docs of [Lambda.PublishVersion](https://docs.aws.amazon.com/sdk-for-go/api/service/lambda/#Lambda.PublishVersion)
+		// AWS Lambda doesn't publish a version if the function's configuration and code haven't changed since the last version.
+		// But currently, unchanged revision is able to make publish (versionId++) as usual.
+		if version, err = client.PublishFunction(ctx, fm); err == nil {
+			publishFunctionSucceed = true
+			break
+		}
+	}
+	if !publishFunctionSucceed {
+		lp.Errorf("Failed to commit new version for Lambda function %s: %v", fm.Spec.Name, err)
+		return
+	}
+
+	lp.Infof("Successfully committed new version (v%s) for Lambda function %s after duration %v", version, fm.Spec.Name, time.Since(startWaitingStamp))
+	ok = true
+	return
+}
+
+func createFunction(ctx context.Context, client provider.Client, gc gitClient, fm provider.FunctionManifest, lp sdk.StageLogPersister) error {
+	if fm.Spec.ImageURI != "" || fm.Spec.S3Bucket != "" {
+		return client.CreateFunction(ctx, fm)
+	}
+
+	zip, err := prepareZipFromSource(ctx, gc, fm)
+	if err != nil {
+		lp.Errorf("Failed to prepare zip from Lambda function source, remote (%s)", fm.Spec.SourceCode.Git)
+		return err
+	}
+
+	return client.CreateFunctionFromSource(ctx, fm, zip)
+}
+
+func updateFunction(ctx context.Context, client provider.Client, gc gitClient, fm provider.FunctionManifest, lp sdk.StageLogPersister) error {
+	if fm.Spec.ImageURI != "" || fm.Spec.S3Bucket != "" {
+		return client.UpdateFunction(ctx, fm)
+	}
+
+	zip, err := prepareZipFromSource(ctx, gc, fm)
+	if err != nil {
+		lp.Errorf("Failed to prepare zip from Lambda function source, remote (%s)", fm.Spec.SourceCode.Git)
+		return err
+	}
+
+	return client.UpdateFunctionFromSource(ctx, fm, zip)
+}
+
+func prepareZipFromSource(ctx context.Context, gc gitClient, fm provider.FunctionManifest) (io.Reader, error) {
+	repo, err := gc.Clone(ctx, fm.Spec.SourceCode.Git, fm.Spec.SourceCode.Git, "", "")
+	if err != nil {
+		return nil, err
+	}
+	defer repo.Clean()
+
+	if err = repo.Checkout(ctx, fm.Spec.SourceCode.Ref); err != nil {
+		return nil, err
+	}
+
+	return zipDirectory(filepath.Join(repo.GetPath(), fm.Spec.SourceCode.Path))
+}
+
+// zipDirectory archives the given directory, the directory itself is placed at the root of the archive.
+func zipDirectory(source string) (io.Reader, error) {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+
+	err := filepath.Walk(source, func(fp string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		header, err := zip.FileInfoHeader(fi)
+		if err != nil {
+			return err
+		}
+		header.Method = zip.Deflate
+		header.Name, err = filepath.Rel(filepath.Dir(source), fp)
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			header.Name += "/"
+		}
+		headerWriter, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(fp)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(headerWriter, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
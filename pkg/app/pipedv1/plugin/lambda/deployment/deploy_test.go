@@ -0,0 +1,336 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+	"github.com/pipe-cd/pipecd/pkg/git"
+)
+
+// fakeClient is an in-memory implementation of provider.Client.
+type fakeClient struct {
+	functions map[string]provider.FunctionManifest
+	zips      map[string][]byte
+	versions  map[string]int
+	aliases   map[string]provider.RoutingTrafficConfig
+}
+
+var _ provider.Client = (*fakeClient)(nil)
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		functions: make(map[string]provider.FunctionManifest),
+		zips:      make(map[string][]byte),
+		versions:  make(map[string]int),
+		aliases:   make(map[string]provider.RoutingTrafficConfig),
+	}
+}
+
+func (c *fakeClient) IsFunctionExist(_ context.Context, name string) (bool, error) {
+	_, ok := c.functions[name]
+	return ok, nil
+}
+
+func (c *fakeClient) CreateFunction(_ context.Context, fm provider.FunctionManifest) error {
+	if _, ok := c.functions[fm.Spec.Name]; ok {
+		return fmt.Errorf("function %s already exists", fm.Spec.Name)
+	}
+	c.functions[fm.Spec.Name] = fm
+	return nil
+}
+
+func (c *fakeClient) CreateFunctionFromSource(ctx context.Context, fm provider.FunctionManifest, zip io.Reader) error {
+	data, err := io.ReadAll(zip)
+	if err != nil {
+		return err
+	}
+	c.zips[fm.Spec.Name] = data
+	return c.CreateFunction(ctx, fm)
+}
+
+func (c *fakeClient) UpdateFunction(_ context.Context, fm provider.FunctionManifest) error {
+	if _, ok := c.functions[fm.Spec.Name]; !ok {
+		return provider.ErrNotFound
+	}
+	c.functions[fm.Spec.Name] = fm
+	return nil
+}
+
+func (c *fakeClient) UpdateFunctionFromSource(ctx context.Context, fm provider.FunctionManifest, zip io.Reader) error {
+	data, err := io.ReadAll(zip)
+	if err != nil {
+		return err
+	}
+	c.zips[fm.Spec.Name] = data
+	return c.UpdateFunction(ctx, fm)
+}
+
+func (c *fakeClient) PublishFunction(_ context.Context, fm provider.FunctionManifest) (string, error) {
+	if _, ok := c.functions[fm.Spec.Name]; !ok {
+		return "", provider.ErrNotFound
+	}
+	c.versions[fm.Spec.Name]++
+	return strconv.Itoa(c.versions[fm.Spec.Name]), nil
+}
+
+func (c *fakeClient) ListFunctions(_ context.Context) ([]types.FunctionConfiguration, error) {
+	out := make([]types.FunctionConfiguration, 0, len(c.functions))
+	for name := range c.functions {
+		out = append(out, types.FunctionConfiguration{FunctionName: aws.String(name)})
+	}
+	return out, nil
+}
+
+func (c *fakeClient) GetFunction(_ context.Context, name string) (*lambda.GetFunctionOutput, error) {
+	fm, ok := c.functions[name]
+	if !ok {
+		return nil, provider.ErrNotFound
+	}
+	return &lambda.GetFunctionOutput{
+		Configuration: &types.FunctionConfiguration{
+			FunctionName: aws.String(name),
+			MemorySize:   aws.Int32(fm.Spec.Memory),
+			Timeout:      aws.Int32(fm.Spec.Timeout),
+		},
+		Code: &types.FunctionCodeLocation{ImageUri: aws.String(fm.Spec.ImageURI)},
+		Tags: fm.Spec.Tags,
+	}, nil
+}
+
+func (c *fakeClient) GetTrafficConfig(_ context.Context, fm provider.FunctionManifest) (provider.RoutingTrafficConfig, error) {
+	cfg, ok := c.aliases[fm.Spec.Name]
+	if !ok {
+		return nil, provider.ErrNotFound
+	}
+	out := make(provider.RoutingTrafficConfig, len(cfg))
+	for k, v := range cfg {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (c *fakeClient) CreateTrafficConfig(_ context.Context, fm provider.FunctionManifest, version string) error {
+	if _, ok := c.aliases[fm.Spec.Name]; ok {
+		return fmt.Errorf("alias of function %s already exists", fm.Spec.Name)
+	}
+	c.aliases[fm.Spec.Name] = provider.RoutingTrafficConfig{
+		provider.TrafficPrimaryVersionKeyName: {Version: version, Percent: 100},
+	}
+	return nil
+}
+
+func (c *fakeClient) UpdateTrafficConfig(_ context.Context, fm provider.FunctionManifest, routingTraffic provider.RoutingTrafficConfig) error {
+	if _, ok := c.aliases[fm.Spec.Name]; !ok {
+		return provider.ErrNotFound
+	}
+	if _, ok := routingTraffic[provider.TrafficPrimaryVersionKeyName]; !ok {
+		return fmt.Errorf("invalid routing traffic configuration given: primary version not found")
+	}
+	out := make(provider.RoutingTrafficConfig, len(routingTraffic))
+	for k, v := range routingTraffic {
+		out[k] = v
+	}
+	c.aliases[fm.Spec.Name] = out
+	return nil
+}
+
+// fakeMetadataStore is an in-memory implementation of metadataStore.
+type fakeMetadataStore struct {
+	deployment map[string]string
+	stage      map[string]string
+}
+
+func newFakeMetadataStore() *fakeMetadataStore {
+	return &fakeMetadataStore{
+		deployment: make(map[string]string),
+		stage:      make(map[string]string),
+	}
+}
+
+func (s *fakeMetadataStore) GetDeploymentPluginMetadata(_ context.Context, key string) (string, bool, error) {
+	v, ok := s.deployment[key]
+	return v, ok, nil
+}
+
+func (s *fakeMetadataStore) PutDeploymentPluginMetadata(_ context.Context, key, value string) error {
+	s.deployment[key] = value
+	return nil
+}
+
+func (s *fakeMetadataStore) PutStageMetadataMulti(_ context.Context, metadata map[string]string) error {
+	for k, v := range metadata {
+		s.stage[k] = v
+	}
+	return nil
+}
+
+// fakeGitClient clones the repositories from the local directory.
+type fakeGitClient struct {
+	dir       string
+	checkouts []string
+}
+
+func (c *fakeGitClient) Clone(_ context.Context, _, _, _, _ string) (git.Repo, error) {
+	return &fakeRepo{client: c}, nil
+}
+
+type fakeRepo struct {
+	git.Repo
+	client *fakeGitClient
+}
+
+func (r *fakeRepo) GetPath() string {
+	return r.client.dir
+}
+
+func (r *fakeRepo) Checkout(_ context.Context, commitish string) error {
+	r.client.checkouts = append(r.client.checkouts, commitish)
+	return nil
+}
+
+func (r *fakeRepo) Clean() error {
+	return nil
+}
+
+func newTestDeploymentSource(t *testing.T, dir, commit string) sdk.DeploymentSource[config.LambdaApplicationSpec] {
+	t.Helper()
+
+	appDir := filepath.Join("testdata", dir)
+	return sdk.DeploymentSource[config.LambdaApplicationSpec]{
+		ApplicationDirectory:      appDir,
+		CommitHash:                commit,
+		ApplicationConfig:         sdk.LoadApplicationConfigForTest[config.LambdaApplicationSpec](t, filepath.Join(appDir, "app.pipecd.yaml"), "lambda"),
+		ApplicationConfigFilename: "app.pipecd.yaml",
+	}
+}
+
+func newTestRequest(t *testing.T, stage string, stageConfig []byte, running, target sdk.DeploymentSource[config.LambdaApplicationSpec]) sdk.ExecuteStageRequest[config.LambdaApplicationSpec] {
+	t.Helper()
+
+	return sdk.ExecuteStageRequest[config.LambdaApplicationSpec]{
+		StageName:               stage,
+		StageConfig:             stageConfig,
+		RunningDeploymentSource: running,
+		TargetDeploymentSource:  target,
+		Deployment: sdk.Deployment{
+			ID:            "deployment-id",
+			PipedID:       "piped-id",
+			ApplicationID: "app-id",
+		},
+	}
+}
+
+func TestConfigureTrafficRouting(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		cfg      provider.RoutingTrafficConfig
+		version  string
+		percent  int
+		expected provider.RoutingTrafficConfig
+		ok       bool
+	}{
+		{
+			name:    "missing primary",
+			cfg:     provider.RoutingTrafficConfig{},
+			version: "2",
+			percent: 100,
+			ok:      false,
+		},
+		{
+			name: "new version becomes primary and the current primary becomes secondary",
+			cfg: provider.RoutingTrafficConfig{
+				provider.TrafficPrimaryVersionKeyName: {Version: "1", Percent: 100},
+			},
+			version: "2",
+			percent: 30,
+			expected: provider.RoutingTrafficConfig{
+				provider.TrafficPrimaryVersionKeyName:   {Version: "2", Percent: 30},
+				provider.TrafficSecondaryVersionKeyName: {Version: "1", Percent: 70},
+			},
+			ok: true,
+		},
+		{
+			name: "update the percentage of the existing secondary",
+			cfg: provider.RoutingTrafficConfig{
+				provider.TrafficPrimaryVersionKeyName:   {Version: "2", Percent: 30},
+				provider.TrafficSecondaryVersionKeyName: {Version: "1", Percent: 70},
+			},
+			version: "2",
+			percent: 100,
+			expected: provider.RoutingTrafficConfig{
+				provider.TrafficPrimaryVersionKeyName:   {Version: "2", Percent: 100},
+				provider.TrafficSecondaryVersionKeyName: {Version: "1", Percent: 0},
+			},
+			ok: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ok := configureTrafficRouting(tc.cfg, tc.version, tc.percent)
+			require.Equal(t, tc.ok, ok)
+			if ok {
+				assert.Equal(t, tc.expected, tc.cfg)
+			}
+		})
+	}
+}
+
+func TestZipDirectory(t *testing.T) {
+	t.Parallel()
+
+	r, err := zipDirectory(filepath.Join("testdata", "repo", "hello-world"))
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	names := make([]string, 0, len(zr.File))
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	slices.Sort(names)
+	assert.Equal(t, []string{
+		"hello-world/",
+		"hello-world/app.js",
+		"hello-world/lib/",
+		"hello-world/lib/util.js",
+	}, names)
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"fmt"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+func loadFunctionManifestFromSource(ds sdk.DeploymentSource[config.LambdaApplicationSpec]) (provider.FunctionManifest, error) {
+	appCfg, err := ds.AppConfig()
+	if err != nil {
+		return provider.FunctionManifest{}, fmt.Errorf("failed to load application config: %w", err)
+	}
+	return provider.LoadFunctionManifest(ds.ApplicationDirectory, appCfg.Spec.Input.FunctionManifestFile)
+}
+
+// determineVersion returns the version of the artifact defined in the given function manifest.
+// It returns "unknown" when the version could not be determined.
+func determineVersion(fm provider.FunctionManifest) string {
+	// Extract container image tag as application version.
+	if fm.Spec.ImageURI != "" {
+		if tag, err := provider.FindImageTag(fm); err == nil {
+			return tag
+		}
+	}
+
+	// Extract s3 object version as application version.
+	if fm.Spec.S3ObjectVersion != "" {
+		return fm.Spec.S3ObjectVersion
+	}
+
+	// Extract source code commitish as application version.
+	if fm.Spec.SourceCode.Ref != "" {
+		return fm.Spec.SourceCode.Ref
+	}
+
+	return "unknown"
+}
+
+// determineStrategy decides the sync strategy by comparing the running and the target function manifests.
+// A pipeline is always used when the function has been deployed before, its summary shows the version change.
+func determineStrategy(running, target provider.FunctionManifest) *sdk.DetermineStrategyResponse {
+	runningVersion := determineVersion(running)
+	targetVersion := determineVersion(target)
+
+	if runningVersion == targetVersion {
+		return &sdk.DetermineStrategyResponse{
+			Strategy: sdk.SyncStrategyPipelineSync,
+			Summary:  fmt.Sprintf("Sync with the specified pipeline to update the function configuration of version %s", targetVersion),
+		}
+	}
+
+	return &sdk.DetermineStrategyResponse{
+		Strategy: sdk.SyncStrategyPipelineSync,
+		Summary:  fmt.Sprintf("Sync with pipeline to update version from %s to %s", runningVersion, targetVersion),
+	}
+}
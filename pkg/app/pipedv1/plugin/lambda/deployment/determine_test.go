@@ -0,0 +1,142 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+func TestPlugin_DetermineVersions(t *testing.T) {
+	t.Parallel()
+
+	p := &Plugin{}
+	resp, err := p.DetermineVersions(t.Context(), nil, &sdk.DetermineVersionsInput[config.LambdaApplicationSpec]{
+		Request: sdk.DetermineVersionsRequest[config.LambdaApplicationSpec]{
+			DeploymentSource: newTestDeploymentSource(t, "v2", "2222222bbbb"),
+		},
+		Logger: zaptest.NewLogger(t),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []sdk.ArtifactVersion{
+		{
+			Version: "v0.0.2",
+			Name:    "lambda-simple-function",
+			URL:     "ecr.ap-northeast-1.amazonaws.com/lambda-simple-function:v0.0.2",
+		},
+	}, resp.Versions)
+}
+
+func TestPlugin_DetermineStrategy(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		running     string
+		target      string
+		wantSummary string
+		want        sdk.SyncStrategy
+	}{
+		{
+			name:        "first deployment",
+			target:      "v1",
+			want:        sdk.SyncStrategyQuickSync,
+			wantSummary: "Quick sync to deploy version v0.0.1 and configure all traffic to it (it seems this is the first deployment)",
+		},
+		{
+			name:        "version was not changed",
+			running:     "v1",
+			target:      "v1",
+			want:        sdk.SyncStrategyPipelineSync,
+			wantSummary: "Sync with the specified pipeline to update the function configuration of version v0.0.1",
+		},
+		{
+			name:        "version was changed",
+			running:     "v1",
+			target:      "v2",
+			want:        sdk.SyncStrategyPipelineSync,
+			wantSummary: "Sync with pipeline to update version from v0.0.1 to v0.0.2",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var running sdk.DeploymentSource[config.LambdaApplicationSpec]
+			if tc.running != "" {
+				running = newTestDeploymentSource(t, tc.running, "1111111aaaa")
+			}
+
+			p := &Plugin{}
+			resp, err := p.DetermineStrategy(t.Context(), nil, &sdk.DetermineStrategyInput[config.LambdaApplicationSpec]{
+				Request: sdk.DetermineStrategyRequest[config.LambdaApplicationSpec]{
+					RunningDeploymentSource: running,
+					TargetDeploymentSource:  newTestDeploymentSource(t, tc.target, "2222222bbbb"),
+				},
+				Logger: zaptest.NewLogger(t),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, resp.Strategy)
+			assert.Equal(t, tc.wantSummary, resp.Summary)
+		})
+	}
+}
+
+func TestDetermineVersion(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		spec     provider.FunctionManifestSpec
+		expected string
+	}{
+		{
+			name:     "container image",
+			spec:     provider.FunctionManifestSpec{ImageURI: "ecr.ap-northeast-1.amazonaws.com/lambda-simple-function:v0.0.1"},
+			expected: "v0.0.1",
+		},
+		{
+			name:     "s3 object",
+			spec:     provider.FunctionManifestSpec{S3Bucket: "bucket", S3Key: "key", S3ObjectVersion: "xyz"},
+			expected: "xyz",
+		},
+		{
+			name:     "source code",
+			spec:     provider.FunctionManifestSpec{SourceCode: provider.SourceCode{Git: "git@github.com:org/repo.git", Ref: "dede7cd"}},
+			expected: "dede7cd",
+		},
+		{
+			name:     "unknown",
+			spec:     provider.FunctionManifestSpec{},
+			expected: "unknown",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, determineVersion(provider.FunctionManifest{Spec: tc.spec}))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"slices"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+const (
+	// StageLambdaSync does quick sync by publishing the new version
+	// and switching all traffic to it.
+	StageLambdaSync = "LAMBDA_SYNC"
+	// StageLambdaCanaryRollout publishes the new version of the function
+	// without routing any traffic to it.
+	StageLambdaCanaryRollout = "LAMBDA_CANARY_ROLLOUT"
+	// StageLambdaPromote promotes the new version to receive amount of traffic.
+	StageLambdaPromote = "LAMBDA_PROMOTE"
+	// StageLambdaRollback rollbacks the function and its traffic to the previous state.
+	StageLambdaRollback = "LAMBDA_ROLLBACK"
+)
+
+var allStages = []string{
+	StageLambdaSync,
+	StageLambdaCanaryRollout,
+	StageLambdaPromote,
+	StageLambdaRollback,
+}
+
+const (
+	StageLambdaSyncDescription     = "Deploy the new version and configure all traffic to it"
+	StageLambdaRollbackDescription = "Rollback the function and its traffic to the previous state"
+)
+
+func buildQuickSyncPipeline(autoRollback bool) []sdk.QuickSyncStage {
+	out := make([]sdk.QuickSyncStage, 0, 2)
+	out = append(out, sdk.QuickSyncStage{
+		Name:               StageLambdaSync,
+		Description:        StageLambdaSyncDescription,
+		Rollback:           false,
+		Metadata:           map[string]string{},
+		AvailableOperation: sdk.ManualOperationNone,
+	})
+	if autoRollback {
+		out = append(out, sdk.QuickSyncStage{
+			Name:               StageLambdaRollback,
+			Description:        StageLambdaRollbackDescription,
+			Rollback:           true,
+			Metadata:           map[string]string{},
+			AvailableOperation: sdk.ManualOperationNone,
+		})
+	}
+	return out
+}
+
+func buildPipelineStages(stages []sdk.StageConfig, autoRollback bool) ([]sdk.PipelineStage, error) {
+	out := make([]sdk.PipelineStage, 0, len(stages)+1)
+	for _, stage := range stages {
+		out = append(out, sdk.PipelineStage{
+			Name:               stage.Name,
+			Index:              stage.Index,
+			Rollback:           false,
+			Metadata:           map[string]string{},
+			AvailableOperation: sdk.ManualOperationNone,
+		})
+	}
+	if autoRollback {
+		if len(stages) == 0 {
+			return nil, errRollbackRequiresStages
+		}
+		out = append(out, sdk.PipelineStage{
+			Name: StageLambdaRollback,
+			Index: slices.MinFunc(stages, func(a, b sdk.StageConfig) int {
+				return a.Index - b.Index
+			}).Index,
+			Rollback:           true,
+			Metadata:           map[string]string{},
+			AvailableOperation: sdk.ManualOperationNone,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildQuickSyncPipeline(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		rollback bool
+		expected []sdk.QuickSyncStage
+	}{
+		{
+			name:     "without rollback",
+			rollback: false,
+			expected: []sdk.QuickSyncStage{
+				{
+					Name:               StageLambdaSync,
+					Description:        StageLambdaSyncDescription,
+					Rollback:           false,
+					Metadata:           map[string]string{},
+					AvailableOperation: sdk.ManualOperationNone,
+				},
+			},
+		},
+		{
+			name:     "with rollback",
+			rollback: true,
+			expected: []sdk.QuickSyncStage{
+				{
+					Name:               StageLambdaSync,
+					Description:        StageLambdaSyncDescription,
+					Rollback:           false,
+					Metadata:           map[string]string{},
+					AvailableOperation: sdk.ManualOperationNone,
+				},
+				{
+					Name:               StageLambdaRollback,
+					Description:        StageLambdaRollbackDescription,
+					Rollback:           true,
+					Metadata:           map[string]string{},
+					AvailableOperation: sdk.ManualOperationNone,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := buildQuickSyncPipeline(tt.rollback)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_buildPipelineStages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		stages       []sdk.StageConfig
+		autoRollback bool
+		expected     []sdk.PipelineStage
+		expectedErr  bool
+	}{
+		{
+			name: "without auto rollback",
+			stages: []sdk.StageConfig{
+				{Name: StageLambdaCanaryRollout, Index: 0},
+				{Name: StageLambdaPromote, Index: 1},
+			},
+			autoRollback: false,
+			expected: []sdk.PipelineStage{
+				{
+					Name:               StageLambdaCanaryRollout,
+					Index:              0,
+					Rollback:           false,
+					Metadata:           map[string]string{},
+					AvailableOperation: sdk.ManualOperationNone,
+				},
+				{
+					Name:               StageLambdaPromote,
+					Index:              1,
+					Rollback:           false,
+					Metadata:           map[string]string{},
+					AvailableOperation: sdk.ManualOperationNone,
+				},
+			},
+		},
+		{
+			name: "with auto rollback",
+			stages: []sdk.StageConfig{
+				{Name: StageLambdaCanaryRollout, Index: 0},
+				{Name: StageLambdaPromote, Index: 1},
+			},
+			autoRollback: true,
+			expected: []sdk.PipelineStage{
+				{
+					Name:               StageLambdaCanaryRollout,
+					Index:              0,
+					Rollback:           false,
+					Metadata:           map[string]string{},
+					AvailableOperation: sdk.ManualOperationNone,
+				},
+				{
+					Name:               StageLambdaPromote,
+					Index:              1,
+					Rollback:           false,
+					Metadata:           map[string]string{},
+					AvailableOperation: sdk.ManualOperationNone,
+				},
+				{
+					Name:               StageLambdaRollback,
+					Index:              0,
+					Rollback:           true,
+					Metadata:           map[string]string{},
+					AvailableOperation: sdk.ManualOperationNone,
+				},
+			},
+		},
+		{
+			name:         "auto rollback without stages",
+			autoRollback: true,
+			expectedErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := buildPipelineStages(tt.stages, tt.autoRollback)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
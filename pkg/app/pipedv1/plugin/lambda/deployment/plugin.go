@@ -0,0 +1,155 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+	"github.com/pipe-cd/pipecd/pkg/git"
+)
+
+var _ sdk.DeploymentPlugin[config.LambdaPluginConfig, config.LambdaDeployTargetConfig, config.LambdaApplicationSpec] = (*Plugin)(nil)
+
+var (
+	errRollbackRequiresStages = errors.New("rollback requires at least one stage")
+	errNoDeployTarget         = errors.New("no deploy target was specified")
+	errUnsupportedStage       = errors.New("unsupported stage")
+)
+
+type Plugin struct {
+	// gitClient is used to fetch the function source code
+	// when the function is packaged from a remote git repository.
+	gitClient     git.Client
+	gitClientErr  error
+	gitClientOnce sync.Once
+}
+
+// FetchDefinedStages returns the list of stages that the plugin can execute.
+func (p *Plugin) FetchDefinedStages() []string {
+	return allStages
+}
+
+// BuildPipelineSyncStages builds the stages that will be executed by the plugin.
+func (p *Plugin) BuildPipelineSyncStages(ctx context.Context, _ *config.LambdaPluginConfig, input *sdk.BuildPipelineSyncStagesInput) (*sdk.BuildPipelineSyncStagesResponse, error) {
+	stages, err := buildPipelineStages(input.Request.Stages, input.Request.Rollback)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.BuildPipelineSyncStagesResponse{
+		Stages: stages,
+	}, nil
+}
+
+// BuildQuickSyncStages builds the stages that will be executed during the quick sync process.
+func (p *Plugin) BuildQuickSyncStages(ctx context.Context, _ *config.LambdaPluginConfig, input *sdk.BuildQuickSyncStagesInput) (*sdk.BuildQuickSyncStagesResponse, error) {
+	return &sdk.BuildQuickSyncStagesResponse{
+		Stages: buildQuickSyncPipeline(input.Request.Rollback),
+	}, nil
+}
+
+// ExecuteStage executes the given stage.
+func (p *Plugin) ExecuteStage(ctx context.Context, _ *config.LambdaPluginConfig, dts []*sdk.DeployTarget[config.LambdaDeployTargetConfig], input *sdk.ExecuteStageInput[config.LambdaApplicationSpec]) (*sdk.ExecuteStageResponse, error) {
+	if len(dts) == 0 {
+		return nil, errNoDeployTarget
+	}
+
+	switch input.Request.StageName {
+	case StageLambdaSync:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeSyncStage(ctx, input, dts[0]),
+		}, nil
+	case StageLambdaCanaryRollout:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeCanaryRolloutStage(ctx, input, dts[0]),
+		}, nil
+	case StageLambdaPromote:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executePromoteStage(ctx, input, dts[0]),
+		}, nil
+	case StageLambdaRollback:
+		return &sdk.ExecuteStageResponse{
+			Status: p.executeRollbackStage(ctx, input, dts[0]),
+		}, nil
+	default:
+		return nil, errUnsupportedStage
+	}
+}
+
+// DetermineVersions determines the versions of the artifact which will be deployed.
+func (p *Plugin) DetermineVersions(ctx context.Context, _ *config.LambdaPluginConfig, input *sdk.DetermineVersionsInput[config.LambdaApplicationSpec]) (*sdk.DetermineVersionsResponse, error) {
+	fm, err := loadFunctionManifestFromSource(input.Request.DeploymentSource)
+	if err != nil {
+		input.Logger.Error("failed to load function manifest", zap.Error(err))
+		return nil, err
+	}
+
+	versions, err := provider.FindArtifactVersions(fm)
+	if err != nil {
+		input.Logger.Error("failed to determine artifact versions", zap.Error(err))
+		return nil, err
+	}
+
+	return &sdk.DetermineVersionsResponse{
+		Versions: versions,
+	}, nil
+}
+
+// DetermineStrategy determines the strategy to deploy the function.
+//
+// Use QuickSync for the first deployment.
+//
+// Use PipelineSync otherwise.
+func (p *Plugin) DetermineStrategy(ctx context.Context, _ *config.LambdaPluginConfig, input *sdk.DetermineStrategyInput[config.LambdaApplicationSpec]) (*sdk.DetermineStrategyResponse, error) {
+	target, err := loadFunctionManifestFromSource(input.Request.TargetDeploymentSource)
+	if err != nil {
+		input.Logger.Error("failed to load target function manifest", zap.Error(err))
+		return nil, err
+	}
+
+	// This is the first time to deploy this application, so we just do the quick sync.
+	if input.Request.RunningDeploymentSource.CommitHash == "" {
+		return &sdk.DetermineStrategyResponse{
+			Strategy: sdk.SyncStrategyQuickSync,
+			Summary:  fmt.Sprintf("Quick sync to deploy version %s and configure all traffic to it (it seems this is the first deployment)", determineVersion(target)),
+		}, nil
+	}
+
+	running, err := loadFunctionManifestFromSource(input.Request.RunningDeploymentSource)
+	if err != nil {
+		input.Logger.Warn("failed to load running function manifest, falling back to pipeline sync", zap.Error(err))
+		return &sdk.DetermineStrategyResponse{
+			Strategy: sdk.SyncStrategyPipelineSync,
+			Summary:  "Sync with the specified pipeline",
+		}, nil
+	}
+
+	return determineStrategy(running, target), nil
+}
+
+// getGitClient returns the git client shared by all stages executed by this plugin.
+func (p *Plugin) getGitClient(logger *zap.Logger) (git.Client, error) {
+	p.gitClientOnce.Do(func() {
+		p.gitClient, p.gitClientErr = git.NewClient(git.WithLogger(logger))
+	})
+	return p.gitClient, p.gitClientErr
+}
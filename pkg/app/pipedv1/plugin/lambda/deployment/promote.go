@@ -0,0 +1,116 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+func (p *Plugin) executePromoteStage(
+	ctx context.Context,
+	input *sdk.ExecuteStageInput[config.LambdaApplicationSpec],
+	deployTarget *sdk.DeployTarget[config.LambdaDeployTargetConfig],
+) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	client, err := provider.DefaultRegistry().Client(deployTarget.Name, deployTarget.Config)
+	if err != nil {
+		lp.Errorf("Unable to create Lambda client for the deploy target %s: %v", deployTarget.Name, err)
+		return sdk.StageStatusFailure
+	}
+
+	return promote(ctx, client, input.Client, input.Request, lp)
+}
+
+// promote routes the configured percentage of traffic to the version published by the canary rollout stage
+// and the rest to the version which was serving the traffic before.
+func promote(ctx context.Context, client provider.Client, store metadataStore, request sdk.ExecuteStageRequest[config.LambdaApplicationSpec], lp sdk.StageLogPersister) sdk.StageStatus {
+	var options config.LambdaPromoteStageOptions
+	if err := json.Unmarshal(request.StageConfig, &options); err != nil {
+		lp.Errorf("Malformed configuration for stage %s (%v)", request.StageName, err)
+		return sdk.StageStatusFailure
+	}
+	percent := options.Percent.Int()
+
+	metadata := map[string]string{
+		promotePercentageMetadataKey: strconv.FormatInt(int64(percent), 10),
+	}
+	if err := store.PutStageMetadataMulti(ctx, metadata); err != nil {
+		lp.Errorf("Failed to save routing percentages to metadata (%v)", err)
+	}
+
+	fm, ok := loadFunctionManifest(request.TargetDeploymentSource, request.Deployment, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	lp.Infof("Start promote new version of the lambda function: %s", fm.Spec.Name)
+
+	version, ok, err := store.GetDeploymentPluginMetadata(ctx, rolloutVersionKey)
+	if err != nil {
+		lp.Errorf("Unable to prepare version to promote for Lambda function %s: %v", fm.Spec.Name, err)
+		return sdk.StageStatusFailure
+	}
+	if !ok {
+		lp.Errorf("Unable to prepare version to promote for Lambda function %s: Not found", fm.Spec.Name)
+		return sdk.StageStatusFailure
+	}
+
+	trafficCfg, err := client.GetTrafficConfig(ctx, fm)
+	// Create Alias on not yet existed.
+	if errors.Is(err, provider.ErrNotFound) {
+		if percent != 100 {
+			lp.Error("Not previous version available to handle traffic, new version has to get 100 percent of traffic")
+			return sdk.StageStatusFailure
+		}
+		if err := client.CreateTrafficConfig(ctx, fm, version); err != nil {
+			lp.Errorf("Failed to create traffic routing for Lambda function %s (version: %s): %v", fm.Spec.Name, version, err)
+			return sdk.StageStatusFailure
+		}
+		lp.Successf("Successfully route all traffic to the lambda function %s (version %s)", fm.Spec.Name, version)
+		return sdk.StageStatusSuccess
+	}
+	if err != nil {
+		lp.Errorf("Failed to prepare traffic routing for Lambda function %s: %v", fm.Spec.Name, err)
+		return sdk.StageStatusFailure
+	}
+
+	// Update traffic to the new lambda version.
+	if !configureTrafficRouting(trafficCfg, version, percent) {
+		lp.Errorf("Failed to prepare traffic routing for Lambda function %s", fm.Spec.Name)
+		return sdk.StageStatusFailure
+	}
+
+	// Store promote traffic config for rollback if necessary.
+	if !storeTrafficConfig(ctx, store, promoteTrafficKey, trafficCfg, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	if err := client.UpdateTrafficConfig(ctx, fm, trafficCfg); err != nil {
+		lp.Errorf("Failed to update traffic routing for Lambda function %s (version: %s): %v", fm.Spec.Name, version, err)
+		return sdk.StageStatusFailure
+	}
+
+	lp.Successf("Successfully promote new version (v%s) of Lambda function %s, it will handle %d percent of traffic", version, fm.Spec.Name, percent)
+	return sdk.StageStatusSuccess
+}
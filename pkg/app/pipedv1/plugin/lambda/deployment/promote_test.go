@@ -0,0 +1,120 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister/logpersistertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+func TestRolloutAndPromote(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rollout publishes the new version without routing traffic", func(t *testing.T) {
+		t.Parallel()
+
+		client := newFakeClient()
+		store := newFakeMetadataStore()
+
+		running := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		require.Equal(t, sdk.StageStatusSuccess, syncFunction(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaSync, nil, sdk.DeploymentSource[config.LambdaApplicationSpec]{}, running), logpersistertest.NewTestLogPersister(t)))
+
+		target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+		status := rollout(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaCanaryRollout, nil, running, target), logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+
+		assert.Equal(t, "2", store.deployment[rolloutVersionKey])
+		assert.Equal(t, provider.RoutingTrafficConfig{
+			provider.TrafficPrimaryVersionKeyName: {Version: "1", Percent: 100},
+		}, client.aliases["SimpleFunction"])
+		assert.Contains(t, store.deployment, originalTrafficKey)
+	})
+
+	t.Run("promote splits the traffic between the new and the old versions", func(t *testing.T) {
+		t.Parallel()
+
+		client := newFakeClient()
+		store := newFakeMetadataStore()
+
+		running := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		require.Equal(t, sdk.StageStatusSuccess, syncFunction(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaSync, nil, sdk.DeploymentSource[config.LambdaApplicationSpec]{}, running), logpersistertest.NewTestLogPersister(t)))
+
+		target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+		require.Equal(t, sdk.StageStatusSuccess, rollout(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaCanaryRollout, nil, running, target), logpersistertest.NewTestLogPersister(t)))
+
+		status := promote(t.Context(), client, store, newTestRequest(t, StageLambdaPromote, []byte(`{"percent": 30}`), running, target), logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+
+		expected := provider.RoutingTrafficConfig{
+			provider.TrafficPrimaryVersionKeyName:   {Version: "2", Percent: 30},
+			provider.TrafficSecondaryVersionKeyName: {Version: "1", Percent: 70},
+		}
+		assert.Equal(t, expected, client.aliases["SimpleFunction"])
+		assert.Equal(t, "30", store.stage[promotePercentageMetadataKey])
+
+		promoted, ok, err := loadTrafficConfig(t.Context(), store, promoteTrafficKey)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, expected, promoted)
+
+		status = promote(t.Context(), client, store, newTestRequest(t, StageLambdaPromote, []byte(`{"percent": "100%"}`), running, target), logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+		assert.Equal(t, provider.RoutingTrafficConfig{
+			provider.TrafficPrimaryVersionKeyName:   {Version: "2", Percent: 100},
+			provider.TrafficSecondaryVersionKeyName: {Version: "1", Percent: 0},
+		}, client.aliases["SimpleFunction"])
+	})
+
+	t.Run("promote requires all traffic when there is no previous version", func(t *testing.T) {
+		t.Parallel()
+
+		target := newTestDeploymentSource(t, "v1", "1111111aaaa")
+
+		testcases := []struct {
+			name     string
+			percent  string
+			expected sdk.StageStatus
+		}{
+			{name: "partial traffic", percent: "50", expected: sdk.StageStatusFailure},
+			{name: "all traffic", percent: "100", expected: sdk.StageStatusSuccess},
+		}
+		for _, tc := range testcases {
+			client := newFakeClient()
+			store := newFakeMetadataStore()
+			request := newTestRequest(t, StageLambdaCanaryRollout, nil, sdk.DeploymentSource[config.LambdaApplicationSpec]{}, target)
+			require.Equal(t, sdk.StageStatusSuccess, rollout(t.Context(), client, &fakeGitClient{}, store, request, logpersistertest.NewTestLogPersister(t)), tc.name)
+
+			request = newTestRequest(t, StageLambdaPromote, []byte(`{"percent": `+tc.percent+`}`), sdk.DeploymentSource[config.LambdaApplicationSpec]{}, target)
+			assert.Equal(t, tc.expected, promote(t.Context(), client, store, request, logpersistertest.NewTestLogPersister(t)), tc.name)
+		}
+	})
+
+	t.Run("promote fails without the rolled out version", func(t *testing.T) {
+		t.Parallel()
+
+		target := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		request := newTestRequest(t, StageLambdaPromote, []byte(`{"percent": 100}`), sdk.DeploymentSource[config.LambdaApplicationSpec]{}, target)
+
+		status := promote(t.Context(), newFakeClient(), newFakeMetadataStore(), request, logpersistertest.NewTestLogPersister(t))
+		assert.Equal(t, sdk.StageStatusFailure, status)
+	})
+}
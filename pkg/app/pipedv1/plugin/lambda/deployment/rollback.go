@@ -0,0 +1,144 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+func (p *Plugin) executeRollbackStage(
+	ctx context.Context,
+	input *sdk.ExecuteStageInput[config.LambdaApplicationSpec],
+	deployTarget *sdk.DeployTarget[config.LambdaDeployTargetConfig],
+) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	client, err := provider.DefaultRegistry().Client(deployTarget.Name, deployTarget.Config)
+	if err != nil {
+		lp.Errorf("Unable to create Lambda client for the deploy target %s: %v", deployTarget.Name, err)
+		return sdk.StageStatusFailure
+	}
+
+	gc, err := p.getGitClient(input.Logger)
+	if err != nil {
+		lp.Errorf("Unable to create git client: %v", err)
+		return sdk.StageStatusFailure
+	}
+
+	return rollback(ctx, client, gc, input.Client, input.Request, lp)
+}
+
+// rollback reverts the function configuration to the running commit
+// and restores the traffic config stored before the deployment changed it.
+func rollback(ctx context.Context, client provider.Client, gc gitClient, store metadataStore, request sdk.ExecuteStageRequest[config.LambdaApplicationSpec], lp sdk.StageLogPersister) sdk.StageStatus {
+	// Not rollback in case this is the first deployment.
+	runningDS := request.RunningDeploymentSource
+	if runningDS.CommitHash == "" {
+		lp.Error("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return sdk.StageStatusFailure
+	}
+
+	fm, ok := loadFunctionManifest(runningDS, request.Deployment, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	lp.Infof("Start rollback the lambda function: %s to original stage", fm.Spec.Name)
+
+	// Rollback Lambda application configuration to previous state.
+	if err := updateFunction(ctx, client, gc, fm, lp); err != nil {
+		lp.Errorf("Unable to rollback Lambda function %s configuration to previous stage: %v", fm.Spec.Name, err)
+		return sdk.StageStatusFailure
+	}
+	lp.Infof("Rolled back the lambda function %s configuration to original stage", fm.Spec.Name)
+
+	// Rollback traffic routing to previous state.
+	// Restore original traffic config from metadata store.
+	originalTrafficCfg, ok, err := loadTrafficConfig(ctx, store, originalTrafficKey)
+	if err != nil {
+		lp.Errorf("Unable to prepare original traffic config to rollback Lambda function %s: %v", fm.Spec.Name, err)
+		return sdk.StageStatusFailure
+	}
+	if !ok {
+		lp.Errorf("Unable to prepare original traffic config to rollback Lambda function %s. No traffic changes have been committed yet.", fm.Spec.Name)
+		return sdk.StageStatusFailure
+	}
+
+	// Restore promoted traffic config from metadata store.
+	promotedTrafficCfg, ok, err := loadTrafficConfig(ctx, store, promoteTrafficKey)
+	if err != nil {
+		lp.Errorf("Unable to prepare promoted traffic config to rollback Lambda function %s: %v", fm.Spec.Name, err)
+		return sdk.StageStatusFailure
+	}
+	// If there is no previous promoted traffic config, which mean no promote run previously so no need to do anything to rollback.
+	if !ok {
+		lp.Info("It seems the traffic has not been changed during the deployment process. No need to rollback the traffic config.")
+		lp.Successf("Successfully rolled back the lambda function %s", fm.Spec.Name)
+		return sdk.StageStatusSuccess
+	}
+
+	switch len(originalTrafficCfg) {
+	// Original traffic config has both PRIMARY and SECONDARY version config.
+	case 2:
+		if err := client.UpdateTrafficConfig(ctx, fm, originalTrafficCfg); err != nil {
+			lp.Errorf("Failed to rollback original traffic config for Lambda function %s: %v", fm.Spec.Name, err)
+			return sdk.StageStatusFailure
+		}
+	// Original traffic config is PRIMARY ONLY config,
+	// we need to reset any others SECONDARY created by previous (until failed) PROMOTE stages.
+	case 1:
+		// Validate stored original traffic config, since it PRIMARY ONLY, the percent must be float64(100)
+		primary, ok := originalTrafficCfg[provider.TrafficPrimaryVersionKeyName]
+		if !ok || primary.Percent != float64(100) {
+			lp.Error("Unable to prepare original traffic config: invalid original traffic config stored")
+			return sdk.StageStatusFailure
+		}
+
+		// Update promoted traffic config by add 0% SECONDARY for reset remote promoted version config.
+		if !configureTrafficRouting(promotedTrafficCfg, primary.Version, 100) {
+			lp.Errorf("Unable to prepare traffic config to rollback Lambda function %s: can not reset promoted version", fm.Spec.Name)
+			return sdk.StageStatusFailure
+		}
+
+		if err := client.UpdateTrafficConfig(ctx, fm, promotedTrafficCfg); err != nil {
+			lp.Errorf("Failed to rollback original traffic config for Lambda function %s: %v", fm.Spec.Name, err)
+			return sdk.StageStatusFailure
+		}
+	default:
+		lp.Error("Unable to prepare original traffic config: invalid original traffic config stored")
+		return sdk.StageStatusFailure
+	}
+
+	lp.Successf("Successfully rolled back the lambda function %s", fm.Spec.Name)
+	return sdk.StageStatusSuccess
+}
+
+func loadTrafficConfig(ctx context.Context, store metadataStore, key string) (provider.RoutingTrafficConfig, bool, error) {
+	data, ok, err := store.GetDeploymentPluginMetadata(ctx, key)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+
+	cfg := provider.RoutingTrafficConfig{}
+	if err := cfg.Decode([]byte(data)); err != nil {
+		return nil, false, err
+	}
+	return cfg, true, nil
+}
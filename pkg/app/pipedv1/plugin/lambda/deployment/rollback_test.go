@@ -0,0 +1,107 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister/logpersistertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+func TestRollback(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fail on the first deployment", func(t *testing.T) {
+		t.Parallel()
+
+		target := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		request := newTestRequest(t, StageLambdaRollback, nil, sdk.DeploymentSource[config.LambdaApplicationSpec]{}, target)
+
+		status := rollback(t.Context(), newFakeClient(), &fakeGitClient{}, newFakeMetadataStore(), request, logpersistertest.NewTestLogPersister(t))
+		assert.Equal(t, sdk.StageStatusFailure, status)
+	})
+
+	t.Run("restore the traffic after promotion", func(t *testing.T) {
+		t.Parallel()
+
+		client := newFakeClient()
+		store := newFakeMetadataStore()
+
+		running := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		require.Equal(t, sdk.StageStatusSuccess, syncFunction(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaSync, nil, sdk.DeploymentSource[config.LambdaApplicationSpec]{}, running), logpersistertest.NewTestLogPersister(t)))
+
+		target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+		require.Equal(t, sdk.StageStatusSuccess, rollout(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaCanaryRollout, nil, running, target), logpersistertest.NewTestLogPersister(t)))
+		require.Equal(t, sdk.StageStatusSuccess, promote(t.Context(), client, store, newTestRequest(t, StageLambdaPromote, []byte(`{"percent": 30}`), running, target), logpersistertest.NewTestLogPersister(t)))
+
+		status := rollback(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaRollback, nil, running, target), logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+
+		assert.Equal(t, int32(512), client.functions["SimpleFunction"].Spec.Memory)
+		assert.Equal(t, provider.RoutingTrafficConfig{
+			provider.TrafficPrimaryVersionKeyName:   {Version: "1", Percent: 100},
+			provider.TrafficSecondaryVersionKeyName: {Version: "2", Percent: 0},
+		}, client.aliases["SimpleFunction"])
+	})
+
+	t.Run("restore the original primary and secondary versions", func(t *testing.T) {
+		t.Parallel()
+
+		client := newFakeClient()
+		store := newFakeMetadataStore()
+
+		running := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		require.Equal(t, sdk.StageStatusSuccess, syncFunction(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaSync, nil, sdk.DeploymentSource[config.LambdaApplicationSpec]{}, running), logpersistertest.NewTestLogPersister(t)))
+
+		original := provider.RoutingTrafficConfig{
+			provider.TrafficPrimaryVersionKeyName:   {Version: "1", Percent: 80},
+			provider.TrafficSecondaryVersionKeyName: {Version: "0", Percent: 20},
+		}
+		client.aliases["SimpleFunction"] = original
+
+		target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+		require.Equal(t, sdk.StageStatusSuccess, rollout(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaCanaryRollout, nil, running, target), logpersistertest.NewTestLogPersister(t)))
+		require.Equal(t, sdk.StageStatusSuccess, promote(t.Context(), client, store, newTestRequest(t, StageLambdaPromote, []byte(`{"percent": 100}`), running, target), logpersistertest.NewTestLogPersister(t)))
+
+		status := rollback(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaRollback, nil, running, target), logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+		assert.Equal(t, original, client.aliases["SimpleFunction"])
+	})
+
+	t.Run("nothing to restore when the traffic was not promoted", func(t *testing.T) {
+		t.Parallel()
+
+		client := newFakeClient()
+		store := newFakeMetadataStore()
+
+		running := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		require.Equal(t, sdk.StageStatusSuccess, syncFunction(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaSync, nil, sdk.DeploymentSource[config.LambdaApplicationSpec]{}, running), logpersistertest.NewTestLogPersister(t)))
+
+		target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+		require.Equal(t, sdk.StageStatusSuccess, rollout(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaCanaryRollout, nil, running, target), logpersistertest.NewTestLogPersister(t)))
+
+		status := rollback(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaRollback, nil, running, target), logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+		assert.Equal(t, provider.RoutingTrafficConfig{
+			provider.TrafficPrimaryVersionKeyName: {Version: "1", Percent: 100},
+		}, client.aliases["SimpleFunction"])
+	})
+}
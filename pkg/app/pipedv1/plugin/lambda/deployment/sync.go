@@ -0,0 +1,98 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+func (p *Plugin) executeSyncStage(
+	ctx context.Context,
+	input *sdk.ExecuteStageInput[config.LambdaApplicationSpec],
+	deployTarget *sdk.DeployTarget[config.LambdaDeployTargetConfig],
+) sdk.StageStatus {
+	lp := input.Client.LogPersister()
+
+	client, err := provider.DefaultRegistry().Client(deployTarget.Name, deployTarget.Config)
+	if err != nil {
+		lp.Errorf("Unable to create Lambda client for the deploy target %s: %v", deployTarget.Name, err)
+		return sdk.StageStatusFailure
+	}
+
+	gc, err := p.getGitClient(input.Logger)
+	if err != nil {
+		lp.Errorf("Unable to create git client: %v", err)
+		return sdk.StageStatusFailure
+	}
+
+	return syncFunction(ctx, client, gc, input.Client, input.Request, lp)
+}
+
+// syncFunction publishes a new version of the function at the target commit and routes all traffic to it.
+func syncFunction(ctx context.Context, client provider.Client, gc gitClient, store metadataStore, request sdk.ExecuteStageRequest[config.LambdaApplicationSpec], lp sdk.StageLogPersister) sdk.StageStatus {
+	fm, ok := loadFunctionManifest(request.TargetDeploymentSource, request.Deployment, lp)
+	if !ok {
+		return sdk.StageStatusFailure
+	}
+
+	lp.Info("Start applying the lambda function manifest")
+
+	// Build and publish new version of Lambda function.
+	version, ok := build(ctx, client, gc, fm, lp)
+	if !ok {
+		lp.Errorf("Failed to build new version for Lambda function %s", fm.Spec.Name)
+		return sdk.StageStatusFailure
+	}
+
+	trafficCfg, err := client.GetTrafficConfig(ctx, fm)
+	// Create Alias on not yet existed.
+	if errors.Is(err, provider.ErrNotFound) {
+		if err := client.CreateTrafficConfig(ctx, fm, version); err != nil {
+			lp.Errorf("Failed to create traffic routing for Lambda function %s (version: %s): %v", fm.Spec.Name, version, err)
+			return sdk.StageStatusFailure
+		}
+		lp.Successf("Successfully applied the manifest for Lambda function %s version (v%s)", fm.Spec.Name, version)
+		return sdk.StageStatusSuccess
+	}
+	if err != nil {
+		lp.Errorf("Failed to prepare traffic routing for Lambda function %s: %v", fm.Spec.Name, err)
+		return sdk.StageStatusFailure
+	}
+
+	// Store the current traffic config for rollback if necessary.
+	if !storeTrafficConfig(ctx, store, originalTrafficKey, trafficCfg, lp) {
+		return sdk.StageStatusFailure
+	}
+
+	// Update 100% traffic to the new lambda version.
+	if !configureTrafficRouting(trafficCfg, version, 100) {
+		lp.Errorf("Failed to prepare traffic routing for Lambda function %s", fm.Spec.Name)
+		return sdk.StageStatusFailure
+	}
+
+	if err := client.UpdateTrafficConfig(ctx, fm, trafficCfg); err != nil {
+		lp.Errorf("Failed to update traffic routing for Lambda function %s (version: %s): %v", fm.Spec.Name, version, err)
+		return sdk.StageStatusFailure
+	}
+
+	lp.Successf("Successfully applied the manifest for Lambda function %s version (v%s)", fm.Spec.Name, version)
+	return sdk.StageStatusSuccess
+}
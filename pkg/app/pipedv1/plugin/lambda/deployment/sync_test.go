@@ -0,0 +1,101 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/pipe-cd/piped-plugin-sdk-go/logpersister/logpersistertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+func TestSyncFunction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create the function and its alias on the first deployment", func(t *testing.T) {
+		t.Parallel()
+
+		client := newFakeClient()
+		store := newFakeMetadataStore()
+
+		target := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		request := newTestRequest(t, StageLambdaSync, nil, sdk.DeploymentSource[config.LambdaApplicationSpec]{}, target)
+
+		status := syncFunction(t.Context(), client, &fakeGitClient{}, store, request, logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+
+		fm, ok := client.functions["SimpleFunction"]
+		require.True(t, ok)
+		assert.Equal(t, "ecr.ap-northeast-1.amazonaws.com/lambda-simple-function:v0.0.1", fm.Spec.ImageURI)
+		assert.Equal(t, map[string]string{
+			"app":                     "simple",
+			provider.LabelManagedBy:   provider.ManagedByLambdaPlugin,
+			provider.LabelPiped:       "piped-id",
+			provider.LabelApplication: "app-id",
+			provider.LabelCommitHash:  "1111111aaaa",
+		}, fm.Spec.Tags)
+		assert.Equal(t, provider.RoutingTrafficConfig{
+			provider.TrafficPrimaryVersionKeyName: {Version: "1", Percent: 100},
+		}, client.aliases["SimpleFunction"])
+		assert.NotContains(t, store.deployment, originalTrafficKey)
+	})
+
+	t.Run("switch all traffic to the new version", func(t *testing.T) {
+		t.Parallel()
+
+		client := newFakeClient()
+		store := newFakeMetadataStore()
+
+		running := newTestDeploymentSource(t, "v1", "1111111aaaa")
+		require.Equal(t, sdk.StageStatusSuccess, syncFunction(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaSync, nil, sdk.DeploymentSource[config.LambdaApplicationSpec]{}, running), logpersistertest.NewTestLogPersister(t)))
+
+		target := newTestDeploymentSource(t, "v2", "2222222bbbb")
+		status := syncFunction(t.Context(), client, &fakeGitClient{}, store, newTestRequest(t, StageLambdaSync, nil, running, target), logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+
+		assert.Equal(t, int32(1024), client.functions["SimpleFunction"].Spec.Memory)
+		assert.Equal(t, provider.RoutingTrafficConfig{
+			provider.TrafficPrimaryVersionKeyName:   {Version: "2", Percent: 100},
+			provider.TrafficSecondaryVersionKeyName: {Version: "1", Percent: 0},
+		}, client.aliases["SimpleFunction"])
+
+		original, ok, err := loadTrafficConfig(t.Context(), store, originalTrafficKey)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, provider.RoutingTrafficConfig{
+			provider.TrafficPrimaryVersionKeyName: {Version: "1", Percent: 100},
+		}, original)
+	})
+
+	t.Run("package the function from the source code", func(t *testing.T) {
+		t.Parallel()
+
+		client := newFakeClient()
+		gc := &fakeGitClient{dir: filepath.Join("testdata", "repo")}
+
+		target := newTestDeploymentSource(t, "source", "1111111aaaa")
+		status := syncFunction(t.Context(), client, gc, newFakeMetadataStore(), newTestRequest(t, StageLambdaSync, nil, sdk.DeploymentSource[config.LambdaApplicationSpec]{}, target), logpersistertest.NewTestLogPersister(t))
+		require.Equal(t, sdk.StageStatusSuccess, status)
+
+		assert.Equal(t, []string{"dede7cdea5bbd3fdbcc4674bfcd2b2f9e0579603"}, gc.checkouts)
+		assert.NotEmpty(t, client.zips["SourceCodeFunction"])
+	})
+}
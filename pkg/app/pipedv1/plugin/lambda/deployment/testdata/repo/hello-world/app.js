@@ -0,0 +1 @@
+exports.lambdaHandler = async () => "hello";
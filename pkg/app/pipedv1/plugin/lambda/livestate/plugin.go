@@ -0,0 +1,98 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livestate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+var _ sdk.LivestatePlugin[config.LambdaPluginConfig, config.LambdaDeployTargetConfig, config.LambdaApplicationSpec] = (*Plugin)(nil)
+
+type Plugin struct{}
+
+// GetLivestate returns the live state of the function
+// and whether the function is in sync with the manifest declared in Git.
+func (p *Plugin) GetLivestate(ctx context.Context, _ *config.LambdaPluginConfig, dts []*sdk.DeployTarget[config.LambdaDeployTargetConfig], input *sdk.GetLivestateInput[config.LambdaApplicationSpec]) (*sdk.GetLivestateResponse, error) {
+	if len(dts) == 0 {
+		return nil, errors.New("no deploy target was specified")
+	}
+	dt := dts[0]
+
+	client, err := provider.DefaultRegistry().Client(dt.Name, dt.Config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Lambda client for the deploy target %s: %w", dt.Name, err)
+	}
+
+	return getLivestate(ctx, client, dt.Name, input.Request)
+}
+
+func getLivestate(ctx context.Context, client provider.Client, deployTarget string, request sdk.GetLivestateRequest[config.LambdaApplicationSpec]) (*sdk.GetLivestateResponse, error) {
+	appCfg, err := request.DeploymentSource.AppConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app config: %w", err)
+	}
+
+	fm, err := provider.LoadFunctionManifest(request.DeploymentSource.ApplicationDirectory, appCfg.Spec.Input.FunctionManifestFile)
+	if err != nil {
+		return &sdk.GetLivestateResponse{
+			SyncState: sdk.ApplicationSyncState{
+				Status:      sdk.ApplicationSyncStateInvalidConfig,
+				ShortReason: "Unable to load the function manifest",
+				Reason:      err.Error(),
+			},
+		}, nil
+	}
+
+	f, err := client.GetFunction(ctx, fm.Spec.Name)
+	if errors.Is(err, provider.ErrNotFound) {
+		return &sdk.GetLivestateResponse{
+			SyncState: sdk.ApplicationSyncState{
+				Status:      sdk.ApplicationSyncStateOutOfSync,
+				ShortReason: fmt.Sprintf("Function %s was not found", fm.Spec.Name),
+			},
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The traffic config is only used to show which versions are serving the traffic,
+	// so the function state is still reported even if it could not be fetched.
+	trafficCfg, err := client.GetTrafficConfig(ctx, fm)
+	if err != nil && !errors.Is(err, provider.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get traffic config of function %s: %w", fm.Spec.Name, err)
+	}
+
+	syncState, err := calculateSyncState(provider.MakeFunctionManifest(f), fm, request.DeploymentSource.CommitHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.GetLivestateResponse{
+		LiveState: sdk.ApplicationLiveState{
+			Resources: []sdk.ResourceState{
+				provider.MakeFunctionResourceState(f, trafficCfg, deployTarget),
+			},
+		},
+		SyncState: syncState,
+	}, nil
+}
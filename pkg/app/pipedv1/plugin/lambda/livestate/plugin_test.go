@@ -0,0 +1,170 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livestate
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+)
+
+// fakeClient implements only the methods of provider.Client used to get the live state.
+type fakeClient struct {
+	provider.Client
+	function *lambda.GetFunctionOutput
+	traffic  provider.RoutingTrafficConfig
+}
+
+func (c *fakeClient) GetFunction(_ context.Context, _ string) (*lambda.GetFunctionOutput, error) {
+	if c.function == nil {
+		return nil, provider.ErrNotFound
+	}
+	return c.function, nil
+}
+
+func (c *fakeClient) GetTrafficConfig(_ context.Context, _ provider.FunctionManifest) (provider.RoutingTrafficConfig, error) {
+	if c.traffic == nil {
+		return nil, provider.ErrNotFound
+	}
+	return c.traffic, nil
+}
+
+func newTestRequest(t *testing.T) sdk.GetLivestateRequest[config.LambdaApplicationSpec] {
+	t.Helper()
+
+	appDir := filepath.Join("testdata", "app")
+	return sdk.GetLivestateRequest[config.LambdaApplicationSpec]{
+		PipedID:         "piped-id",
+		ApplicationID:   "app-id",
+		ApplicationName: "SimpleFunction",
+		DeploymentSource: sdk.DeploymentSource[config.LambdaApplicationSpec]{
+			ApplicationDirectory:      appDir,
+			CommitHash:                "1111111aaaa",
+			ApplicationConfig:         sdk.LoadApplicationConfigForTest[config.LambdaApplicationSpec](t, filepath.Join(appDir, "app.pipecd.yaml"), "lambda"),
+			ApplicationConfigFilename: "app.pipecd.yaml",
+		},
+	}
+}
+
+func makeFunction(image string, memory int32) *lambda.GetFunctionOutput {
+	return &lambda.GetFunctionOutput{
+		Configuration: &types.FunctionConfiguration{
+			FunctionArn:  aws.String("arn:aws:lambda:ap-northeast-1:123456789012:function:SimpleFunction"),
+			FunctionName: aws.String("SimpleFunction"),
+			Role:         aws.String("arn:aws:iam::123456789012:role/lambda-role"),
+			MemorySize:   aws.Int32(memory),
+			Timeout:      aws.Int32(30),
+			State:        types.StateActive,
+		},
+		Code: &types.FunctionCodeLocation{
+			ImageUri: aws.String(image),
+		},
+		Tags: map[string]string{
+			"app":                    "simple",
+			provider.LabelCommitHash: "1111111aaaa",
+		},
+	}
+}
+
+func TestGetLivestate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name            string
+		client          *fakeClient
+		wantStatus      sdk.ApplicationSyncStatus
+		wantResources   int
+		wantShortReason string
+	}{
+		{
+			name:            "function not found",
+			client:          &fakeClient{},
+			wantStatus:      sdk.ApplicationSyncStateOutOfSync,
+			wantShortReason: "Function SimpleFunction was not found",
+		},
+		{
+			name: "synced",
+			client: &fakeClient{
+				function: makeFunction("ecr.ap-northeast-1.amazonaws.com/lambda-simple-function:v0.0.1", 512),
+				traffic: provider.RoutingTrafficConfig{
+					provider.TrafficPrimaryVersionKeyName: {Version: "1", Percent: 100},
+				},
+			},
+			wantStatus:    sdk.ApplicationSyncStateSynced,
+			wantResources: 1,
+		},
+		{
+			name: "out of sync",
+			client: &fakeClient{
+				function: makeFunction("ecr.ap-northeast-1.amazonaws.com/lambda-simple-function:v0.0.0", 256),
+			},
+			wantStatus:      sdk.ApplicationSyncStateOutOfSync,
+			wantResources:   1,
+			wantShortReason: "The function manifest doesn't be synced",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp, err := getLivestate(t.Context(), tc.client, "default", newTestRequest(t))
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, resp.SyncState.Status)
+			assert.Equal(t, tc.wantShortReason, resp.SyncState.ShortReason)
+			assert.Len(t, resp.LiveState.Resources, tc.wantResources)
+		})
+	}
+}
+
+func TestGetLivestate_ResourceState(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeClient{
+		function: makeFunction("ecr.ap-northeast-1.amazonaws.com/lambda-simple-function:v0.0.1", 512),
+		traffic: provider.RoutingTrafficConfig{
+			provider.TrafficPrimaryVersionKeyName:   {Version: "2", Percent: 30},
+			provider.TrafficSecondaryVersionKeyName: {Version: "1", Percent: 70},
+		},
+	}
+
+	resp, err := getLivestate(t.Context(), client, "default", newTestRequest(t))
+	require.NoError(t, err)
+	require.Len(t, resp.LiveState.Resources, 1)
+
+	r := resp.LiveState.Resources[0]
+	assert.Equal(t, "arn:aws:lambda:ap-northeast-1:123456789012:function:SimpleFunction", r.ID)
+	assert.Equal(t, "SimpleFunction", r.Name)
+	assert.Equal(t, provider.ResourceTypeFunction, r.ResourceType)
+	assert.Equal(t, sdk.ResourceHealthStateHealthy, r.HealthStatus)
+	assert.Equal(t, "default", r.DeployTarget)
+	assert.Equal(t, map[string]string{
+		"state":     "Active",
+		"runtime":   "",
+		"commit":    "1111111aaaa",
+		"primary":   "version 2: 30%",
+		"secondary": "version 1: 70%",
+	}, r.ResourceMetadata)
+}
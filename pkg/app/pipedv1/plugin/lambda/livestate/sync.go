@@ -0,0 +1,116 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package livestate
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+// calculateSyncState compares the live function manifest with the one declared in Git.
+func calculateSyncState(live, head provider.FunctionManifest, commit string) (sdk.ApplicationSyncState, error) {
+	head.Spec = ignoreAndSortParameters(head.Spec)
+
+	// WithIgnoreAddingMapKeys option ignores all of followings:
+	//  - default value of Architecture
+	//  - default value of EphemeralStorage
+	//  - environments added in live states
+	//  - tags added in live states, including pipecd managed tags
+	result, err := provider.Diff(
+		live,
+		head,
+		diff.WithEquateEmpty(),
+		diff.WithIgnoreAddingMapKeys(),
+		diff.WithCompareNumberAndNumericString(),
+	)
+	if err != nil {
+		return sdk.ApplicationSyncState{}, fmt.Errorf("failed to compare the function manifests: %w", err)
+	}
+
+	return makeSyncState(result, commit), nil
+}
+
+// ignoreAndSortParameters removes parameters which cannot be compared and sorts specific parameters.
+// ignores:
+//   - SourceCode in headSpec
+//   - S3Bucket, S3Key, and S3ObjectVersion in headSpec
+//
+// sorts: (Lambda sorts them in liveSpec)
+//   - Architectures in headSpec
+//   - SubnetIDs in headSpec
+func ignoreAndSortParameters(headSpec provider.FunctionManifestSpec) provider.FunctionManifestSpec {
+	cloneSpec := headSpec
+	// We cannot compare SourceCode and S3 packaging because live states do not have them.
+	cloneSpec.SourceCode = provider.SourceCode{}
+	cloneSpec.S3Bucket = ""
+	cloneSpec.S3Key = ""
+	cloneSpec.S3ObjectVersion = ""
+
+	// Architectures, Environments, SubnetIDs, and Tags are sorted in live states.
+	if len(headSpec.Architectures) > 1 {
+		cloneSpec.Architectures = slices.Clone(headSpec.Architectures)
+		sort.Slice(cloneSpec.Architectures, func(i, j int) bool {
+			return strings.Compare(cloneSpec.Architectures[i].Name, cloneSpec.Architectures[j].Name) < 0
+		})
+	}
+	if headSpec.VPCConfig != nil && len(headSpec.VPCConfig.SubnetIDs) > 1 {
+		cloneSubnets := slices.Clone(headSpec.VPCConfig.SubnetIDs)
+		slices.Sort(cloneSubnets)
+		cloneSpec.VPCConfig = &provider.VPCConfig{
+			SecurityGroupIDs: headSpec.VPCConfig.SecurityGroupIDs,
+			SubnetIDs:        cloneSubnets,
+		}
+	}
+
+	return cloneSpec
+}
+
+func makeSyncState(r *provider.DiffResult, commit string) sdk.ApplicationSyncState {
+	if r.NoChange() {
+		return sdk.ApplicationSyncState{
+			Status: sdk.ApplicationSyncStateSynced,
+		}
+	}
+
+	shortReason := "The function manifest doesn't be synced"
+	if len(commit) >= 7 {
+		commit = commit[:7]
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Diff between the defined state in Git at commit %s and actual live state:\n\n", commit)
+	b.WriteString("--- Actual   (LiveState)\n+++ Expected (Git)\n\n")
+
+	details := r.Render(provider.DiffRenderOptions{
+		// Currently, we do not use the diff command to render the result
+		// because Lambda adds a large number of default values to the
+		// running manifest that causes a wrong diff text.
+		UseDiffCommand: false,
+	})
+	b.WriteString(details)
+
+	return sdk.ApplicationSyncState{
+		Status:      sdk.ApplicationSyncStateOutOfSync,
+		ShortReason: shortReason,
+		Reason:      b.String(),
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/provider"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+var _ sdk.PlanPreviewPlugin[config.LambdaPluginConfig, config.LambdaDeployTargetConfig, config.LambdaApplicationSpec] = (*Plugin)(nil)
+
+// Plugin implements the PlanPreview feature for the Lambda plugin.
+type Plugin struct{}
+
+// GetPlanPreview returns the changes of the function manifest between the running and the target commits.
+func (p *Plugin) GetPlanPreview(ctx context.Context, _ *config.LambdaPluginConfig, dts []*sdk.DeployTarget[config.LambdaDeployTargetConfig], input *sdk.GetPlanPreviewInput[config.LambdaApplicationSpec]) (*sdk.GetPlanPreviewResponse, error) {
+	if len(dts) == 0 {
+		return nil, errors.New("no deploy target was specified")
+	}
+
+	newManifest, err := loadFunctionManifest(input.Request.TargetDeploymentSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load lambda manifest at the head commit: %w", err)
+	}
+
+	if input.Request.RunningDeploymentSource.CommitHash == "" {
+		return nil, errors.New("cannot get the old manifest without the last successful deployment")
+	}
+
+	oldManifest, err := loadFunctionManifest(input.Request.RunningDeploymentSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load lambda manifest at the running commit: %w", err)
+	}
+
+	result, err := provider.Diff(
+		oldManifest,
+		newManifest,
+		diff.WithEquateEmpty(),
+		diff.WithCompareNumberAndNumericString(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare manifest: %w", err)
+	}
+
+	return toResponse(dts[0].Name, result), nil
+}
+
+func loadFunctionManifest(ds sdk.DeploymentSource[config.LambdaApplicationSpec]) (provider.FunctionManifest, error) {
+	appCfg, err := ds.AppConfig()
+	if err != nil {
+		return provider.FunctionManifest{}, err
+	}
+	return provider.LoadFunctionManifest(ds.ApplicationDirectory, appCfg.Spec.Input.FunctionManifestFile)
+}
+
+func toResponse(deployTarget string, result *provider.DiffResult) *sdk.GetPlanPreviewResponse {
+	if result.NoChange() {
+		return &sdk.GetPlanPreviewResponse{
+			Results: []sdk.PlanPreviewResult{
+				{
+					DeployTarget: deployTarget,
+					NoChange:     true,
+					Summary:      "No changes were detected",
+				},
+			},
+		}
+	}
+
+	details := result.Render(provider.DiffRenderOptions{
+		UseDiffCommand: true,
+	})
+
+	return &sdk.GetPlanPreviewResponse{
+		Results: []sdk.PlanPreviewResult{
+			{
+				DeployTarget: deployTarget,
+				Summary:      fmt.Sprintf("%d changes were detected", len(result.Diff.Nodes())),
+				Details:      []byte(details),
+				DiffLanguage: "diff",
+			},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/pipedv1/plugin/lambda/config"
+)
+
+func newTestDeploymentSource(t *testing.T, dir, commit string) sdk.DeploymentSource[config.LambdaApplicationSpec] {
+	t.Helper()
+
+	appDir := filepath.Join("testdata", dir)
+	return sdk.DeploymentSource[config.LambdaApplicationSpec]{
+		ApplicationDirectory:      appDir,
+		CommitHash:                commit,
+		ApplicationConfig:         sdk.LoadApplicationConfigForTest[config.LambdaApplicationSpec](t, filepath.Join(appDir, "app.pipecd.yaml"), "lambda"),
+		ApplicationConfigFilename: "app.pipecd.yaml",
+	}
+}
+
+func TestGetPlanPreview(t *testing.T) {
+	t.Parallel()
+
+	dts := []*sdk.DeployTarget[config.LambdaDeployTargetConfig]{{Name: "default"}}
+
+	testcases := []struct {
+		name         string
+		running      string
+		target       string
+		wantErr      bool
+		wantNoChange bool
+		wantSummary  string
+	}{
+		{
+			name:    "first deployment",
+			target:  "v1",
+			wantErr: true,
+		},
+		{
+			name:         "no change",
+			running:      "v1",
+			target:       "v1",
+			wantNoChange: true,
+			wantSummary:  "No changes were detected",
+		},
+		{
+			name:        "image and memory were changed",
+			running:     "v1",
+			target:      "v2",
+			wantSummary: "2 changes were detected",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var running sdk.DeploymentSource[config.LambdaApplicationSpec]
+			if tc.running != "" {
+				running = newTestDeploymentSource(t, tc.running, "1111111aaaa")
+			}
+
+			p := &Plugin{}
+			resp, err := p.GetPlanPreview(t.Context(), nil, dts, &sdk.GetPlanPreviewInput[config.LambdaApplicationSpec]{
+				Request: sdk.GetPlanPreviewRequest[config.LambdaApplicationSpec]{
+					RunningDeploymentSource: running,
+					TargetDeploymentSource:  newTestDeploymentSource(t, tc.target, "2222222bbbb"),
+				},
+			})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.Results, 1)
+
+			result := resp.Results[0]
+			assert.Equal(t, "default", result.DeployTarget)
+			assert.Equal(t, tc.wantNoChange, result.NoChange)
+			assert.Equal(t, tc.wantSummary, result.Summary)
+			if !tc.wantNoChange {
+				assert.Equal(t, "diff", result.DiffLanguage)
+				assert.NotEmpty(t, result.Details)
+			}
+		})
+	}
+}
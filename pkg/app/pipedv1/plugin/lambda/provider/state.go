@@ -0,0 +1,128 @@
+// Copyright 2025 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdk "github.com/pipe-cd/piped-plugin-sdk-go"
+)
+
+const (
+	ResourceTypeFunction = "Lambda:Function"
+)
+
+// MakeFunctionResourceState creates the ResourceState of a Lambda function.
+// The given traffic config is recorded as metadata to show which versions are serving the traffic.
+func MakeFunctionResourceState(f *lambda.GetFunctionOutput, trafficCfg RoutingTrafficConfig, deployTarget string) sdk.ResourceState {
+	fc := f.Configuration
+
+	var healthStatus sdk.ResourceHealthStatus
+	switch fc.State {
+	case types.StateActive:
+		healthStatus = sdk.ResourceHealthStateHealthy
+	case types.StatePending, types.StateInactive, types.StateFailed:
+		healthStatus = sdk.ResourceHealthStateUnhealthy
+	default:
+		healthStatus = sdk.ResourceHealthStateUnknown
+	}
+
+	healthDesc := fmt.Sprintf("Function's state is %s.", fc.State)
+	if fc.StateReason != nil {
+		healthDesc = fmt.Sprintf("%s StateReason: %s, StateReasonCode: %s", healthDesc, *fc.StateReason, fc.StateReasonCode)
+	}
+
+	metadata := map[string]string{
+		"state":   string(fc.State),
+		"runtime": string(fc.Runtime),
+	}
+	if commit, ok := f.Tags[LabelCommitHash]; ok {
+		metadata["commit"] = commit
+	}
+	for key, traffic := range trafficCfg {
+		metadata[string(key)] = fmt.Sprintf("version %s: %s%%", traffic.Version, strconv.FormatFloat(traffic.Percent, 'f', -1, 64))
+	}
+
+	return sdk.ResourceState{
+		ID:                aws.ToString(fc.FunctionArn),
+		Name:              aws.ToString(fc.FunctionName),
+		ResourceType:      ResourceTypeFunction,
+		ResourceMetadata:  metadata,
+		HealthStatus:      healthStatus,
+		HealthDescription: healthDesc,
+		DeployTarget:      deployTarget,
+	}
+}
+
+// MakeFunctionManifest converts the live state of a Lambda function to a FunctionManifest.
+func MakeFunctionManifest(f *lambda.GetFunctionOutput) FunctionManifest {
+	fc := f.Configuration
+
+	architectures := make([]Architecture, 0, len(fc.Architectures))
+	for _, arch := range fc.Architectures {
+		architectures = append(architectures, Architecture{Name: string(arch)})
+	}
+
+	layerArns := make([]string, 0, len(fc.Layers))
+	for _, layer := range fc.Layers {
+		layerArns = append(layerArns, aws.ToString(layer.Arn))
+	}
+
+	m := FunctionManifest{
+		Kind:       FunctionManifestKind,
+		APIVersion: VersionV1Beta1,
+		Spec: FunctionManifestSpec{
+			Name: aws.ToString(fc.FunctionName),
+			// S3Bucket, S3Key, S3ObjectVersion, and SourceCode cannot be retrieved from Lambda's response.
+
+			Architectures: architectures,
+			Runtime:       string(fc.Runtime),
+			Memory:        aws.ToInt32(fc.MemorySize),
+			Timeout:       aws.ToInt32(fc.Timeout),
+			Tags:          f.Tags,
+			Layers:        layerArns,
+		},
+	}
+
+	if fc.Role != nil {
+		m.Spec.Role = *fc.Role
+	}
+	if f.Code != nil && f.Code.ImageUri != nil {
+		m.Spec.ImageURI = *f.Code.ImageUri
+	}
+	if fc.Handler != nil {
+		m.Spec.Handler = *fc.Handler
+	}
+	if fc.EphemeralStorage != nil && fc.EphemeralStorage.Size != nil {
+		m.Spec.EphemeralStorage = &EphemeralStorage{
+			Size: *fc.EphemeralStorage.Size,
+		}
+	}
+	if fc.Environment != nil {
+		m.Spec.Environments = fc.Environment.Variables
+	}
+	if fc.VpcConfig != nil {
+		m.Spec.VPCConfig = &VPCConfig{
+			SecurityGroupIDs: fc.VpcConfig.SecurityGroupIds,
+			SubnetIDs:        fc.VpcConfig.SubnetIds,
+		}
+	}
+
+	return m
+}
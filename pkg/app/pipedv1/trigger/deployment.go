@@ -47,8 +47,10 @@ func buildDeployment(
 	commander string,
 	syncStrategy model.SyncStrategy,
 	strategySummary string,
+	ignoreFreezeWindows bool,
 	now time.Time,
 	noti *config.DeploymentNotification,
+	concurrencyPolicy model.DeploymentConcurrencyPolicy,
 	deploymentChainID string,
 	deploymentChainBlockIndex uint32,
 ) (*model.Deployment, error) {
@@ -86,14 +88,16 @@ func buildDeployment(
 				Url:       commitURL,
 				CreatedAt: int64(commit.CreatedAt),
 			},
-			Commander:       commander,
-			Timestamp:       now.Unix(),
-			SyncStrategy:    syncStrategy,
-			StrategySummary: strategySummary,
+			Commander:           commander,
+			Timestamp:           now.Unix(),
+			SyncStrategy:        syncStrategy,
+			StrategySummary:     strategySummary,
+			IgnoreFreezeWindows: ignoreFreezeWindows,
 		},
 		GitPath:               app.GitPath,
 		DeployTargetsByPlugin: app.DeployTargetsByPlugin,
 		Labels:                app.Labels,
+		ConcurrencyPolicy:     concurrencyPolicy,
 		Status:                model.DeploymentStatus_DEPLOYMENT_PENDING,
 		StatusReason:          "The deployment is waiting to be planned",
 		MetadataV2: &model.DeploymentMetadata{
@@ -16,24 +16,68 @@ package trigger
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// shouldTriggerDeploymentChain checks whether the given upstream deployment satisfies
+// the trigger conditions of the deployment chain configured for the application.
+func shouldTriggerDeploymentChain(ctx context.Context, repo git.Repo, app *model.Application, appCfg *config.GenericApplicationSpec, upstream *model.Deployment) (bool, error) {
+	if appCfg.PostSync == nil || appCfg.PostSync.DeploymentChain == nil {
+		return false, nil
+	}
+	cond := appCfg.PostSync.DeploymentChain.Conditions
+	if cond == nil {
+		return true, nil
+	}
+
+	var changedFiles []string
+	if len(cond.Paths) != 0 {
+		preCommit := app.GetMostRecentlyTriggeredDeployment().GetTrigger().GetCommit().GetHash()
+		if preCommit == "" {
+			// There is no previous deployment so the paths condition is considered as satisfied.
+			c := *cond
+			c.Paths = nil
+			cond = &c
+		} else {
+			files, err := repo.ChangedFiles(ctx, preCommit, upstream.Trigger.Commit.Hash)
+			if err != nil {
+				return false, err
+			}
+			changedFiles = files
+		}
+	}
+
+	return cond.Match(upstream.Trigger.Commit.Message, upstream.Labels, changedFiles)
+}
+
 func (t *Trigger) triggerDeploymentChain(
 	ctx context.Context,
+	repoDir string,
 	dc *config.DeploymentChain,
 	firstDeployment *model.Deployment,
 ) error {
 	matchers := make([]*pipedservice.CreateDeploymentChainRequest_ApplicationMatcher, 0, len(dc.ApplicationMatchers))
 	for _, m := range dc.ApplicationMatchers {
+		var gate *model.ChainBlockGate
+		if m.Gate != nil {
+			g, err := buildChainBlockGate(repoDir, m.Gate)
+			if err != nil {
+				return err
+			}
+			gate = g
+		}
 		matchers = append(matchers, &pipedservice.CreateDeploymentChainRequest_ApplicationMatcher{
-			Name:   m.Name,
-			Kind:   m.Kind,
-			Labels: m.Labels,
+			Name:              m.Name,
+			Kind:              m.Kind,
+			Labels:            m.Labels,
+			ContinueOnFailure: m.ContinueOnFailure,
+			Gate:              gate,
 		})
 	}
 
@@ -45,3 +89,96 @@ func (t *Trigger) triggerDeploymentChain(
 	}
 	return nil
 }
+
+// chainGateAnalysis is the options of the ANALYSIS stage run by the analysis plugin to evaluate the gate.
+// The analyses are copied from the analysis template as they are.
+type chainGateAnalysis struct {
+	Duration config.Duration   `json:"duration"`
+	Metrics  []json.RawMessage `json:"metrics,omitempty"`
+	Logs     []json.RawMessage `json:"logs,omitempty"`
+	HTTPS    []json.RawMessage `json:"https,omitempty"`
+}
+
+// chainGateAnalysisStrategy is the only strategy of metrics analysis available for the gate
+// since there is no variant to compare with.
+const chainGateAnalysisStrategy = "THRESHOLD"
+
+// buildChainBlockGate converts the given gate configuration into the model sent to the control-plane.
+// The referenced analysis template is resolved here since the analysis is run later by the piped
+// which handles the deployments of the gated block, and only its result is sent to the control-plane.
+func buildChainBlockGate(repoDir string, cfg *config.DeploymentChainGate) (*model.ChainBlockGate, error) {
+	gate := &model.ChainBlockGate{
+		WaitSeconds:     int64(cfg.Wait.Duration().Seconds()),
+		RequireApproval: cfg.ManualApproval,
+	}
+	if cfg.Analysis == nil {
+		return gate, nil
+	}
+
+	tpl, err := config.LoadAnalysisTemplate(repoDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load analysis template for deployment chain gate: %w", err)
+	}
+
+	var (
+		name     = cfg.Analysis.Name
+		analysis = chainGateAnalysis{
+			Duration: cfg.AnalysisDuration,
+		}
+	)
+	if m, ok := tpl.Metrics[name]; ok {
+		var metrics struct {
+			Strategy string `json:"strategy"`
+		}
+		if err := json.Unmarshal(m, &metrics); err != nil {
+			return nil, fmt.Errorf("failed to decode analysis template %s: %w", name, err)
+		}
+		if metrics.Strategy != "" && metrics.Strategy != chainGateAnalysisStrategy {
+			return nil, fmt.Errorf("strategy %s of analysis template %s is not available for deployment chain gate, only %s is available", metrics.Strategy, name, chainGateAnalysisStrategy)
+		}
+		if m, err = withAppArgs(m, cfg.Analysis.AppArgs); err != nil {
+			return nil, err
+		}
+		analysis.Metrics = append(analysis.Metrics, m)
+	}
+	if l, ok := tpl.Logs[name]; ok {
+		if l, err = withAppArgs(l, cfg.Analysis.AppArgs); err != nil {
+			return nil, err
+		}
+		analysis.Logs = append(analysis.Logs, l)
+	}
+	if h, ok := tpl.HTTPS[name]; ok {
+		if h, err = withAppArgs(h, cfg.Analysis.AppArgs); err != nil {
+			return nil, err
+		}
+		analysis.HTTPS = append(analysis.HTTPS, h)
+	}
+	if len(analysis.Metrics)+len(analysis.Logs)+len(analysis.HTTPS) == 0 {
+		return nil, fmt.Errorf("analysis template %s for deployment chain gate was not found", name)
+	}
+
+	data, err := json.Marshal(analysis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode analysis for deployment chain gate: %w", err)
+	}
+	gate.AnalysisTemplate = name
+	gate.AnalysisConfig = data
+	return gate, nil
+}
+
+// withAppArgs sets the given custom args to the analysis copied from the analysis template.
+func withAppArgs(analysis json.RawMessage, args map[string]string) (json.RawMessage, error) {
+	if len(args) == 0 {
+		return analysis, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(analysis, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode analysis: %w", err)
+	}
+	template, err := json.Marshal(config.AnalysisTemplateRef{AppArgs: args})
+	if err != nil {
+		return nil, err
+	}
+	fields["template"] = template
+	return json.Marshal(fields)
+}
@@ -0,0 +1,280 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/git/gittest"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestShouldTriggerDeploymentChain(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		postSync     *config.PostSync
+		deployment   *model.ApplicationDeploymentReference
+		changedFiles []string
+		expected     bool
+	}{
+		{
+			name:     "no deployment chain",
+			expected: false,
+		},
+		{
+			name: "no conditions",
+			postSync: &config.PostSync{
+				DeploymentChain: &config.DeploymentChain{},
+			},
+			expected: true,
+		},
+		{
+			name: "commit prefix and labels matched",
+			postSync: &config.PostSync{
+				DeploymentChain: &config.DeploymentChain{
+					Conditions: &config.DeploymentChainTriggerCondition{
+						CommitPrefix: "release:",
+						Labels:       map[string]string{"env": "dev"},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "commit prefix not matched",
+			postSync: &config.PostSync{
+				DeploymentChain: &config.DeploymentChain{
+					Conditions: &config.DeploymentChainTriggerCondition{
+						CommitPrefix: "hotfix:",
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "paths: no previous deployment",
+			postSync: &config.PostSync{
+				DeploymentChain: &config.DeploymentChain{
+					Conditions: &config.DeploymentChainTriggerCondition{
+						Paths: []string{"app/demo/**"},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "paths matched",
+			postSync: &config.PostSync{
+				DeploymentChain: &config.DeploymentChain{
+					Conditions: &config.DeploymentChainTriggerCondition{
+						Paths: []string{"app/demo/**"},
+					},
+				},
+			},
+			deployment: &model.ApplicationDeploymentReference{
+				Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: "previous"}},
+			},
+			changedFiles: []string{"app/demo/deployment.yaml"},
+			expected:     true,
+		},
+		{
+			name: "paths not matched",
+			postSync: &config.PostSync{
+				DeploymentChain: &config.DeploymentChain{
+					Conditions: &config.DeploymentChainTriggerCondition{
+						Paths: []string{"app/demo/**"},
+					},
+				},
+			},
+			deployment: &model.ApplicationDeploymentReference{
+				Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: "previous"}},
+			},
+			changedFiles: []string{"app/other/deployment.yaml"},
+			expected:     false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := gittest.NewMockRepo(ctrl)
+			if tc.changedFiles != nil {
+				repo.EXPECT().ChangedFiles(gomock.Any(), "previous", "head").Return(tc.changedFiles, nil)
+			}
+
+			app := &model.Application{
+				Id:                              "app-id",
+				Name:                            "demo",
+				MostRecentlyTriggeredDeployment: tc.deployment,
+			}
+			appCfg := &config.GenericApplicationSpec{
+				PostSync: tc.postSync,
+			}
+			upstream := &model.Deployment{
+				Trigger: &model.DeploymentTrigger{
+					Commit: &model.Commit{
+						Hash:    "head",
+						Message: "release: v1.0.0",
+					},
+				},
+				Labels: map[string]string{"env": "dev"},
+			}
+
+			got, err := shouldTriggerDeploymentChain(context.Background(), repo, app, appCfg, upstream)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestBuildChainBlockGate(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, config.SharedConfigurationDirName), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, config.SharedConfigurationDirName, "analysis-template.yaml"), []byte(`
+apiVersion: pipecd.dev/v1beta1
+kind: AnalysisTemplate
+spec:
+  metrics:
+    error_rate:
+      provider: prometheus-dev
+      query: sum(rate(http_requests_total{app="{{ .AppCustomArgs.name }}"}[1m]))
+      interval: 1m
+    canary_error_rate:
+      strategy: CANARY_BASELINE
+      provider: prometheus-dev
+      query: sum(rate(http_requests_total[1m]))
+      interval: 1m
+  logs:
+    error_logs:
+      provider: stackdriver-dev
+      query: severity>=ERROR
+      interval: 5m
+  https:
+    http_stage_check:
+      url: https://staging.example.com/healthz
+      interval: 1m
+`), 0600))
+
+	testcases := []struct {
+		name             string
+		gate             *config.DeploymentChainGate
+		expected         *model.ChainBlockGate
+		expectedAnalysis string
+		wantErr          bool
+	}{
+		{
+			name: "wait and manual approval",
+			gate: &config.DeploymentChainGate{
+				Wait:           config.Duration(10 * time.Minute),
+				ManualApproval: true,
+			},
+			expected: &model.ChainBlockGate{
+				WaitSeconds:     600,
+				RequireApproval: true,
+			},
+		},
+		{
+			name: "metrics analysis with app args",
+			gate: &config.DeploymentChainGate{
+				Analysis: &config.AnalysisTemplateRef{
+					Name:    "error_rate",
+					AppArgs: map[string]string{"name": "demo"},
+				},
+				AnalysisDuration: config.Duration(10 * time.Minute),
+			},
+			expected: &model.ChainBlockGate{
+				AnalysisTemplate: "error_rate",
+			},
+			expectedAnalysis: `{"duration":"10m0s","metrics":[{"provider":"prometheus-dev","query":"sum(rate(http_requests_total{app=\"{{ .AppCustomArgs.name }}\"}[1m]))","interval":"1m","template":{"name":"","appArgs":{"name":"demo"}}}]}`,
+		},
+		{
+			name: "log analysis",
+			gate: &config.DeploymentChainGate{
+				Analysis: &config.AnalysisTemplateRef{
+					Name: "error_logs",
+				},
+				AnalysisDuration: config.Duration(10 * time.Minute),
+			},
+			expected: &model.ChainBlockGate{
+				AnalysisTemplate: "error_logs",
+			},
+			expectedAnalysis: `{"duration":"10m0s","logs":[{"provider":"stackdriver-dev","query":"severity>=ERROR","interval":"5m"}]}`,
+		},
+		{
+			name: "http analysis",
+			gate: &config.DeploymentChainGate{
+				Analysis: &config.AnalysisTemplateRef{
+					Name: "http_stage_check",
+				},
+				AnalysisDuration: config.Duration(10 * time.Minute),
+			},
+			expected: &model.ChainBlockGate{
+				AnalysisTemplate: "http_stage_check",
+			},
+			expectedAnalysis: `{"duration":"10m0s","https":[{"url":"https://staging.example.com/healthz","interval":"1m"}]}`,
+		},
+		{
+			name: "unavailable metrics strategy",
+			gate: &config.DeploymentChainGate{
+				Analysis: &config.AnalysisTemplateRef{
+					Name: "canary_error_rate",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing analysis template",
+			gate: &config.DeploymentChainGate{
+				Analysis: &config.AnalysisTemplateRef{
+					Name: "not-found",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := buildChainBlockGate(repoDir, tc.gate)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if tc.expected == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tc.expected.WaitSeconds, got.WaitSeconds)
+			assert.Equal(t, tc.expected.RequireApproval, got.RequireApproval)
+			assert.Equal(t, tc.expected.AnalysisTemplate, got.AnalysisTemplate)
+			if tc.expectedAnalysis == "" {
+				assert.Nil(t, got.AnalysisConfig)
+				return
+			}
+			assert.JSONEq(t, tc.expectedAnalysis, string(got.AnalysisConfig))
+		})
+	}
+}
@@ -38,6 +38,7 @@ type determiners struct {
 	onOutOfSync Determiner
 	onCommit    Determiner
 	onChain     Determiner
+	onSchedule  Determiner
 }
 
 func (ds *determiners) Determiner(k model.TriggerKind) Determiner {
@@ -48,6 +49,8 @@ func (ds *determiners) Determiner(k model.TriggerKind) Determiner {
 		return ds.onOutOfSync
 	case model.TriggerKind_ON_CHAIN:
 		return ds.onChain
+	case model.TriggerKind_ON_SCHEDULE:
+		return ds.onSchedule
 	default:
 		return ds.onCommit
 	}
@@ -204,6 +207,62 @@ func (d *OnCommitDeterminer) ShouldTrigger(ctx context.Context, app *model.Appli
 	return true, nil
 }
 
+type OnScheduleDeterminer struct {
+	repo         git.Repo
+	targetCommit string
+	from         time.Time
+	to           time.Time
+	logger       *zap.Logger
+}
+
+// NewOnScheduleDeterminer returns a determiner which triggers the applications
+// whose schedules were fired after the from time and at or before the to time.
+func NewOnScheduleDeterminer(repo git.Repo, targetCommit string, from, to time.Time, logger *zap.Logger) *OnScheduleDeterminer {
+	return &OnScheduleDeterminer{
+		repo:         repo,
+		targetCommit: targetCommit,
+		from:         from,
+		to:           to,
+		logger:       logger.Named("determiner"),
+	}
+}
+
+// ShouldTrigger decides whether a given application should be triggered or not.
+func (d *OnScheduleDeterminer) ShouldTrigger(ctx context.Context, app *model.Application, appCfg *config.GenericApplicationSpec) (bool, error) {
+	scheduled, err := appCfg.Trigger.OnSchedule.IsScheduledBetween(d.from, d.to)
+	if err != nil {
+		return false, err
+	}
+	if !scheduled {
+		return false, nil
+	}
+
+	if !appCfg.Trigger.OnSchedule.OnlyUndeployedCommits {
+		return true, nil
+	}
+
+	// Find the most recently triggered deployment.
+	// Nil means no deployment was triggered yet, so all commits are undeployed.
+	preCommit := app.GetMostRecentlyTriggeredDeployment().GetTrigger().GetCommit().GetHash()
+	if preCommit == "" {
+		return true, nil
+	}
+	if preCommit == d.targetCommit {
+		d.logger.Info("skipped scheduled trigger since there is no undeployed commit",
+			zap.String("app", app.Name),
+			zap.String("app-id", app.Id),
+			zap.String("target-commit", d.targetCommit),
+		)
+		return false, nil
+	}
+
+	changedFiles, err := d.repo.ChangedFiles(ctx, preCommit, d.targetCommit)
+	if err != nil {
+		return false, err
+	}
+	return isTouchedByChangedFiles(app.GitPath.Path, appCfg.Trigger.OnCommit.Paths, appCfg.Trigger.OnCommit.Ignores, changedFiles)
+}
+
 // isTouchedByChangedFiles checks whether this application changed files can trigger a new deployment or not (considered as "touched")
 // The logic of watching files pattern contains both "includes" and "excludes" filter and be implemented as flow:
 //  1. If any of changed files are listed in excludes, app is NOT considered as touched
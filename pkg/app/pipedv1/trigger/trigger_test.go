@@ -0,0 +1,334 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
+	config "github.com/pipe-cd/pipecd/pkg/configv1"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/git/gittest"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeAPIClient struct {
+	apiClient
+
+	freezeWindows      []*model.ProjectFreezeWindow
+	freezeWindowsCalls int
+	createdDeployments []*model.Deployment
+}
+
+func (c *fakeAPIClient) GetProjectFreezeWindows(context.Context, *pipedservice.GetProjectFreezeWindowsRequest, ...grpc.CallOption) (*pipedservice.GetProjectFreezeWindowsResponse, error) {
+	c.freezeWindowsCalls++
+	return &pipedservice.GetProjectFreezeWindowsResponse{FreezeWindows: c.freezeWindows}, nil
+}
+
+func (c *fakeAPIClient) CreateDeployment(_ context.Context, req *pipedservice.CreateDeploymentRequest, _ ...grpc.CallOption) (*pipedservice.CreateDeploymentResponse, error) {
+	c.createdDeployments = append(c.createdDeployments, req.Deployment)
+	return &pipedservice.CreateDeploymentResponse{}, nil
+}
+
+func (c *fakeAPIClient) ReportApplicationMostRecentDeployment(context.Context, *pipedservice.ReportApplicationMostRecentDeploymentRequest, ...grpc.CallOption) (*pipedservice.ReportApplicationMostRecentDeploymentResponse, error) {
+	return &pipedservice.ReportApplicationMostRecentDeploymentResponse{}, nil
+}
+
+type fakeNotifier struct{}
+
+func (fakeNotifier) Notify(model.NotificationEvent) {}
+
+// newTestTrigger returns a trigger checking the applications in a repository
+// which has the given application configs under the directories named by the application IDs.
+func newTestTrigger(t *testing.T, ctrl *gomock.Controller, client *fakeAPIClient, appConfigs map[string]string, now time.Time) *Trigger {
+	t.Helper()
+
+	repoDir := t.TempDir()
+	for appID, cfg := range appConfigs {
+		require.NoError(t, os.MkdirAll(filepath.Join(repoDir, appID), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(repoDir, appID, "app.pipecd.yaml"), []byte(cfg), 0o644))
+	}
+
+	repo := gittest.NewMockRepo(ctrl)
+	repo.EXPECT().GetClonedBranch().Return("main").AnyTimes()
+	repo.EXPECT().Pull(gomock.Any(), "main").Return(nil).AnyTimes()
+	repo.EXPECT().GetLatestCommit(gomock.Any()).Return(git.Commit{Hash: "head"}, nil).AnyTimes()
+	repo.EXPECT().GetPath().Return(repoDir).AnyTimes()
+
+	tr, err := NewTrigger(client, nil, nil, nil, fakeNotifier{}, &config.PipedSpec{}, 0, zap.NewNop())
+	require.NoError(t, err)
+	tr.gitRepos["repo-id"] = repo
+	tr.nowFunc = func() time.Time { return now }
+	return tr
+}
+
+func newTestApplication(id string) *model.Application {
+	return &model.Application{
+		Id:      id,
+		Name:    id,
+		PipedId: "piped-id",
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{
+				Id:     "repo-id",
+				Remote: "git@github.com:org/repo.git",
+				Branch: "main",
+			},
+			Path:           id,
+			ConfigFilename: "app.pipecd.yaml",
+		},
+	}
+}
+
+func TestCheckCandidatesDuringFreezeWindow(t *testing.T) {
+	t.Parallel()
+
+	const appConfig = `
+apiVersion: pipecd.dev/v1beta1
+kind: Application
+spec:
+  name: app
+  trigger:
+    onOutOfSync:
+      disabled: false
+`
+	// 2024-06-01 09:30 in Asia/Tokyo.
+	now := time.Date(2024, 6, 1, 0, 30, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name          string
+		projectWindow *model.ProjectFreezeWindow
+		appConfig     string
+		expectHeld    bool
+	}{
+		{
+			name:       "no freeze window",
+			appConfig:  appConfig,
+			expectHeld: false,
+		},
+		{
+			name: "held by project freeze window",
+			projectWindow: &model.ProjectFreezeWindow{
+				Name:  "project-freeze",
+				Start: "2024-06-01T00:00:00Z",
+				End:   "2024-06-01T01:00:00Z",
+			},
+			appConfig:  appConfig,
+			expectHeld: true,
+		},
+		{
+			name: "held by application freeze window",
+			appConfig: appConfig + `
+  freezeWindows:
+    - name: app-freeze
+      cron: "0 0 * * *"
+      duration: 1h
+`,
+			expectHeld: true,
+		},
+		{
+			name: "held by freeze window in time zone",
+			appConfig: appConfig + `
+  freezeWindows:
+    - name: app-freeze
+      start: "2024-06-01 09:00"
+      end: "2024-06-01 10:00"
+      timeZone: Asia/Tokyo
+`,
+			expectHeld: true,
+		},
+		{
+			name: "freeze window in another time zone is not active",
+			appConfig: appConfig + `
+  freezeWindows:
+    - name: app-freeze
+      start: "2024-06-01 00:00"
+      end: "2024-06-01 01:00"
+      timeZone: America/New_York
+`,
+			expectHeld: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			client := &fakeAPIClient{}
+			if tc.projectWindow != nil {
+				client.freezeWindows = []*model.ProjectFreezeWindow{tc.projectWindow}
+			}
+			tr := newTestTrigger(t, ctrl, client, map[string]string{"app-1": tc.appConfig, "app-2": tc.appConfig}, now)
+
+			candidates := []candidate{
+				{application: newTestApplication("app-1"), kind: model.TriggerKind_ON_OUT_OF_SYNC},
+				{application: newTestApplication("app-2"), kind: model.TriggerKind_ON_OUT_OF_SYNC},
+			}
+			require.NoError(t, tr.checkCandidates(context.Background(), candidates))
+
+			// The freeze windows of the project are fetched once for all candidates.
+			assert.Equal(t, 1, client.freezeWindowsCalls)
+			if tc.expectHeld {
+				assert.Empty(t, client.createdDeployments)
+			} else {
+				assert.Len(t, client.createdDeployments, 2)
+			}
+		})
+	}
+}
+
+func TestCheckCandidatesSyncCommandDuringFreezeWindow(t *testing.T) {
+	t.Parallel()
+
+	const appConfig = `
+apiVersion: pipecd.dev/v1beta1
+kind: Application
+spec:
+  name: app
+`
+	now := time.Date(2024, 6, 1, 0, 30, 0, 0, time.UTC)
+
+	testcases := []struct {
+		name                string
+		ignoreFreezeWindows bool
+		expectedStatus      model.CommandStatus
+	}{
+		{
+			name:                "rejected without ignoring freeze windows",
+			ignoreFreezeWindows: false,
+			expectedStatus:      model.CommandStatus_COMMAND_FAILED,
+		},
+		{
+			name:                "triggered with ignoring freeze windows",
+			ignoreFreezeWindows: true,
+			expectedStatus:      model.CommandStatus_COMMAND_SUCCEEDED,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			client := &fakeAPIClient{
+				freezeWindows: []*model.ProjectFreezeWindow{
+					{
+						Name:   "project-freeze",
+						Reason: "release freeze",
+						Start:  "2024-06-01T00:00:00Z",
+						End:    "2024-06-01T01:00:00Z",
+					},
+				},
+			}
+			tr := newTestTrigger(t, ctrl, client, map[string]string{"app-1": appConfig}, now)
+
+			var (
+				reportedStatus   model.CommandStatus
+				reportedMetadata map[string]string
+			)
+			cmd := model.ReportableCommand{
+				Command: &model.Command{
+					Id:            "command-id",
+					ApplicationId: "app-1",
+					Type:          model.Command_SYNC_APPLICATION,
+					SyncApplication: &model.Command_SyncApplication{
+						ApplicationId:       "app-1",
+						SyncStrategy:        model.SyncStrategy_AUTO,
+						IgnoreFreezeWindows: tc.ignoreFreezeWindows,
+					},
+				},
+				Report: func(_ context.Context, status model.CommandStatus, metadata map[string]string, _ []byte) error {
+					reportedStatus = status
+					reportedMetadata = metadata
+					return nil
+				},
+			}
+			candidates := []candidate{
+				{application: newTestApplication("app-1"), kind: model.TriggerKind_ON_COMMAND, command: cmd},
+			}
+			require.NoError(t, tr.checkCandidates(context.Background(), candidates))
+
+			assert.Equal(t, tc.expectedStatus, reportedStatus)
+			if tc.ignoreFreezeWindows {
+				require.Len(t, client.createdDeployments, 1)
+				assert.True(t, client.createdDeployments[0].Trigger.IgnoreFreezeWindows)
+				assert.Equal(t, client.createdDeployments[0].Id, reportedMetadata[model.MetadataKeyTriggeredDeploymentID])
+			} else {
+				assert.Empty(t, client.createdDeployments)
+				assert.Equal(t, `Deployments are frozen by the freeze window "project-freeze": release freeze`, reportedMetadata[model.MetadataKeyCommandFailureReason])
+			}
+		})
+	}
+}
+
+func TestCheckCandidatesKeepsSchedulesUntilRepoUpdated(t *testing.T) {
+	t.Parallel()
+
+	const appConfig = `
+apiVersion: pipecd.dev/v1beta1
+kind: Application
+spec:
+  name: app
+  trigger:
+    onSchedule:
+      crons:
+        - "0 0 * * *"
+`
+	midnight := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	client := &fakeAPIClient{}
+	tr := newTestTrigger(t, ctrl, client, map[string]string{"app-1": appConfig}, midnight)
+
+	// The repository fails to be updated only at the first time.
+	repoDir := tr.gitRepos["repo-id"].GetPath()
+	repo := gittest.NewMockRepo(ctrl)
+	repo.EXPECT().GetClonedBranch().Return("main").AnyTimes()
+	repo.EXPECT().Pull(gomock.Any(), "main").Return(errors.New("network error")).Times(1)
+	repo.EXPECT().Pull(gomock.Any(), "main").Return(nil).AnyTimes()
+	repo.EXPECT().GetLatestCommit(gomock.Any()).Return(git.Commit{Hash: "head"}, nil).AnyTimes()
+	repo.EXPECT().GetPath().Return(repoDir).AnyTimes()
+	tr.gitRepos["repo-id"] = repo
+	tr.scheduleCheckedAt["repo-id"] = midnight.Add(-time.Minute)
+
+	candidates := []candidate{
+		{application: newTestApplication("app-1"), kind: model.TriggerKind_ON_SCHEDULE},
+	}
+
+	// The schedule fired at midnight is not dropped by the failure of updating the repository.
+	tr.scheduleTo = midnight.Add(time.Minute)
+	require.Error(t, tr.checkCandidates(context.Background(), candidates))
+	assert.Empty(t, client.createdDeployments)
+	assert.Equal(t, midnight.Add(-time.Minute), tr.scheduleCheckedAt["repo-id"])
+
+	tr.scheduleTo = midnight.Add(2 * time.Minute)
+	require.NoError(t, tr.checkCandidates(context.Background(), candidates))
+	require.Len(t, client.createdDeployments, 1)
+	assert.Equal(t, midnight.Add(2*time.Minute), tr.scheduleCheckedAt["repo-id"])
+
+	// The same schedule is not triggered twice.
+	tr.scheduleTo = midnight.Add(3 * time.Minute)
+	require.NoError(t, tr.checkCandidates(context.Background(), candidates))
+	assert.Len(t, client.createdDeployments, 1)
+}
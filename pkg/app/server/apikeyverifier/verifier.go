@@ -91,6 +91,10 @@ func (v *Verifier) checkAPIKey(_ context.Context, apiKey *model.APIKey, id, key
 		return fmt.Errorf("the api key %s was already disabled", id)
 	}
 
+	if apiKey.IsExpired(v.nowFunc()) {
+		return fmt.Errorf("the api key %s was already expired", id)
+	}
+
 	if err := apiKey.CompareKey(key); err != nil {
 		return fmt.Errorf("invalid api key %s: %w", id, err)
 	}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -60,6 +61,10 @@ func TestVerify(t *testing.T) {
 	key2, hash2, err := model.GenerateAPIKey(id2)
 	require.NoError(t, err)
 
+	var id3 = "expired-api-key"
+	key3, hash3, err := model.GenerateAPIKey(id3)
+	require.NoError(t, err)
+
 	apiKeyGetter := &fakeAPIKeyGetter{
 		apiKeys: map[string]*model.APIKey{
 			id1: {
@@ -75,6 +80,13 @@ func TestVerify(t *testing.T) {
 				ProjectId: "test-project",
 				Disabled:  true,
 			},
+			id3: {
+				Id:        id3,
+				Name:      id3,
+				KeyHash:   hash3,
+				ProjectId: "test-project",
+				ExpiresAt: time.Now().Add(-time.Hour).Unix(),
+			},
 		},
 	}
 	fakeRedisHashCache := &fakeRedisHashCache{}
@@ -97,16 +109,23 @@ func TestVerify(t *testing.T) {
 	assert.Equal(t, "the api key disabled-api-key was already disabled", err.Error())
 	require.Equal(t, 2, apiKeyGetter.calls)
 
+	// Found key but it was expired.
+	apiKey, err = v.Verify(ctx, key3)
+	require.Nil(t, apiKey)
+	require.NotNil(t, err)
+	assert.Equal(t, "the api key expired-api-key was already expired", err.Error())
+	require.Equal(t, 3, apiKeyGetter.calls)
+
 	// Found key but invalid secret.
 	apiKey, err = v.Verify(ctx, fmt.Sprintf("%s.invalidhash", id1))
 	require.Nil(t, apiKey)
 	require.NotNil(t, err)
 	assert.Equal(t, "invalid api key test-api-key: wrong api key test-api-key.invalidhash: crypto/bcrypt: hashedPassword is not the hash of the given password", err.Error())
-	require.Equal(t, 3, apiKeyGetter.calls)
+	require.Equal(t, 4, apiKeyGetter.calls)
 
 	// OK.
 	apiKey, err = v.Verify(ctx, key1)
 	assert.Equal(t, id1, apiKey.Name)
 	assert.Nil(t, err)
-	require.Equal(t, 3, apiKeyGetter.calls)
+	require.Equal(t, 4, apiKeyGetter.calls)
 }
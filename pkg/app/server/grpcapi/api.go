@@ -55,6 +55,11 @@ type apiDeploymentStore interface {
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Deployment, string, error)
 }
 
+type apiDeploymentChainStore interface {
+	Get(ctx context.Context, id string) (*model.DeploymentChain, error)
+	ApproveBlockGate(ctx context.Context, chainID string, blockIndex uint32, approver string) error
+}
+
 type apiPipedStore interface {
 	Get(ctx context.Context, id string) (*model.Piped, error)
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Piped, error)
@@ -72,6 +77,10 @@ type apiDeploymentTraceStore interface {
 	Add(ctx context.Context, trace model.DeploymentTrace) error
 }
 
+type apiAuditLogStore interface {
+	List(ctx context.Context, opts datastore.ListOptions) ([]*model.AuditLog, string, error)
+}
+
 type commandOutputGetter interface {
 	Get(ctx context.Context, commandID string) ([]byte, error)
 }
@@ -82,9 +91,11 @@ type API struct {
 
 	applicationStore     apiApplicationStore
 	deploymentStore      apiDeploymentStore
+	deploymentChainStore apiDeploymentChainStore
 	pipedStore           apiPipedStore
 	eventStore           apiEventStore
 	deploymentTraceStore apiDeploymentTraceStore
+	auditLogStore        apiAuditLogStore
 	commandStore         commandstore.Store
 	stageLogStore        stagelogstore.Store
 	commandOutputGetter  commandOutputGetter
@@ -110,9 +121,11 @@ func NewAPI(
 	a := &API{
 		applicationStore:     datastore.NewApplicationStore(ds),
 		deploymentStore:      datastore.NewDeploymentStore(ds),
+		deploymentChainStore: datastore.NewDeploymentChainStore(ds),
 		pipedStore:           datastore.NewPipedStore(ds),
 		eventStore:           datastore.NewEventStore(ds),
 		deploymentTraceStore: datastore.NewDeploymentTraceStore(ds),
+		auditLogStore:        datastore.NewAuditLogStore(ds),
 		commandStore:         commandstore.NewStore(ds, sc, logger),
 		stageLogStore:        stagelogstore.NewStore(fs, sc, logger),
 		commandOutputGetter:  cog,
@@ -145,6 +158,10 @@ func (a *API) AddApplication(ctx context.Context, req *apiservice.AddApplication
 		return nil, status.Error(codes.InvalidArgument, "Requested piped does not belong to your project")
 	}
 
+	if err := requireResourcePermission(key, model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_CREATE, req.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
 	gitpath, err := makeGitPath(
 		req.GitPath.Repo.Id,
 		req.GitPath.Path,
@@ -192,6 +209,10 @@ func (a *API) SyncApplication(ctx context.Context, req *apiservice.SyncApplicati
 		return nil, status.Error(codes.InvalidArgument, "Requested application does not belong to your project")
 	}
 
+	if err := requireResourcePermission(key, model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_UPDATE, app.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
 	cmd := model.Command{
 		Id:            uuid.New().String(),
 		PipedId:       app.PipedId,
@@ -200,8 +221,9 @@ func (a *API) SyncApplication(ctx context.Context, req *apiservice.SyncApplicati
 		Type:          model.Command_SYNC_APPLICATION,
 		Commander:     key.Id,
 		SyncApplication: &model.Command_SyncApplication{
-			ApplicationId: app.Id,
-			SyncStrategy:  model.SyncStrategy_AUTO,
+			ApplicationId:       app.Id,
+			SyncStrategy:        model.SyncStrategy_AUTO,
+			IgnoreFreezeWindows: req.IgnoreFreezeWindows,
 		},
 	}
 	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
@@ -228,6 +250,10 @@ func (a *API) GetApplication(ctx context.Context, req *apiservice.GetApplication
 		return nil, status.Error(codes.InvalidArgument, "Requested application does not belong to your project")
 	}
 
+	if err := requireResourcePermission(key, model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_GET, app.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
 	return &apiservice.GetApplicationResponse{
 		Application: app,
 	}, nil
@@ -306,17 +332,22 @@ func (a *API) ListApplications(ctx context.Context, req *apiservice.ListApplicat
 	}
 
 	labels := req.Labels
-	if len(req.Labels) == 0 {
+	if len(req.Labels) == 0 && len(key.Policies) == 0 {
 		return &apiservice.ListApplicationsResponse{
 			Applications: apps,
 			Cursor:       cursor,
 		}, nil
 	}
 
+	// The applications which are not allowed to be listed by the policies of the key are also filtered out here.
+	match := func(app *model.Application) bool {
+		return app.ContainLabels(labels) && key.HasResourcePermission(model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_LIST, app.Labels)
+	}
+
 	// NOTE: Filtering by labels is done by the application-side because we need to create composite indexes for every combination in the filter.
 	filtered := make([]*model.Application, 0, len(apps))
 	for _, a := range apps {
-		if a.ContainLabels(req.Labels) {
+		if match(a) {
 			filtered = append(filtered, a)
 		}
 	}
@@ -341,7 +372,7 @@ func (a *API) ListApplications(ctx context.Context, req *apiservice.ListApplicat
 			break
 		}
 		for _, d := range apps {
-			if d.ContainLabels(labels) {
+			if match(d) {
 				filtered = append(filtered, d)
 			}
 		}
@@ -367,6 +398,16 @@ func (a *API) UpdateApplication(ctx context.Context, req *apiservice.UpdateAppli
 		return nil, status.Error(codes.InvalidArgument, "Requested piped does not belong to your project")
 	}
 
+	if len(key.Policies) > 0 {
+		app, err := getApplication(ctx, a.applicationStore, req.ApplicationId, a.logger)
+		if err != nil {
+			return nil, err
+		}
+		if err := requireResourcePermission(key, model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_UPDATE, app.Labels, a.logger); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := a.applicationStore.UpdateConfiguration(ctx, req.ApplicationId, req.PipedId, req.PlatformProvider, req.GitPath.ConfigFilename, nil); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("failed to update application %s", req.ApplicationId))
 	}
@@ -391,6 +432,10 @@ func (a *API) DeleteApplication(ctx context.Context, req *apiservice.DeleteAppli
 		return nil, status.Error(codes.InvalidArgument, "Requested application does not belong to your project")
 	}
 
+	if err := requireResourcePermission(key, model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_DELETE, app.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
 	if err := a.applicationStore.Delete(ctx, req.ApplicationId); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("delete application %s", app.Id))
 	}
@@ -415,6 +460,10 @@ func (a *API) EnableApplication(ctx context.Context, req *apiservice.EnableAppli
 		return nil, status.Error(codes.InvalidArgument, "Requested application does not belong to your project")
 	}
 
+	if err := requireResourcePermission(key, model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_UPDATE, app.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
 	if err := a.applicationStore.Enable(ctx, req.ApplicationId); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("enable application %s", req.ApplicationId))
 	}
@@ -439,6 +488,10 @@ func (a *API) DisableApplication(ctx context.Context, req *apiservice.DisableApp
 		return nil, status.Error(codes.InvalidArgument, "Requested application does not belong to your project")
 	}
 
+	if err := requireResourcePermission(key, model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_UPDATE, app.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
 	if err := a.applicationStore.Disable(ctx, req.ApplicationId); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("disable application %s", req.ApplicationId))
 	}
@@ -462,6 +515,9 @@ func (a *API) RenameApplicationConfigFile(ctx context.Context, req *apiservice.R
 		if app.ProjectId != key.ProjectId {
 			return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("requested application %s does not belong to your project", appID))
 		}
+		if err := requireResourcePermission(key, model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_UPDATE, app.Labels, a.logger); err != nil {
+			return nil, err
+		}
 		if err = a.applicationStore.UpdateConfigFilename(ctx, appID, req.NewFilename); err != nil {
 			return nil, gRPCStoreError(err, fmt.Sprintf("failed to update application %s config file name", appID))
 		}
@@ -485,6 +541,9 @@ func (a *API) UpdateApplicationDeployTargets(ctx context.Context, req *apiservic
 		a.logger.Warn("requested application does not belong to your project", zap.String("applicationID", app.Id), zap.String("requestProjectID", key.ProjectId), zap.String("applicationProjectID", app.ProjectId))
 		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("requested application %s does not belong to your project", req.GetApplicationId()))
 	}
+	if err := requireResourcePermission(key, model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_UPDATE, app.Labels, a.logger); err != nil {
+		return nil, err
+	}
 	if err := a.applicationStore.UpdateDeployTargets(ctx, req.GetApplicationId(), req.GetDeployTargetsByPlugin()); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("failed to update application %s deploy targets", req.ApplicationId))
 	}
@@ -506,6 +565,10 @@ func (a *API) GetDeployment(ctx context.Context, req *apiservice.GetDeploymentRe
 		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
 	}
 
+	if err := requireResourcePermission(key, model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_GET, deployment.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
 	return &apiservice.GetDeploymentResponse{
 		Deployment: deployment,
 	}, nil
@@ -600,20 +663,25 @@ func (a *API) ListDeployments(ctx context.Context, req *apiservice.ListDeploymen
 		return nil, gRPCStoreError(err, "get deployments")
 	}
 	labels := req.Labels
-	if len(labels) == 0 || len(deployments) == 0 {
+	if (len(labels) == 0 && len(key.Policies) == 0) || len(deployments) == 0 {
 		return &apiservice.ListDeploymentsResponse{
 			Deployments: deployments,
 			Cursor:      cursor,
 		}, nil
 	}
 
+	// The deployments which are not allowed to be listed by the policies of the key are also filtered out here.
+	match := func(d *model.Deployment) bool {
+		return d.ContainLabels(labels) && key.HasResourcePermission(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_LIST, d.Labels)
+	}
+
 	// Start filtering them by labels.
 	//
 	// NOTE: Filtering by labels is done by the application-side because we need to create composite indexes for every combination in the filter.
 	// We don't want to depend on any other search engine, that's why it filters here.
 	filtered := make([]*model.Deployment, 0, len(deployments))
 	for _, d := range deployments {
-		if d.ContainLabels(labels) {
+		if match(d) {
 			filtered = append(filtered, d)
 		}
 	}
@@ -638,7 +706,7 @@ func (a *API) ListDeployments(ctx context.Context, req *apiservice.ListDeploymen
 			break
 		}
 		for _, d := range deployments {
-			if d.ContainLabels(labels) {
+			if match(d) {
 				filtered = append(filtered, d)
 			}
 		}
@@ -649,6 +717,191 @@ func (a *API) ListDeployments(ctx context.Context, req *apiservice.ListDeploymen
 	}, nil
 }
 
+// CancelDeployment adds a command to cancel the specified deployment.
+func (a *API) CancelDeployment(ctx context.Context, req *apiservice.CancelDeploymentRequest) (*apiservice.CancelDeploymentResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+
+	if err := requireResourcePermission(key, model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE, deployment.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
+	if deployment.Status.IsCompleted() {
+		return nil, status.Error(codes.FailedPrecondition, "Could not cancel the deployment because it was already completed")
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  req.DeploymentId,
+		Type:          model.Command_CANCEL_DEPLOYMENT,
+		Commander:     key.Id,
+		CancelDeployment: &model.Command_CancelDeployment{
+			DeploymentId:    req.DeploymentId,
+			ForceRollback:   req.ForceRollback,
+			ForceNoRollback: req.ForceNoRollback,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &apiservice.CancelDeploymentResponse{
+		CommandId: cmd.Id,
+	}, nil
+}
+
+// ApproveStage approves a WAIT_APPROVAL stage of a deployment.
+// When the stage specifies its approvers, the ID of the API key must be one of them.
+func (a *API) ApproveStage(ctx context.Context, req *apiservice.ApproveStageRequest) (*apiservice.ApproveStageResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+
+	if err := requireResourcePermission(key, model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE, deployment.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
+	stage, ok := deployment.Stage(req.StageId)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "The stage was not found in the deployment")
+	}
+	if stage.Name != model.StageWaitApproval.String() {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("APPROVE STAGE is not supported for stage %q", stage.Name))
+	}
+	if stage.Status.IsCompleted() {
+		return nil, status.Error(codes.FailedPrecondition, "Could not approve the stage because it was already completed")
+	}
+	if err := validateApprover(deployment.Stages, key.Id, req.StageId); err != nil {
+		return nil, err
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  req.DeploymentId,
+		StageId:       req.StageId,
+		Type:          model.Command_APPROVE_STAGE,
+		Commander:     key.Id,
+		ApproveStage: &model.Command_ApproveStage{
+			DeploymentId: req.DeploymentId,
+			StageId:      req.StageId,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &apiservice.ApproveStageResponse{
+		CommandId: cmd.Id,
+	}, nil
+}
+
+// SkipStage adds a command to skip the specified stage of a deployment.
+func (a *API) SkipStage(ctx context.Context, req *apiservice.SkipStageRequest) (*apiservice.SkipStageResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := getDeployment(ctx, a.deploymentStore, req.DeploymentId, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.ProjectId != deployment.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
+	}
+
+	if err := requireResourcePermission(key, model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_UPDATE, deployment.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
+	stage, ok := deployment.Stage(req.StageId)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "The stage was not found in the deployment")
+	}
+	if !stage.IsSkippable() {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("SKIP STAGE is not supported for stage %q", stage.Name))
+	}
+	if stage.Status.IsCompleted() {
+		return nil, status.Error(codes.FailedPrecondition, "Could not skip the stage because it was already completed")
+	}
+
+	cmd := model.Command{
+		Id:            uuid.New().String(),
+		PipedId:       deployment.PipedId,
+		ApplicationId: deployment.ApplicationId,
+		ProjectId:     deployment.ProjectId,
+		DeploymentId:  req.DeploymentId,
+		StageId:       req.StageId,
+		Type:          model.Command_SKIP_STAGE,
+		Commander:     key.Id,
+		SkipStage: &model.Command_SkipStage{
+			DeploymentId: req.DeploymentId,
+			StageId:      req.StageId,
+		},
+	}
+	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
+		return nil, err
+	}
+
+	return &apiservice.SkipStageResponse{
+		CommandId: cmd.Id,
+	}, nil
+}
+
+// ApproveDeploymentChainGate approves the gate placed before the specified block of the deployment chain.
+func (a *API) ApproveDeploymentChainGate(ctx context.Context, req *apiservice.ApproveDeploymentChainGateRequest) (*apiservice.ApproveDeploymentChainGateResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_WRITE, a.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	dc, err := a.deploymentChainStore.Get(ctx, req.DeploymentChainId)
+	if err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("get deployment chain %s", req.DeploymentChainId))
+	}
+	if key.ProjectId != dc.ProjectId {
+		return nil, status.Error(codes.InvalidArgument, "Requested deployment chain does not belong to your project")
+	}
+
+	if err := a.deploymentChainStore.ApproveBlockGate(ctx, req.DeploymentChainId, req.BlockIndex, key.Id); err != nil {
+		if errors.Is(err, datastore.ErrInvalidArgument) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		a.logger.Error("failed to approve deployment chain gate", zap.Error(err))
+		return nil, gRPCStoreError(err, fmt.Sprintf("approve gate of deployment chain %s", req.DeploymentChainId))
+	}
+
+	return &apiservice.ApproveDeploymentChainGateResponse{}, nil
+}
+
 func (a *API) ListStageLogs(ctx context.Context, req *apiservice.ListStageLogsRequest) (*apiservice.ListStageLogsResponse, error) {
 	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
 	if err != nil {
@@ -664,6 +917,10 @@ func (a *API) ListStageLogs(ctx context.Context, req *apiservice.ListStageLogsRe
 		return nil, status.Error(codes.InvalidArgument, "Requested deployment does not belong to your project")
 	}
 
+	if err := requireResourcePermission(key, model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_GET, deployment.Labels, a.logger); err != nil {
+		return nil, err
+	}
+
 	stageLogs := map[string]*apiservice.StageLog{}
 
 	for _, stage := range deployment.Stages {
@@ -814,20 +1071,12 @@ func (a *API) RegisterEvent(ctx context.Context, req *apiservice.RegisterEventRe
 	if err != nil {
 		return nil, err
 	}
+	if err := requireResourcePermission(key, model.ProjectRBACResource_EVENT, model.ProjectRBACPolicy_CREATE, req.Labels, a.logger); err != nil {
+		return nil, err
+	}
 	id := uuid.New().String()
 
-	event := model.Event{
-		Id:                id,
-		Name:              req.Name,
-		Data:              req.Data,
-		Labels:            req.Labels,
-		Contexts:          req.Contexts,
-		TriggerCommitHash: req.CommitHash,
-		EventKey:          model.MakeEventKey(req.Name, req.Labels),
-		ProjectId:         key.ProjectId,
-		Status:            model.EventStatus_EVENT_NOT_HANDLED,
-		StatusDescription: fmt.Sprintf("It is going to be replaced by %s", req.Data),
-	}
+	event := model.NewEvent(id, key.ProjectId, req.Name, req.Data, req.Labels, req.Contexts, req.CommitHash)
 	if err = a.eventStore.Add(ctx, event); err != nil {
 		return nil, gRPCStoreError(err, fmt.Sprintf("add event %s", id))
 	}
@@ -1045,6 +1294,75 @@ func (a *API) GetPlanPreviewResults(ctx context.Context, req *apiservice.GetPlan
 	}, nil
 }
 
+func (a *API) ListAuditLogs(ctx context.Context, req *apiservice.ListAuditLogsRequest) (*apiservice.ListAuditLogsResponse, error) {
+	key, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireResourcePermission(key, model.ProjectRBACResource_AUDIT_LOG, model.ProjectRBACPolicy_LIST, nil, a.logger); err != nil {
+		return nil, err
+	}
+
+	orders := []datastore.Order{
+		{
+			Field:     "CreatedAt",
+			Direction: datastore.Desc,
+		},
+		{
+			Field:     "Id",
+			Direction: datastore.Asc,
+		},
+	}
+	filters := []datastore.ListFilter{
+		{
+			Field:    "ProjectId",
+			Operator: datastore.OperatorEqual,
+			Value:    key.ProjectId,
+		},
+		{
+			Field:    "CreatedAt",
+			Operator: datastore.OperatorGreaterThanOrEqual,
+			Value:    req.Since,
+		},
+	}
+	if req.Actor != "" {
+		filters = append(filters, datastore.ListFilter{
+			Field:    "Actor",
+			Operator: datastore.OperatorEqual,
+			Value:    req.Actor,
+		})
+	}
+	if req.ResourceType != "" {
+		typ, ok := model.ProjectRBACResource_ResourceType_value[req.ResourceType]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is invalid resource type", req.ResourceType)
+		}
+		if model.ProjectRBACResource_ResourceType(typ) != model.ProjectRBACResource_ALL {
+			filters = append(filters, datastore.ListFilter{
+				Field:    "ResourceType",
+				Operator: datastore.OperatorEqual,
+				Value:    model.ProjectRBACResource_ResourceType(typ),
+			})
+		}
+	}
+
+	logs, cursor, err := a.auditLogStore.List(ctx, datastore.ListOptions{
+		Filters: filters,
+		Orders:  orders,
+		Limit:   int(req.Limit),
+		Cursor:  req.Cursor,
+	})
+	if err != nil {
+		a.logger.Error("failed to list audit logs", zap.Error(err))
+		return nil, gRPCStoreError(err, "list audit logs")
+	}
+
+	return &apiservice.ListAuditLogsResponse{
+		AuditLogs: logs,
+		Cursor:    cursor,
+	}, nil
+}
+
 func (a *API) Encrypt(ctx context.Context, req *apiservice.EncryptRequest) (*apiservice.EncryptResponse, error) {
 	_, err := requireAPIKey(ctx, model.APIKey_READ_ONLY, a.logger)
 	if err != nil {
@@ -1076,6 +1394,20 @@ func (a *API) Encrypt(ctx context.Context, req *apiservice.EncryptRequest) (*api
 	}, nil
 }
 
+// requireResourcePermission checks whether the given API key is allowed to perform the action
+// on the resource which has the given labels.
+func requireResourcePermission(key *model.APIKey, typ model.ProjectRBACResource_ResourceType, action model.ProjectRBACPolicy_Action, labels map[string]string, logger *zap.Logger) error {
+	if key.HasResourcePermission(typ, action, labels) {
+		return nil
+	}
+	logger.Warn("detected an API key that is not allowed to access the resource",
+		zap.String("key", key.Id),
+		zap.String("resource", typ.String()),
+		zap.String("action", action.String()),
+	)
+	return status.Error(codes.PermissionDenied, "Permission denied")
+}
+
 // requireAPIKey checks the existence of an API key inside the given context
 // and ensures that it has enough permissions for the give role.
 func requireAPIKey(ctx context.Context, role model.APIKey_Role, logger *zap.Logger) (*model.APIKey, error) {
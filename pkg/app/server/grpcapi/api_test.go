@@ -22,6 +22,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/server/commandstore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
 	"github.com/pipe-cd/pipecd/pkg/datastore"
 	"github.com/pipe-cd/pipecd/pkg/datastore/datastoretest"
@@ -166,3 +167,244 @@ func TestListApplicationsCursor(t *testing.T) {
 		})
 	}
 }
+
+type fakeCommandStore struct {
+	commandstore.Store
+	commands []*model.Command
+}
+
+func (s *fakeCommandStore) AddCommand(_ context.Context, cmd *model.Command) error {
+	s.commands = append(s.commands, cmd)
+	return nil
+}
+
+func TestApproveStage(t *testing.T) {
+	deployment := &model.Deployment{
+		Id:        "deployment-id",
+		ProjectId: "project-id",
+		Stages: []*model.PipelineStage{
+			{
+				Id:     "stage-approval",
+				Name:   model.StageWaitApproval.String(),
+				Status: model.StageStatus_STAGE_RUNNING,
+			},
+			{
+				Id:                  "stage-restricted-approval",
+				Name:                model.StageWaitApproval.String(),
+				Status:              model.StageStatus_STAGE_RUNNING,
+				AuthorizedOperators: []string{"user-1"},
+			},
+			{
+				Id:     "stage-completed-approval",
+				Name:   model.StageWaitApproval.String(),
+				Status: model.StageStatus_STAGE_SUCCESS,
+			},
+			{
+				Id:     "stage-sync",
+				Name:   model.StageK8sSync.String(),
+				Status: model.StageStatus_STAGE_RUNNING,
+			},
+		},
+	}
+
+	testcases := []struct {
+		name        string
+		projectID   string
+		stageID     string
+		expectedErr string
+	}{
+		{
+			name:      "ok",
+			projectID: "project-id",
+			stageID:   "stage-approval",
+		},
+		{
+			name:        "deployment in another project",
+			projectID:   "another-project-id",
+			stageID:     "stage-approval",
+			expectedErr: "rpc error: code = InvalidArgument desc = Requested deployment does not belong to your project",
+		},
+		{
+			name:        "stage not found",
+			projectID:   "project-id",
+			stageID:     "stage-unknown",
+			expectedErr: "rpc error: code = FailedPrecondition desc = The stage was not found in the deployment",
+		},
+		{
+			name:        "not a WAIT_APPROVAL stage",
+			projectID:   "project-id",
+			stageID:     "stage-sync",
+			expectedErr: `rpc error: code = FailedPrecondition desc = APPROVE STAGE is not supported for stage "K8S_SYNC"`,
+		},
+		{
+			name:        "stage already completed",
+			projectID:   "project-id",
+			stageID:     "stage-completed-approval",
+			expectedErr: "rpc error: code = FailedPrecondition desc = Could not approve the stage because it was already completed",
+		},
+		{
+			name:        "api key is not an approver",
+			projectID:   "project-id",
+			stageID:     "stage-restricted-approval",
+			expectedErr: "rpc error: code = PermissionDenied desc = You can't approve this deployment because you (key-id) are not in the approver list: [user-1]",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			deploymentStore := datastoretest.NewMockDeploymentStore(ctrl)
+			deploymentStore.EXPECT().Get(gomock.Any(), deployment.Id).Return(deployment, nil)
+			commandStore := &fakeCommandStore{}
+
+			api := &API{
+				deploymentStore: deploymentStore,
+				commandStore:    commandStore,
+				logger:          zap.NewNop(),
+			}
+			ctx := rpcauth.ContextWithAPIKey(context.TODO(), &model.APIKey{
+				Id:        "key-id",
+				ProjectId: tc.projectID,
+				Role:      model.APIKey_READ_WRITE,
+			})
+
+			resp, err := api.ApproveStage(ctx, &apiservice.ApproveStageRequest{
+				DeploymentId: deployment.Id,
+				StageId:      tc.stageID,
+			})
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				assert.Empty(t, commandStore.commands)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, commandStore.commands, 1)
+			cmd := commandStore.commands[0]
+			assert.Equal(t, resp.CommandId, cmd.Id)
+			assert.Equal(t, model.Command_APPROVE_STAGE, cmd.Type)
+			assert.Equal(t, "key-id", cmd.Commander)
+			assert.Equal(t, tc.stageID, cmd.ApproveStage.StageId)
+		})
+	}
+}
+
+func TestListApplicationsWithScopedAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := datastoretest.NewMockApplicationStore(ctrl)
+	store.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return([]*model.Application{
+			{Id: "app-1", ProjectId: "project-id", Labels: map[string]string{"env": "dev"}},
+			{Id: "app-2", ProjectId: "project-id", Labels: map[string]string{"env": "prod"}},
+		}, "", nil)
+
+	api := &API{
+		applicationStore: store,
+		logger:           zap.NewNop(),
+	}
+	ctx := rpcauth.ContextWithAPIKey(context.TODO(), &model.APIKey{
+		ProjectId: "project-id",
+		Role:      model.APIKey_READ_ONLY,
+		Policies: []*model.ProjectRBACPolicy{
+			{
+				Resources: []*model.ProjectRBACResource{
+					{
+						Type:   model.ProjectRBACResource_APPLICATION,
+						Labels: map[string]string{"env": "dev"},
+					},
+				},
+				Actions: []model.ProjectRBACPolicy_Action{model.ProjectRBACPolicy_LIST},
+			},
+		},
+	})
+
+	resp, err := api.ListApplications(ctx, &apiservice.ListApplicationsRequest{Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Applications, 1)
+	assert.Equal(t, "app-1", resp.Applications[0].Id)
+}
+
+type fakeAuditLogStore struct {
+	opts datastore.ListOptions
+}
+
+func (s *fakeAuditLogStore) List(_ context.Context, opts datastore.ListOptions) ([]*model.AuditLog, string, error) {
+	s.opts = opts
+	return []*model.AuditLog{{Id: "log-1"}}, "cursor", nil
+}
+
+func TestListAuditLogs(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		req         *apiservice.ListAuditLogsRequest
+		wantFilters []datastore.ListFilter
+		wantErr     bool
+	}{
+		{
+			name: "no filter",
+			req:  &apiservice.ListAuditLogsRequest{Since: 100},
+			wantFilters: []datastore.ListFilter{
+				{Field: "ProjectId", Operator: datastore.OperatorEqual, Value: "project-id"},
+				{Field: "CreatedAt", Operator: datastore.OperatorGreaterThanOrEqual, Value: int64(100)},
+			},
+		},
+		{
+			name: "filter by actor and resource type",
+			req: &apiservice.ListAuditLogsRequest{
+				Actor:        "key-id",
+				ResourceType: "APPLICATION",
+			},
+			wantFilters: []datastore.ListFilter{
+				{Field: "ProjectId", Operator: datastore.OperatorEqual, Value: "project-id"},
+				{Field: "CreatedAt", Operator: datastore.OperatorGreaterThanOrEqual, Value: int64(0)},
+				{Field: "Actor", Operator: datastore.OperatorEqual, Value: "key-id"},
+				{Field: "ResourceType", Operator: datastore.OperatorEqual, Value: model.ProjectRBACResource_APPLICATION},
+			},
+		},
+		{
+			name: "ALL resource type is not used as filter",
+			req:  &apiservice.ListAuditLogsRequest{ResourceType: "ALL"},
+			wantFilters: []datastore.ListFilter{
+				{Field: "ProjectId", Operator: datastore.OperatorEqual, Value: "project-id"},
+				{Field: "CreatedAt", Operator: datastore.OperatorGreaterThanOrEqual, Value: int64(0)},
+			},
+		},
+		{
+			name:    "invalid resource type",
+			req:     &apiservice.ListAuditLogsRequest{ResourceType: "UNKNOWN"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := &fakeAuditLogStore{}
+			api := &API{
+				auditLogStore: store,
+				logger:        zap.NewNop(),
+			}
+			ctx := rpcauth.ContextWithAPIKey(context.TODO(), &model.APIKey{
+				ProjectId: "project-id",
+				Role:      model.APIKey_READ_ONLY,
+			})
+
+			resp, err := api.ListAuditLogs(ctx, tc.req)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantFilters, store.opts.Filters)
+			assert.Len(t, resp.AuditLogs, 1)
+			assert.Equal(t, "cursor", resp.Cursor)
+		})
+	}
+}
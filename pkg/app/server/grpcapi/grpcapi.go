@@ -155,7 +155,9 @@ func getEncriptionKey(se *model.Piped_SecretEncryption) ([]byte, error) {
 		return nil, status.Error(codes.FailedPrecondition, "The piped does not contain a public key")
 	}
 	switch model.SecretManagementType(se.Type) {
-	case model.SecretManagementTypeKeyPair:
+	// The KMS types use the same hybrid encryption with the public key of the KMS key,
+	// so the ciphertext can be decrypted by piped through KMS.
+	case model.SecretManagementTypeKeyPair, model.SecretManagementTypeGCPKMS, model.SecretManagementTypeAWSKMS:
 		if se.PublicKey == "" {
 			return nil, status.Error(codes.FailedPrecondition, "The piped does not contain a public key")
 		}
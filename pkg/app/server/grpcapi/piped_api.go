@@ -70,6 +70,7 @@ type pipedAPIDeploymentStore interface {
 type pipedAPIDeploymentChainStore interface {
 	Add(ctx context.Context, d *model.DeploymentChain) error
 	Get(ctx context.Context, id string) (*model.DeploymentChain, error)
+	UpdateBlockGateAnalysisResult(ctx context.Context, chainID string, blockIndex uint32, passed bool, reason string) error
 }
 
 type pipedAPIPipedStore interface {
@@ -77,6 +78,10 @@ type pipedAPIPipedStore interface {
 	UpdateMetadata(ctx context.Context, id, version, config string, pps []*model.Piped_PlatformProvider, pls []*model.Piped_Plugin, repos []*model.ApplicationGitRepository, se *model.Piped_SecretEncryption, startedAt int64) error
 }
 
+type pipedAPIProjectStore interface {
+	Get(ctx context.Context, id string) (*model.Project, error)
+}
+
 type pipedAPIEventStore interface {
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Event, string, error)
 	UpdateStatus(ctx context.Context, eventID string, status model.EventStatus, statusDescription string) error
@@ -94,6 +99,7 @@ type PipedAPI struct {
 	deploymentStore           pipedAPIDeploymentStore
 	deploymentChainStore      pipedAPIDeploymentChainStore
 	pipedStore                pipedAPIPipedStore
+	projectStore              pipedAPIProjectStore
 	eventStore                pipedAPIEventStore
 	stageLogStore             stagelogstore.Store
 	applicationLiveStateStore applicationlivestatestore.Store
@@ -118,6 +124,7 @@ func NewPipedAPI(ctx context.Context, ds datastore.DataStore, sc cache.Cache, sl
 		deploymentStore:           datastore.NewDeploymentStore(ds),
 		deploymentChainStore:      datastore.NewDeploymentChainStore(ds),
 		pipedStore:                datastore.NewPipedStore(ds),
+		projectStore:              datastore.NewProjectStore(ds),
 		eventStore:                datastore.NewEventStore(ds),
 		stageLogStore:             sls,
 		applicationLiveStateStore: alss,
@@ -1049,11 +1056,25 @@ func (a *PipedAPI) CreateDeploymentChain(ctx context.Context, req *pipedservice.
 			return nil, err
 		}
 
+		var gate *model.ChainBlockGate
+		if g := filter.Gate; g != nil {
+			// Only the gate configuration is taken from piped, the state is always managed by control-plane.
+			gate = &model.ChainBlockGate{
+				WaitSeconds:      g.WaitSeconds,
+				AnalysisTemplate: g.AnalysisTemplate,
+				AnalysisConfig:   g.AnalysisConfig,
+				RequireApproval:  g.RequireApproval,
+				Status:           model.ChainGateStatus_CHAIN_GATE_PENDING,
+			}
+		}
+
 		blockAppsMap[i+1] = blockApps
 		chainBlocks = append(chainBlocks, &model.ChainBlock{
-			Nodes:     nodes,
-			Status:    model.ChainBlockStatus_DEPLOYMENT_BLOCK_PENDING,
-			StartedAt: time.Now().Unix(),
+			Nodes:             nodes,
+			Status:            model.ChainBlockStatus_DEPLOYMENT_BLOCK_PENDING,
+			ContinueOnFailure: filter.ContinueOnFailure,
+			Gate:              gate,
+			StartedAt:         time.Now().Unix(),
 		})
 	}
 
@@ -1109,6 +1130,7 @@ func (a *PipedAPI) CreateDeploymentChain(ctx context.Context, req *pipedservice.
 // An in chain deployment is treated as plannable in case:
 // - It's the first deployment of its deployment chain.
 // - All deployments of its previous block in chain are at DEPLOYMENT_SUCCESS state.
+// - All deployments of its previous block in chain are completed and that block is configured to continue on failure.
 // In case the previous block is finished with unsuccessfully status, cancelled flag will be returned
 // so that the in charge piped will be aware and stop that deployment.
 func (a *PipedAPI) InChainDeploymentPlannable(ctx context.Context, req *pipedservice.InChainDeploymentPlannableRequest) (*pipedservice.InChainDeploymentPlannableResponse, error) {
@@ -1162,6 +1184,11 @@ func (a *PipedAPI) InChainDeploymentPlannable(ctx context.Context, req *pipedser
 	case model.ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS:
 		plannable = true
 	case model.ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE:
+		// The previous block allows the next blocks to be continued even it's failed.
+		if previousBlock.ContinueOnFailure {
+			plannable = true
+			break
+		}
 		cancel = true
 		reason = "Previous block finished with FAILURE status"
 	case model.ChainBlockStatus_DEPLOYMENT_BLOCK_CANCELLED:
@@ -1169,13 +1196,62 @@ func (a *PipedAPI) InChainDeploymentPlannable(ctx context.Context, req *pipedser
 		reason = "Previous block finished with CANCELLED status"
 	}
 
+	// The gate of the current block must be passed before planning its deployments.
+	if plannable && currentBlock.Gate != nil {
+		switch currentBlock.Gate.Status {
+		case model.ChainGateStatus_CHAIN_GATE_PASSED:
+			plannable = true
+		case model.ChainGateStatus_CHAIN_GATE_FAILED:
+			plannable = false
+			cancel = true
+			reason = fmt.Sprintf("Gate before this block failed: %s", currentBlock.Gate.StatusReason)
+		default:
+			plannable = false
+		}
+	}
+
+	// The analysis of the gate is run by piped, so the gate is sent back to let piped run it.
+	var gateToAnalyze *model.ChainBlockGate
+	if gate := currentBlock.Gate; gate != nil && previousBlock.IsPassed() && gate.IsWaitingForAnalysis(time.Unix(previousBlock.CompletedAt, 0), time.Now()) {
+		gateToAnalyze = gate
+	}
+
 	return &pipedservice.InChainDeploymentPlannableResponse{
-		Plannable:    plannable,
-		Cancel:       cancel,
-		CancelReason: reason,
+		Plannable:     plannable,
+		Cancel:        cancel,
+		CancelReason:  reason,
+		GateToAnalyze: gateToAnalyze,
 	}, nil
 }
 
+// ReportDeploymentChainGateAnalysisResult stores the result of the analysis run by piped
+// for the gate of the block which contains the given deployment.
+func (a *PipedAPI) ReportDeploymentChainGateAnalysisResult(ctx context.Context, req *pipedservice.ReportDeploymentChainGateAnalysisResultRequest) (*pipedservice.ReportDeploymentChainGateAnalysisResultResponse, error) {
+	_, pipedID, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.validateDeploymentBelongsToPiped(ctx, req.DeploymentId, pipedID); err != nil {
+		return nil, err
+	}
+
+	dc, err := a.deploymentChainStore.Get(ctx, req.DeploymentChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "unable to find the deployment chain which this deployment belongs to")
+	}
+	if req.DeploymentChainBlockIndex >= uint32(len(dc.Blocks)) {
+		return nil, status.Error(codes.InvalidArgument, "invalid deployment with chain block index provided")
+	}
+	if _, err := dc.Blocks[req.DeploymentChainBlockIndex].GetNodeByDeploymentID(req.DeploymentId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "the deployment does not belong to the given block of deployment chain")
+	}
+
+	if err := a.deploymentChainStore.UpdateBlockGateAnalysisResult(ctx, req.DeploymentChainId, req.DeploymentChainBlockIndex, req.Passed, req.Reason); err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("update the analysis result of gate of block %d in deployment chain %s", req.DeploymentChainBlockIndex, req.DeploymentChainId))
+	}
+	return &pipedservice.ReportDeploymentChainGateAnalysisResultResponse{}, nil
+}
+
 // validateAppBelongsToPiped checks if the given application belongs to the given piped.
 // It gives back an error unless the application belongs to the piped.
 func (a *PipedAPI) validateAppBelongsToPiped(ctx context.Context, appID, pipedID string) error {
@@ -1263,3 +1339,24 @@ func (a *PipedAPI) PutApplicationSharedObject(ctx context.Context, req *pipedser
 
 	return &pipedservice.PutApplicationSharedObjectResponse{}, nil
 }
+
+// GetProjectFreezeWindows returns the deployment freeze windows configured in the project of piped.
+func (a *PipedAPI) GetProjectFreezeWindows(ctx context.Context, req *pipedservice.GetProjectFreezeWindowsRequest) (*pipedservice.GetProjectFreezeWindowsResponse, error) {
+	projectID, _, _, err := rpcauth.ExtractPipedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := a.projectStore.Get(ctx, projectID)
+	if errors.Is(err, datastore.ErrNotFound) {
+		// The projects specified in the control-plane configuration are not stored in the datastore.
+		return &pipedservice.GetProjectFreezeWindowsResponse{}, nil
+	}
+	if err != nil {
+		return nil, gRPCStoreError(err, fmt.Sprintf("get project %s", projectID))
+	}
+
+	return &pipedservice.GetProjectFreezeWindowsResponse{
+		FreezeWindows: p.FreezeWindows,
+	}, nil
+}
@@ -98,12 +98,18 @@ type webAPIProjectStore interface {
 	DeleteProjectRBACRole(ctx context.Context, id, name string) error
 	AddProjectUserGroup(ctx context.Context, id, sso, role string) error
 	DeleteProjectUserGroup(ctx context.Context, id, sso string) error
+	UpdateProjectRegistryWebhookConfig(ctx context.Context, id string, webhook *model.ProjectRegistryWebhookConfig) error
+	UpdateProjectFreezeWindows(ctx context.Context, id string, windows []*model.ProjectFreezeWindow) error
 }
 
 type webAPIEventStore interface {
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.Event, string, error)
 }
 
+type webAPIAuditLogStore interface {
+	List(ctx context.Context, opts datastore.ListOptions) ([]*model.AuditLog, string, error)
+}
+
 type webAPIDeploymentTraceStore interface {
 	List(ctx context.Context, opts datastore.ListOptions) ([]*model.DeploymentTrace, string, error)
 }
@@ -131,6 +137,7 @@ type WebAPI struct {
 	apiKeyStore               webAPIAPIKeyStore
 	apiKeyLastUsedStore       webAPIAPIKeyLastUsedStore
 	eventStore                webAPIEventStore
+	auditLogStore             webAPIAuditLogStore
 	stageLogStore             stagelogstore.Store
 	applicationLiveStateStore applicationlivestatestore.Store
 	commandStore              commandstore.Store
@@ -173,6 +180,7 @@ func NewWebAPI(
 		apiKeyStore:               datastore.NewAPIKeyStore(ds),
 		apiKeyLastUsedStore:       akluc,
 		eventStore:                datastore.NewEventStore(ds),
+		auditLogStore:             datastore.NewAuditLogStore(ds),
 		stageLogStore:             sls,
 		applicationLiveStateStore: alss,
 		commandStore:              commandstore.NewStore(ds, sc, logger),
@@ -727,8 +735,9 @@ func (a *WebAPI) SyncApplication(ctx context.Context, req *webservice.SyncApplic
 		Type:          model.Command_SYNC_APPLICATION,
 		Commander:     claims.Subject,
 		SyncApplication: &model.Command_SyncApplication{
-			ApplicationId: app.Id,
-			SyncStrategy:  req.SyncStrategy,
+			ApplicationId:       app.Id,
+			SyncStrategy:        req.SyncStrategy,
+			IgnoreFreezeWindows: req.IgnoreFreezeWindows,
 		},
 	}
 	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
@@ -1539,6 +1548,53 @@ func (a *WebAPI) DeleteProjectUserGroup(ctx context.Context, req *webservice.Del
 	return &webservice.DeleteProjectUserGroupResponse{}, nil
 }
 
+// UpdateProjectRegistryWebhookConfig updates the settings for the container registry webhooks.
+func (a *WebAPI) UpdateProjectRegistryWebhookConfig(ctx context.Context, req *webservice.UpdateProjectRegistryWebhookConfigRequest) (*webservice.UpdateProjectRegistryWebhookConfigResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if _, ok := a.projectsInConfig[claims.Role.ProjectId]; ok {
+		return nil, status.Error(codes.FailedPrecondition, "Failed to update a debug project specified in the control-plane configuration")
+	}
+
+	if err := req.RegistryWebhook.Encrypt(a.encrypter); err != nil {
+		a.logger.Error("failed to encrypt the registry webhook secret", zap.Error(err))
+		return nil, gRPCStoreError(err, "encrypt the registry webhook secret")
+	}
+
+	if err := a.projectStore.UpdateProjectRegistryWebhookConfig(ctx, claims.Role.ProjectId, req.RegistryWebhook); err != nil {
+		a.logger.Error("failed to update project registry webhook settings", zap.Error(err))
+		return nil, gRPCStoreError(err, "update project registry webhook settings")
+	}
+	return &webservice.UpdateProjectRegistryWebhookConfigResponse{}, nil
+}
+
+// UpdateProjectFreezeWindows replaces the deployment freeze windows of the project.
+func (a *WebAPI) UpdateProjectFreezeWindows(ctx context.Context, req *webservice.UpdateProjectFreezeWindowsRequest) (*webservice.UpdateProjectFreezeWindowsResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	if _, ok := a.projectsInConfig[claims.Role.ProjectId]; ok {
+		return nil, status.Error(codes.FailedPrecondition, "Failed to update a debug project specified in the control-plane configuration")
+	}
+
+	if _, err := config.NewProjectFreezeWindows(req.FreezeWindows); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := a.projectStore.UpdateProjectFreezeWindows(ctx, claims.Role.ProjectId, req.FreezeWindows); err != nil {
+		a.logger.Error("failed to update project freeze windows", zap.Error(err))
+		return nil, gRPCStoreError(err, "update project freeze windows")
+	}
+	return &webservice.UpdateProjectFreezeWindowsResponse{}, nil
+}
+
 func (a *WebAPI) GetCommand(ctx context.Context, req *webservice.GetCommandRequest) (*webservice.GetCommandResponse, error) {
 	claims, err := rpcauth.ExtractClaims(ctx)
 	if err != nil {
@@ -1567,6 +1623,10 @@ func (a *WebAPI) GenerateAPIKey(ctx context.Context, req *webservice.GenerateAPI
 		return nil, err
 	}
 
+	if req.ExpiresAt > 0 && req.ExpiresAt <= time.Now().Unix() {
+		return nil, status.Error(codes.InvalidArgument, "The expiration time must be in the future")
+	}
+
 	id := uuid.New().String()
 	key, hash, err := model.GenerateAPIKey(id)
 	if err != nil {
@@ -1582,6 +1642,8 @@ func (a *WebAPI) GenerateAPIKey(ctx context.Context, req *webservice.GenerateAPI
 		Role:       req.Role,
 		Creator:    claims.Subject,
 		LastUsedAt: 0,
+		Policies:   req.Policies,
+		ExpiresAt:  req.ExpiresAt,
 	}
 
 	if err = a.apiKeyStore.Add(ctx, &apiKey); err != nil {
@@ -1704,6 +1766,28 @@ func (a *WebAPI) GetInsightData(ctx context.Context, req *webservice.GetInsightD
 			req.Resolution,
 		)
 
+	case model.InsightMetricsKind_LEAD_TIME:
+		points, err = a.insightProvider.GetDeploymentLeadTimeDataPoints(
+			ctx,
+			claims.Role.ProjectId,
+			req.ApplicationId,
+			req.Labels,
+			req.RangeFrom,
+			req.RangeTo,
+			req.Resolution,
+		)
+
+	case model.InsightMetricsKind_MTTR:
+		points, err = a.insightProvider.GetMTTRDataPoints(
+			ctx,
+			claims.Role.ProjectId,
+			req.ApplicationId,
+			req.Labels,
+			req.RangeFrom,
+			req.RangeTo,
+			req.Resolution,
+		)
+
 	default:
 		return nil, status.Error(codes.Unimplemented, fmt.Sprintf("The insight metrics %s is not implemented yet", req.MetricsKind.String()))
 	}
@@ -1931,6 +2015,69 @@ func (a *WebAPI) ListEvents(ctx context.Context, req *webservice.ListEventsReque
 	}, nil
 }
 
+func (a *WebAPI) ListAuditLogs(ctx context.Context, req *webservice.ListAuditLogsRequest) (*webservice.ListAuditLogsResponse, error) {
+	claims, err := rpcauth.ExtractClaims(ctx)
+	if err != nil {
+		a.logger.Error("failed to authenticate the current user", zap.Error(err))
+		return nil, err
+	}
+
+	orders := []datastore.Order{
+		{
+			Field:     "CreatedAt",
+			Direction: datastore.Desc,
+		},
+		{
+			Field:     "Id",
+			Direction: datastore.Asc,
+		},
+	}
+	filters := []datastore.ListFilter{
+		{
+			Field:    "ProjectId",
+			Operator: datastore.OperatorEqual,
+			Value:    claims.Role.ProjectId,
+		},
+		{
+			Field:    "CreatedAt",
+			Operator: datastore.OperatorGreaterThanOrEqual,
+			Value:    req.PageMinCreatedAt,
+		},
+	}
+	if o := req.Options; o != nil {
+		if o.Actor != "" {
+			filters = append(filters, datastore.ListFilter{
+				Field:    "Actor",
+				Operator: datastore.OperatorEqual,
+				Value:    o.Actor,
+			})
+		}
+		if o.ResourceType != model.ProjectRBACResource_ALL {
+			filters = append(filters, datastore.ListFilter{
+				Field:    "ResourceType",
+				Operator: datastore.OperatorEqual,
+				Value:    o.ResourceType,
+			})
+		}
+	}
+
+	logs, cursor, err := a.auditLogStore.List(ctx, datastore.ListOptions{
+		Filters: filters,
+		Orders:  orders,
+		Limit:   int(req.PageSize),
+		Cursor:  req.Cursor,
+	})
+	if err != nil {
+		a.logger.Error("failed to list audit logs", zap.Error(err))
+		return nil, gRPCStoreError(err, "list audit logs")
+	}
+
+	return &webservice.ListAuditLogsResponse{
+		AuditLogs: logs,
+		Cursor:    cursor,
+	}, nil
+}
+
 func (a *WebAPI) ListReleasedVersions(ctx context.Context, req *webservice.ListReleasedVersionsRequest) (*webservice.ListReleasedVersionsResponse, error) {
 	_, err := rpcauth.ExtractClaims(ctx)
 	if err != nil {
@@ -0,0 +1,248 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrywebhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const (
+	ghcrHost = "ghcr.io"
+
+	dockerPushAction  = "push"
+	harborPushType    = "PUSH_ARTIFACT"
+	ghcrPublishAction = "published"
+	ghcrContainerType = "container"
+
+	githubEventHeader     = "X-GitHub-Event"
+	githubSignatureHeader = "X-Hub-Signature-256"
+	githubSignaturePrefix = "sha256="
+)
+
+// image represents a pushed container image.
+type image struct {
+	host       string
+	repository string
+	tag        string
+	digest     string
+	// fullName is the full image reference given by the registry, if any.
+	fullName string
+}
+
+// reference returns the reference of the image to be used as the event data.
+func (i image) reference() string {
+	if i.fullName != "" {
+		return i.fullName
+	}
+	ref := i.repository + ":" + i.tag
+	if i.host != "" {
+		ref = i.host + "/" + ref
+	}
+	return ref
+}
+
+type provider interface {
+	// authenticate reports whether the request was sent with the given secret.
+	authenticate(r *http.Request, body []byte, secret string) bool
+	// parse returns the tagged images pushed in the given payload.
+	parse(r *http.Request, body []byte) ([]image, error)
+}
+
+var providers = map[string]provider{
+	"docker": dockerProvider{},
+	"harbor": harborProvider{},
+	"ghcr":   ghcrProvider{},
+}
+
+// dockerProvider handles the notifications sent from Docker Registry v2.
+// The secret must be set as a bearer token in the Authorization header
+// through the headers field of the registry's notification endpoint.
+type dockerProvider struct{}
+
+type dockerPayload struct {
+	Events []struct {
+		Action string `json:"action"`
+		Target struct {
+			Repository string `json:"repository"`
+			Tag        string `json:"tag"`
+			Digest     string `json:"digest"`
+		} `json:"target"`
+		Request struct {
+			Host string `json:"host"`
+		} `json:"request"`
+	} `json:"events"`
+}
+
+func (dockerProvider) authenticate(r *http.Request, _ []byte, secret string) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && equalSecret(token, secret)
+}
+
+func (dockerProvider) parse(_ *http.Request, body []byte) ([]image, error) {
+	var payload dockerPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	images := make([]image, 0, len(payload.Events))
+	for _, e := range payload.Events {
+		// Pushing a layer or a manifest by digest does not give any tag.
+		if e.Action != dockerPushAction || e.Target.Tag == "" {
+			continue
+		}
+		images = append(images, image{
+			host:       e.Request.Host,
+			repository: e.Target.Repository,
+			tag:        e.Target.Tag,
+			digest:     e.Target.Digest,
+		})
+	}
+	return images, nil
+}
+
+// harborProvider handles the webhooks sent from Harbor.
+// The secret must be set to the Auth Header of the webhook policy,
+// either as it is or as a bearer token.
+type harborProvider struct{}
+
+type harborPayload struct {
+	Type      string `json:"type"`
+	EventData struct {
+		Resources []struct {
+			Digest      string `json:"digest"`
+			Tag         string `json:"tag"`
+			ResourceURL string `json:"resource_url"`
+		} `json:"resources"`
+		Repository struct {
+			RepoFullName string `json:"repo_full_name"`
+		} `json:"repository"`
+	} `json:"event_data"`
+}
+
+func (harborProvider) authenticate(r *http.Request, _ []byte, secret string) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return equalSecret(token, secret)
+}
+
+func (harborProvider) parse(_ *http.Request, body []byte) ([]image, error) {
+	var payload harborPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	if payload.Type != harborPushType {
+		return nil, nil
+	}
+	images := make([]image, 0, len(payload.EventData.Resources))
+	for _, res := range payload.EventData.Resources {
+		if res.Tag == "" {
+			continue
+		}
+		images = append(images, image{
+			repository: payload.EventData.Repository.RepoFullName,
+			tag:        res.Tag,
+			digest:     res.Digest,
+			fullName:   res.ResourceURL,
+		})
+	}
+	return images, nil
+}
+
+// ghcrProvider handles the package events of GitHub Container Registry.
+// The secret must be set to the secret of the GitHub webhook
+// which is used to sign the payload.
+type ghcrProvider struct{}
+
+type ghcrPackage struct {
+	Name           string `json:"name"`
+	Namespace      string `json:"namespace"`
+	PackageType    string `json:"package_type"`
+	PackageVersion struct {
+		Version           string `json:"version"`
+		PackageURL        string `json:"package_url"`
+		ContainerMetadata struct {
+			Tag struct {
+				Name   string `json:"name"`
+				Digest string `json:"digest"`
+			} `json:"tag"`
+		} `json:"container_metadata"`
+	} `json:"package_version"`
+}
+
+type ghcrPayload struct {
+	Action string `json:"action"`
+	// Package is set in the package events.
+	Package *ghcrPackage `json:"package"`
+	// RegistryPackage is set in the registry_package events.
+	RegistryPackage *ghcrPackage `json:"registry_package"`
+}
+
+func (ghcrProvider) authenticate(r *http.Request, body []byte, secret string) bool {
+	signature, ok := strings.CutPrefix(r.Header.Get(githubSignatureHeader), githubSignaturePrefix)
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+func (ghcrProvider) parse(r *http.Request, body []byte) ([]image, error) {
+	switch r.Header.Get(githubEventHeader) {
+	case "package", "registry_package":
+	default:
+		// Other events such as ping are accepted without doing anything.
+		return nil, nil
+	}
+
+	var payload ghcrPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+	pkg := payload.Package
+	if pkg == nil {
+		pkg = payload.RegistryPackage
+	}
+	if payload.Action != ghcrPublishAction || pkg == nil || !strings.EqualFold(pkg.PackageType, ghcrContainerType) {
+		return nil, nil
+	}
+	tag := pkg.PackageVersion.ContainerMetadata.Tag
+	if tag.Name == "" {
+		return nil, nil
+	}
+	digest := tag.Digest
+	if digest == "" {
+		digest = pkg.PackageVersion.Version
+	}
+	return []image{{
+		host:       ghcrHost,
+		repository: strings.ToLower(pkg.Namespace + "/" + pkg.Name),
+		tag:        tag.Name,
+		digest:     digest,
+		fullName:   pkg.PackageVersion.PackageURL,
+	}}, nil
+}
+
+func equalSecret(got, want string) bool {
+	return subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
+}
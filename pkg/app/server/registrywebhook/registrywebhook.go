@@ -0,0 +1,188 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registrywebhook provides an HTTP handler that receives the push webhooks
+// sent from container registries and registers them as events for EventWatcher.
+package registrywebhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// Path is the path prefix for receiving the webhooks.
+	// The full path is /webhook/registry/{project-id}/{provider}.
+	Path = "/webhook/registry/"
+
+	maxBodySize = 1 << 20
+
+	repositoryContextKey = "repository"
+	tagContextKey        = "tag"
+	digestContextKey     = "digest"
+	providerContextKey   = "provider"
+)
+
+type projectGetter interface {
+	Get(ctx context.Context, id string) (*model.Project, error)
+}
+
+type eventStore interface {
+	Add(ctx context.Context, e model.Event) error
+}
+
+type decrypter interface {
+	Decrypt(encryptedText string) (string, error)
+}
+
+// Handler handles the push webhooks sent from container registries.
+type Handler struct {
+	projectGetter projectGetter
+	eventStore    eventStore
+	decrypter     decrypter
+	logger        *zap.Logger
+}
+
+// NewHandler returns a handler that registers an event for every pushed image
+// matching the registry webhook rules of its project.
+func NewHandler(projectGetter projectGetter, eventStore eventStore, decrypter decrypter, logger *zap.Logger) *Handler {
+	return &Handler{
+		projectGetter: projectGetter,
+		eventStore:    eventStore,
+		decrypter:     decrypter,
+		logger:        logger.Named("registry-webhook"),
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	projectID, providerName, ok := parsePath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	p, ok := providers[providerName]
+	if !ok {
+		http.Error(w, fmt.Sprintf("Unsupported provider %s", providerName), http.StatusNotFound)
+		return
+	}
+	logger := h.logger.With(
+		zap.String("project-id", projectID),
+		zap.String("provider", providerName),
+	)
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		logger.Error("failed to read the webhook payload", zap.Error(err))
+		http.Error(w, "Failed to read the payload", http.StatusBadRequest)
+		return
+	}
+
+	cfg, err := h.getConfig(r.Context(), projectID)
+	if err != nil {
+		logger.Warn("unable to get the registry webhook configuration", zap.Error(err))
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if !p.authenticate(r, body, cfg.Secret) {
+		logger.Warn("received a registry webhook with an invalid secret")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	images, err := p.parse(r, body)
+	if err != nil {
+		logger.Warn("failed to parse the webhook payload", zap.Error(err))
+		http.Error(w, fmt.Sprintf("Invalid payload: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	var registered int
+	for _, img := range images {
+		rule, ok := cfg.FindRule(img.repository, img.tag)
+		if !ok {
+			logger.Debug("no rule matches the pushed image",
+				zap.String("repository", img.repository),
+				zap.String("tag", img.tag),
+			)
+			continue
+		}
+
+		contexts := map[string]string{
+			repositoryContextKey: img.repository,
+			tagContextKey:        img.tag,
+			providerContextKey:   providerName,
+		}
+		if img.digest != "" {
+			contexts[digestContextKey] = img.digest
+		}
+		event := model.NewEvent(uuid.New().String(), projectID, rule.EventName, img.reference(), rule.EventLabels, contexts, "")
+		if err := h.eventStore.Add(r.Context(), event); err != nil {
+			logger.Error("failed to register event", zap.String("event-name", rule.EventName), zap.Error(err))
+			http.Error(w, "Failed to register event", http.StatusInternalServerError)
+			return
+		}
+		logger.Info("successfully registered an event from the registry webhook",
+			zap.String("event-id", event.Id),
+			zap.String("event-name", event.Name),
+			zap.String("data", event.Data),
+		)
+		registered++
+	}
+
+	fmt.Fprintf(w, "%d event(s) registered", registered)
+}
+
+// getConfig returns the registry webhook configuration of the given project
+// with its secret decrypted.
+func (h *Handler) getConfig(ctx context.Context, projectID string) (*model.ProjectRegistryWebhookConfig, error) {
+	project, err := h.projectGetter.Get(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, datastore.ErrNotFound) {
+			return nil, fmt.Errorf("project %s was not found", projectID)
+		}
+		return nil, fmt.Errorf("failed to get project %s: %w", projectID, err)
+	}
+	cfg := project.RegistryWebhook
+	if cfg == nil || cfg.Secret == "" {
+		return nil, fmt.Errorf("registry webhook is not configured for project %s", projectID)
+	}
+	if err := cfg.Decrypt(h.decrypter); err != nil {
+		return nil, fmt.Errorf("failed to decrypt the registry webhook secret: %w", err)
+	}
+	return cfg, nil
+}
+
+// parsePath extracts the project ID and the provider name from the given path.
+func parsePath(path string) (projectID, provider string, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(path, Path), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
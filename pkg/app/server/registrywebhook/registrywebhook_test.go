@@ -0,0 +1,262 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrywebhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const testSecret = "secret"
+
+type fakeProjectGetter struct {
+	projects map[string]*model.Project
+}
+
+func (g *fakeProjectGetter) Get(_ context.Context, id string) (*model.Project, error) {
+	p, ok := g.projects[id]
+	if !ok {
+		return nil, datastore.ErrNotFound
+	}
+	// Return a copy since the handler decrypts the secret in place.
+	return proto.Clone(p).(*model.Project), nil
+}
+
+type fakeEventStore struct {
+	events []*model.Event
+}
+
+func (s *fakeEventStore) Add(_ context.Context, e model.Event) error {
+	s.events = append(s.events, &e)
+	return nil
+}
+
+type fakeDecrypter struct{}
+
+func (fakeDecrypter) Decrypt(encryptedText string) (string, error) {
+	return strings.TrimPrefix(encryptedText, "encrypted-"), nil
+}
+
+func sign(body string) string {
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write([]byte(body))
+	return githubSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	const (
+		dockerPayload = `{"events":[
+			{"action":"push","target":{"repository":"foo/app","tag":"v1.0.0","digest":"sha256:abc"},"request":{"host":"registry.example.com"}},
+			{"action":"push","target":{"repository":"foo/app","digest":"sha256:abc"},"request":{"host":"registry.example.com"}},
+			{"action":"pull","target":{"repository":"foo/app","tag":"v1.0.0"},"request":{"host":"registry.example.com"}},
+			{"action":"push","target":{"repository":"bar/app","tag":"v1.0.0"},"request":{"host":"registry.example.com"}}
+		]}`
+		harborPayload = `{"type":"PUSH_ARTIFACT","event_data":{
+			"resources":[{"digest":"sha256:def","tag":"v2.0.0","resource_url":"harbor.example.com/foo/app:v2.0.0"}],
+			"repository":{"repo_full_name":"foo/app"}
+		}}`
+		ghcrPayload = `{"action":"published","package":{
+			"name":"App","namespace":"Foo","package_type":"CONTAINER",
+			"package_version":{"version":"sha256:ghi","package_url":"ghcr.io/foo/app:v3.0.0","container_metadata":{"tag":{"name":"v3.0.0","digest":"sha256:ghi"}}}
+		}}`
+	)
+
+	testcases := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		header         map[string]string
+		expectedStatus int
+		expectedEvents []*model.Event
+	}{
+		{
+			name:           "method not allowed",
+			method:         http.MethodGet,
+			path:           "/webhook/registry/project/docker",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "unsupported provider",
+			method:         http.MethodPost,
+			path:           "/webhook/registry/project/unknown",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "project not found",
+			method:         http.MethodPost,
+			path:           "/webhook/registry/not-found/docker",
+			body:           dockerPayload,
+			header:         map[string]string{"Authorization": "Bearer " + testSecret},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "webhook not configured",
+			method:         http.MethodPost,
+			path:           "/webhook/registry/no-secret/docker",
+			body:           dockerPayload,
+			header:         map[string]string{"Authorization": "Bearer " + testSecret},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "wrong secret",
+			method:         http.MethodPost,
+			path:           "/webhook/registry/project/docker",
+			body:           dockerPayload,
+			header:         map[string]string{"Authorization": "Bearer wrong"},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid payload",
+			method:         http.MethodPost,
+			path:           "/webhook/registry/project/docker",
+			body:           "{",
+			header:         map[string]string{"Authorization": "Bearer " + testSecret},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "docker registry",
+			method:         http.MethodPost,
+			path:           "/webhook/registry/project/docker",
+			body:           dockerPayload,
+			header:         map[string]string{"Authorization": "Bearer " + testSecret},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []*model.Event{
+				{
+					Name:     "app-image-update",
+					Data:     "registry.example.com/foo/app:v1.0.0",
+					Labels:   map[string]string{"app": "foo"},
+					Contexts: map[string]string{"repository": "foo/app", "tag": "v1.0.0", "digest": "sha256:abc", "provider": "docker"},
+				},
+			},
+		},
+		{
+			name:           "harbor",
+			method:         http.MethodPost,
+			path:           "/webhook/registry/project/harbor",
+			body:           harborPayload,
+			header:         map[string]string{"Authorization": testSecret},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []*model.Event{
+				{
+					Name:     "app-image-update",
+					Data:     "harbor.example.com/foo/app:v2.0.0",
+					Labels:   map[string]string{"app": "foo"},
+					Contexts: map[string]string{"repository": "foo/app", "tag": "v2.0.0", "digest": "sha256:def", "provider": "harbor"},
+				},
+			},
+		},
+		{
+			name:           "ghcr with invalid signature",
+			method:         http.MethodPost,
+			path:           "/webhook/registry/project/ghcr",
+			body:           ghcrPayload,
+			header:         map[string]string{githubEventHeader: "package", githubSignatureHeader: sign("other")},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "ghcr",
+			method:         http.MethodPost,
+			path:           "/webhook/registry/project/ghcr",
+			body:           ghcrPayload,
+			header:         map[string]string{githubEventHeader: "package", githubSignatureHeader: sign(ghcrPayload)},
+			expectedStatus: http.StatusOK,
+			expectedEvents: []*model.Event{
+				{
+					Name:     "app-image-update",
+					Data:     "ghcr.io/foo/app:v3.0.0",
+					Labels:   map[string]string{"app": "foo"},
+					Contexts: map[string]string{"repository": "foo/app", "tag": "v3.0.0", "digest": "sha256:ghi", "provider": "ghcr"},
+				},
+			},
+		},
+		{
+			name:           "ghcr ping",
+			method:         http.MethodPost,
+			path:           "/webhook/registry/project/ghcr",
+			body:           `{"zen":"hello"}`,
+			header:         map[string]string{githubEventHeader: "ping", githubSignatureHeader: sign(`{"zen":"hello"}`)},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			projects := &fakeProjectGetter{
+				projects: map[string]*model.Project{
+					"project": {
+						Id: "project",
+						RegistryWebhook: &model.ProjectRegistryWebhookConfig{
+							Secret: "encrypted-" + testSecret,
+							Rules: []*model.ProjectRegistryWebhookConfig_Rule{
+								{
+									Repository:  "foo/*",
+									Tag:         "v*",
+									EventName:   "app-image-update",
+									EventLabels: map[string]string{"app": "foo"},
+								},
+							},
+						},
+					},
+					"no-secret": {
+						Id:              "no-secret",
+						RegistryWebhook: &model.ProjectRegistryWebhookConfig{},
+					},
+				},
+			}
+			events := &fakeEventStore{}
+			h := NewHandler(projects, events, fakeDecrypter{}, zap.NewNop())
+
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			require.Len(t, events.events, len(tc.expectedEvents))
+			for i, expected := range tc.expectedEvents {
+				got := events.events[i]
+				assert.NotEmpty(t, got.Id)
+				assert.Equal(t, "project", got.ProjectId)
+				assert.Equal(t, expected.Name, got.Name)
+				assert.Equal(t, expected.Data, got.Data)
+				assert.Equal(t, expected.Labels, got.Labels)
+				assert.Equal(t, expected.Contexts, got.Contexts)
+				assert.Equal(t, model.MakeEventKey(expected.Name, expected.Labels), got.EventKey)
+				assert.Equal(t, model.EventStatus_EVENT_NOT_HANDLED, got.Status)
+			}
+		})
+	}
+}
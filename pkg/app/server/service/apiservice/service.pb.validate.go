@@ -366,6 +366,8 @@ func (m *SyncApplicationRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for IgnoreFreezeWindows
+
 	if len(errors) > 0 {
 		return SyncApplicationRequestMultiError(errors)
 	}
@@ -3688,31 +3690,31 @@ var _ interface {
 	ErrorName() string
 } = ListDeploymentsResponseValidationError{}
 
-// Validate checks the field values on GetCommandRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetCommandRequest) Validate() error {
+// Validate checks the field values on CancelDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCommandRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CancelDeploymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCommandRequestMultiError, or nil if none found.
-func (m *GetCommandRequest) ValidateAll() error {
+// CancelDeploymentRequestMultiError, or nil if none found.
+func (m *CancelDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCommandRequest) validate(all bool) error {
+func (m *CancelDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCommandId()) < 1 {
-		err := GetCommandRequestValidationError{
-			field:  "CommandId",
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := CancelDeploymentRequestValidationError{
+			field:  "DeploymentId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3721,20 +3723,24 @@ func (m *GetCommandRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for ForceRollback
+
+	// no validation rules for ForceNoRollback
+
 	if len(errors) > 0 {
-		return GetCommandRequestMultiError(errors)
+		return CancelDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCommandRequestMultiError is an error wrapping multiple validation errors
-// returned by GetCommandRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetCommandRequestMultiError []error
+// CancelDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCommandRequestMultiError) Error() string {
+func (m CancelDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3743,11 +3749,11 @@ func (m GetCommandRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCommandRequestMultiError) AllErrors() []error { return m }
+func (m CancelDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// GetCommandRequestValidationError is the validation error returned by
-// GetCommandRequest.Validate if the designated constraints aren't met.
-type GetCommandRequestValidationError struct {
+// CancelDeploymentRequestValidationError is the validation error returned by
+// CancelDeploymentRequest.Validate if the designated constraints aren't met.
+type CancelDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3755,24 +3761,24 @@ type GetCommandRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCommandRequestValidationError) Field() string { return e.field }
+func (e CancelDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCommandRequestValidationError) Reason() string { return e.reason }
+func (e CancelDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCommandRequestValidationError) Cause() error { return e.cause }
+func (e CancelDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCommandRequestValidationError) Key() bool { return e.key }
+func (e CancelDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCommandRequestValidationError) ErrorName() string {
-	return "GetCommandRequestValidationError"
+func (e CancelDeploymentRequestValidationError) ErrorName() string {
+	return "CancelDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCommandRequestValidationError) Error() string {
+func (e CancelDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3784,14 +3790,14 @@ func (e GetCommandRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCommandRequest.%s: %s%s",
+		"invalid %sCancelDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCommandRequestValidationError{}
+var _ error = CancelDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3799,73 +3805,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCommandRequestValidationError{}
+} = CancelDeploymentRequestValidationError{}
 
-// Validate checks the field values on GetCommandResponse with the rules
+// Validate checks the field values on CancelDeploymentResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCommandResponse) Validate() error {
+func (m *CancelDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCommandResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CancelDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCommandResponseMultiError, or nil if none found.
-func (m *GetCommandResponse) ValidateAll() error {
+// CancelDeploymentResponseMultiError, or nil if none found.
+func (m *CancelDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCommandResponse) validate(all bool) error {
+func (m *CancelDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCommand()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCommandResponseValidationError{
-					field:  "Command",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCommandResponseValidationError{
-					field:  "Command",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCommand()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCommandResponseValidationError{
-				field:  "Command",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CommandId
 
 	if len(errors) > 0 {
-		return GetCommandResponseMultiError(errors)
+		return CancelDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCommandResponseMultiError is an error wrapping multiple validation errors
-// returned by GetCommandResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetCommandResponseMultiError []error
+// CancelDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelDeploymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCommandResponseMultiError) Error() string {
+func (m CancelDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3874,11 +3853,11 @@ func (m GetCommandResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCommandResponseMultiError) AllErrors() []error { return m }
+func (m CancelDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// GetCommandResponseValidationError is the validation error returned by
-// GetCommandResponse.Validate if the designated constraints aren't met.
-type GetCommandResponseValidationError struct {
+// CancelDeploymentResponseValidationError is the validation error returned by
+// CancelDeploymentResponse.Validate if the designated constraints aren't met.
+type CancelDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3886,24 +3865,24 @@ type GetCommandResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCommandResponseValidationError) Field() string { return e.field }
+func (e CancelDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCommandResponseValidationError) Reason() string { return e.reason }
+func (e CancelDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCommandResponseValidationError) Cause() error { return e.cause }
+func (e CancelDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCommandResponseValidationError) Key() bool { return e.key }
+func (e CancelDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCommandResponseValidationError) ErrorName() string {
-	return "GetCommandResponseValidationError"
+func (e CancelDeploymentResponseValidationError) ErrorName() string {
+	return "CancelDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCommandResponseValidationError) Error() string {
+func (e CancelDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3915,14 +3894,14 @@ func (e GetCommandResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCommandResponse.%s: %s%s",
+		"invalid %sCancelDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCommandResponseValidationError{}
+var _ error = CancelDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3930,33 +3909,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCommandResponseValidationError{}
+} = CancelDeploymentResponseValidationError{}
 
-// Validate checks the field values on EnablePipedRequest with the rules
+// Validate checks the field values on ApproveStageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EnablePipedRequest) Validate() error {
+func (m *ApproveStageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnablePipedRequest with the rules
+// ValidateAll checks the field values on ApproveStageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EnablePipedRequestMultiError, or nil if none found.
-func (m *EnablePipedRequest) ValidateAll() error {
+// ApproveStageRequestMultiError, or nil if none found.
+func (m *ApproveStageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnablePipedRequest) validate(all bool) error {
+func (m *ApproveStageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
-		err := EnablePipedRequestValidationError{
-			field:  "PipedId",
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := ApproveStageRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := ApproveStageRequestValidationError{
+			field:  "StageId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -3966,19 +3956,19 @@ func (m *EnablePipedRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return EnablePipedRequestMultiError(errors)
+		return ApproveStageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnablePipedRequestMultiError is an error wrapping multiple validation errors
-// returned by EnablePipedRequest.ValidateAll() if the designated constraints
-// aren't met.
-type EnablePipedRequestMultiError []error
+// ApproveStageRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveStageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveStageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnablePipedRequestMultiError) Error() string {
+func (m ApproveStageRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3987,11 +3977,11 @@ func (m EnablePipedRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnablePipedRequestMultiError) AllErrors() []error { return m }
+func (m ApproveStageRequestMultiError) AllErrors() []error { return m }
 
-// EnablePipedRequestValidationError is the validation error returned by
-// EnablePipedRequest.Validate if the designated constraints aren't met.
-type EnablePipedRequestValidationError struct {
+// ApproveStageRequestValidationError is the validation error returned by
+// ApproveStageRequest.Validate if the designated constraints aren't met.
+type ApproveStageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3999,24 +3989,24 @@ type EnablePipedRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnablePipedRequestValidationError) Field() string { return e.field }
+func (e ApproveStageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnablePipedRequestValidationError) Reason() string { return e.reason }
+func (e ApproveStageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnablePipedRequestValidationError) Cause() error { return e.cause }
+func (e ApproveStageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnablePipedRequestValidationError) Key() bool { return e.key }
+func (e ApproveStageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnablePipedRequestValidationError) ErrorName() string {
-	return "EnablePipedRequestValidationError"
+func (e ApproveStageRequestValidationError) ErrorName() string {
+	return "ApproveStageRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EnablePipedRequestValidationError) Error() string {
+func (e ApproveStageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4028,14 +4018,14 @@ func (e EnablePipedRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnablePipedRequest.%s: %s%s",
+		"invalid %sApproveStageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnablePipedRequestValidationError{}
+var _ error = ApproveStageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4043,44 +4033,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnablePipedRequestValidationError{}
+} = ApproveStageRequestValidationError{}
 
-// Validate checks the field values on EnablePipedResponse with the rules
+// Validate checks the field values on ApproveStageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EnablePipedResponse) Validate() error {
+func (m *ApproveStageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EnablePipedResponse with the rules
+// ValidateAll checks the field values on ApproveStageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// EnablePipedResponseMultiError, or nil if none found.
-func (m *EnablePipedResponse) ValidateAll() error {
+// ApproveStageResponseMultiError, or nil if none found.
+func (m *ApproveStageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EnablePipedResponse) validate(all bool) error {
+func (m *ApproveStageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CommandId
+
 	if len(errors) > 0 {
-		return EnablePipedResponseMultiError(errors)
+		return ApproveStageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// EnablePipedResponseMultiError is an error wrapping multiple validation
-// errors returned by EnablePipedResponse.ValidateAll() if the designated
+// ApproveStageResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveStageResponse.ValidateAll() if the designated
 // constraints aren't met.
-type EnablePipedResponseMultiError []error
+type ApproveStageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EnablePipedResponseMultiError) Error() string {
+func (m ApproveStageResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4089,11 +4081,11 @@ func (m EnablePipedResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EnablePipedResponseMultiError) AllErrors() []error { return m }
+func (m ApproveStageResponseMultiError) AllErrors() []error { return m }
 
-// EnablePipedResponseValidationError is the validation error returned by
-// EnablePipedResponse.Validate if the designated constraints aren't met.
-type EnablePipedResponseValidationError struct {
+// ApproveStageResponseValidationError is the validation error returned by
+// ApproveStageResponse.Validate if the designated constraints aren't met.
+type ApproveStageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4101,24 +4093,24 @@ type EnablePipedResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EnablePipedResponseValidationError) Field() string { return e.field }
+func (e ApproveStageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EnablePipedResponseValidationError) Reason() string { return e.reason }
+func (e ApproveStageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EnablePipedResponseValidationError) Cause() error { return e.cause }
+func (e ApproveStageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EnablePipedResponseValidationError) Key() bool { return e.key }
+func (e ApproveStageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EnablePipedResponseValidationError) ErrorName() string {
-	return "EnablePipedResponseValidationError"
+func (e ApproveStageResponseValidationError) ErrorName() string {
+	return "ApproveStageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EnablePipedResponseValidationError) Error() string {
+func (e ApproveStageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4130,14 +4122,14 @@ func (e EnablePipedResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEnablePipedResponse.%s: %s%s",
+		"invalid %sApproveStageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EnablePipedResponseValidationError{}
+var _ error = ApproveStageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4145,33 +4137,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EnablePipedResponseValidationError{}
+} = ApproveStageResponseValidationError{}
 
-// Validate checks the field values on DisablePipedRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DisablePipedRequest) Validate() error {
+// Validate checks the field values on SkipStageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkipStageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DisablePipedRequest with the rules
+// ValidateAll checks the field values on SkipStageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DisablePipedRequestMultiError, or nil if none found.
-func (m *DisablePipedRequest) ValidateAll() error {
+// SkipStageRequestMultiError, or nil if none found.
+func (m *SkipStageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DisablePipedRequest) validate(all bool) error {
+func (m *SkipStageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
-		err := DisablePipedRequestValidationError{
-			field:  "PipedId",
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := SkipStageRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetStageId()) < 1 {
+		err := SkipStageRequestValidationError{
+			field:  "StageId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -4181,19 +4184,19 @@ func (m *DisablePipedRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DisablePipedRequestMultiError(errors)
+		return SkipStageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DisablePipedRequestMultiError is an error wrapping multiple validation
-// errors returned by DisablePipedRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DisablePipedRequestMultiError []error
+// SkipStageRequestMultiError is an error wrapping multiple validation errors
+// returned by SkipStageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SkipStageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisablePipedRequestMultiError) Error() string {
+func (m SkipStageRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4202,11 +4205,11 @@ func (m DisablePipedRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisablePipedRequestMultiError) AllErrors() []error { return m }
+func (m SkipStageRequestMultiError) AllErrors() []error { return m }
 
-// DisablePipedRequestValidationError is the validation error returned by
-// DisablePipedRequest.Validate if the designated constraints aren't met.
-type DisablePipedRequestValidationError struct {
+// SkipStageRequestValidationError is the validation error returned by
+// SkipStageRequest.Validate if the designated constraints aren't met.
+type SkipStageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4214,24 +4217,22 @@ type DisablePipedRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisablePipedRequestValidationError) Field() string { return e.field }
+func (e SkipStageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisablePipedRequestValidationError) Reason() string { return e.reason }
+func (e SkipStageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisablePipedRequestValidationError) Cause() error { return e.cause }
+func (e SkipStageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisablePipedRequestValidationError) Key() bool { return e.key }
+func (e SkipStageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisablePipedRequestValidationError) ErrorName() string {
-	return "DisablePipedRequestValidationError"
-}
+func (e SkipStageRequestValidationError) ErrorName() string { return "SkipStageRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DisablePipedRequestValidationError) Error() string {
+func (e SkipStageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4243,14 +4244,14 @@ func (e DisablePipedRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisablePipedRequest.%s: %s%s",
+		"invalid %sSkipStageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisablePipedRequestValidationError{}
+var _ error = SkipStageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4258,44 +4259,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisablePipedRequestValidationError{}
+} = SkipStageRequestValidationError{}
 
-// Validate checks the field values on DisablePipedResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DisablePipedResponse) Validate() error {
+// Validate checks the field values on SkipStageResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkipStageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DisablePipedResponse with the rules
+// ValidateAll checks the field values on SkipStageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DisablePipedResponseMultiError, or nil if none found.
-func (m *DisablePipedResponse) ValidateAll() error {
+// SkipStageResponseMultiError, or nil if none found.
+func (m *SkipStageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DisablePipedResponse) validate(all bool) error {
+func (m *SkipStageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for CommandId
+
 	if len(errors) > 0 {
-		return DisablePipedResponseMultiError(errors)
+		return SkipStageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DisablePipedResponseMultiError is an error wrapping multiple validation
-// errors returned by DisablePipedResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DisablePipedResponseMultiError []error
+// SkipStageResponseMultiError is an error wrapping multiple validation errors
+// returned by SkipStageResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SkipStageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisablePipedResponseMultiError) Error() string {
+func (m SkipStageResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4304,11 +4307,11 @@ func (m DisablePipedResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisablePipedResponseMultiError) AllErrors() []error { return m }
+func (m SkipStageResponseMultiError) AllErrors() []error { return m }
 
-// DisablePipedResponseValidationError is the validation error returned by
-// DisablePipedResponse.Validate if the designated constraints aren't met.
-type DisablePipedResponseValidationError struct {
+// SkipStageResponseValidationError is the validation error returned by
+// SkipStageResponse.Validate if the designated constraints aren't met.
+type SkipStageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4316,24 +4319,24 @@ type DisablePipedResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisablePipedResponseValidationError) Field() string { return e.field }
+func (e SkipStageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisablePipedResponseValidationError) Reason() string { return e.reason }
+func (e SkipStageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisablePipedResponseValidationError) Cause() error { return e.cause }
+func (e SkipStageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisablePipedResponseValidationError) Key() bool { return e.key }
+func (e SkipStageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisablePipedResponseValidationError) ErrorName() string {
-	return "DisablePipedResponseValidationError"
+func (e SkipStageResponseValidationError) ErrorName() string {
+	return "SkipStageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DisablePipedResponseValidationError) Error() string {
+func (e SkipStageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4345,14 +4348,14 @@ func (e DisablePipedResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisablePipedResponse.%s: %s%s",
+		"invalid %sSkipStageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisablePipedResponseValidationError{}
+var _ error = SkipStageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4360,20 +4363,926 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisablePipedResponseValidationError{}
+} = SkipStageResponseValidationError{}
 
-// Validate checks the field values on RegisterEventRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RegisterEventRequest) Validate() error {
+// Validate checks the field values on ApproveDeploymentChainGateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ApproveDeploymentChainGateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RegisterEventRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RegisterEventRequestMultiError, or nil if none found.
-func (m *RegisterEventRequest) ValidateAll() error {
+// ValidateAll checks the field values on ApproveDeploymentChainGateRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ApproveDeploymentChainGateRequestMultiError, or nil if none found.
+func (m *ApproveDeploymentChainGateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveDeploymentChainGateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentChainId()) < 1 {
+		err := ApproveDeploymentChainGateRequestValidationError{
+			field:  "DeploymentChainId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBlockIndex() <= 0 {
+		err := ApproveDeploymentChainGateRequestValidationError{
+			field:  "BlockIndex",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApproveDeploymentChainGateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveDeploymentChainGateRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ApproveDeploymentChainGateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveDeploymentChainGateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveDeploymentChainGateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveDeploymentChainGateRequestMultiError) AllErrors() []error { return m }
+
+// ApproveDeploymentChainGateRequestValidationError is the validation error
+// returned by ApproveDeploymentChainGateRequest.Validate if the designated
+// constraints aren't met.
+type ApproveDeploymentChainGateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveDeploymentChainGateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveDeploymentChainGateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveDeploymentChainGateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveDeploymentChainGateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveDeploymentChainGateRequestValidationError) ErrorName() string {
+	return "ApproveDeploymentChainGateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveDeploymentChainGateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveDeploymentChainGateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveDeploymentChainGateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveDeploymentChainGateRequestValidationError{}
+
+// Validate checks the field values on ApproveDeploymentChainGateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ApproveDeploymentChainGateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveDeploymentChainGateResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ApproveDeploymentChainGateResponseMultiError, or nil if none found.
+func (m *ApproveDeploymentChainGateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveDeploymentChainGateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ApproveDeploymentChainGateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveDeploymentChainGateResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ApproveDeploymentChainGateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveDeploymentChainGateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveDeploymentChainGateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveDeploymentChainGateResponseMultiError) AllErrors() []error { return m }
+
+// ApproveDeploymentChainGateResponseValidationError is the validation error
+// returned by ApproveDeploymentChainGateResponse.Validate if the designated
+// constraints aren't met.
+type ApproveDeploymentChainGateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveDeploymentChainGateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveDeploymentChainGateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveDeploymentChainGateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveDeploymentChainGateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveDeploymentChainGateResponseValidationError) ErrorName() string {
+	return "ApproveDeploymentChainGateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveDeploymentChainGateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveDeploymentChainGateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveDeploymentChainGateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveDeploymentChainGateResponseValidationError{}
+
+// Validate checks the field values on GetCommandRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCommandRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCommandRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCommandRequestMultiError, or nil if none found.
+func (m *GetCommandRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCommandRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCommandId()) < 1 {
+		err := GetCommandRequestValidationError{
+			field:  "CommandId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCommandRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCommandRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCommandRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCommandRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCommandRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCommandRequestMultiError) AllErrors() []error { return m }
+
+// GetCommandRequestValidationError is the validation error returned by
+// GetCommandRequest.Validate if the designated constraints aren't met.
+type GetCommandRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCommandRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCommandRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCommandRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCommandRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCommandRequestValidationError) ErrorName() string {
+	return "GetCommandRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCommandRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCommandRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCommandRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCommandRequestValidationError{}
+
+// Validate checks the field values on GetCommandResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCommandResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCommandResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCommandResponseMultiError, or nil if none found.
+func (m *GetCommandResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCommandResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCommand()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCommandResponseValidationError{
+					field:  "Command",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCommandResponseValidationError{
+					field:  "Command",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommand()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCommandResponseValidationError{
+				field:  "Command",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCommandResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCommandResponseMultiError is an error wrapping multiple validation errors
+// returned by GetCommandResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetCommandResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCommandResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCommandResponseMultiError) AllErrors() []error { return m }
+
+// GetCommandResponseValidationError is the validation error returned by
+// GetCommandResponse.Validate if the designated constraints aren't met.
+type GetCommandResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCommandResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCommandResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCommandResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCommandResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCommandResponseValidationError) ErrorName() string {
+	return "GetCommandResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCommandResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCommandResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCommandResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCommandResponseValidationError{}
+
+// Validate checks the field values on EnablePipedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnablePipedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnablePipedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnablePipedRequestMultiError, or nil if none found.
+func (m *EnablePipedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnablePipedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
+		err := EnablePipedRequestValidationError{
+			field:  "PipedId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EnablePipedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnablePipedRequestMultiError is an error wrapping multiple validation errors
+// returned by EnablePipedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EnablePipedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnablePipedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnablePipedRequestMultiError) AllErrors() []error { return m }
+
+// EnablePipedRequestValidationError is the validation error returned by
+// EnablePipedRequest.Validate if the designated constraints aren't met.
+type EnablePipedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnablePipedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnablePipedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnablePipedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnablePipedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnablePipedRequestValidationError) ErrorName() string {
+	return "EnablePipedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnablePipedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnablePipedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnablePipedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnablePipedRequestValidationError{}
+
+// Validate checks the field values on EnablePipedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnablePipedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnablePipedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnablePipedResponseMultiError, or nil if none found.
+func (m *EnablePipedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnablePipedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EnablePipedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnablePipedResponseMultiError is an error wrapping multiple validation
+// errors returned by EnablePipedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EnablePipedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnablePipedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnablePipedResponseMultiError) AllErrors() []error { return m }
+
+// EnablePipedResponseValidationError is the validation error returned by
+// EnablePipedResponse.Validate if the designated constraints aren't met.
+type EnablePipedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnablePipedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnablePipedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnablePipedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnablePipedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnablePipedResponseValidationError) ErrorName() string {
+	return "EnablePipedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnablePipedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnablePipedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnablePipedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnablePipedResponseValidationError{}
+
+// Validate checks the field values on DisablePipedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisablePipedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisablePipedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisablePipedRequestMultiError, or nil if none found.
+func (m *DisablePipedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisablePipedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPipedId()) < 1 {
+		err := DisablePipedRequestValidationError{
+			field:  "PipedId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DisablePipedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisablePipedRequestMultiError is an error wrapping multiple validation
+// errors returned by DisablePipedRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisablePipedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisablePipedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisablePipedRequestMultiError) AllErrors() []error { return m }
+
+// DisablePipedRequestValidationError is the validation error returned by
+// DisablePipedRequest.Validate if the designated constraints aren't met.
+type DisablePipedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisablePipedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisablePipedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisablePipedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisablePipedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisablePipedRequestValidationError) ErrorName() string {
+	return "DisablePipedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisablePipedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisablePipedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisablePipedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisablePipedRequestValidationError{}
+
+// Validate checks the field values on DisablePipedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisablePipedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisablePipedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisablePipedResponseMultiError, or nil if none found.
+func (m *DisablePipedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisablePipedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisablePipedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisablePipedResponseMultiError is an error wrapping multiple validation
+// errors returned by DisablePipedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisablePipedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisablePipedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisablePipedResponseMultiError) AllErrors() []error { return m }
+
+// DisablePipedResponseValidationError is the validation error returned by
+// DisablePipedResponse.Validate if the designated constraints aren't met.
+type DisablePipedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisablePipedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisablePipedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisablePipedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisablePipedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisablePipedResponseValidationError) ErrorName() string {
+	return "DisablePipedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisablePipedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisablePipedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisablePipedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisablePipedResponseValidationError{}
+
+// Validate checks the field values on RegisterEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterEventRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterEventRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterEventRequestMultiError, or nil if none found.
+func (m *RegisterEventRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
@@ -5829,3 +6738,262 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListStageLogsResponseValidationError{}
+
+// Validate checks the field values on ListAuditLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditLogsRequestMultiError, or nil if none found.
+func (m *ListAuditLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Actor
+
+	// no validation rules for ResourceType
+
+	if m.GetSince() < 0 {
+		err := ListAuditLogsRequestValidationError{
+			field:  "Since",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return ListAuditLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditLogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditLogsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditLogsRequestValidationError is the validation error returned by
+// ListAuditLogsRequest.Validate if the designated constraints aren't met.
+type ListAuditLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditLogsRequestValidationError) ErrorName() string {
+	return "ListAuditLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditLogsRequestValidationError{}
+
+// Validate checks the field values on ListAuditLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditLogsResponseMultiError, or nil if none found.
+func (m *ListAuditLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuditLogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditLogsResponseValidationError{
+						field:  fmt.Sprintf("AuditLogs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditLogsResponseValidationError{
+						field:  fmt.Sprintf("AuditLogs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditLogsResponseValidationError{
+					field:  fmt.Sprintf("AuditLogs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return ListAuditLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditLogsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditLogsResponseValidationError is the validation error returned by
+// ListAuditLogsResponse.Validate if the designated constraints aren't met.
+type ListAuditLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditLogsResponseValidationError) ErrorName() string {
+	return "ListAuditLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditLogsResponseValidationError{}
@@ -34,6 +34,10 @@ type APIServiceClient interface {
 	UpdateApplicationDeployTargets(ctx context.Context, in *UpdateApplicationDeployTargetsRequest, opts ...grpc.CallOption) (*UpdateApplicationDeployTargetsResponse, error)
 	GetDeployment(ctx context.Context, in *GetDeploymentRequest, opts ...grpc.CallOption) (*GetDeploymentResponse, error)
 	ListDeployments(ctx context.Context, in *ListDeploymentsRequest, opts ...grpc.CallOption) (*ListDeploymentsResponse, error)
+	CancelDeployment(ctx context.Context, in *CancelDeploymentRequest, opts ...grpc.CallOption) (*CancelDeploymentResponse, error)
+	ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*ApproveStageResponse, error)
+	SkipStage(ctx context.Context, in *SkipStageRequest, opts ...grpc.CallOption) (*SkipStageResponse, error)
+	ApproveDeploymentChainGate(ctx context.Context, in *ApproveDeploymentChainGateRequest, opts ...grpc.CallOption) (*ApproveDeploymentChainGateResponse, error)
 	GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error)
 	GetPiped(ctx context.Context, in *GetPipedRequest, opts ...grpc.CallOption) (*GetPipedResponse, error)
 	RegisterPiped(ctx context.Context, in *RegisterPipedRequest, opts ...grpc.CallOption) (*RegisterPipedResponse, error)
@@ -45,6 +49,7 @@ type APIServiceClient interface {
 	GetPlanPreviewResults(ctx context.Context, in *GetPlanPreviewResultsRequest, opts ...grpc.CallOption) (*GetPlanPreviewResultsResponse, error)
 	Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*EncryptResponse, error)
 	ListStageLogs(ctx context.Context, in *ListStageLogsRequest, opts ...grpc.CallOption) (*ListStageLogsResponse, error)
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
 }
 
 type aPIServiceClient struct {
@@ -163,6 +168,42 @@ func (c *aPIServiceClient) ListDeployments(ctx context.Context, in *ListDeployme
 	return out, nil
 }
 
+func (c *aPIServiceClient) CancelDeployment(ctx context.Context, in *CancelDeploymentRequest, opts ...grpc.CallOption) (*CancelDeploymentResponse, error) {
+	out := new(CancelDeploymentResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/CancelDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*ApproveStageResponse, error) {
+	out := new(ApproveStageResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ApproveStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SkipStage(ctx context.Context, in *SkipStageRequest, opts ...grpc.CallOption) (*SkipStageResponse, error) {
+	out := new(SkipStageResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/SkipStage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ApproveDeploymentChainGate(ctx context.Context, in *ApproveDeploymentChainGateRequest, opts ...grpc.CallOption) (*ApproveDeploymentChainGateResponse, error) {
+	out := new(ApproveDeploymentChainGateResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ApproveDeploymentChainGate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIServiceClient) GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error) {
 	out := new(GetCommandResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/GetCommand", in, out, opts...)
@@ -262,6 +303,15 @@ func (c *aPIServiceClient) ListStageLogs(ctx context.Context, in *ListStageLogsR
 	return out, nil
 }
 
+func (c *aPIServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.apiservice.APIService/ListAuditLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServiceServer is the server API for APIService service.
 // All implementations must embed UnimplementedAPIServiceServer
 // for forward compatibility
@@ -278,6 +328,10 @@ type APIServiceServer interface {
 	UpdateApplicationDeployTargets(context.Context, *UpdateApplicationDeployTargetsRequest) (*UpdateApplicationDeployTargetsResponse, error)
 	GetDeployment(context.Context, *GetDeploymentRequest) (*GetDeploymentResponse, error)
 	ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error)
+	CancelDeployment(context.Context, *CancelDeploymentRequest) (*CancelDeploymentResponse, error)
+	ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error)
+	SkipStage(context.Context, *SkipStageRequest) (*SkipStageResponse, error)
+	ApproveDeploymentChainGate(context.Context, *ApproveDeploymentChainGateRequest) (*ApproveDeploymentChainGateResponse, error)
 	GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error)
 	GetPiped(context.Context, *GetPipedRequest) (*GetPipedResponse, error)
 	RegisterPiped(context.Context, *RegisterPipedRequest) (*RegisterPipedResponse, error)
@@ -289,6 +343,7 @@ type APIServiceServer interface {
 	GetPlanPreviewResults(context.Context, *GetPlanPreviewResultsRequest) (*GetPlanPreviewResultsResponse, error)
 	Encrypt(context.Context, *EncryptRequest) (*EncryptResponse, error)
 	ListStageLogs(context.Context, *ListStageLogsRequest) (*ListStageLogsResponse, error)
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
 	mustEmbedUnimplementedAPIServiceServer()
 }
 
@@ -332,6 +387,18 @@ func (UnimplementedAPIServiceServer) GetDeployment(context.Context, *GetDeployme
 func (UnimplementedAPIServiceServer) ListDeployments(context.Context, *ListDeploymentsRequest) (*ListDeploymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
 }
+func (UnimplementedAPIServiceServer) CancelDeployment(context.Context, *CancelDeploymentRequest) (*CancelDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeployment not implemented")
+}
+func (UnimplementedAPIServiceServer) ApproveStage(context.Context, *ApproveStageRequest) (*ApproveStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveStage not implemented")
+}
+func (UnimplementedAPIServiceServer) SkipStage(context.Context, *SkipStageRequest) (*SkipStageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipStage not implemented")
+}
+func (UnimplementedAPIServiceServer) ApproveDeploymentChainGate(context.Context, *ApproveDeploymentChainGateRequest) (*ApproveDeploymentChainGateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDeploymentChainGate not implemented")
+}
 func (UnimplementedAPIServiceServer) GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommand not implemented")
 }
@@ -365,6 +432,9 @@ func (UnimplementedAPIServiceServer) Encrypt(context.Context, *EncryptRequest) (
 func (UnimplementedAPIServiceServer) ListStageLogs(context.Context, *ListStageLogsRequest) (*ListStageLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStageLogs not implemented")
 }
+func (UnimplementedAPIServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
 func (UnimplementedAPIServiceServer) mustEmbedUnimplementedAPIServiceServer() {}
 
 // UnsafeAPIServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -594,6 +664,78 @@ func _APIService_ListDeployments_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_CancelDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).CancelDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/CancelDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).CancelDeployment(ctx, req.(*CancelDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ApproveStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ApproveStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ApproveStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ApproveStage(ctx, req.(*ApproveStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_SkipStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).SkipStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/SkipStage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).SkipStage(ctx, req.(*SkipStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_ApproveDeploymentChainGate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveDeploymentChainGateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ApproveDeploymentChainGate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ApproveDeploymentChainGate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ApproveDeploymentChainGate(ctx, req.(*ApproveDeploymentChainGateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _APIService_GetCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommandRequest)
 	if err := dec(in); err != nil {
@@ -792,6 +934,24 @@ func _APIService_ListStageLogs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.apiservice.APIService/ListAuditLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // APIService_ServiceDesc is the grpc.ServiceDesc for APIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -847,6 +1007,22 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDeployments",
 			Handler:    _APIService_ListDeployments_Handler,
 		},
+		{
+			MethodName: "CancelDeployment",
+			Handler:    _APIService_CancelDeployment_Handler,
+		},
+		{
+			MethodName: "ApproveStage",
+			Handler:    _APIService_ApproveStage_Handler,
+		},
+		{
+			MethodName: "SkipStage",
+			Handler:    _APIService_SkipStage_Handler,
+		},
+		{
+			MethodName: "ApproveDeploymentChainGate",
+			Handler:    _APIService_ApproveDeploymentChainGate_Handler,
+		},
 		{
 			MethodName: "GetCommand",
 			Handler:    _APIService_GetCommand_Handler,
@@ -891,6 +1067,10 @@ var APIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListStageLogs",
 			Handler:    _APIService_ListStageLogs_Handler,
 		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _APIService_ListAuditLogs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/app/server/service/apiservice/service.proto",
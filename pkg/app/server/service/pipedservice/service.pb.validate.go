@@ -2994,7 +2994,7 @@ func (m *ReportDeploymentStatusChangedRequest) validate(all bool) error {
 	if _, ok := _ReportDeploymentStatusChangedRequest_Status_InLookup[m.GetStatus()]; !ok {
 		err := ReportDeploymentStatusChangedRequestValidationError{
 			field:  "Status",
-			reason: "value must be in list [2 3]",
+			reason: "value must be in list [0 2 3]",
 		}
 		if !all {
 			return err
@@ -3091,6 +3091,7 @@ var _ interface {
 } = ReportDeploymentStatusChangedRequestValidationError{}
 
 var _ReportDeploymentStatusChangedRequest_Status_InLookup = map[model.DeploymentStatus]struct{}{
+	0: {},
 	2: {},
 	3: {},
 }
@@ -8552,6 +8553,35 @@ func (m *InChainDeploymentPlannableResponse) validate(all bool) error {
 
 	// no validation rules for CancelReason
 
+	if all {
+		switch v := interface{}(m.GetGateToAnalyze()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InChainDeploymentPlannableResponseValidationError{
+					field:  "GateToAnalyze",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InChainDeploymentPlannableResponseValidationError{
+					field:  "GateToAnalyze",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGateToAnalyze()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InChainDeploymentPlannableResponseValidationError{
+				field:  "GateToAnalyze",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return InChainDeploymentPlannableResponseMultiError(errors)
 	}
@@ -8634,6 +8664,254 @@ var _ interface {
 	ErrorName() string
 } = InChainDeploymentPlannableResponseValidationError{}
 
+// Validate checks the field values on
+// ReportDeploymentChainGateAnalysisResultRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReportDeploymentChainGateAnalysisResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ReportDeploymentChainGateAnalysisResultRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// ReportDeploymentChainGateAnalysisResultRequestMultiError, or nil if none found.
+func (m *ReportDeploymentChainGateAnalysisResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportDeploymentChainGateAnalysisResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDeploymentId()) < 1 {
+		err := ReportDeploymentChainGateAnalysisResultRequestValidationError{
+			field:  "DeploymentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDeploymentChainId()) < 1 {
+		err := ReportDeploymentChainGateAnalysisResultRequestValidationError{
+			field:  "DeploymentChainId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DeploymentChainBlockIndex
+
+	// no validation rules for Passed
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return ReportDeploymentChainGateAnalysisResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportDeploymentChainGateAnalysisResultRequestMultiError is an error
+// wrapping multiple validation errors returned by
+// ReportDeploymentChainGateAnalysisResultRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ReportDeploymentChainGateAnalysisResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportDeploymentChainGateAnalysisResultRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportDeploymentChainGateAnalysisResultRequestMultiError) AllErrors() []error { return m }
+
+// ReportDeploymentChainGateAnalysisResultRequestValidationError is the
+// validation error returned by
+// ReportDeploymentChainGateAnalysisResultRequest.Validate if the designated
+// constraints aren't met.
+type ReportDeploymentChainGateAnalysisResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportDeploymentChainGateAnalysisResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportDeploymentChainGateAnalysisResultRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e ReportDeploymentChainGateAnalysisResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportDeploymentChainGateAnalysisResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportDeploymentChainGateAnalysisResultRequestValidationError) ErrorName() string {
+	return "ReportDeploymentChainGateAnalysisResultRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportDeploymentChainGateAnalysisResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportDeploymentChainGateAnalysisResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportDeploymentChainGateAnalysisResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportDeploymentChainGateAnalysisResultRequestValidationError{}
+
+// Validate checks the field values on
+// ReportDeploymentChainGateAnalysisResultResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReportDeploymentChainGateAnalysisResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ReportDeploymentChainGateAnalysisResultResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// ReportDeploymentChainGateAnalysisResultResponseMultiError, or nil if none found.
+func (m *ReportDeploymentChainGateAnalysisResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportDeploymentChainGateAnalysisResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReportDeploymentChainGateAnalysisResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportDeploymentChainGateAnalysisResultResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// ReportDeploymentChainGateAnalysisResultResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReportDeploymentChainGateAnalysisResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportDeploymentChainGateAnalysisResultResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportDeploymentChainGateAnalysisResultResponseMultiError) AllErrors() []error { return m }
+
+// ReportDeploymentChainGateAnalysisResultResponseValidationError is the
+// validation error returned by
+// ReportDeploymentChainGateAnalysisResultResponse.Validate if the designated
+// constraints aren't met.
+type ReportDeploymentChainGateAnalysisResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportDeploymentChainGateAnalysisResultResponseValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e ReportDeploymentChainGateAnalysisResultResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e ReportDeploymentChainGateAnalysisResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportDeploymentChainGateAnalysisResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportDeploymentChainGateAnalysisResultResponseValidationError) ErrorName() string {
+	return "ReportDeploymentChainGateAnalysisResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportDeploymentChainGateAnalysisResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportDeploymentChainGateAnalysisResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportDeploymentChainGateAnalysisResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportDeploymentChainGateAnalysisResultResponseValidationError{}
+
 // Validate checks the field values on GetApplicationSharedObjectRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
@@ -9124,6 +9402,246 @@ var _ interface {
 	ErrorName() string
 } = PutApplicationSharedObjectResponseValidationError{}
 
+// Validate checks the field values on GetProjectFreezeWindowsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectFreezeWindowsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectFreezeWindowsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProjectFreezeWindowsRequestMultiError, or nil if none found.
+func (m *GetProjectFreezeWindowsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectFreezeWindowsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetProjectFreezeWindowsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectFreezeWindowsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetProjectFreezeWindowsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetProjectFreezeWindowsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectFreezeWindowsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectFreezeWindowsRequestMultiError) AllErrors() []error { return m }
+
+// GetProjectFreezeWindowsRequestValidationError is the validation error
+// returned by GetProjectFreezeWindowsRequest.Validate if the designated
+// constraints aren't met.
+type GetProjectFreezeWindowsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectFreezeWindowsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectFreezeWindowsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectFreezeWindowsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectFreezeWindowsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectFreezeWindowsRequestValidationError) ErrorName() string {
+	return "GetProjectFreezeWindowsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectFreezeWindowsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectFreezeWindowsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectFreezeWindowsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectFreezeWindowsRequestValidationError{}
+
+// Validate checks the field values on GetProjectFreezeWindowsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetProjectFreezeWindowsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProjectFreezeWindowsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetProjectFreezeWindowsResponseMultiError, or nil if none found.
+func (m *GetProjectFreezeWindowsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProjectFreezeWindowsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFreezeWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProjectFreezeWindowsResponseValidationError{
+						field:  fmt.Sprintf("FreezeWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProjectFreezeWindowsResponseValidationError{
+						field:  fmt.Sprintf("FreezeWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProjectFreezeWindowsResponseValidationError{
+					field:  fmt.Sprintf("FreezeWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetProjectFreezeWindowsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProjectFreezeWindowsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetProjectFreezeWindowsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetProjectFreezeWindowsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProjectFreezeWindowsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProjectFreezeWindowsResponseMultiError) AllErrors() []error { return m }
+
+// GetProjectFreezeWindowsResponseValidationError is the validation error
+// returned by GetProjectFreezeWindowsResponse.Validate if the designated
+// constraints aren't met.
+type GetProjectFreezeWindowsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProjectFreezeWindowsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProjectFreezeWindowsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProjectFreezeWindowsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProjectFreezeWindowsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProjectFreezeWindowsResponseValidationError) ErrorName() string {
+	return "GetProjectFreezeWindowsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProjectFreezeWindowsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProjectFreezeWindowsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProjectFreezeWindowsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProjectFreezeWindowsResponseValidationError{}
+
 // Validate checks the field values on ReportEventStatusesRequest_Event with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
@@ -9292,6 +9810,37 @@ func (m *CreateDeploymentChainRequest_ApplicationMatcher) validate(all bool) err
 
 	// no validation rules for Labels
 
+	// no validation rules for ContinueOnFailure
+
+	if all {
+		switch v := interface{}(m.GetGate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDeploymentChainRequest_ApplicationMatcherValidationError{
+					field:  "Gate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDeploymentChainRequest_ApplicationMatcherValidationError{
+					field:  "Gate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDeploymentChainRequest_ApplicationMatcherValidationError{
+				field:  "Gate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateDeploymentChainRequest_ApplicationMatcherMultiError(errors)
 	}
@@ -143,11 +143,16 @@ type PipedServiceClient interface {
 	// In case the previous block of this deployment is finished with FAILURE | CANCELLED status,
 	// `cancel` flag will be returned to aware piped to stop this deployment.
 	InChainDeploymentPlannable(ctx context.Context, in *InChainDeploymentPlannableRequest, opts ...grpc.CallOption) (*InChainDeploymentPlannableResponse, error)
+	// ReportDeploymentChainGateAnalysisResult reports the result of the analysis
+	// run by piped to evaluate the gate of a block in the deployment chain.
+	ReportDeploymentChainGateAnalysisResult(ctx context.Context, in *ReportDeploymentChainGateAnalysisResultRequest, opts ...grpc.CallOption) (*ReportDeploymentChainGateAnalysisResultResponse, error)
 	// GetApplicationSharedObject fetches an object of the application.
 	// The object path will be derived by the request.
 	GetApplicationSharedObject(ctx context.Context, in *GetApplicationSharedObjectRequest, opts ...grpc.CallOption) (*GetApplicationSharedObjectResponse, error)
 	// PutApplicationSharedObject persists the given object for the application.
 	PutApplicationSharedObject(ctx context.Context, in *PutApplicationSharedObjectRequest, opts ...grpc.CallOption) (*PutApplicationSharedObjectResponse, error)
+	// GetProjectFreezeWindows returns the deployment freeze windows configured in the project of piped.
+	GetProjectFreezeWindows(ctx context.Context, in *GetProjectFreezeWindowsRequest, opts ...grpc.CallOption) (*GetProjectFreezeWindowsResponse, error)
 }
 
 type pipedServiceClient struct {
@@ -467,6 +472,15 @@ func (c *pipedServiceClient) InChainDeploymentPlannable(ctx context.Context, in
 	return out, nil
 }
 
+func (c *pipedServiceClient) ReportDeploymentChainGateAnalysisResult(ctx context.Context, in *ReportDeploymentChainGateAnalysisResultRequest, opts ...grpc.CallOption) (*ReportDeploymentChainGateAnalysisResultResponse, error) {
+	out := new(ReportDeploymentChainGateAnalysisResultResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/ReportDeploymentChainGateAnalysisResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pipedServiceClient) GetApplicationSharedObject(ctx context.Context, in *GetApplicationSharedObjectRequest, opts ...grpc.CallOption) (*GetApplicationSharedObjectResponse, error) {
 	out := new(GetApplicationSharedObjectResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/GetApplicationSharedObject", in, out, opts...)
@@ -485,6 +499,15 @@ func (c *pipedServiceClient) PutApplicationSharedObject(ctx context.Context, in
 	return out, nil
 }
 
+func (c *pipedServiceClient) GetProjectFreezeWindows(ctx context.Context, in *GetProjectFreezeWindowsRequest, opts ...grpc.CallOption) (*GetProjectFreezeWindowsResponse, error) {
+	out := new(GetProjectFreezeWindowsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.pipedservice.PipedService/GetProjectFreezeWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PipedServiceServer is the server API for PipedService service.
 // All implementations must embed UnimplementedPipedServiceServer
 // for forward compatibility
@@ -610,11 +633,16 @@ type PipedServiceServer interface {
 	// In case the previous block of this deployment is finished with FAILURE | CANCELLED status,
 	// `cancel` flag will be returned to aware piped to stop this deployment.
 	InChainDeploymentPlannable(context.Context, *InChainDeploymentPlannableRequest) (*InChainDeploymentPlannableResponse, error)
+	// ReportDeploymentChainGateAnalysisResult reports the result of the analysis
+	// run by piped to evaluate the gate of a block in the deployment chain.
+	ReportDeploymentChainGateAnalysisResult(context.Context, *ReportDeploymentChainGateAnalysisResultRequest) (*ReportDeploymentChainGateAnalysisResultResponse, error)
 	// GetApplicationSharedObject fetches an object of the application.
 	// The object path will be derived by the request.
 	GetApplicationSharedObject(context.Context, *GetApplicationSharedObjectRequest) (*GetApplicationSharedObjectResponse, error)
 	// PutApplicationSharedObject persists the given object for the application.
 	PutApplicationSharedObject(context.Context, *PutApplicationSharedObjectRequest) (*PutApplicationSharedObjectResponse, error)
+	// GetProjectFreezeWindows returns the deployment freeze windows configured in the project of piped.
+	GetProjectFreezeWindows(context.Context, *GetProjectFreezeWindowsRequest) (*GetProjectFreezeWindowsResponse, error)
 	mustEmbedUnimplementedPipedServiceServer()
 }
 
@@ -724,12 +752,18 @@ func (UnimplementedPipedServiceServer) CreateDeploymentChain(context.Context, *C
 func (UnimplementedPipedServiceServer) InChainDeploymentPlannable(context.Context, *InChainDeploymentPlannableRequest) (*InChainDeploymentPlannableResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InChainDeploymentPlannable not implemented")
 }
+func (UnimplementedPipedServiceServer) ReportDeploymentChainGateAnalysisResult(context.Context, *ReportDeploymentChainGateAnalysisResultRequest) (*ReportDeploymentChainGateAnalysisResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDeploymentChainGateAnalysisResult not implemented")
+}
 func (UnimplementedPipedServiceServer) GetApplicationSharedObject(context.Context, *GetApplicationSharedObjectRequest) (*GetApplicationSharedObjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationSharedObject not implemented")
 }
 func (UnimplementedPipedServiceServer) PutApplicationSharedObject(context.Context, *PutApplicationSharedObjectRequest) (*PutApplicationSharedObjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutApplicationSharedObject not implemented")
 }
+func (UnimplementedPipedServiceServer) GetProjectFreezeWindows(context.Context, *GetProjectFreezeWindowsRequest) (*GetProjectFreezeWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectFreezeWindows not implemented")
+}
 func (UnimplementedPipedServiceServer) mustEmbedUnimplementedPipedServiceServer() {}
 
 // UnsafePipedServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1355,6 +1389,24 @@ func _PipedService_InChainDeploymentPlannable_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipedService_ReportDeploymentChainGateAnalysisResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportDeploymentChainGateAnalysisResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).ReportDeploymentChainGateAnalysisResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/ReportDeploymentChainGateAnalysisResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).ReportDeploymentChainGateAnalysisResult(ctx, req.(*ReportDeploymentChainGateAnalysisResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PipedService_GetApplicationSharedObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetApplicationSharedObjectRequest)
 	if err := dec(in); err != nil {
@@ -1391,6 +1443,24 @@ func _PipedService_PutApplicationSharedObject_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PipedService_GetProjectFreezeWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectFreezeWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipedServiceServer).GetProjectFreezeWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.pipedservice.PipedService/GetProjectFreezeWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipedServiceServer).GetProjectFreezeWindows(ctx, req.(*GetProjectFreezeWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PipedService_ServiceDesc is the grpc.ServiceDesc for PipedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1534,6 +1604,10 @@ var PipedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InChainDeploymentPlannable",
 			Handler:    _PipedService_InChainDeploymentPlannable_Handler,
 		},
+		{
+			MethodName: "ReportDeploymentChainGateAnalysisResult",
+			Handler:    _PipedService_ReportDeploymentChainGateAnalysisResult_Handler,
+		},
 		{
 			MethodName: "GetApplicationSharedObject",
 			Handler:    _PipedService_GetApplicationSharedObject_Handler,
@@ -1542,6 +1616,10 @@ var PipedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PutApplicationSharedObject",
 			Handler:    _PipedService_PutApplicationSharedObject_Handler,
 		},
+		{
+			MethodName: "GetProjectFreezeWindows",
+			Handler:    _PipedService_GetProjectFreezeWindows_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/app/server/service/pipedservice/service.proto",
@@ -150,6 +150,8 @@ func (a *authorizer) Authorize(ctx context.Context, method string, r model.Role)
 		return verify(model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_LIST)
 	case "/grpc.service.webservice.WebService/GetApplicationLiveState":
 		return verify(model.ProjectRBACResource_APPLICATION, model.ProjectRBACPolicy_GET)
+	case "/grpc.service.webservice.WebService/ListAuditLogs":
+		return verify(model.ProjectRBACResource_AUDIT_LOG, model.ProjectRBACPolicy_LIST)
 	case "/grpc.service.webservice.WebService/ListDeployments":
 		return verify(model.ProjectRBACResource_DEPLOYMENT, model.ProjectRBACPolicy_LIST)
 	case "/grpc.service.webservice.WebService/GetDeployment":
@@ -218,6 +220,10 @@ func (a *authorizer) Authorize(ctx context.Context, method string, r model.Role)
 		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
 	case "/grpc.service.webservice.WebService/DeleteProjectUserGroup":
 		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/UpdateProjectRegistryWebhookConfig":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
+	case "/grpc.service.webservice.WebService/UpdateProjectFreezeWindows":
+		return verify(model.ProjectRBACResource_PROJECT, model.ProjectRBACPolicy_UPDATE)
 	}
 	return false
 }
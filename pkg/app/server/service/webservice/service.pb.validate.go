@@ -4299,6 +4299,8 @@ func (m *SyncApplicationRequest) validate(all bool) error {
 
 	// no validation rules for SyncStrategy
 
+	// no validation rules for IgnoreFreezeWindows
+
 	if len(errors) > 0 {
 		return SyncApplicationRequestMultiError(errors)
 	}
@@ -9952,32 +9954,34 @@ var _ interface {
 	ErrorName() string
 } = DeleteProjectUserGroupResponseValidationError{}
 
-// Validate checks the field values on GetCommandRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetCommandRequest) Validate() error {
+// Validate checks the field values on
+// UpdateProjectRegistryWebhookConfigRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateProjectRegistryWebhookConfigRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCommandRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCommandRequestMultiError, or nil if none found.
-func (m *GetCommandRequest) ValidateAll() error {
+// ValidateAll checks the field values on
+// UpdateProjectRegistryWebhookConfigRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// UpdateProjectRegistryWebhookConfigRequestMultiError, or nil if none found.
+func (m *UpdateProjectRegistryWebhookConfigRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCommandRequest) validate(all bool) error {
+func (m *UpdateProjectRegistryWebhookConfigRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetCommandId()) < 1 {
-		err := GetCommandRequestValidationError{
-			field:  "CommandId",
-			reason: "value length must be at least 1 runes",
+	if m.GetRegistryWebhook() == nil {
+		err := UpdateProjectRegistryWebhookConfigRequestValidationError{
+			field:  "RegistryWebhook",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -9985,20 +9989,50 @@ func (m *GetCommandRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetRegistryWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProjectRegistryWebhookConfigRequestValidationError{
+					field:  "RegistryWebhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProjectRegistryWebhookConfigRequestValidationError{
+					field:  "RegistryWebhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistryWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProjectRegistryWebhookConfigRequestValidationError{
+				field:  "RegistryWebhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return GetCommandRequestMultiError(errors)
+		return UpdateProjectRegistryWebhookConfigRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCommandRequestMultiError is an error wrapping multiple validation errors
-// returned by GetCommandRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetCommandRequestMultiError []error
+// UpdateProjectRegistryWebhookConfigRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateProjectRegistryWebhookConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProjectRegistryWebhookConfigRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCommandRequestMultiError) Error() string {
+func (m UpdateProjectRegistryWebhookConfigRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10007,11 +10041,12 @@ func (m GetCommandRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCommandRequestMultiError) AllErrors() []error { return m }
+func (m UpdateProjectRegistryWebhookConfigRequestMultiError) AllErrors() []error { return m }
 
-// GetCommandRequestValidationError is the validation error returned by
-// GetCommandRequest.Validate if the designated constraints aren't met.
-type GetCommandRequestValidationError struct {
+// UpdateProjectRegistryWebhookConfigRequestValidationError is the validation
+// error returned by UpdateProjectRegistryWebhookConfigRequest.Validate if the
+// designated constraints aren't met.
+type UpdateProjectRegistryWebhookConfigRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10019,24 +10054,24 @@ type GetCommandRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCommandRequestValidationError) Field() string { return e.field }
+func (e UpdateProjectRegistryWebhookConfigRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCommandRequestValidationError) Reason() string { return e.reason }
+func (e UpdateProjectRegistryWebhookConfigRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCommandRequestValidationError) Cause() error { return e.cause }
+func (e UpdateProjectRegistryWebhookConfigRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCommandRequestValidationError) Key() bool { return e.key }
+func (e UpdateProjectRegistryWebhookConfigRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCommandRequestValidationError) ErrorName() string {
-	return "GetCommandRequestValidationError"
+func (e UpdateProjectRegistryWebhookConfigRequestValidationError) ErrorName() string {
+	return "UpdateProjectRegistryWebhookConfigRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCommandRequestValidationError) Error() string {
+func (e UpdateProjectRegistryWebhookConfigRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10048,14 +10083,14 @@ func (e GetCommandRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCommandRequest.%s: %s%s",
+		"invalid %sUpdateProjectRegistryWebhookConfigRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCommandRequestValidationError{}
+var _ error = UpdateProjectRegistryWebhookConfigRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10063,73 +10098,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCommandRequestValidationError{}
+} = UpdateProjectRegistryWebhookConfigRequestValidationError{}
 
-// Validate checks the field values on GetCommandResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCommandResponse) Validate() error {
+// Validate checks the field values on
+// UpdateProjectRegistryWebhookConfigResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateProjectRegistryWebhookConfigResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCommandResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCommandResponseMultiError, or nil if none found.
-func (m *GetCommandResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// UpdateProjectRegistryWebhookConfigResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// UpdateProjectRegistryWebhookConfigResponseMultiError, or nil if none found.
+func (m *UpdateProjectRegistryWebhookConfigResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCommandResponse) validate(all bool) error {
+func (m *UpdateProjectRegistryWebhookConfigResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCommand()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCommandResponseValidationError{
-					field:  "Command",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCommandResponseValidationError{
-					field:  "Command",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCommand()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCommandResponseValidationError{
-				field:  "Command",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return GetCommandResponseMultiError(errors)
+		return UpdateProjectRegistryWebhookConfigResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCommandResponseMultiError is an error wrapping multiple validation errors
-// returned by GetCommandResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetCommandResponseMultiError []error
+// UpdateProjectRegistryWebhookConfigResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateProjectRegistryWebhookConfigResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProjectRegistryWebhookConfigResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCommandResponseMultiError) Error() string {
+func (m UpdateProjectRegistryWebhookConfigResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10138,11 +10147,12 @@ func (m GetCommandResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCommandResponseMultiError) AllErrors() []error { return m }
+func (m UpdateProjectRegistryWebhookConfigResponseMultiError) AllErrors() []error { return m }
 
-// GetCommandResponseValidationError is the validation error returned by
-// GetCommandResponse.Validate if the designated constraints aren't met.
-type GetCommandResponseValidationError struct {
+// UpdateProjectRegistryWebhookConfigResponseValidationError is the validation
+// error returned by UpdateProjectRegistryWebhookConfigResponse.Validate if
+// the designated constraints aren't met.
+type UpdateProjectRegistryWebhookConfigResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10150,24 +10160,24 @@ type GetCommandResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCommandResponseValidationError) Field() string { return e.field }
+func (e UpdateProjectRegistryWebhookConfigResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCommandResponseValidationError) Reason() string { return e.reason }
+func (e UpdateProjectRegistryWebhookConfigResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCommandResponseValidationError) Cause() error { return e.cause }
+func (e UpdateProjectRegistryWebhookConfigResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCommandResponseValidationError) Key() bool { return e.key }
+func (e UpdateProjectRegistryWebhookConfigResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCommandResponseValidationError) ErrorName() string {
-	return "GetCommandResponseValidationError"
+func (e UpdateProjectRegistryWebhookConfigResponseValidationError) ErrorName() string {
+	return "UpdateProjectRegistryWebhookConfigResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCommandResponseValidationError) Error() string {
+func (e UpdateProjectRegistryWebhookConfigResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10179,14 +10189,14 @@ func (e GetCommandResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCommandResponse.%s: %s%s",
+		"invalid %sUpdateProjectRegistryWebhookConfigResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCommandResponseValidationError{}
+var _ error = UpdateProjectRegistryWebhookConfigResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10194,66 +10204,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCommandResponseValidationError{}
+} = UpdateProjectRegistryWebhookConfigResponseValidationError{}
 
-// Validate checks the field values on GenerateAPIKeyRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateAPIKeyRequest) Validate() error {
+// Validate checks the field values on UpdateProjectFreezeWindowsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateProjectFreezeWindowsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateAPIKeyRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateAPIKeyRequestMultiError, or nil if none found.
-func (m *GenerateAPIKeyRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateProjectFreezeWindowsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateProjectFreezeWindowsRequestMultiError, or nil if none found.
+func (m *UpdateProjectFreezeWindowsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateAPIKeyRequest) validate(all bool) error {
+func (m *UpdateProjectFreezeWindowsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := GenerateAPIKeyRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetFreezeWindows() {
+		_, _ = idx, item
 
-	if _, ok := model.APIKey_Role_name[int32(m.GetRole())]; !ok {
-		err := GenerateAPIKeyRequestValidationError{
-			field:  "Role",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateProjectFreezeWindowsRequestValidationError{
+						field:  fmt.Sprintf("FreezeWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateProjectFreezeWindowsRequestValidationError{
+						field:  fmt.Sprintf("FreezeWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateProjectFreezeWindowsRequestValidationError{
+					field:  fmt.Sprintf("FreezeWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return GenerateAPIKeyRequestMultiError(errors)
+		return UpdateProjectFreezeWindowsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateAPIKeyRequestMultiError is an error wrapping multiple validation
-// errors returned by GenerateAPIKeyRequest.ValidateAll() if the designated
+// UpdateProjectFreezeWindowsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateProjectFreezeWindowsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateAPIKeyRequestMultiError []error
+type UpdateProjectFreezeWindowsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateAPIKeyRequestMultiError) Error() string {
+func (m UpdateProjectFreezeWindowsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10262,11 +10286,12 @@ func (m GenerateAPIKeyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateAPIKeyRequestMultiError) AllErrors() []error { return m }
+func (m UpdateProjectFreezeWindowsRequestMultiError) AllErrors() []error { return m }
 
-// GenerateAPIKeyRequestValidationError is the validation error returned by
-// GenerateAPIKeyRequest.Validate if the designated constraints aren't met.
-type GenerateAPIKeyRequestValidationError struct {
+// UpdateProjectFreezeWindowsRequestValidationError is the validation error
+// returned by UpdateProjectFreezeWindowsRequest.Validate if the designated
+// constraints aren't met.
+type UpdateProjectFreezeWindowsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10274,24 +10299,24 @@ type GenerateAPIKeyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateAPIKeyRequestValidationError) Field() string { return e.field }
+func (e UpdateProjectFreezeWindowsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateAPIKeyRequestValidationError) Reason() string { return e.reason }
+func (e UpdateProjectFreezeWindowsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateAPIKeyRequestValidationError) Cause() error { return e.cause }
+func (e UpdateProjectFreezeWindowsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateAPIKeyRequestValidationError) Key() bool { return e.key }
+func (e UpdateProjectFreezeWindowsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateAPIKeyRequestValidationError) ErrorName() string {
-	return "GenerateAPIKeyRequestValidationError"
+func (e UpdateProjectFreezeWindowsRequestValidationError) ErrorName() string {
+	return "UpdateProjectFreezeWindowsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateAPIKeyRequestValidationError) Error() string {
+func (e UpdateProjectFreezeWindowsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10303,14 +10328,14 @@ func (e GenerateAPIKeyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateAPIKeyRequest.%s: %s%s",
+		"invalid %sUpdateProjectFreezeWindowsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateAPIKeyRequestValidationError{}
+var _ error = UpdateProjectFreezeWindowsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10318,46 +10343,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateAPIKeyRequestValidationError{}
+} = UpdateProjectFreezeWindowsRequestValidationError{}
 
-// Validate checks the field values on GenerateAPIKeyResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateAPIKeyResponse) Validate() error {
+// Validate checks the field values on UpdateProjectFreezeWindowsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateProjectFreezeWindowsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateAPIKeyResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GenerateAPIKeyResponseMultiError, or nil if none found.
-func (m *GenerateAPIKeyResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateProjectFreezeWindowsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateProjectFreezeWindowsResponseMultiError, or nil if none found.
+func (m *UpdateProjectFreezeWindowsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateAPIKeyResponse) validate(all bool) error {
+func (m *UpdateProjectFreezeWindowsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Key
-
 	if len(errors) > 0 {
-		return GenerateAPIKeyResponseMultiError(errors)
+		return UpdateProjectFreezeWindowsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateAPIKeyResponseMultiError is an error wrapping multiple validation
-// errors returned by GenerateAPIKeyResponse.ValidateAll() if the designated
+// UpdateProjectFreezeWindowsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateProjectFreezeWindowsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateAPIKeyResponseMultiError []error
+type UpdateProjectFreezeWindowsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateAPIKeyResponseMultiError) Error() string {
+func (m UpdateProjectFreezeWindowsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10366,11 +10391,12 @@ func (m GenerateAPIKeyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateAPIKeyResponseMultiError) AllErrors() []error { return m }
+func (m UpdateProjectFreezeWindowsResponseMultiError) AllErrors() []error { return m }
 
-// GenerateAPIKeyResponseValidationError is the validation error returned by
-// GenerateAPIKeyResponse.Validate if the designated constraints aren't met.
-type GenerateAPIKeyResponseValidationError struct {
+// UpdateProjectFreezeWindowsResponseValidationError is the validation error
+// returned by UpdateProjectFreezeWindowsResponse.Validate if the designated
+// constraints aren't met.
+type UpdateProjectFreezeWindowsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10378,24 +10404,24 @@ type GenerateAPIKeyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateAPIKeyResponseValidationError) Field() string { return e.field }
+func (e UpdateProjectFreezeWindowsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateAPIKeyResponseValidationError) Reason() string { return e.reason }
+func (e UpdateProjectFreezeWindowsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateAPIKeyResponseValidationError) Cause() error { return e.cause }
+func (e UpdateProjectFreezeWindowsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateAPIKeyResponseValidationError) Key() bool { return e.key }
+func (e UpdateProjectFreezeWindowsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateAPIKeyResponseValidationError) ErrorName() string {
-	return "GenerateAPIKeyResponseValidationError"
+func (e UpdateProjectFreezeWindowsResponseValidationError) ErrorName() string {
+	return "UpdateProjectFreezeWindowsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateAPIKeyResponseValidationError) Error() string {
+func (e UpdateProjectFreezeWindowsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10407,14 +10433,14 @@ func (e GenerateAPIKeyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateAPIKeyResponse.%s: %s%s",
+		"invalid %sUpdateProjectFreezeWindowsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateAPIKeyResponseValidationError{}
+var _ error = UpdateProjectFreezeWindowsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10422,33 +10448,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateAPIKeyResponseValidationError{}
+} = UpdateProjectFreezeWindowsResponseValidationError{}
 
-// Validate checks the field values on DisableAPIKeyRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DisableAPIKeyRequest) Validate() error {
+// Validate checks the field values on GetCommandRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCommandRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DisableAPIKeyRequest with the rules
+// ValidateAll checks the field values on GetCommandRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DisableAPIKeyRequestMultiError, or nil if none found.
-func (m *DisableAPIKeyRequest) ValidateAll() error {
-	return m.validate(true)
+// GetCommandRequestMultiError, or nil if none found.
+func (m *GetCommandRequest) ValidateAll() error {
+	return m.validate(true)
 }
 
-func (m *DisableAPIKeyRequest) validate(all bool) error {
+func (m *GetCommandRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := DisableAPIKeyRequestValidationError{
-			field:  "Id",
+	if utf8.RuneCountInString(m.GetCommandId()) < 1 {
+		err := GetCommandRequestValidationError{
+			field:  "CommandId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -10458,19 +10484,19 @@ func (m *DisableAPIKeyRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DisableAPIKeyRequestMultiError(errors)
+		return GetCommandRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DisableAPIKeyRequestMultiError is an error wrapping multiple validation
-// errors returned by DisableAPIKeyRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DisableAPIKeyRequestMultiError []error
+// GetCommandRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCommandRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCommandRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisableAPIKeyRequestMultiError) Error() string {
+func (m GetCommandRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10479,11 +10505,11 @@ func (m DisableAPIKeyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisableAPIKeyRequestMultiError) AllErrors() []error { return m }
+func (m GetCommandRequestMultiError) AllErrors() []error { return m }
 
-// DisableAPIKeyRequestValidationError is the validation error returned by
-// DisableAPIKeyRequest.Validate if the designated constraints aren't met.
-type DisableAPIKeyRequestValidationError struct {
+// GetCommandRequestValidationError is the validation error returned by
+// GetCommandRequest.Validate if the designated constraints aren't met.
+type GetCommandRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10491,24 +10517,24 @@ type DisableAPIKeyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisableAPIKeyRequestValidationError) Field() string { return e.field }
+func (e GetCommandRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisableAPIKeyRequestValidationError) Reason() string { return e.reason }
+func (e GetCommandRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisableAPIKeyRequestValidationError) Cause() error { return e.cause }
+func (e GetCommandRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisableAPIKeyRequestValidationError) Key() bool { return e.key }
+func (e GetCommandRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisableAPIKeyRequestValidationError) ErrorName() string {
-	return "DisableAPIKeyRequestValidationError"
+func (e GetCommandRequestValidationError) ErrorName() string {
+	return "GetCommandRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DisableAPIKeyRequestValidationError) Error() string {
+func (e GetCommandRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10520,14 +10546,14 @@ func (e DisableAPIKeyRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisableAPIKeyRequest.%s: %s%s",
+		"invalid %sGetCommandRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisableAPIKeyRequestValidationError{}
+var _ error = GetCommandRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10535,44 +10561,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisableAPIKeyRequestValidationError{}
+} = GetCommandRequestValidationError{}
 
-// Validate checks the field values on DisableAPIKeyResponse with the rules
+// Validate checks the field values on GetCommandResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DisableAPIKeyResponse) Validate() error {
+func (m *GetCommandResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DisableAPIKeyResponse with the rules
+// ValidateAll checks the field values on GetCommandResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DisableAPIKeyResponseMultiError, or nil if none found.
-func (m *DisableAPIKeyResponse) ValidateAll() error {
+// GetCommandResponseMultiError, or nil if none found.
+func (m *GetCommandResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DisableAPIKeyResponse) validate(all bool) error {
+func (m *GetCommandResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetCommand()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCommandResponseValidationError{
+					field:  "Command",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCommandResponseValidationError{
+					field:  "Command",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCommand()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCommandResponseValidationError{
+				field:  "Command",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DisableAPIKeyResponseMultiError(errors)
+		return GetCommandResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DisableAPIKeyResponseMultiError is an error wrapping multiple validation
-// errors returned by DisableAPIKeyResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DisableAPIKeyResponseMultiError []error
+// GetCommandResponseMultiError is an error wrapping multiple validation errors
+// returned by GetCommandResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetCommandResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DisableAPIKeyResponseMultiError) Error() string {
+func (m GetCommandResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10581,11 +10636,11 @@ func (m DisableAPIKeyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DisableAPIKeyResponseMultiError) AllErrors() []error { return m }
+func (m GetCommandResponseMultiError) AllErrors() []error { return m }
 
-// DisableAPIKeyResponseValidationError is the validation error returned by
-// DisableAPIKeyResponse.Validate if the designated constraints aren't met.
-type DisableAPIKeyResponseValidationError struct {
+// GetCommandResponseValidationError is the validation error returned by
+// GetCommandResponse.Validate if the designated constraints aren't met.
+type GetCommandResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10593,24 +10648,24 @@ type DisableAPIKeyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DisableAPIKeyResponseValidationError) Field() string { return e.field }
+func (e GetCommandResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DisableAPIKeyResponseValidationError) Reason() string { return e.reason }
+func (e GetCommandResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DisableAPIKeyResponseValidationError) Cause() error { return e.cause }
+func (e GetCommandResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DisableAPIKeyResponseValidationError) Key() bool { return e.key }
+func (e GetCommandResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DisableAPIKeyResponseValidationError) ErrorName() string {
-	return "DisableAPIKeyResponseValidationError"
+func (e GetCommandResponseValidationError) ErrorName() string {
+	return "GetCommandResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DisableAPIKeyResponseValidationError) Error() string {
+func (e GetCommandResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10622,14 +10677,14 @@ func (e DisableAPIKeyResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDisableAPIKeyResponse.%s: %s%s",
+		"invalid %sGetCommandResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DisableAPIKeyResponseValidationError{}
+var _ error = GetCommandResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10637,73 +10692,111 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DisableAPIKeyResponseValidationError{}
+} = GetCommandResponseValidationError{}
 
-// Validate checks the field values on ListAPIKeysRequest with the rules
+// Validate checks the field values on GenerateAPIKeyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListAPIKeysRequest) Validate() error {
+func (m *GenerateAPIKeyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListAPIKeysRequest with the rules
+// ValidateAll checks the field values on GenerateAPIKeyRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListAPIKeysRequestMultiError, or nil if none found.
-func (m *ListAPIKeysRequest) ValidateAll() error {
+// GenerateAPIKeyRequestMultiError, or nil if none found.
+func (m *GenerateAPIKeyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListAPIKeysRequest) validate(all bool) error {
+func (m *GenerateAPIKeyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListAPIKeysRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := GenerateAPIKeyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := model.APIKey_Role_name[int32(m.GetRole())]; !ok {
+		err := GenerateAPIKeyRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GenerateAPIKeyRequestValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GenerateAPIKeyRequestValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListAPIKeysRequestValidationError{
-					field:  "Options",
+				return GenerateAPIKeyRequestValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListAPIKeysRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+
+	}
+
+	if m.GetExpiresAt() < 0 {
+		err := GenerateAPIKeyRequestValidationError{
+			field:  "ExpiresAt",
+			reason: "value must be greater than or equal to 0",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return ListAPIKeysRequestMultiError(errors)
+		return GenerateAPIKeyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListAPIKeysRequestMultiError is an error wrapping multiple validation errors
-// returned by ListAPIKeysRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListAPIKeysRequestMultiError []error
+// GenerateAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateAPIKeyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListAPIKeysRequestMultiError) Error() string {
+func (m GenerateAPIKeyRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10712,11 +10805,11 @@ func (m ListAPIKeysRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListAPIKeysRequestMultiError) AllErrors() []error { return m }
+func (m GenerateAPIKeyRequestMultiError) AllErrors() []error { return m }
 
-// ListAPIKeysRequestValidationError is the validation error returned by
-// ListAPIKeysRequest.Validate if the designated constraints aren't met.
-type ListAPIKeysRequestValidationError struct {
+// GenerateAPIKeyRequestValidationError is the validation error returned by
+// GenerateAPIKeyRequest.Validate if the designated constraints aren't met.
+type GenerateAPIKeyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10724,24 +10817,24 @@ type ListAPIKeysRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListAPIKeysRequestValidationError) Field() string { return e.field }
+func (e GenerateAPIKeyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListAPIKeysRequestValidationError) Reason() string { return e.reason }
+func (e GenerateAPIKeyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListAPIKeysRequestValidationError) Cause() error { return e.cause }
+func (e GenerateAPIKeyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListAPIKeysRequestValidationError) Key() bool { return e.key }
+func (e GenerateAPIKeyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListAPIKeysRequestValidationError) ErrorName() string {
-	return "ListAPIKeysRequestValidationError"
+func (e GenerateAPIKeyRequestValidationError) ErrorName() string {
+	return "GenerateAPIKeyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListAPIKeysRequestValidationError) Error() string {
+func (e GenerateAPIKeyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10753,14 +10846,14 @@ func (e ListAPIKeysRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListAPIKeysRequest.%s: %s%s",
+		"invalid %sGenerateAPIKeyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListAPIKeysRequestValidationError{}
+var _ error = GenerateAPIKeyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10768,7 +10861,457 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListAPIKeysRequestValidationError{}
+} = GenerateAPIKeyRequestValidationError{}
+
+// Validate checks the field values on GenerateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateAPIKeyResponseMultiError, or nil if none found.
+func (m *GenerateAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return GenerateAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by GenerateAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateAPIKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// GenerateAPIKeyResponseValidationError is the validation error returned by
+// GenerateAPIKeyResponse.Validate if the designated constraints aren't met.
+type GenerateAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateAPIKeyResponseValidationError) ErrorName() string {
+	return "GenerateAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateAPIKeyResponseValidationError{}
+
+// Validate checks the field values on DisableAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableAPIKeyRequestMultiError, or nil if none found.
+func (m *DisableAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DisableAPIKeyRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DisableAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by DisableAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisableAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableAPIKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// DisableAPIKeyRequestValidationError is the validation error returned by
+// DisableAPIKeyRequest.Validate if the designated constraints aren't met.
+type DisableAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableAPIKeyRequestValidationError) ErrorName() string {
+	return "DisableAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableAPIKeyRequestValidationError{}
+
+// Validate checks the field values on DisableAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableAPIKeyResponseMultiError, or nil if none found.
+func (m *DisableAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisableAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableAPIKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// DisableAPIKeyResponseValidationError is the validation error returned by
+// DisableAPIKeyResponse.Validate if the designated constraints aren't met.
+type DisableAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableAPIKeyResponseValidationError) ErrorName() string {
+	return "DisableAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableAPIKeyResponseValidationError{}
+
+// Validate checks the field values on ListAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAPIKeysRequestMultiError, or nil if none found.
+func (m *ListAPIKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAPIKeysRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAPIKeysRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAPIKeysRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListAPIKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAPIKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAPIKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAPIKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListAPIKeysRequestValidationError is the validation error returned by
+// ListAPIKeysRequest.Validate if the designated constraints aren't met.
+type ListAPIKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysRequestValidationError) ErrorName() string {
+	return "ListAPIKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysRequestValidationError{}
 
 // Validate checks the field values on ListAPIKeysResponse with the rules
 // defined in the proto definition for this message. If any rules are
@@ -11527,26 +12070,279 @@ func (m *ListDeploymentChainsRequest) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for PageSize
+	// no validation rules for PageSize
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageMinUpdatedAt
+
+	if len(errors) > 0 {
+		return ListDeploymentChainsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeploymentChainsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDeploymentChainsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListDeploymentChainsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeploymentChainsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeploymentChainsRequestMultiError) AllErrors() []error { return m }
+
+// ListDeploymentChainsRequestValidationError is the validation error returned
+// by ListDeploymentChainsRequest.Validate if the designated constraints
+// aren't met.
+type ListDeploymentChainsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeploymentChainsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeploymentChainsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeploymentChainsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeploymentChainsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeploymentChainsRequestValidationError) ErrorName() string {
+	return "ListDeploymentChainsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeploymentChainsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeploymentChainsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeploymentChainsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeploymentChainsRequestValidationError{}
+
+// Validate checks the field values on ListDeploymentChainsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeploymentChainsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeploymentChainsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeploymentChainsResponseMultiError, or nil if none found.
+func (m *ListDeploymentChainsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeploymentChainsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeploymentChains() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeploymentChainsResponseValidationError{
+						field:  fmt.Sprintf("DeploymentChains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeploymentChainsResponseValidationError{
+						field:  fmt.Sprintf("DeploymentChains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeploymentChainsResponseValidationError{
+					field:  fmt.Sprintf("DeploymentChains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return ListDeploymentChainsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeploymentChainsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDeploymentChainsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListDeploymentChainsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeploymentChainsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeploymentChainsResponseMultiError) AllErrors() []error { return m }
+
+// ListDeploymentChainsResponseValidationError is the validation error returned
+// by ListDeploymentChainsResponse.Validate if the designated constraints
+// aren't met.
+type ListDeploymentChainsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeploymentChainsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeploymentChainsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeploymentChainsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeploymentChainsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeploymentChainsResponseValidationError) ErrorName() string {
+	return "ListDeploymentChainsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeploymentChainsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeploymentChainsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeploymentChainsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeploymentChainsResponseValidationError{}
+
+// Validate checks the field values on GetDeploymentChainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeploymentChainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentChainRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentChainRequestMultiError, or nil if none found.
+func (m *GetDeploymentChainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentChainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-	// no validation rules for Cursor
+	var errors []error
 
-	// no validation rules for PageMinUpdatedAt
+	if utf8.RuneCountInString(m.GetDeploymentChainId()) < 1 {
+		err := GetDeploymentChainRequestValidationError{
+			field:  "DeploymentChainId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListDeploymentChainsRequestMultiError(errors)
+		return GetDeploymentChainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListDeploymentChainsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListDeploymentChainsRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListDeploymentChainsRequestMultiError []error
+// GetDeploymentChainRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentChainRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetDeploymentChainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListDeploymentChainsRequestMultiError) Error() string {
+func (m GetDeploymentChainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11555,12 +12351,11 @@ func (m ListDeploymentChainsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListDeploymentChainsRequestMultiError) AllErrors() []error { return m }
+func (m GetDeploymentChainRequestMultiError) AllErrors() []error { return m }
 
-// ListDeploymentChainsRequestValidationError is the validation error returned
-// by ListDeploymentChainsRequest.Validate if the designated constraints
-// aren't met.
-type ListDeploymentChainsRequestValidationError struct {
+// GetDeploymentChainRequestValidationError is the validation error returned by
+// GetDeploymentChainRequest.Validate if the designated constraints aren't met.
+type GetDeploymentChainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11568,24 +12363,24 @@ type ListDeploymentChainsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListDeploymentChainsRequestValidationError) Field() string { return e.field }
+func (e GetDeploymentChainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListDeploymentChainsRequestValidationError) Reason() string { return e.reason }
+func (e GetDeploymentChainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListDeploymentChainsRequestValidationError) Cause() error { return e.cause }
+func (e GetDeploymentChainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListDeploymentChainsRequestValidationError) Key() bool { return e.key }
+func (e GetDeploymentChainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListDeploymentChainsRequestValidationError) ErrorName() string {
-	return "ListDeploymentChainsRequestValidationError"
+func (e GetDeploymentChainRequestValidationError) ErrorName() string {
+	return "GetDeploymentChainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListDeploymentChainsRequestValidationError) Error() string {
+func (e GetDeploymentChainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11597,14 +12392,14 @@ func (e ListDeploymentChainsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListDeploymentChainsRequest.%s: %s%s",
+		"invalid %sGetDeploymentChainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListDeploymentChainsRequestValidationError{}
+var _ error = GetDeploymentChainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11612,80 +12407,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListDeploymentChainsRequestValidationError{}
+} = GetDeploymentChainRequestValidationError{}
 
-// Validate checks the field values on ListDeploymentChainsResponse with the
+// Validate checks the field values on GetDeploymentChainResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListDeploymentChainsResponse) Validate() error {
+func (m *GetDeploymentChainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListDeploymentChainsResponse with the
+// ValidateAll checks the field values on GetDeploymentChainResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListDeploymentChainsResponseMultiError, or nil if none found.
-func (m *ListDeploymentChainsResponse) ValidateAll() error {
+// GetDeploymentChainResponseMultiError, or nil if none found.
+func (m *GetDeploymentChainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListDeploymentChainsResponse) validate(all bool) error {
+func (m *GetDeploymentChainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDeploymentChains() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListDeploymentChainsResponseValidationError{
-						field:  fmt.Sprintf("DeploymentChains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListDeploymentChainsResponseValidationError{
-						field:  fmt.Sprintf("DeploymentChains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDeploymentChain()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDeploymentChainResponseValidationError{
+					field:  "DeploymentChain",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListDeploymentChainsResponseValidationError{
-					field:  fmt.Sprintf("DeploymentChains[%v]", idx),
+				errors = append(errors, GetDeploymentChainResponseValidationError{
+					field:  "DeploymentChain",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeploymentChain()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDeploymentChainResponseValidationError{
+				field:  "DeploymentChain",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Cursor
-
 	if len(errors) > 0 {
-		return ListDeploymentChainsResponseMultiError(errors)
+		return GetDeploymentChainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListDeploymentChainsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListDeploymentChainsResponse.ValidateAll() if
+// GetDeploymentChainResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDeploymentChainResponse.ValidateAll() if
 // the designated constraints aren't met.
-type ListDeploymentChainsResponseMultiError []error
+type GetDeploymentChainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListDeploymentChainsResponseMultiError) Error() string {
+func (m GetDeploymentChainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11694,12 +12482,11 @@ func (m ListDeploymentChainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListDeploymentChainsResponseMultiError) AllErrors() []error { return m }
+func (m GetDeploymentChainResponseMultiError) AllErrors() []error { return m }
 
-// ListDeploymentChainsResponseValidationError is the validation error returned
-// by ListDeploymentChainsResponse.Validate if the designated constraints
-// aren't met.
-type ListDeploymentChainsResponseValidationError struct {
+// GetDeploymentChainResponseValidationError is the validation error returned
+// by GetDeploymentChainResponse.Validate if the designated constraints aren't met.
+type GetDeploymentChainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11707,24 +12494,24 @@ type ListDeploymentChainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListDeploymentChainsResponseValidationError) Field() string { return e.field }
+func (e GetDeploymentChainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListDeploymentChainsResponseValidationError) Reason() string { return e.reason }
+func (e GetDeploymentChainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListDeploymentChainsResponseValidationError) Cause() error { return e.cause }
+func (e GetDeploymentChainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListDeploymentChainsResponseValidationError) Key() bool { return e.key }
+func (e GetDeploymentChainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListDeploymentChainsResponseValidationError) ErrorName() string {
-	return "ListDeploymentChainsResponseValidationError"
+func (e GetDeploymentChainResponseValidationError) ErrorName() string {
+	return "GetDeploymentChainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListDeploymentChainsResponseValidationError) Error() string {
+func (e GetDeploymentChainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11736,14 +12523,14 @@ func (e ListDeploymentChainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListDeploymentChainsResponse.%s: %s%s",
+		"invalid %sGetDeploymentChainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListDeploymentChainsResponseValidationError{}
+var _ error = GetDeploymentChainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11751,55 +12538,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListDeploymentChainsResponseValidationError{}
+} = GetDeploymentChainResponseValidationError{}
 
-// Validate checks the field values on GetDeploymentChainRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDeploymentChainRequest) Validate() error {
+// Validate checks the field values on ListEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDeploymentChainRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDeploymentChainRequestMultiError, or nil if none found.
-func (m *GetDeploymentChainRequest) ValidateAll() error {
+// ListEventsRequestMultiError, or nil if none found.
+func (m *ListEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDeploymentChainRequest) validate(all bool) error {
+func (m *ListEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetDeploymentChainId()) < 1 {
-		err := GetDeploymentChainRequestValidationError{
-			field:  "DeploymentChainId",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListEventsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListEventsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for PageSize
+
+	// no validation rules for Cursor
+
+	// no validation rules for PageMinUpdatedAt
+
 	if len(errors) > 0 {
-		return GetDeploymentChainRequestMultiError(errors)
+		return ListEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDeploymentChainRequestMultiError is an error wrapping multiple validation
-// errors returned by GetDeploymentChainRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetDeploymentChainRequestMultiError []error
+// ListEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDeploymentChainRequestMultiError) Error() string {
+func (m ListEventsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11808,11 +12619,11 @@ func (m GetDeploymentChainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDeploymentChainRequestMultiError) AllErrors() []error { return m }
+func (m ListEventsRequestMultiError) AllErrors() []error { return m }
 
-// GetDeploymentChainRequestValidationError is the validation error returned by
-// GetDeploymentChainRequest.Validate if the designated constraints aren't met.
-type GetDeploymentChainRequestValidationError struct {
+// ListEventsRequestValidationError is the validation error returned by
+// ListEventsRequest.Validate if the designated constraints aren't met.
+type ListEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11820,24 +12631,24 @@ type GetDeploymentChainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDeploymentChainRequestValidationError) Field() string { return e.field }
+func (e ListEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDeploymentChainRequestValidationError) Reason() string { return e.reason }
+func (e ListEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDeploymentChainRequestValidationError) Cause() error { return e.cause }
+func (e ListEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDeploymentChainRequestValidationError) Key() bool { return e.key }
+func (e ListEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDeploymentChainRequestValidationError) ErrorName() string {
-	return "GetDeploymentChainRequestValidationError"
+func (e ListEventsRequestValidationError) ErrorName() string {
+	return "ListEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDeploymentChainRequestValidationError) Error() string {
+func (e ListEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11849,14 +12660,14 @@ func (e GetDeploymentChainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDeploymentChainRequest.%s: %s%s",
+		"invalid %sListEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDeploymentChainRequestValidationError{}
+var _ error = ListEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11864,73 +12675,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDeploymentChainRequestValidationError{}
+} = ListEventsRequestValidationError{}
 
-// Validate checks the field values on GetDeploymentChainResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDeploymentChainResponse) Validate() error {
+func (m *ListEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDeploymentChainResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDeploymentChainResponseMultiError, or nil if none found.
-func (m *GetDeploymentChainResponse) ValidateAll() error {
+// ListEventsResponseMultiError, or nil if none found.
+func (m *ListEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDeploymentChainResponse) validate(all bool) error {
+func (m *ListEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDeploymentChain()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetDeploymentChainResponseValidationError{
-					field:  "DeploymentChain",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetDeploymentChainResponseValidationError{
-					field:  "DeploymentChain",
+				return ListEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDeploymentChain()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetDeploymentChainResponseValidationError{
-				field:  "DeploymentChain",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for Cursor
+
 	if len(errors) > 0 {
-		return GetDeploymentChainResponseMultiError(errors)
+		return ListEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDeploymentChainResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDeploymentChainResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetDeploymentChainResponseMultiError []error
+// ListEventsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListEventsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDeploymentChainResponseMultiError) Error() string {
+func (m ListEventsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11939,11 +12757,11 @@ func (m GetDeploymentChainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDeploymentChainResponseMultiError) AllErrors() []error { return m }
+func (m ListEventsResponseMultiError) AllErrors() []error { return m }
 
-// GetDeploymentChainResponseValidationError is the validation error returned
-// by GetDeploymentChainResponse.Validate if the designated constraints aren't met.
-type GetDeploymentChainResponseValidationError struct {
+// ListEventsResponseValidationError is the validation error returned by
+// ListEventsResponse.Validate if the designated constraints aren't met.
+type ListEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11951,24 +12769,24 @@ type GetDeploymentChainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDeploymentChainResponseValidationError) Field() string { return e.field }
+func (e ListEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDeploymentChainResponseValidationError) Reason() string { return e.reason }
+func (e ListEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDeploymentChainResponseValidationError) Cause() error { return e.cause }
+func (e ListEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDeploymentChainResponseValidationError) Key() bool { return e.key }
+func (e ListEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDeploymentChainResponseValidationError) ErrorName() string {
-	return "GetDeploymentChainResponseValidationError"
+func (e ListEventsResponseValidationError) ErrorName() string {
+	return "ListEventsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDeploymentChainResponseValidationError) Error() string {
+func (e ListEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11980,14 +12798,14 @@ func (e GetDeploymentChainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDeploymentChainResponse.%s: %s%s",
+		"invalid %sListEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDeploymentChainResponseValidationError{}
+var _ error = ListEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11995,24 +12813,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDeploymentChainResponseValidationError{}
+} = ListEventsResponseValidationError{}
 
-// Validate checks the field values on ListEventsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListEventsRequest) Validate() error {
+// Validate checks the field values on ListAuditLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEventsRequest with the rules
+// ValidateAll checks the field values on ListAuditLogsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEventsRequestMultiError, or nil if none found.
-func (m *ListEventsRequest) ValidateAll() error {
+// ListAuditLogsRequestMultiError, or nil if none found.
+func (m *ListAuditLogsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEventsRequest) validate(all bool) error {
+func (m *ListAuditLogsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12023,7 +12841,7 @@ func (m *ListEventsRequest) validate(all bool) error {
 		switch v := interface{}(m.GetOptions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
+				errors = append(errors, ListAuditLogsRequestValidationError{
 					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12031,7 +12849,7 @@ func (m *ListEventsRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListEventsRequestValidationError{
+				errors = append(errors, ListAuditLogsRequestValidationError{
 					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12040,7 +12858,7 @@ func (m *ListEventsRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListEventsRequestValidationError{
+			return ListAuditLogsRequestValidationError{
 				field:  "Options",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -12052,22 +12870,22 @@ func (m *ListEventsRequest) validate(all bool) error {
 
 	// no validation rules for Cursor
 
-	// no validation rules for PageMinUpdatedAt
+	// no validation rules for PageMinCreatedAt
 
 	if len(errors) > 0 {
-		return ListEventsRequestMultiError(errors)
+		return ListAuditLogsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEventsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListEventsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListEventsRequestMultiError []error
+// ListAuditLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditLogsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEventsRequestMultiError) Error() string {
+func (m ListAuditLogsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12076,11 +12894,11 @@ func (m ListEventsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEventsRequestMultiError) AllErrors() []error { return m }
+func (m ListAuditLogsRequestMultiError) AllErrors() []error { return m }
 
-// ListEventsRequestValidationError is the validation error returned by
-// ListEventsRequest.Validate if the designated constraints aren't met.
-type ListEventsRequestValidationError struct {
+// ListAuditLogsRequestValidationError is the validation error returned by
+// ListAuditLogsRequest.Validate if the designated constraints aren't met.
+type ListAuditLogsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12088,24 +12906,24 @@ type ListEventsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEventsRequestValidationError) Field() string { return e.field }
+func (e ListAuditLogsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+func (e ListAuditLogsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+func (e ListAuditLogsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEventsRequestValidationError) Key() bool { return e.key }
+func (e ListAuditLogsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEventsRequestValidationError) ErrorName() string {
-	return "ListEventsRequestValidationError"
+func (e ListAuditLogsRequestValidationError) ErrorName() string {
+	return "ListAuditLogsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEventsRequestValidationError) Error() string {
+func (e ListAuditLogsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12117,14 +12935,14 @@ func (e ListEventsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEventsRequest.%s: %s%s",
+		"invalid %sListAuditLogsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEventsRequestValidationError{}
+var _ error = ListAuditLogsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12132,47 +12950,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEventsRequestValidationError{}
+} = ListAuditLogsRequestValidationError{}
 
-// Validate checks the field values on ListEventsResponse with the rules
+// Validate checks the field values on ListAuditLogsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListEventsResponse) Validate() error {
+func (m *ListAuditLogsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEventsResponse with the rules
+// ValidateAll checks the field values on ListAuditLogsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEventsResponseMultiError, or nil if none found.
-func (m *ListEventsResponse) ValidateAll() error {
+// ListAuditLogsResponseMultiError, or nil if none found.
+func (m *ListAuditLogsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEventsResponse) validate(all bool) error {
+func (m *ListAuditLogsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEvents() {
+	for idx, item := range m.GetAuditLogs() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
+					errors = append(errors, ListAuditLogsResponseValidationError{
+						field:  fmt.Sprintf("AuditLogs[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
+					errors = append(errors, ListAuditLogsResponseValidationError{
+						field:  fmt.Sprintf("AuditLogs[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -12180,8 +12998,8 @@ func (m *ListEventsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListEventsResponseValidationError{
-					field:  fmt.Sprintf("Events[%v]", idx),
+				return ListAuditLogsResponseValidationError{
+					field:  fmt.Sprintf("AuditLogs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -12193,19 +13011,19 @@ func (m *ListEventsResponse) validate(all bool) error {
 	// no validation rules for Cursor
 
 	if len(errors) > 0 {
-		return ListEventsResponseMultiError(errors)
+		return ListAuditLogsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEventsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListEventsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListEventsResponseMultiError []error
+// ListAuditLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditLogsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEventsResponseMultiError) Error() string {
+func (m ListAuditLogsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12214,11 +13032,11 @@ func (m ListEventsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEventsResponseMultiError) AllErrors() []error { return m }
+func (m ListAuditLogsResponseMultiError) AllErrors() []error { return m }
 
-// ListEventsResponseValidationError is the validation error returned by
-// ListEventsResponse.Validate if the designated constraints aren't met.
-type ListEventsResponseValidationError struct {
+// ListAuditLogsResponseValidationError is the validation error returned by
+// ListAuditLogsResponse.Validate if the designated constraints aren't met.
+type ListAuditLogsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12226,24 +13044,24 @@ type ListEventsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEventsResponseValidationError) Field() string { return e.field }
+func (e ListAuditLogsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEventsResponseValidationError) Reason() string { return e.reason }
+func (e ListAuditLogsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEventsResponseValidationError) Cause() error { return e.cause }
+func (e ListAuditLogsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEventsResponseValidationError) Key() bool { return e.key }
+func (e ListAuditLogsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEventsResponseValidationError) ErrorName() string {
-	return "ListEventsResponseValidationError"
+func (e ListAuditLogsResponseValidationError) ErrorName() string {
+	return "ListAuditLogsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEventsResponseValidationError) Error() string {
+func (e ListAuditLogsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12255,14 +13073,14 @@ func (e ListEventsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEventsResponse.%s: %s%s",
+		"invalid %sListAuditLogsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEventsResponseValidationError{}
+var _ error = ListAuditLogsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12270,7 +13088,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEventsResponseValidationError{}
+} = ListAuditLogsResponseValidationError{}
 
 // Validate checks the field values on ListPipedsRequest_Options with the rules
 // defined in the proto definition for this message. If any rules are
@@ -13292,3 +14110,110 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListEventsRequest_OptionsValidationError{}
+
+// Validate checks the field values on ListAuditLogsRequest_Options with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogsRequest_Options) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditLogsRequest_Options with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditLogsRequest_OptionsMultiError, or nil if none found.
+func (m *ListAuditLogsRequest_Options) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditLogsRequest_Options) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Actor
+
+	// no validation rules for ResourceType
+
+	if len(errors) > 0 {
+		return ListAuditLogsRequest_OptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditLogsRequest_OptionsMultiError is an error wrapping multiple
+// validation errors returned by ListAuditLogsRequest_Options.ValidateAll() if
+// the designated constraints aren't met.
+type ListAuditLogsRequest_OptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditLogsRequest_OptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditLogsRequest_OptionsMultiError) AllErrors() []error { return m }
+
+// ListAuditLogsRequest_OptionsValidationError is the validation error returned
+// by ListAuditLogsRequest_Options.Validate if the designated constraints
+// aren't met.
+type ListAuditLogsRequest_OptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditLogsRequest_OptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditLogsRequest_OptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditLogsRequest_OptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditLogsRequest_OptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditLogsRequest_OptionsValidationError) ErrorName() string {
+	return "ListAuditLogsRequest_OptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditLogsRequest_OptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditLogsRequest_Options.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditLogsRequest_OptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditLogsRequest_OptionsValidationError{}
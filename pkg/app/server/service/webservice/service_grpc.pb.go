@@ -70,6 +70,8 @@ type WebServiceClient interface {
 	DeleteProjectRBACRole(ctx context.Context, in *DeleteProjectRBACRoleRequest, opts ...grpc.CallOption) (*DeleteProjectRBACRoleResponse, error)
 	AddProjectUserGroup(ctx context.Context, in *AddProjectUserGroupRequest, opts ...grpc.CallOption) (*AddProjectUserGroupResponse, error)
 	DeleteProjectUserGroup(ctx context.Context, in *DeleteProjectUserGroupRequest, opts ...grpc.CallOption) (*DeleteProjectUserGroupResponse, error)
+	UpdateProjectRegistryWebhookConfig(ctx context.Context, in *UpdateProjectRegistryWebhookConfigRequest, opts ...grpc.CallOption) (*UpdateProjectRegistryWebhookConfigResponse, error)
+	UpdateProjectFreezeWindows(ctx context.Context, in *UpdateProjectFreezeWindowsRequest, opts ...grpc.CallOption) (*UpdateProjectFreezeWindowsResponse, error)
 	// Command
 	GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error)
 	// API Key
@@ -84,6 +86,8 @@ type WebServiceClient interface {
 	GetDeploymentChain(ctx context.Context, in *GetDeploymentChainRequest, opts ...grpc.CallOption) (*GetDeploymentChainResponse, error)
 	// Events
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// AuditLogs
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
 }
 
 type webServiceClient struct {
@@ -472,6 +476,24 @@ func (c *webServiceClient) DeleteProjectUserGroup(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *webServiceClient) UpdateProjectRegistryWebhookConfig(ctx context.Context, in *UpdateProjectRegistryWebhookConfigRequest, opts ...grpc.CallOption) (*UpdateProjectRegistryWebhookConfigResponse, error) {
+	out := new(UpdateProjectRegistryWebhookConfigResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/UpdateProjectRegistryWebhookConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webServiceClient) UpdateProjectFreezeWindows(ctx context.Context, in *UpdateProjectFreezeWindowsRequest, opts ...grpc.CallOption) (*UpdateProjectFreezeWindowsResponse, error) {
+	out := new(UpdateProjectFreezeWindowsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/UpdateProjectFreezeWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webServiceClient) GetCommand(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*GetCommandResponse, error) {
 	out := new(GetCommandResponse)
 	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/GetCommand", in, out, opts...)
@@ -553,6 +575,15 @@ func (c *webServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest
 	return out, nil
 }
 
+func (c *webServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, "/grpc.service.webservice.WebService/ListAuditLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WebServiceServer is the server API for WebService service.
 // All implementations must embed UnimplementedWebServiceServer
 // for forward compatibility
@@ -605,6 +636,8 @@ type WebServiceServer interface {
 	DeleteProjectRBACRole(context.Context, *DeleteProjectRBACRoleRequest) (*DeleteProjectRBACRoleResponse, error)
 	AddProjectUserGroup(context.Context, *AddProjectUserGroupRequest) (*AddProjectUserGroupResponse, error)
 	DeleteProjectUserGroup(context.Context, *DeleteProjectUserGroupRequest) (*DeleteProjectUserGroupResponse, error)
+	UpdateProjectRegistryWebhookConfig(context.Context, *UpdateProjectRegistryWebhookConfigRequest) (*UpdateProjectRegistryWebhookConfigResponse, error)
+	UpdateProjectFreezeWindows(context.Context, *UpdateProjectFreezeWindowsRequest) (*UpdateProjectFreezeWindowsResponse, error)
 	// Command
 	GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error)
 	// API Key
@@ -619,6 +652,8 @@ type WebServiceServer interface {
 	GetDeploymentChain(context.Context, *GetDeploymentChainRequest) (*GetDeploymentChainResponse, error)
 	// Events
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// AuditLogs
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
 	mustEmbedUnimplementedWebServiceServer()
 }
 
@@ -752,6 +787,12 @@ func (UnimplementedWebServiceServer) AddProjectUserGroup(context.Context, *AddPr
 func (UnimplementedWebServiceServer) DeleteProjectUserGroup(context.Context, *DeleteProjectUserGroupRequest) (*DeleteProjectUserGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectUserGroup not implemented")
 }
+func (UnimplementedWebServiceServer) UpdateProjectRegistryWebhookConfig(context.Context, *UpdateProjectRegistryWebhookConfigRequest) (*UpdateProjectRegistryWebhookConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectRegistryWebhookConfig not implemented")
+}
+func (UnimplementedWebServiceServer) UpdateProjectFreezeWindows(context.Context, *UpdateProjectFreezeWindowsRequest) (*UpdateProjectFreezeWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectFreezeWindows not implemented")
+}
 func (UnimplementedWebServiceServer) GetCommand(context.Context, *GetCommandRequest) (*GetCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommand not implemented")
 }
@@ -779,6 +820,9 @@ func (UnimplementedWebServiceServer) GetDeploymentChain(context.Context, *GetDep
 func (UnimplementedWebServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
 }
+func (UnimplementedWebServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
 func (UnimplementedWebServiceServer) mustEmbedUnimplementedWebServiceServer() {}
 
 // UnsafeWebServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1548,6 +1592,42 @@ func _WebService_DeleteProjectUserGroup_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_UpdateProjectRegistryWebhookConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRegistryWebhookConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).UpdateProjectRegistryWebhookConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/UpdateProjectRegistryWebhookConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).UpdateProjectRegistryWebhookConfig(ctx, req.(*UpdateProjectRegistryWebhookConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebService_UpdateProjectFreezeWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectFreezeWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).UpdateProjectFreezeWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/UpdateProjectFreezeWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).UpdateProjectFreezeWindows(ctx, req.(*UpdateProjectFreezeWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WebService_GetCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommandRequest)
 	if err := dec(in); err != nil {
@@ -1710,6 +1790,24 @@ func _WebService_ListEvents_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebService_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc.service.webservice.WebService/ListAuditLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WebService_ServiceDesc is the grpc.ServiceDesc for WebService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1885,6 +1983,14 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProjectUserGroup",
 			Handler:    _WebService_DeleteProjectUserGroup_Handler,
 		},
+		{
+			MethodName: "UpdateProjectRegistryWebhookConfig",
+			Handler:    _WebService_UpdateProjectRegistryWebhookConfig_Handler,
+		},
+		{
+			MethodName: "UpdateProjectFreezeWindows",
+			Handler:    _WebService_UpdateProjectFreezeWindows_Handler,
+		},
 		{
 			MethodName: "GetCommand",
 			Handler:    _WebService_GetCommand_Handler,
@@ -1921,6 +2027,10 @@ var WebService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEvents",
 			Handler:    _WebService_ListEvents_Handler,
 		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _WebService_ListAuditLogs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/app/server/service/webservice/service.proto",
@@ -65,6 +65,12 @@ func (a *App) AddCommands(cmds ...*cobra.Command) {
 	}
 }
 
+// PersistentFlags returns the persistent flags of the root command
+// which are shared by all sub commands.
+func (a *App) PersistentFlags() *pflag.FlagSet {
+	return a.rootCmd.PersistentFlags()
+}
+
 func (a *App) Run() error {
 	return a.rootCmd.Execute()
 }
@@ -237,6 +237,66 @@ func TestFindSlackUsersAndGroups(t *testing.T) {
 	}
 }
 
+func TestFindTeamsUsers(t *testing.T) {
+	testcases := []struct {
+		name      string
+		mentions  []NotificationMention
+		event     model.NotificationEventType
+		wantUsers []string
+	}{
+		{
+			name: "match an event name",
+			mentions: []NotificationMention{
+				{
+					Event: "DEPLOYMENT_TRIGGERED",
+					Teams: []string{"user-1@example.com", "user-2@example.com"},
+				},
+				{
+					Event: "DEPLOYMENT_PLANNED",
+					Teams: []string{"user-3@example.com"},
+				},
+			},
+			event:     model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED,
+			wantUsers: []string{"user-1@example.com", "user-2@example.com"},
+		},
+		{
+			name: "match with both event name and all-events mark",
+			mentions: []NotificationMention{
+				{
+					Event: "DEPLOYMENT_TRIGGERED",
+					Teams: []string{"user-1@example.com"},
+				},
+				{
+					Event: "*",
+					Slack: []string{"slack-user"},
+					Teams: []string{"user-1@example.com", "user-3@example.com"},
+				},
+			},
+			event:     model.NotificationEventType_EVENT_DEPLOYMENT_TRIGGERED,
+			wantUsers: []string{"user-1@example.com", "user-3@example.com"},
+		},
+		{
+			name: "does not match anything",
+			mentions: []NotificationMention{
+				{
+					Event: "DEPLOYMENT_TRIGGERED",
+					Teams: []string{"user-1@example.com"},
+				},
+			},
+			event:     model.NotificationEventType_EVENT_DEPLOYMENT_PLANNED,
+			wantUsers: []string{},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := &DeploymentNotification{
+				tc.mentions,
+			}
+			assert.ElementsMatch(t, tc.wantUsers, n.FindTeamsUsers(tc.event))
+		})
+	}
+}
+
 func TestValidateAnalysisTemplateRef(t *testing.T) {
 	testcases := []struct {
 		name    string
@@ -426,6 +486,13 @@ func TestGenericTriggerConfiguration(t *testing.T) {
 						OnChain: OnChain{
 							Disabled: newBoolPointer(true),
 						},
+						OnSchedule: OnSchedule{
+							Crons: []string{
+								"0 9 * * 1-5",
+							},
+							TimeZone:              "Asia/Tokyo",
+							OnlyUndeployedCommits: true,
+						},
 					},
 					Planner: DeploymentPlanner{
 						AutoRollback: newBoolPointer(true),
@@ -606,18 +673,34 @@ func TestGenericPostSyncConfiguration(t *testing.T) {
 						DeploymentChain: &DeploymentChain{
 							ApplicationMatchers: []ChainApplicationMatcher{
 								{
-									Name: "app-1",
+									Name:              "app-1",
+									ContinueOnFailure: true,
 								},
 								{
 									Labels: map[string]string{
 										"env": "staging",
 										"foo": "bar",
 									},
+									Gate: &DeploymentChainGate{
+										Wait: Duration(30 * time.Minute),
+										Analysis: &AnalysisTemplateRef{
+											Name: "http_stage_check",
+										},
+										AnalysisDuration: Duration(10 * time.Minute),
+										ManualApproval:   true,
+									},
 								},
 								{
 									Kind: "ECSApp",
 								},
 							},
+							Conditions: &DeploymentChainTriggerCondition{
+								CommitPrefix: "release:",
+								Paths:        []string{"manifests/**"},
+								Labels: map[string]string{
+									"env": "dev",
+								},
+							},
 						},
 					},
 				},
@@ -870,3 +953,226 @@ func TestScriptSycConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestOnScheduleIsScheduledBetween(t *testing.T) {
+	t.Parallel()
+
+	weekdayMorning := OnSchedule{
+		Crons:    []string{"0 9 * * 1-5"},
+		TimeZone: "Asia/Tokyo",
+	}
+
+	testcases := []struct {
+		name     string
+		schedule OnSchedule
+		from     string
+		to       string
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name:     "no cron",
+			schedule: OnSchedule{},
+			from:     "2025-10-06T08:59:00+09:00",
+			to:       "2025-10-06T09:00:00+09:00",
+			want:     false,
+		},
+		{
+			name:     "fired at the end of the range",
+			schedule: weekdayMorning,
+			from:     "2025-10-06T08:59:00+09:00",
+			to:       "2025-10-06T09:00:00+09:00",
+			want:     true,
+		},
+		{
+			name:     "fired in the range in another time zone",
+			schedule: weekdayMorning,
+			from:     "2025-10-05T23:59:00Z",
+			to:       "2025-10-06T00:01:00Z",
+			want:     true,
+		},
+		{
+			name:     "already fired before the range",
+			schedule: weekdayMorning,
+			from:     "2025-10-06T09:00:00+09:00",
+			to:       "2025-10-06T09:01:00+09:00",
+			want:     false,
+		},
+		{
+			name:     "not fired on weekend",
+			schedule: weekdayMorning,
+			from:     "2025-10-04T08:59:00+09:00",
+			to:       "2025-10-04T09:01:00+09:00",
+			want:     false,
+		},
+		{
+			name: "one of multiple crons fired",
+			schedule: OnSchedule{
+				Crons: []string{"0 9 * * 1-5", "0 0 * * *"},
+			},
+			from: "2025-10-04T23:59:00Z",
+			to:   "2025-10-05T00:00:00Z",
+			want: true,
+		},
+		{
+			name: "invalid cron",
+			schedule: OnSchedule{
+				Crons: []string{"every morning"},
+			},
+			from:    "2025-10-06T08:59:00+09:00",
+			to:      "2025-10-06T09:00:00+09:00",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			from, err := time.Parse(time.RFC3339, tc.from)
+			require.NoError(t, err)
+			to, err := time.Parse(time.RFC3339, tc.to)
+			require.NoError(t, err)
+
+			got, err := tc.schedule.IsScheduledBetween(from, to)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDeploymentChainTriggerConditionMatch(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		condition     DeploymentChainTriggerCondition
+		commitMessage string
+		labels        map[string]string
+		changedFiles  []string
+		want          bool
+	}{
+		{
+			name: "commit prefix matched",
+			condition: DeploymentChainTriggerCondition{
+				CommitPrefix: "release:",
+			},
+			commitMessage: "release: v1.0.0",
+			want:          true,
+		},
+		{
+			name: "commit prefix not matched",
+			condition: DeploymentChainTriggerCondition{
+				CommitPrefix: "release:",
+			},
+			commitMessage: "fix: typo",
+			want:          false,
+		},
+		{
+			name: "labels matched",
+			condition: DeploymentChainTriggerCondition{
+				Labels: map[string]string{"env": "dev"},
+			},
+			labels: map[string]string{"env": "dev", "team": "a"},
+			want:   true,
+		},
+		{
+			name: "labels not matched",
+			condition: DeploymentChainTriggerCondition{
+				Labels: map[string]string{"env": "dev"},
+			},
+			labels: map[string]string{"env": "prod"},
+			want:   false,
+		},
+		{
+			name: "paths matched",
+			condition: DeploymentChainTriggerCondition{
+				Paths: []string{"manifests/**"},
+			},
+			changedFiles: []string{"README.md", "manifests/deployment.yaml"},
+			want:         true,
+		},
+		{
+			name: "paths not matched",
+			condition: DeploymentChainTriggerCondition{
+				Paths: []string{"manifests/**"},
+			},
+			changedFiles: []string{"README.md"},
+			want:         false,
+		},
+		{
+			name: "one of conditions not matched",
+			condition: DeploymentChainTriggerCondition{
+				CommitPrefix: "release:",
+				Labels:       map[string]string{"env": "dev"},
+			},
+			commitMessage: "release: v1.0.0",
+			labels:        map[string]string{"env": "prod"},
+			want:          false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tc.condition.Match(tc.commitMessage, tc.labels, tc.changedFiles)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDeploymentChainGateValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		gate    DeploymentChainGate
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			gate:    DeploymentChainGate{},
+			wantErr: true,
+		},
+		{
+			name: "negative wait",
+			gate: DeploymentChainGate{
+				Wait: Duration(-time.Minute),
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative analysis duration",
+			gate: DeploymentChainGate{
+				Analysis: &AnalysisTemplateRef{
+					Name: "http_stage_check",
+				},
+				AnalysisDuration: Duration(-time.Minute),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing analysis template name",
+			gate: DeploymentChainGate{
+				Analysis: &AnalysisTemplateRef{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			gate: DeploymentChainGate{
+				Wait: Duration(10 * time.Minute),
+				Analysis: &AnalysisTemplateRef{
+					Name: "http_stage_check",
+				},
+				ManualApproval: true,
+			},
+			wantErr: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.gate.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// freezeWindowTimeLayouts are the accepted layouts for the start and end of a freeze window.
+// The ones without an offset are interpreted in the time zone of the window.
+var freezeWindowTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+}
+
+// DeploymentFreezeWindow represents a period of time in which no new deployment should be started.
+// It can be either a recurring window specified by a cron expression and a duration,
+// or an absolute window specified by its start and end time.
+type DeploymentFreezeWindow struct {
+	// The name of the freeze window.
+	Name string `json:"name"`
+	// The reason of the freeze. It will be shown in the status of the held deployments.
+	Reason string `json:"reason,omitempty"`
+	// The cron expression (standard 5 fields) at which the recurring window starts.
+	// This must be used together with duration.
+	Cron string `json:"cron,omitempty"`
+	// How long the recurring window lasts from each of its starts.
+	Duration Duration `json:"duration,omitempty"`
+	// The start time of the absolute window.
+	// RFC3339, "2006-01-02T15:04:05" and "2006-01-02 15:04" formats are accepted.
+	Start string `json:"start,omitempty"`
+	// The end time of the absolute window.
+	// RFC3339, "2006-01-02T15:04:05" and "2006-01-02 15:04" formats are accepted.
+	End string `json:"end,omitempty"`
+	// The IANA time zone name (e.g. Asia/Tokyo) used to interpret the cron expression
+	// and the start, end time without offset.
+	// Default is UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+func (w *DeploymentFreezeWindow) Validate() error {
+	if w.Name == "" {
+		return errors.New("name of freeze window must be set")
+	}
+	if _, err := w.location(); err != nil {
+		return fmt.Errorf("invalid timeZone of freeze window %s: %w", w.Name, err)
+	}
+
+	if w.Cron != "" {
+		if w.Start != "" || w.End != "" {
+			return fmt.Errorf("only one of cron or start/end can be set in freeze window %s", w.Name)
+		}
+		if _, err := cron.ParseStandard(w.Cron); err != nil {
+			return fmt.Errorf("invalid cron of freeze window %s: %w", w.Name, err)
+		}
+		if w.Duration <= 0 {
+			return fmt.Errorf("duration of freeze window %s must be greater than 0", w.Name)
+		}
+		return nil
+	}
+
+	if w.Start == "" || w.End == "" {
+		return fmt.Errorf("either cron or both start and end must be set in freeze window %s", w.Name)
+	}
+	start, end, err := w.timeRange()
+	if err != nil {
+		return err
+	}
+	if !start.Before(end) {
+		return fmt.Errorf("start of freeze window %s must be before its end", w.Name)
+	}
+	return nil
+}
+
+// IsActive reports whether the given time is inside this freeze window.
+func (w *DeploymentFreezeWindow) IsActive(t time.Time) (bool, error) {
+	loc, err := w.location()
+	if err != nil {
+		return false, err
+	}
+
+	if w.Cron != "" {
+		sched, err := cron.ParseStandard(w.Cron)
+		if err != nil {
+			return false, err
+		}
+		// The window is active when it has been started within the last duration.
+		start := sched.Next(t.In(loc).Add(-w.Duration.Duration()))
+		return !start.After(t), nil
+	}
+
+	start, end, err := w.timeRange()
+	if err != nil {
+		return false, err
+	}
+	return !t.Before(start) && t.Before(end), nil
+}
+
+// Description returns a human readable message telling why deployments are frozen.
+func (w *DeploymentFreezeWindow) Description() string {
+	if w.Reason == "" {
+		return fmt.Sprintf("Deployments are frozen by the freeze window %q", w.Name)
+	}
+	return fmt.Sprintf("Deployments are frozen by the freeze window %q: %s", w.Name, w.Reason)
+}
+
+func (w *DeploymentFreezeWindow) location() (*time.Location, error) {
+	if w.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(w.TimeZone)
+}
+
+func (w *DeploymentFreezeWindow) timeRange() (start, end time.Time, err error) {
+	loc, err := w.location()
+	if err != nil {
+		return
+	}
+	if start, err = parseFreezeWindowTime(w.Start, loc); err != nil {
+		err = fmt.Errorf("invalid start of freeze window %s: %w", w.Name, err)
+		return
+	}
+	if end, err = parseFreezeWindowTime(w.End, loc); err != nil {
+		err = fmt.Errorf("invalid end of freeze window %s: %w", w.Name, err)
+		return
+	}
+	return
+}
+
+func parseFreezeWindowTime(value string, loc *time.Location) (time.Time, error) {
+	for _, layout := range freezeWindowTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q does not match any of the supported formats", value)
+}
+
+// NewProjectFreezeWindows converts the freeze windows configured in the project
+// into DeploymentFreezeWindows and validates them.
+func NewProjectFreezeWindows(windows []*model.ProjectFreezeWindow) ([]DeploymentFreezeWindow, error) {
+	out := make([]DeploymentFreezeWindow, 0, len(windows))
+	for _, pw := range windows {
+		w := DeploymentFreezeWindow{
+			Name:     pw.Name,
+			Reason:   pw.Reason,
+			Cron:     pw.Cron,
+			Start:    pw.Start,
+			End:      pw.End,
+			TimeZone: pw.TimeZone,
+		}
+		if pw.Duration != "" {
+			d, err := time.ParseDuration(pw.Duration)
+			if err != nil {
+				return nil, fmt.Errorf("invalid duration of freeze window %s: %w", pw.Name, err)
+			}
+			w.Duration = Duration(d)
+		}
+		if err := w.Validate(); err != nil {
+			return nil, err
+		}
+		out = append(out, w)
+	}
+	return out, nil
+}
+
+// FindActiveFreezeWindow returns the first window which is active at the given time.
+func FindActiveFreezeWindow(windows []DeploymentFreezeWindow, t time.Time) (*DeploymentFreezeWindow, bool) {
+	for i := range windows {
+		if active, err := windows[i].IsActive(t); err == nil && active {
+			return &windows[i], true
+		}
+	}
+	return nil, false
+}
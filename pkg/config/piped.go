@@ -153,6 +153,11 @@ func (s *PipedSpec) Validate() error {
 				return err
 			}
 		}
+		if n.Teams != nil {
+			if err := n.Teams.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 	for _, p := range s.AnalysisProviders {
 		if err := p.Validate(); err != nil {
@@ -688,9 +693,13 @@ type PipedAnalysisProvider struct {
 	Name string                     `json:"name"`
 	Type model.AnalysisProviderType `json:"type"`
 
-	PrometheusConfig  *AnalysisProviderPrometheusConfig
-	DatadogConfig     *AnalysisProviderDatadogConfig
-	StackdriverConfig *AnalysisProviderStackdriverConfig
+	PrometheusConfig    *AnalysisProviderPrometheusConfig
+	DatadogConfig       *AnalysisProviderDatadogConfig
+	StackdriverConfig   *AnalysisProviderStackdriverConfig
+	LokiConfig          *AnalysisProviderLokiConfig
+	ElasticsearchConfig *AnalysisProviderElasticsearchConfig
+	CloudWatchConfig    *AnalysisProviderCloudWatchConfig
+	NewRelicConfig      *AnalysisProviderNewRelicConfig
 }
 
 func (p *PipedAnalysisProvider) Mask() {
@@ -703,6 +712,18 @@ func (p *PipedAnalysisProvider) Mask() {
 	if p.StackdriverConfig != nil {
 		p.StackdriverConfig.Mask()
 	}
+	if p.LokiConfig != nil {
+		p.LokiConfig.Mask()
+	}
+	if p.ElasticsearchConfig != nil {
+		p.ElasticsearchConfig.Mask()
+	}
+	if p.CloudWatchConfig != nil {
+		p.CloudWatchConfig.Mask()
+	}
+	if p.NewRelicConfig != nil {
+		p.NewRelicConfig.Mask()
+	}
 }
 
 type genericPipedAnalysisProvider struct {
@@ -724,6 +745,14 @@ func (p *PipedAnalysisProvider) MarshalJSON() ([]byte, error) {
 		config, err = json.Marshal(p.PrometheusConfig)
 	case model.AnalysisProviderStackdriver:
 		config, err = json.Marshal(p.StackdriverConfig)
+	case model.AnalysisProviderLoki:
+		config, err = json.Marshal(p.LokiConfig)
+	case model.AnalysisProviderElasticsearch:
+		config, err = json.Marshal(p.ElasticsearchConfig)
+	case model.AnalysisProviderCloudWatch:
+		config, err = json.Marshal(p.CloudWatchConfig)
+	case model.AnalysisProviderNewRelic:
+		config, err = json.Marshal(p.NewRelicConfig)
 	default:
 		err = fmt.Errorf("unsupported analysis provider type: %s", p.Name)
 	}
@@ -764,6 +793,26 @@ func (p *PipedAnalysisProvider) UnmarshalJSON(data []byte) error {
 		if len(gp.Config) > 0 {
 			err = json.Unmarshal(gp.Config, p.StackdriverConfig)
 		}
+	case model.AnalysisProviderLoki:
+		p.LokiConfig = &AnalysisProviderLokiConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.LokiConfig)
+		}
+	case model.AnalysisProviderElasticsearch:
+		p.ElasticsearchConfig = &AnalysisProviderElasticsearchConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.ElasticsearchConfig)
+		}
+	case model.AnalysisProviderCloudWatch:
+		p.CloudWatchConfig = &AnalysisProviderCloudWatchConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.CloudWatchConfig)
+		}
+	case model.AnalysisProviderNewRelic:
+		p.NewRelicConfig = &AnalysisProviderNewRelicConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.NewRelicConfig)
+		}
 	default:
 		err = fmt.Errorf("unsupported analysis provider type: %s", p.Name)
 	}
@@ -778,6 +827,14 @@ func (p *PipedAnalysisProvider) Validate() error {
 		return p.DatadogConfig.Validate()
 	case model.AnalysisProviderStackdriver:
 		return p.StackdriverConfig.Validate()
+	case model.AnalysisProviderLoki:
+		return p.LokiConfig.Validate()
+	case model.AnalysisProviderElasticsearch:
+		return p.ElasticsearchConfig.Validate()
+	case model.AnalysisProviderCloudWatch:
+		return p.CloudWatchConfig.Validate()
+	case model.AnalysisProviderNewRelic:
+		return p.NewRelicConfig.Validate()
 	default:
 		return fmt.Errorf("unknow provider type: %s", p.Type)
 	}
@@ -867,6 +924,147 @@ func (a *AnalysisProviderStackdriverConfig) Validate() error {
 	return nil
 }
 
+type AnalysisProviderLokiConfig struct {
+	// The address of Loki server.
+	Address string `json:"address"`
+	// The path to the username file.
+	UsernameFile string `json:"usernameFile,omitempty"`
+	// The path to the password file.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// The tenant ID sent as the X-Scope-OrgID header.
+	// Required only when Loki runs in the multi-tenant mode.
+	TenantID string `json:"tenantID,omitempty"`
+}
+
+func (a *AnalysisProviderLokiConfig) Validate() error {
+	if a.Address == "" {
+		return fmt.Errorf("loki analysis provider requires the address")
+	}
+	if (a.UsernameFile == "") != (a.PasswordFile == "") {
+		return fmt.Errorf("both loki usernameFile and passwordFile must be set")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderLokiConfig) Mask() {
+	if len(a.PasswordFile) != 0 {
+		a.PasswordFile = maskString
+	}
+}
+
+type AnalysisProviderElasticsearchConfig struct {
+	// The address of Elasticsearch or OpenSearch server.
+	Address string `json:"address"`
+	// The index or index pattern to be searched. e.g. "logs-*"
+	Index string `json:"index"`
+	// The field used to filter the documents by time.
+	// Defaults to "@timestamp".
+	TimestampField string `json:"timestampField,omitempty"`
+	// The field shown as the message of the matched documents.
+	// Defaults to "message".
+	MessageField string `json:"messageField,omitempty"`
+	// The path to the username file.
+	UsernameFile string `json:"usernameFile,omitempty"`
+	// The path to the password file.
+	PasswordFile string `json:"passwordFile,omitempty"`
+	// The path to the API key file.
+	APIKeyFile string `json:"apiKeyFile,omitempty"`
+}
+
+func (a *AnalysisProviderElasticsearchConfig) Validate() error {
+	if a.Address == "" {
+		return fmt.Errorf("elasticsearch analysis provider requires the address")
+	}
+	if a.Index == "" {
+		return fmt.Errorf("elasticsearch analysis provider requires the index")
+	}
+	if (a.UsernameFile == "") != (a.PasswordFile == "") {
+		return fmt.Errorf("both elasticsearch usernameFile and passwordFile must be set")
+	}
+	if a.APIKeyFile != "" && a.UsernameFile != "" {
+		return fmt.Errorf("only elasticsearch apiKeyFile or usernameFile/passwordFile can be set")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderElasticsearchConfig) Mask() {
+	if len(a.PasswordFile) != 0 {
+		a.PasswordFile = maskString
+	}
+	if len(a.APIKeyFile) != 0 {
+		a.APIKeyFile = maskString
+	}
+}
+
+type AnalysisProviderCloudWatchConfig struct {
+	// The region to send requests to. This parameter is required.
+	// e.g. "us-west-2"
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (a *AnalysisProviderCloudWatchConfig) Validate() error {
+	if a.Region == "" {
+		return fmt.Errorf("cloudwatch analysis provider requires the region")
+	}
+	if (a.RoleARN == "") != (a.TokenFile == "") {
+		return fmt.Errorf("both cloudwatch roleARN and tokenFile must be set")
+	}
+	return nil
+}
+
+func (a *AnalysisProviderCloudWatchConfig) Mask() {
+	if len(a.CredentialsFile) != 0 {
+		a.CredentialsFile = maskString
+	}
+	if len(a.RoleARN) != 0 {
+		a.RoleARN = maskString
+	}
+	if len(a.TokenFile) != 0 {
+		a.TokenFile = maskString
+	}
+}
+
+type AnalysisProviderNewRelicConfig struct {
+	// The ID of the New Relic account that the queries run against.
+	AccountID int64 `json:"accountID"`
+	// The region of the account. One of "US" or "EU" is available.
+	// Defaults to "US".
+	Region string `json:"region,omitempty"`
+	// The path to the user API key file.
+	APIKeyFile string `json:"apiKeyFile"`
+}
+
+func (a *AnalysisProviderNewRelicConfig) Validate() error {
+	if a.AccountID <= 0 {
+		return fmt.Errorf("newrelic analysis provider requires the accountID")
+	}
+	if a.APIKeyFile == "" {
+		return fmt.Errorf("newrelic analysis provider requires the apiKeyFile")
+	}
+	switch a.Region {
+	case "", "US", "EU":
+	default:
+		return fmt.Errorf("newrelic region must be one of US or EU, but %q was given", a.Region)
+	}
+	return nil
+}
+
+func (a *AnalysisProviderNewRelicConfig) Mask() {
+	if len(a.APIKeyFile) != 0 {
+		a.APIKeyFile = maskString
+	}
+}
+
 type Notifications struct {
 	// List of notification routes.
 	Routes []NotificationRoute `json:"routes,omitempty"`
@@ -897,6 +1095,7 @@ type NotificationReceiver struct {
 	Name    string                       `json:"name"`
 	Slack   *NotificationReceiverSlack   `json:"slack,omitempty"`
 	Webhook *NotificationReceiverWebhook `json:"webhook,omitempty"`
+	Teams   *NotificationReceiverTeams   `json:"teams,omitempty"`
 }
 
 func (n *NotificationReceiver) Mask() {
@@ -906,6 +1105,9 @@ func (n *NotificationReceiver) Mask() {
 	if n.Webhook != nil {
 		n.Webhook.Mask()
 	}
+	if n.Teams != nil {
+		n.Teams.Mask()
+	}
 }
 
 type NotificationReceiverSlack struct {
@@ -966,6 +1168,29 @@ func (n *NotificationReceiverSlack) Validate() error {
 	return nil
 }
 
+// NotificationReceiverTeams represents a Microsoft Teams channel
+// that receives notifications via an incoming webhook or a Workflows URL.
+type NotificationReceiverTeams struct {
+	// The URL of the incoming webhook or the Workflows webhook trigger.
+	HookURL string `json:"hookURL"`
+	// List of user IDs to be mentioned in every notification.
+	// Both the user principal name (e.g. user@example.com) and the Microsoft Entra object ID are accepted.
+	MentionedAccounts []string `json:"mentionedAccounts,omitempty"`
+}
+
+func (n *NotificationReceiverTeams) Mask() {
+	if len(n.HookURL) != 0 {
+		n.HookURL = maskString
+	}
+}
+
+func (n *NotificationReceiverTeams) Validate() error {
+	if n.HookURL == "" {
+		return errors.New("missing hookURL for Microsoft Teams receiver")
+	}
+	return nil
+}
+
 type NotificationReceiverWebhook struct {
 	URL                string `json:"url"`
 	SignatureKey       string `json:"signatureKey,omitempty" default:"PipeCD-Signature"`
@@ -1012,6 +1237,7 @@ type SecretManagement struct {
 
 	KeyPair *SecretManagementKeyPair
 	GCPKMS  *SecretManagementGCPKMS
+	AWSKMS  *SecretManagementAWSKMS
 }
 
 type genericSecretManagement struct {
@@ -1030,6 +1256,8 @@ func (s *SecretManagement) MarshalJSON() ([]byte, error) {
 		config, err = json.Marshal(s.KeyPair)
 	case model.SecretManagementTypeGCPKMS:
 		config, err = json.Marshal(s.GCPKMS)
+	case model.SecretManagementTypeAWSKMS:
+		config, err = json.Marshal(s.AWSKMS)
 	default:
 		err = fmt.Errorf("unsupported secret management type: %s", s.Type)
 	}
@@ -1064,6 +1292,12 @@ func (s *SecretManagement) UnmarshalJSON(data []byte) error {
 		if len(g.Config) > 0 {
 			err = json.Unmarshal(g.Config, s.GCPKMS)
 		}
+	case model.SecretManagementTypeAWSKMS:
+		s.Type = model.SecretManagementTypeAWSKMS
+		s.AWSKMS = &SecretManagementAWSKMS{}
+		if len(g.Config) > 0 {
+			err = json.Unmarshal(g.Config, s.AWSKMS)
+		}
 	default:
 		err = fmt.Errorf("unsupported secret management type: %s", s.Type)
 	}
@@ -1077,6 +1311,9 @@ func (s *SecretManagement) Mask() {
 	if s.GCPKMS != nil {
 		s.GCPKMS.Mask()
 	}
+	if s.AWSKMS != nil {
+		s.AWSKMS.Mask()
+	}
 }
 
 func (s *SecretManagement) Validate() error {
@@ -1085,6 +1322,8 @@ func (s *SecretManagement) Validate() error {
 		return s.KeyPair.Validate()
 	case model.SecretManagementTypeGCPKMS:
 		return s.GCPKMS.Validate()
+	case model.SecretManagementTypeAWSKMS:
+		return s.AWSKMS.Validate()
 	default:
 		return fmt.Errorf("unsupported sealed secret management type: %s", s.Type)
 	}
@@ -1148,11 +1387,13 @@ func (s *SecretManagementKeyPair) LoadPublicKey() ([]byte, error) {
 
 type SecretManagementGCPKMS struct {
 	// Configurable fields when using Google Cloud KMS.
-	// The key name used for decrypting the sealed secret.
+	// The name of the asymmetric key version used for decrypting the sealed secret.
+	// The key must have ASYMMETRIC_DECRYPT purpose with an RSA_DECRYPT_OAEP_*_SHA256 algorithm.
+	// e.g. projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{key}/cryptoKeyVersions/{version}
 	KeyName string `json:"keyName"`
 	// The path to the service account used to decrypt secret.
 	DecryptServiceAccountFile string `json:"decryptServiceAccountFile"`
-	// The path to the service account used to encrypt secret.
+	// The path to the service account used to fetch the public key for encrypting secret.
 	EncryptServiceAccountFile string `json:"encryptServiceAccountFile"`
 }
 
@@ -1178,6 +1419,47 @@ func (s *SecretManagementGCPKMS) Mask() {
 	}
 }
 
+type SecretManagementAWSKMS struct {
+	// Configurable fields when using AWS KMS.
+	// The ID, ARN or alias of the asymmetric key used for decrypting the sealed secret.
+	// The key must have ENCRYPT_DECRYPT usage with an RSA key spec.
+	KeyID string `json:"keyId"`
+	// The region to send requests to.
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (s *SecretManagementAWSKMS) Validate() error {
+	if s.KeyID == "" {
+		return fmt.Errorf("keyId must be set")
+	}
+	if s.Region == "" {
+		return fmt.Errorf("region must be set")
+	}
+	return nil
+}
+
+func (s *SecretManagementAWSKMS) Mask() {
+	if len(s.CredentialsFile) != 0 {
+		s.CredentialsFile = maskString
+	}
+	if len(s.RoleARN) != 0 {
+		s.RoleARN = maskString
+	}
+	if len(s.TokenFile) != 0 {
+		s.TokenFile = maskString
+	}
+}
+
 type PipedEventWatcher struct {
 	// Interval to fetch the latest event and compare it with one defined in EventWatcher config files
 	CheckInterval Duration `json:"checkInterval,omitempty"`
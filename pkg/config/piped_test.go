@@ -15,6 +15,7 @@
 package config
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -175,6 +176,38 @@ func TestPipedConfig(t *testing.T) {
 							ServiceAccountFile: "/etc/piped-secret/gcp-service-account.json",
 						},
 					},
+					{
+						Name: "loki-dev",
+						Type: model.AnalysisProviderLoki,
+						LokiConfig: &AnalysisProviderLokiConfig{
+							Address:  "https://your-loki.dev",
+							TenantID: "pipecd",
+						},
+					},
+					{
+						Name: "elasticsearch-dev",
+						Type: model.AnalysisProviderElasticsearch,
+						ElasticsearchConfig: &AnalysisProviderElasticsearchConfig{
+							Address:    "https://your-elasticsearch.dev",
+							Index:      "logs-*",
+							APIKeyFile: "/etc/piped-secret/elasticsearch-api-key",
+						},
+					},
+					{
+						Name: "cloudwatch-dev",
+						Type: model.AnalysisProviderCloudWatch,
+						CloudWatchConfig: &AnalysisProviderCloudWatchConfig{
+							Region: "us-east-1",
+						},
+					},
+					{
+						Name: "newrelic-dev",
+						Type: model.AnalysisProviderNewRelic,
+						NewRelicConfig: &AnalysisProviderNewRelicConfig{
+							AccountID:  1234567,
+							APIKeyFile: "/etc/piped-secret/newrelic-api-key",
+						},
+					},
 				},
 				Notifications: Notifications{
 					Routes: []NotificationRoute{
@@ -342,6 +375,13 @@ func TestPipedConfig(t *testing.T) {
 								SignatureValue: "random-signature-string",
 							},
 						},
+						{
+							Name: "dev-teams-channel",
+							Teams: &NotificationReceiverTeams{
+								HookURL:           "https://example.webhook.office.com/dev",
+								MentionedAccounts: []string{"user1@example.com"},
+							},
+						},
 					},
 				},
 				SecretManagement: &SecretManagement{
@@ -632,6 +672,114 @@ func TestPipedSlackNotificationValidate(t *testing.T) {
 	}
 }
 
+func TestPipedAnalysisProviderValidate(t *testing.T) {
+	testcases := []struct {
+		name     string
+		provider PipedAnalysisProvider
+		wantErr  bool
+	}{
+		{
+			name: "valid cloudwatch",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderCloudWatch,
+				CloudWatchConfig: &AnalysisProviderCloudWatchConfig{
+					Region: "us-east-1",
+				},
+			},
+		},
+		{
+			name: "cloudwatch without region",
+			provider: PipedAnalysisProvider{
+				Type:             model.AnalysisProviderCloudWatch,
+				CloudWatchConfig: &AnalysisProviderCloudWatchConfig{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cloudwatch with role arn but no token file",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderCloudWatch,
+				CloudWatchConfig: &AnalysisProviderCloudWatchConfig{
+					Region:  "us-east-1",
+					RoleARN: "arn:aws:iam::123456789012:role/piped",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid newrelic",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderNewRelic,
+				NewRelicConfig: &AnalysisProviderNewRelicConfig{
+					AccountID:  1234,
+					Region:     "EU",
+					APIKeyFile: "/etc/piped-secret/newrelic-api-key",
+				},
+			},
+		},
+		{
+			name: "newrelic without account id",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderNewRelic,
+				NewRelicConfig: &AnalysisProviderNewRelicConfig{
+					APIKeyFile: "/etc/piped-secret/newrelic-api-key",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "newrelic without api key file",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderNewRelic,
+				NewRelicConfig: &AnalysisProviderNewRelicConfig{
+					AccountID: 1234,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "newrelic with unknown region",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderNewRelic,
+				NewRelicConfig: &AnalysisProviderNewRelicConfig{
+					AccountID:  1234,
+					Region:     "JP",
+					APIKeyFile: "/etc/piped-secret/newrelic-api-key",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "loki without address",
+			provider: PipedAnalysisProvider{
+				Type:       model.AnalysisProviderLoki,
+				LokiConfig: &AnalysisProviderLokiConfig{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "elasticsearch with both api key and basic auth",
+			provider: PipedAnalysisProvider{
+				Type: model.AnalysisProviderElasticsearch,
+				ElasticsearchConfig: &AnalysisProviderElasticsearchConfig{
+					Address:      "https://your-elasticsearch.dev",
+					Index:        "logs-*",
+					UsernameFile: "/etc/piped-secret/username",
+					PasswordFile: "/etc/piped-secret/password",
+					APIKeyFile:   "/etc/piped-secret/api-key",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.provider.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
 func TestNotificationReceiverWebhook_LoadSignatureValue(t *testing.T) {
 	testcase := []struct {
 		name    string
@@ -818,6 +966,9 @@ func TestPipedConfigMask(t *testing.T) {
 								SignatureValue:     "foo",
 								SignatureValueFile: "foo",
 							},
+							Teams: &NotificationReceiverTeams{
+								HookURL: "foo",
+							},
 						},
 					},
 				},
@@ -982,6 +1133,9 @@ func TestPipedConfigMask(t *testing.T) {
 								SignatureValue:     maskString,
 								SignatureValueFile: maskString,
 							},
+							Teams: &NotificationReceiverTeams{
+								HookURL: maskString,
+							},
 						},
 					},
 				},
@@ -1626,3 +1780,58 @@ func TestPipeGitValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretManagementAWSKMS(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		name        string
+		data        string
+		expected    *SecretManagement
+		expectedErr error
+	}{
+		{
+			name: "valid config",
+			data: `{"type": "AWS_KMS", "config": {"keyId": "alias/piped", "region": "us-west-2", "profile": "piped"}}`,
+			expected: &SecretManagement{
+				Type: model.SecretManagementTypeAWSKMS,
+				AWSKMS: &SecretManagementAWSKMS{
+					KeyID:   "alias/piped",
+					Region:  "us-west-2",
+					Profile: "piped",
+				},
+			},
+		},
+		{
+			name: "missing keyId",
+			data: `{"type": "AWS_KMS", "config": {"region": "us-west-2"}}`,
+			expected: &SecretManagement{
+				Type: model.SecretManagementTypeAWSKMS,
+				AWSKMS: &SecretManagementAWSKMS{
+					Region: "us-west-2",
+				},
+			},
+			expectedErr: errors.New("keyId must be set"),
+		},
+		{
+			name: "missing region",
+			data: `{"type": "AWS_KMS", "config": {"keyId": "alias/piped"}}`,
+			expected: &SecretManagement{
+				Type: model.SecretManagementTypeAWSKMS,
+				AWSKMS: &SecretManagementAWSKMS{
+					KeyID: "alias/piped",
+				},
+			},
+			expectedErr: errors.New("region must be set"),
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			sm := &SecretManagement{}
+			require.NoError(t, json.Unmarshal([]byte(tc.data), sm))
+			assert.Equal(t, tc.expected, sm)
+			assert.Equal(t, tc.expectedErr, sm.Validate())
+		})
+	}
+}
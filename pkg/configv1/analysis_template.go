@@ -0,0 +1,84 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// KindAnalysisTemplate represents shared analysis template for a repository.
+// This configuration file should be placed in .pipe directory
+// at the root of the repository.
+const KindAnalysisTemplate Kind = "AnalysisTemplate"
+
+// AnalysisTemplateSpec contains the analyses defined in the analysis template.
+// The analyses are kept as raw JSON since they are decoded and run by the analysis plugin.
+type AnalysisTemplateSpec struct {
+	Metrics map[string]json.RawMessage `json:"metrics"`
+	Logs    map[string]json.RawMessage `json:"logs"`
+	HTTPS   map[string]json.RawMessage `json:"https"`
+}
+
+func (s *AnalysisTemplateSpec) Validate() error {
+	return nil
+}
+
+// AnalysisTemplateRef is the reference to an analysis defined in the analysis template.
+type AnalysisTemplateRef struct {
+	Name    string            `json:"name"`
+	AppArgs map[string]string `json:"appArgs"`
+}
+
+func (a *AnalysisTemplateRef) Validate() error {
+	if a.Name == "" {
+		return fmt.Errorf("the reference of analysis template name is empty")
+	}
+	return nil
+}
+
+// LoadAnalysisTemplate finds the config file for the analysis template in the .pipe
+// directory first up. And returns parsed config, ErrNotFound is returned if not found.
+func LoadAnalysisTemplate(repoRoot string) (*AnalysisTemplateSpec, error) {
+	dir := filepath.Join(repoRoot, SharedConfigurationDirName)
+	files, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(f.Name())
+		if ext != ".yaml" && ext != ".yml" && ext != ".json" {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		cfg, err := LoadFromYAML[*AnalysisTemplateSpec](path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load config file %s: %w", path, err)
+		}
+		if cfg.Kind == KindAnalysisTemplate {
+			return cfg.Spec, nil
+		}
+	}
+	return nil, ErrNotFound
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadAnalysisTemplate(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+	dir := filepath.Join(repoDir, SharedConfigurationDirName)
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "event-watcher.yaml"), []byte(`
+apiVersion: pipecd.dev/v1beta1
+kind: EventWatcher
+spec:
+  events:
+    - name: helloworld-image-update
+`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "analysis-template.yaml"), []byte(`
+apiVersion: pipecd.dev/v1beta1
+kind: AnalysisTemplate
+spec:
+  metrics:
+    error_rate:
+      provider: prometheus-dev
+      query: sum(rate(http_requests_total[1m]))
+  https:
+    http_stage_check:
+      url: https://staging.example.com/healthz
+`), 0600))
+
+	spec, err := LoadAnalysisTemplate(repoDir)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"provider":"prometheus-dev","query":"sum(rate(http_requests_total[1m]))"}`, string(spec.Metrics["error_rate"]))
+	assert.JSONEq(t, `{"url":"https://staging.example.com/healthz"}`, string(spec.HTTPS["http_stage_check"]))
+	assert.Empty(t, spec.Logs)
+
+	_, err = LoadAnalysisTemplate(t.TempDir())
+	assert.ErrorIs(t, err, ErrNotFound)
+}
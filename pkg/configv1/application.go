@@ -20,7 +20,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/robfig/cron/v3"
+
+	"github.com/pipe-cd/pipecd/pkg/filematcher"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -58,6 +62,11 @@ type GenericApplicationSpec struct {
 	EventWatcher []EventWatcherConfig `json:"eventWatcher"`
 	// Configuration for drift detection
 	DriftDetection *DriftDetection `json:"driftDetection"`
+	// List of periods in which no new deployment of this application should be started.
+	FreezeWindows []DeploymentFreezeWindow `json:"freezeWindows,omitempty"`
+	// How a new deployment is handled when another deployment of this application is running.
+	// Either QUEUE, CANCEL_RUNNING or SUPERSEDE_PENDING. Default is QUEUE.
+	ConcurrencyPolicy DeploymentConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
 	// List of the configuration for plugin
 	// This field is plugin-specific, so intentionally restrict the access for the actual value here and decode it on the SDK side.
 	Plugins map[string]struct{} `json:"plugins"`
@@ -85,6 +94,9 @@ type Trigger struct {
 	// Configurable fields used while deciding the application
 	// should be triggered based on received CHAIN_SYNC command.
 	OnChain OnChain `json:"onChain"`
+	// Configurable fields used while deciding the application
+	// should be triggered or not at the scheduled times.
+	OnSchedule OnSchedule `json:"onSchedule"`
 }
 
 type OnCommit struct {
@@ -124,7 +136,69 @@ type OnChain struct {
 	Disabled *bool `json:"disabled,omitempty" default:"true"`
 }
 
+type OnSchedule struct {
+	// List of cron expressions (standard 5 fields) at which the application should be triggered.
+	// Empty means the application is never triggered by schedule.
+	Crons []string `json:"crons,omitempty"`
+	// The IANA time zone name (e.g. Asia/Tokyo) used to interpret the cron expressions.
+	// Default is UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+	// Whether to trigger only when there are commits touching the application
+	// which have not been deployed yet.
+	// Default is false, meaning the application is re-synced at every scheduled time.
+	OnlyUndeployedCommits bool `json:"onlyUndeployedCommits,omitempty"`
+}
+
+func (s *OnSchedule) Validate() error {
+	if _, err := s.schedules(); err != nil {
+		return fmt.Errorf("invalid onSchedule trigger: %w", err)
+	}
+	return nil
+}
+
+// IsScheduledBetween reports whether any of the schedules was fired
+// after the given from time and at or before the given to time.
+func (s *OnSchedule) IsScheduledBetween(from, to time.Time) (bool, error) {
+	schedules, err := s.schedules()
+	if err != nil {
+		return false, err
+	}
+	for _, sched := range schedules {
+		if next := sched.Next(from); !next.After(to) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *OnSchedule) schedules() ([]cron.Schedule, error) {
+	loc := time.UTC
+	if s.TimeZone != "" {
+		l, err := time.LoadLocation(s.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeZone: %w", err)
+		}
+		loc = l
+	}
+	schedules := make([]cron.Schedule, 0, len(s.Crons))
+	for _, c := range s.Crons {
+		sched, err := cron.ParseStandard(c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron %q: %w", c, err)
+		}
+		if spec, ok := sched.(*cron.SpecSchedule); ok && spec.Location == time.Local {
+			spec.Location = loc
+		}
+		schedules = append(schedules, sched)
+	}
+	return schedules, nil
+}
+
 func (s *GenericApplicationSpec) Validate() error {
+	if err := s.Trigger.OnSchedule.Validate(); err != nil {
+		return err
+	}
+
 	if ps := s.PostSync; ps != nil {
 		if err := ps.Validate(); err != nil {
 			return err
@@ -157,6 +231,16 @@ func (s *GenericApplicationSpec) Validate() error {
 		}
 	}
 
+	for i := range s.FreezeWindows {
+		if err := s.FreezeWindows[i].Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.ConcurrencyPolicy.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -353,6 +437,25 @@ func (n *DeploymentNotification) FindSlackUsers(event model.NotificationEventTyp
 	return approvers
 }
 
+// FindTeamsUsers returns a list of Microsoft Teams user IDs to be mentioned for the given event.
+func (n *DeploymentNotification) FindTeamsUsers(event model.NotificationEventType) []string {
+	as := make(map[string]struct{})
+	for _, m := range n.Mentions {
+		if m.Event != allEventsSymbol && "EVENT_"+m.Event != event.String() {
+			continue
+		}
+		for _, u := range m.Teams {
+			as[u] = struct{}{}
+		}
+	}
+
+	users := make([]string, 0, len(as))
+	for u := range as {
+		users = append(users, u)
+	}
+	return users
+}
+
 type NotificationMention struct {
 	// The event to be notified to users.
 	Event string `json:"event"`
@@ -369,6 +472,11 @@ type NotificationMention struct {
 	// See https://api.slack.com/reference/surfaces/formatting#mentioning-groups
 	// for more information on how to check them.
 	SlackGroups []string `json:"slackgroups,omitempty"`
+	// List of user IDs for mentioning in Microsoft Teams.
+	// Both the user principal name (e.g. user@example.com) and the Microsoft Entra object ID are accepted.
+	// See https://learn.microsoft.com/en-us/microsoftteams/platform/task-modules-and-cards/cards/cards-format#mention-support-within-adaptive-cards
+	// for more information.
+	Teams []string `json:"teams,omitempty"`
 	// TODO: Support for email notification
 	// The email for notification.
 	Email []string `json:"email"`
@@ -410,8 +518,7 @@ type DeploymentChain struct {
 	// the first applications in the chain trigger a whole new deployment chain or not.
 	// If this field is not set, always trigger a whole new deployment chain when the current
 	// application is triggered.
-	// TODO: Add conditions to deployment chain configuration.
-	// Conditions *DeploymentChainTriggerCondition `json:"conditions,omitempty"`
+	Conditions *DeploymentChainTriggerCondition `json:"conditions,omitempty"`
 }
 
 func (dc *DeploymentChain) Validate() error {
@@ -425,11 +532,11 @@ func (dc *DeploymentChain) Validate() error {
 		}
 	}
 
-	// if cc := dc.Conditions; cc != nil {
-	// 	if err := cc.Validate(); err != nil {
-	// 		return err
-	// 	}
-	// }
+	if cc := dc.Conditions; cc != nil {
+		if err := cc.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -440,6 +547,13 @@ type ChainApplicationMatcher struct {
 	Name   string            `json:"name"`
 	Kind   string            `json:"kind"`
 	Labels map[string]string `json:"labels"`
+	// Whether the next applications in the chain should be deployed
+	// even if the deployments of the applications found by this matcher failed.
+	// Default is false.
+	ContinueOnFailure bool `json:"continueOnFailure"`
+	// The gate which must be passed before deploying the applications found by this matcher.
+	// The gate is evaluated after all deployments of the previous applications in the chain were completed.
+	Gate *DeploymentChainGate `json:"gate,omitempty"`
 }
 
 func (m *ChainApplicationMatcher) Validate() error {
@@ -448,21 +562,95 @@ func (m *ChainApplicationMatcher) Validate() error {
 	if !hasFilterCond {
 		return fmt.Errorf("at least one of \"name\", \"kind\" or \"labels\" must be set to find applications to deploy")
 	}
+	if m.Gate != nil {
+		if err := m.Gate.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeploymentChainGate provides the conditions to promote the deployment chain to the next applications.
+// All of the specified conditions must be satisfied in order: wait and then manual approval.
+type DeploymentChainGate struct {
+	// How long to wait after the previous applications in the chain were deployed.
+	Wait Duration `json:"wait"`
+	// The reference to a metrics, log or HTTP analysis defined in the analysis template.
+	// The analysis is run by the analysis plugin of piped after the wait duration elapsed.
+	Analysis *AnalysisTemplateRef `json:"analysis,omitempty"`
+	// How long to run the analysis. Default is 10m.
+	AnalysisDuration Duration `json:"analysisDuration" default:"10m"`
+	// Whether a manual approval is required to promote the chain.
+	ManualApproval bool `json:"manualApproval"`
+}
+
+func (g *DeploymentChainGate) Validate() error {
+	if g.Wait < 0 {
+		return fmt.Errorf("wait of deployment chain gate must not be negative")
+	}
+	if g.AnalysisDuration < 0 {
+		return fmt.Errorf("analysisDuration of deployment chain gate must not be negative")
+	}
+	if g.Wait == 0 && g.Analysis == nil && !g.ManualApproval {
+		return fmt.Errorf("at least one of \"wait\", \"analysis\" or \"manualApproval\" must be set as deployment chain gate")
+	}
+	if g.Analysis != nil {
+		if err := g.Analysis.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// DeploymentChainTriggerCondition provides conditions to decide whether the deployment chain
+// should be triggered or not. All of the specified conditions must be satisfied.
 type DeploymentChainTriggerCondition struct {
+	// The prefix of the commit message of the upstream deployment.
 	CommitPrefix string `json:"commitPrefix"`
+	// List of file path patterns. The chain is triggered only when at least one of
+	// the files changed by the upstream deployment matches them.
+	// The paths are relative to the repository root.
+	Paths []string `json:"paths"`
+	// The labels that the upstream deployment must have.
+	Labels map[string]string `json:"labels"`
 }
 
 func (c *DeploymentChainTriggerCondition) Validate() error {
-	hasCond := c.CommitPrefix != ""
+	hasCond := c.CommitPrefix != "" || len(c.Paths) != 0 || len(c.Labels) != 0
 	if !hasCond {
-		return fmt.Errorf("missing commitPrefix configuration as deployment chain trigger condition")
+		return fmt.Errorf("at least one of \"commitPrefix\", \"paths\" or \"labels\" must be set as deployment chain trigger condition")
+	}
+	if len(c.Paths) != 0 {
+		if _, err := filematcher.NewPatternMatcher(c.Paths); err != nil {
+			return fmt.Errorf("invalid paths in deployment chain trigger condition: %w", err)
+		}
 	}
 	return nil
 }
 
+// Match reports whether the upstream deployment satisfies all of the conditions.
+// The changedFiles are only used when the paths condition is specified.
+func (c *DeploymentChainTriggerCondition) Match(commitMessage string, labels map[string]string, changedFiles []string) (bool, error) {
+	if c.CommitPrefix != "" && !strings.HasPrefix(commitMessage, c.CommitPrefix) {
+		return false, nil
+	}
+	for k, v := range c.Labels {
+		if labels[k] != v {
+			return false, nil
+		}
+	}
+	if len(c.Paths) != 0 {
+		matcher, err := filematcher.NewPatternMatcher(c.Paths)
+		if err != nil {
+			return false, err
+		}
+		if !matcher.MatchesAny(changedFiles) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 type DriftDetection struct {
 	// IgnoreFields are a list of 'apiVersion:kind:namespace:name#fieldPath'
 	IgnoreFields []string `json:"ignoreFields"`
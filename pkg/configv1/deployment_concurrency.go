@@ -0,0 +1,121 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// DeploymentConcurrency represents the limits of the number of deployments
+// which are planned or run at the same time by a piped.
+type DeploymentConcurrency struct {
+	// The maximum number of deployments planned or run at the same time by the piped.
+	// Zero means no limit.
+	MaxRunningDeployments int `json:"maxRunningDeployments,omitempty"`
+	// List of the limits applied to the deployments of the applications having the specified labels.
+	LabelGroups []DeploymentConcurrencyLabelGroup `json:"labelGroups,omitempty"`
+}
+
+func (c *DeploymentConcurrency) Validate() error {
+	if c.MaxRunningDeployments < 0 {
+		return errors.New("maxRunningDeployments of deploymentConcurrency must not be negative")
+	}
+	names := make(map[string]struct{}, len(c.LabelGroups))
+	for i := range c.LabelGroups {
+		g := &c.LabelGroups[i]
+		if err := g.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[g.Name]; ok {
+			return fmt.Errorf("duplicated label group name %s in deploymentConcurrency", g.Name)
+		}
+		names[g.Name] = struct{}{}
+	}
+	return nil
+}
+
+// DeploymentConcurrencyLabelGroup represents the limit of the number of deployments
+// of the applications having all of the specified labels.
+type DeploymentConcurrencyLabelGroup struct {
+	// The name of the group. It will be shown in the status of the queued deployments.
+	Name string `json:"name"`
+	// The labels which the applications in this group must have.
+	Labels map[string]string `json:"labels"`
+	// The maximum number of deployments of this group planned or run at the same time.
+	MaxRunningDeployments int `json:"maxRunningDeployments"`
+}
+
+func (g *DeploymentConcurrencyLabelGroup) Validate() error {
+	if g.Name == "" {
+		return errors.New("name of deploymentConcurrency label group must be set")
+	}
+	if len(g.Labels) == 0 {
+		return fmt.Errorf("labels of deploymentConcurrency label group %s must be set", g.Name)
+	}
+	if g.MaxRunningDeployments <= 0 {
+		return fmt.Errorf("maxRunningDeployments of deploymentConcurrency label group %s must be greater than 0", g.Name)
+	}
+	return nil
+}
+
+// Match reports whether the given labels contain all labels of the group.
+func (g *DeploymentConcurrencyLabelGroup) Match(labels map[string]string) bool {
+	for k, v := range g.Labels {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// DeploymentConcurrencyPolicy represents how a new deployment is handled
+// when another deployment of the same application is running.
+type DeploymentConcurrencyPolicy string
+
+const (
+	// DeploymentConcurrencyPolicyQueue makes the new deployment wait until
+	// the deployments triggered before it are completed.
+	DeploymentConcurrencyPolicyQueue DeploymentConcurrencyPolicy = "QUEUE"
+	// DeploymentConcurrencyPolicyCancelRunning cancels the running deployment
+	// and the older pending deployments to start the new deployment.
+	DeploymentConcurrencyPolicyCancelRunning DeploymentConcurrencyPolicy = "CANCEL_RUNNING"
+	// DeploymentConcurrencyPolicySupersedePending cancels the older pending deployments
+	// while the running deployment continues.
+	DeploymentConcurrencyPolicySupersedePending DeploymentConcurrencyPolicy = "SUPERSEDE_PENDING"
+)
+
+func (p DeploymentConcurrencyPolicy) Validate() error {
+	switch p {
+	case "", DeploymentConcurrencyPolicyQueue, DeploymentConcurrencyPolicyCancelRunning, DeploymentConcurrencyPolicySupersedePending:
+		return nil
+	default:
+		return fmt.Errorf("invalid concurrencyPolicy %s, it must be one of QUEUE, CANCEL_RUNNING or SUPERSEDE_PENDING", p)
+	}
+}
+
+// ToModel converts the policy into its model representation. Empty means QUEUE.
+func (p DeploymentConcurrencyPolicy) ToModel() model.DeploymentConcurrencyPolicy {
+	switch p {
+	case DeploymentConcurrencyPolicyCancelRunning:
+		return model.DeploymentConcurrencyPolicy_CONCURRENCY_CANCEL_RUNNING
+	case DeploymentConcurrencyPolicySupersedePending:
+		return model.DeploymentConcurrencyPolicy_CONCURRENCY_SUPERSEDE_PENDING
+	default:
+		return model.DeploymentConcurrencyPolicy_CONCURRENCY_QUEUE
+	}
+}
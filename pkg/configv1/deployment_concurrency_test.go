@@ -0,0 +1,134 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDeploymentConcurrencyValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		concurrency DeploymentConcurrency
+		wantErr     bool
+	}{
+		{
+			name: "valid",
+			concurrency: DeploymentConcurrency{
+				MaxRunningDeployments: 10,
+				LabelGroups: []DeploymentConcurrencyLabelGroup{
+					{Name: "prod", Labels: map[string]string{"env": "prod"}, MaxRunningDeployments: 2},
+					{Name: "dev", Labels: map[string]string{"env": "dev"}, MaxRunningDeployments: 5},
+				},
+			},
+		},
+		{
+			name:        "no limit",
+			concurrency: DeploymentConcurrency{},
+		},
+		{
+			name:        "negative max",
+			concurrency: DeploymentConcurrency{MaxRunningDeployments: -1},
+			wantErr:     true,
+		},
+		{
+			name: "missing group name",
+			concurrency: DeploymentConcurrency{
+				LabelGroups: []DeploymentConcurrencyLabelGroup{
+					{Labels: map[string]string{"env": "prod"}, MaxRunningDeployments: 2},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing group labels",
+			concurrency: DeploymentConcurrency{
+				LabelGroups: []DeploymentConcurrencyLabelGroup{
+					{Name: "prod", MaxRunningDeployments: 2},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero group max",
+			concurrency: DeploymentConcurrency{
+				LabelGroups: []DeploymentConcurrencyLabelGroup{
+					{Name: "prod", Labels: map[string]string{"env": "prod"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated group name",
+			concurrency: DeploymentConcurrency{
+				LabelGroups: []DeploymentConcurrencyLabelGroup{
+					{Name: "prod", Labels: map[string]string{"env": "prod"}, MaxRunningDeployments: 2},
+					{Name: "prod", Labels: map[string]string{"tier": "prod"}, MaxRunningDeployments: 2},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.concurrency.Validate()
+			assert.Equal(t, tc.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestDeploymentConcurrencyLabelGroupMatch(t *testing.T) {
+	t.Parallel()
+
+	g := DeploymentConcurrencyLabelGroup{
+		Name:                  "prod",
+		Labels:                map[string]string{"env": "prod", "team": "payment"},
+		MaxRunningDeployments: 1,
+	}
+	assert.True(t, g.Match(map[string]string{"env": "prod", "team": "payment", "app": "api"}))
+	assert.False(t, g.Match(map[string]string{"env": "prod"}))
+	assert.False(t, g.Match(nil))
+}
+
+func TestDeploymentConcurrencyPolicy(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		policy  DeploymentConcurrencyPolicy
+		want    model.DeploymentConcurrencyPolicy
+		wantErr bool
+	}{
+		{policy: "", want: model.DeploymentConcurrencyPolicy_CONCURRENCY_QUEUE},
+		{policy: DeploymentConcurrencyPolicyQueue, want: model.DeploymentConcurrencyPolicy_CONCURRENCY_QUEUE},
+		{policy: DeploymentConcurrencyPolicyCancelRunning, want: model.DeploymentConcurrencyPolicy_CONCURRENCY_CANCEL_RUNNING},
+		{policy: DeploymentConcurrencyPolicySupersedePending, want: model.DeploymentConcurrencyPolicy_CONCURRENCY_SUPERSEDE_PENDING},
+		{policy: "CANCEL", want: model.DeploymentConcurrencyPolicy_CONCURRENCY_QUEUE, wantErr: true},
+	}
+	for _, tc := range testcases {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			t.Parallel()
+			err := tc.policy.Validate()
+			assert.Equal(t, tc.wantErr, err != nil, err)
+			assert.Equal(t, tc.want, tc.policy.ToModel())
+		})
+	}
+}
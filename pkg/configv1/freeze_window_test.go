@@ -0,0 +1,307 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDeploymentFreezeWindowValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		window  DeploymentFreezeWindow
+		wantErr bool
+	}{
+		{
+			name: "valid cron window",
+			window: DeploymentFreezeWindow{
+				Name:     "weekend",
+				Cron:     "0 0 * * 6",
+				Duration: Duration(48 * time.Hour),
+				TimeZone: "Asia/Tokyo",
+			},
+		},
+		{
+			name: "valid absolute window",
+			window: DeploymentFreezeWindow{
+				Name:  "new-year",
+				Start: "2025-12-28 00:00",
+				End:   "2026-01-04T00:00:00+09:00",
+			},
+		},
+		{
+			name: "missing name",
+			window: DeploymentFreezeWindow{
+				Cron:     "0 0 * * 6",
+				Duration: Duration(time.Hour),
+			},
+			wantErr: true,
+		},
+		{
+			name: "cron without duration",
+			window: DeploymentFreezeWindow{
+				Name: "weekend",
+				Cron: "0 0 * * 6",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid cron",
+			window: DeploymentFreezeWindow{
+				Name:     "weekend",
+				Cron:     "every saturday",
+				Duration: Duration(time.Hour),
+			},
+			wantErr: true,
+		},
+		{
+			name: "both cron and range",
+			window: DeploymentFreezeWindow{
+				Name:     "weekend",
+				Cron:     "0 0 * * 6",
+				Duration: Duration(time.Hour),
+				Start:    "2025-12-28 00:00",
+				End:      "2026-01-04 00:00",
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing end",
+			window: DeploymentFreezeWindow{
+				Name:  "new-year",
+				Start: "2025-12-28 00:00",
+			},
+			wantErr: true,
+		},
+		{
+			name: "start after end",
+			window: DeploymentFreezeWindow{
+				Name:  "new-year",
+				Start: "2026-01-04 00:00",
+				End:   "2025-12-28 00:00",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid time zone",
+			window: DeploymentFreezeWindow{
+				Name:     "new-year",
+				Start:    "2025-12-28 00:00",
+				End:      "2026-01-04 00:00",
+				TimeZone: "Unknown/Zone",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.window.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestDeploymentFreezeWindowIsActive(t *testing.T) {
+	t.Parallel()
+
+	weekend := DeploymentFreezeWindow{
+		Name:     "weekend",
+		Cron:     "0 0 * * 6",
+		Duration: Duration(48 * time.Hour),
+		TimeZone: "Asia/Tokyo",
+	}
+	newYear := DeploymentFreezeWindow{
+		Name:     "new-year",
+		Start:    "2025-12-28 00:00",
+		End:      "2026-01-04 00:00",
+		TimeZone: "Asia/Tokyo",
+	}
+
+	testcases := []struct {
+		name   string
+		window DeploymentFreezeWindow
+		now    string
+		want   bool
+	}{
+		{
+			name:   "cron: before the window",
+			window: weekend,
+			now:    "2025-10-03T23:59:00+09:00",
+			want:   false,
+		},
+		{
+			name:   "cron: at the start of the window",
+			window: weekend,
+			now:    "2025-10-04T00:00:00+09:00",
+			want:   true,
+		},
+		{
+			name:   "cron: inside the window in another time zone",
+			window: weekend,
+			now:    "2025-10-05T10:00:00Z",
+			want:   true,
+		},
+		{
+			name:   "cron: at the end of the window",
+			window: weekend,
+			now:    "2025-10-06T00:00:00+09:00",
+			want:   false,
+		},
+		{
+			name:   "range: before the window",
+			window: newYear,
+			now:    "2025-12-27T14:59:59Z",
+			want:   false,
+		},
+		{
+			name:   "range: inside the window",
+			window: newYear,
+			now:    "2025-12-27T15:00:00Z",
+			want:   true,
+		},
+		{
+			name:   "range: after the window",
+			window: newYear,
+			now:    "2026-01-04T00:00:00+09:00",
+			want:   false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			now, err := time.Parse(time.RFC3339, tc.now)
+			require.NoError(t, err)
+
+			got, err := tc.window.IsActive(now)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFindActiveFreezeWindow(t *testing.T) {
+	t.Parallel()
+
+	windows := []DeploymentFreezeWindow{
+		{
+			Name:  "maintenance",
+			Start: "2025-10-01 00:00",
+			End:   "2025-10-02 00:00",
+		},
+		{
+			Name:   "release",
+			Reason: "v1.0 release",
+			Start:  "2025-10-10 00:00",
+			End:    "2025-10-11 00:00",
+		},
+	}
+
+	w, ok := FindActiveFreezeWindow(windows, time.Date(2025, 10, 10, 12, 0, 0, 0, time.UTC))
+	require.True(t, ok)
+	assert.Equal(t, "release", w.Name)
+	assert.Equal(t, `Deployments are frozen by the freeze window "release": v1.0 release`, w.Description())
+
+	_, ok = FindActiveFreezeWindow(windows, time.Date(2025, 10, 5, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestNewProjectFreezeWindows(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		windows []*model.ProjectFreezeWindow
+		want    []DeploymentFreezeWindow
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			want: []DeploymentFreezeWindow{},
+		},
+		{
+			name: "valid windows",
+			windows: []*model.ProjectFreezeWindow{
+				{
+					Name:     "weekend",
+					Cron:     "0 0 * * 6",
+					Duration: "48h",
+					TimeZone: "Asia/Tokyo",
+				},
+				{
+					Name:   "new-year",
+					Reason: "new year holidays",
+					Start:  "2025-12-28T00:00:00",
+					End:    "2026-01-04 00:00",
+				},
+			},
+			want: []DeploymentFreezeWindow{
+				{
+					Name:     "weekend",
+					Cron:     "0 0 * * 6",
+					Duration: Duration(48 * time.Hour),
+					TimeZone: "Asia/Tokyo",
+				},
+				{
+					Name:   "new-year",
+					Reason: "new year holidays",
+					Start:  "2025-12-28T00:00:00",
+					End:    "2026-01-04 00:00",
+				},
+			},
+		},
+		{
+			name: "invalid duration",
+			windows: []*model.ProjectFreezeWindow{
+				{
+					Name:     "weekend",
+					Cron:     "0 0 * * 6",
+					Duration: "2 days",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid window",
+			windows: []*model.ProjectFreezeWindow{
+				{
+					Name: "weekend",
+					Cron: "0 0 * * 6",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewProjectFreezeWindows(tc.windows)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
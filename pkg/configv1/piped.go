@@ -68,6 +68,8 @@ type PipedSpec struct {
 	PlanPreview PipedPlanPreview `json:"planPreview"`
 	// List of labels to filter all applications this piped will handle.
 	AppSelector map[string]string `json:"appSelector,omitempty"`
+	// The limits of the number of deployments planned or run at the same time by this piped.
+	DeploymentConcurrency *DeploymentConcurrency `json:"deploymentConcurrency,omitempty"`
 }
 
 func (s *PipedSpec) UnmarshalJSON(data []byte) error {
@@ -124,6 +126,16 @@ func (s *PipedSpec) Validate() error {
 				return err
 			}
 		}
+		if n.Teams != nil {
+			if err := n.Teams.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	if s.DeploymentConcurrency != nil {
+		if err := s.DeploymentConcurrency.Validate(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -316,6 +328,7 @@ type NotificationReceiver struct {
 	Name    string                       `json:"name"`
 	Slack   *NotificationReceiverSlack   `json:"slack,omitempty"`
 	Webhook *NotificationReceiverWebhook `json:"webhook,omitempty"`
+	Teams   *NotificationReceiverTeams   `json:"teams,omitempty"`
 }
 
 func (n *NotificationReceiver) Mask() {
@@ -325,6 +338,9 @@ func (n *NotificationReceiver) Mask() {
 	if n.Webhook != nil {
 		n.Webhook.Mask()
 	}
+	if n.Teams != nil {
+		n.Teams.Mask()
+	}
 }
 
 type NotificationReceiverSlack struct {
@@ -385,6 +401,29 @@ func (n *NotificationReceiverSlack) Validate() error {
 	return nil
 }
 
+// NotificationReceiverTeams represents a Microsoft Teams channel
+// that receives notifications via an incoming webhook or a Workflows URL.
+type NotificationReceiverTeams struct {
+	// The URL of the incoming webhook or the Workflows webhook trigger.
+	HookURL string `json:"hookURL"`
+	// List of user IDs to be mentioned in every notification.
+	// Both the user principal name (e.g. user@example.com) and the Microsoft Entra object ID are accepted.
+	MentionedAccounts []string `json:"mentionedAccounts,omitempty"`
+}
+
+func (n *NotificationReceiverTeams) Mask() {
+	if len(n.HookURL) != 0 {
+		n.HookURL = maskString
+	}
+}
+
+func (n *NotificationReceiverTeams) Validate() error {
+	if n.HookURL == "" {
+		return errors.New("missing hookURL for Microsoft Teams receiver")
+	}
+	return nil
+}
+
 type NotificationReceiverWebhook struct {
 	URL                string `json:"url"`
 	SignatureKey       string `json:"signatureKey,omitempty" default:"PipeCD-Signature"`
@@ -431,6 +470,7 @@ type SecretManagement struct {
 
 	KeyPair *SecretManagementKeyPair
 	GCPKMS  *SecretManagementGCPKMS
+	AWSKMS  *SecretManagementAWSKMS
 }
 
 type genericSecretManagement struct {
@@ -449,6 +489,8 @@ func (s *SecretManagement) MarshalJSON() ([]byte, error) {
 		config, err = json.Marshal(s.KeyPair)
 	case model.SecretManagementTypeGCPKMS:
 		config, err = json.Marshal(s.GCPKMS)
+	case model.SecretManagementTypeAWSKMS:
+		config, err = json.Marshal(s.AWSKMS)
 	default:
 		err = fmt.Errorf("unsupported secret management type: %s", s.Type)
 	}
@@ -483,6 +525,12 @@ func (s *SecretManagement) UnmarshalJSON(data []byte) error {
 		if len(g.Config) > 0 {
 			err = json.Unmarshal(g.Config, s.GCPKMS)
 		}
+	case model.SecretManagementTypeAWSKMS:
+		s.Type = model.SecretManagementTypeAWSKMS
+		s.AWSKMS = &SecretManagementAWSKMS{}
+		if len(g.Config) > 0 {
+			err = json.Unmarshal(g.Config, s.AWSKMS)
+		}
 	default:
 		err = fmt.Errorf("unsupported secret management type: %s", s.Type)
 	}
@@ -496,6 +544,9 @@ func (s *SecretManagement) Mask() {
 	if s.GCPKMS != nil {
 		s.GCPKMS.Mask()
 	}
+	if s.AWSKMS != nil {
+		s.AWSKMS.Mask()
+	}
 }
 
 func (s *SecretManagement) Validate() error {
@@ -504,6 +555,8 @@ func (s *SecretManagement) Validate() error {
 		return s.KeyPair.Validate()
 	case model.SecretManagementTypeGCPKMS:
 		return s.GCPKMS.Validate()
+	case model.SecretManagementTypeAWSKMS:
+		return s.AWSKMS.Validate()
 	default:
 		return fmt.Errorf("unsupported sealed secret management type: %s", s.Type)
 	}
@@ -567,11 +620,13 @@ func (s *SecretManagementKeyPair) LoadPublicKey() ([]byte, error) {
 
 type SecretManagementGCPKMS struct {
 	// Configurable fields when using Google Cloud KMS.
-	// The key name used for decrypting the sealed secret.
+	// The name of the asymmetric key version used for decrypting the sealed secret.
+	// The key must have ASYMMETRIC_DECRYPT purpose with an RSA_DECRYPT_OAEP_*_SHA256 algorithm.
+	// e.g. projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{key}/cryptoKeyVersions/{version}
 	KeyName string `json:"keyName"`
 	// The path to the service account used to decrypt secret.
 	DecryptServiceAccountFile string `json:"decryptServiceAccountFile"`
-	// The path to the service account used to encrypt secret.
+	// The path to the service account used to fetch the public key for encrypting secret.
 	EncryptServiceAccountFile string `json:"encryptServiceAccountFile"`
 }
 
@@ -597,6 +652,47 @@ func (s *SecretManagementGCPKMS) Mask() {
 	}
 }
 
+type SecretManagementAWSKMS struct {
+	// Configurable fields when using AWS KMS.
+	// The ID, ARN or alias of the asymmetric key used for decrypting the sealed secret.
+	// The key must have ENCRYPT_DECRYPT usage with an RSA key spec.
+	KeyID string `json:"keyId"`
+	// The region to send requests to.
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (s *SecretManagementAWSKMS) Validate() error {
+	if s.KeyID == "" {
+		return fmt.Errorf("keyId must be set")
+	}
+	if s.Region == "" {
+		return fmt.Errorf("region must be set")
+	}
+	return nil
+}
+
+func (s *SecretManagementAWSKMS) Mask() {
+	if len(s.CredentialsFile) != 0 {
+		s.CredentialsFile = maskString
+	}
+	if len(s.RoleARN) != 0 {
+		s.RoleARN = maskString
+	}
+	if len(s.TokenFile) != 0 {
+		s.TokenFile = maskString
+	}
+}
+
 type PipedEventWatcher struct {
 	// Interval to fetch the latest event and compare it with one defined in EventWatcher config files
 	CheckInterval Duration `json:"checkInterval,omitempty"`
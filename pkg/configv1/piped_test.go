@@ -228,6 +228,13 @@ func TestPipedConfig(t *testing.T) {
 								SignatureValue: "random-signature-string",
 							},
 						},
+						{
+							Name: "dev-teams-channel",
+							Teams: &NotificationReceiverTeams{
+								HookURL:           "https://example.webhook.office.com/dev",
+								MentionedAccounts: []string{"user1@example.com"},
+							},
+						},
 					},
 				},
 				SecretManagement: &SecretManagement{
@@ -629,6 +636,9 @@ func TestPipedConfigMask(t *testing.T) {
 								SignatureValue:     "foo",
 								SignatureValueFile: "foo",
 							},
+							Teams: &NotificationReceiverTeams{
+								HookURL: "foo",
+							},
 						},
 					},
 				},
@@ -718,6 +728,9 @@ func TestPipedConfigMask(t *testing.T) {
 								SignatureValue:     maskString,
 								SignatureValueFile: maskString,
 							},
+							Teams: &NotificationReceiverTeams{
+								HookURL: maskString,
+							},
 						},
 					},
 				},
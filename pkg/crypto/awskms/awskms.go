@@ -0,0 +1,110 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package awskms provides an envelope encryption backed by an asymmetric key of AWS KMS.
+// The key must have ENCRYPT_DECRYPT usage with one of RSA key specs,
+// so that the ciphertext produced by crypto.HybridEncrypter with its public key
+// can be decrypted using RSAES_OAEP_SHA_256 algorithm.
+package awskms
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+const defaultRequestTimeout = 30 * time.Second
+
+// Client calls AWS KMS to fetch the public key of
+// and to decrypt data with the configured key.
+type Client struct {
+	keyID  string
+	client *kms.Client
+}
+
+// NewClient creates a new Client for the given key.
+// The keyID can be a key ID, key ARN, alias name or alias ARN.
+func NewClient(ctx context.Context, keyID, region, profile, credentialsFile, roleARN, tokenPath string, optFns ...func(*kms.Options)) (*Client, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("keyID is required")
+	}
+	if region == "" {
+		return nil, fmt.Errorf("region is required")
+	}
+
+	loadOptFns := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if credentialsFile != "" {
+		loadOptFns = append(loadOptFns, config.WithSharedCredentialsFiles([]string{credentialsFile}))
+	}
+	if profile != "" {
+		loadOptFns = append(loadOptFns, config.WithSharedConfigProfile(profile))
+	}
+	if tokenPath != "" && roleARN != "" {
+		loadOptFns = append(loadOptFns, config.WithWebIdentityRoleCredentialOptions(func(v *stscreds.WebIdentityRoleOptions) {
+			v.RoleARN = roleARN
+			v.TokenRetriever = stscreds.IdentityTokenFile(tokenPath)
+		}))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, loadOptFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config to create kms client: %w", err)
+	}
+
+	return &Client{
+		keyID:  keyID,
+		client: kms.NewFromConfig(cfg, optFns...),
+	}, nil
+}
+
+// PublicKey returns the PEM encoded public key of the key.
+func (c *Client) PublicKey(ctx context.Context) ([]byte, error) {
+	out, err := c.client.GetPublicKey(ctx, &kms.GetPublicKeyInput{
+		KeyId: aws.String(c.keyID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key of %s: %w", c.keyID, err)
+	}
+	if out.KeyUsage != types.KeyUsageTypeEncryptDecrypt {
+		return nil, fmt.Errorf("key %s must have %s usage but got %s", c.keyID, types.KeyUsageTypeEncryptDecrypt, out.KeyUsage)
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: out.PublicKey,
+	}), nil
+}
+
+// DecryptKey implements crypto.KeyDecrypter interface
+// by calling Decrypt API of AWS KMS.
+func (c *Client) DecryptKey(ciphertext []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	out, err := c.client.Decrypt(ctx, &kms.DecryptInput{
+		CiphertextBlob:      ciphertext,
+		KeyId:               aws.String(c.keyID),
+		EncryptionAlgorithm: types.EncryptionAlgorithmSpecRsaesOaepSha256,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt with %s: %w", c.keyID, err)
+	}
+	return out.Plaintext, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awskms
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/crypto"
+)
+
+const testKeyID = "alias/pipecd"
+
+// fakeKMS serves the subset of AWS KMS JSON API used by Client.
+func fakeKMS(t *testing.T) *httptest.Server {
+	privatePem, _, err := crypto.GenerateRSAPems(crypto.DefauleRSAKeySize)
+	require.NoError(t, err)
+	key, err := crypto.ParseRSAPrivateKeyFromPem(privatePem)
+	require.NoError(t, err)
+	publicDer, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	writeError := func(w http.ResponseWriter, code, msg string) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"__type": code, "message": msg})
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			KeyId               string
+			CiphertextBlob      []byte
+			EncryptionAlgorithm string
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.KeyId != testKeyID {
+			writeError(w, "NotFoundException", "key not found")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.GetPublicKey":
+			json.NewEncoder(w).Encode(map[string]any{
+				"KeyId":     req.KeyId,
+				"KeyUsage":  "ENCRYPT_DECRYPT",
+				"KeySpec":   "RSA_2048",
+				"PublicKey": publicDer,
+			})
+		case "TrentService.Decrypt":
+			if req.EncryptionAlgorithm != "RSAES_OAEP_SHA_256" {
+				writeError(w, "IncorrectKeyException", "unexpected algorithm")
+				return
+			}
+			plaintext, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, key, req.CiphertextBlob, nil)
+			if err != nil {
+				writeError(w, "InvalidCiphertextException", err.Error())
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{
+				"KeyId":     req.KeyId,
+				"Plaintext": plaintext,
+			})
+		default:
+			writeError(w, "UnknownOperationException", r.Header.Get("X-Amz-Target"))
+		}
+	}))
+}
+
+func newTestClient(t *testing.T, url, keyID string) *Client {
+	c, err := NewClient(context.Background(), keyID, "us-west-2", "", "", "", "", func(o *kms.Options) {
+		o.BaseEndpoint = aws.String(url)
+		o.Credentials = credentials.NewStaticCredentialsProvider("key", "secret", "")
+	})
+	require.NoError(t, err)
+	return c
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+
+	srv := fakeKMS(t)
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL, testKeyID)
+	publicKey, err := c.PublicKey(context.Background())
+	require.NoError(t, err)
+
+	encrypter, err := crypto.NewHybridEncrypter(publicKey)
+	require.NoError(t, err)
+	decrypter := crypto.NewHybridDecrypterWithKeyDecrypter(c)
+
+	text := "my-secret-value"
+	encryptedText, err := encrypter.Encrypt(text)
+	require.NoError(t, err)
+
+	decryptedText, err := decrypter.Decrypt(encryptedText)
+	require.NoError(t, err)
+	assert.Equal(t, text, decryptedText)
+}
+
+func TestDecryptKeyError(t *testing.T) {
+	t.Parallel()
+
+	srv := fakeKMS(t)
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL, testKeyID)
+	_, err := c.DecryptKey([]byte("invalid"))
+	assert.Error(t, err)
+
+	unknown := newTestClient(t, srv.URL, "alias/unknown")
+	_, err = unknown.PublicKey(context.Background())
+	assert.Error(t, err)
+}
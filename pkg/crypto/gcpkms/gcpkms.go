@@ -0,0 +1,118 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gcpkms provides an envelope encryption backed by an asymmetric key of Google Cloud KMS.
+// The key version must have ASYMMETRIC_DECRYPT purpose with one of RSA_DECRYPT_OAEP_*_SHA256 algorithms,
+// so that the ciphertext produced by crypto.HybridEncrypter with its public key can be decrypted.
+package gcpkms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"time"
+
+	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+const defaultRequestTimeout = 30 * time.Second
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Client calls Google Cloud KMS to fetch the public key of
+// and to decrypt data with the configured key version.
+type Client struct {
+	keyName string
+	service *cloudkms.ProjectsLocationsKeyRingsCryptoKeysCryptoKeyVersionsService
+}
+
+// NewClient creates a new Client for the given key version.
+// The keyName must be in the format of
+// projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{cryptoKey}/cryptoKeyVersions/{version}.
+func NewClient(ctx context.Context, keyName, credentialsFile string, opts ...option.ClientOption) (*Client, error) {
+	if keyName == "" {
+		return nil, fmt.Errorf("keyName is required")
+	}
+
+	var options []option.ClientOption
+	if len(credentialsFile) > 0 {
+		data, err := os.ReadFile(credentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read credentials file (%w)", err)
+		}
+		options = append(options, option.WithCredentialsJSON(data))
+	}
+	options = append(options, opts...)
+
+	svc, err := cloudkms.NewService(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cloudkms service (%w)", err)
+	}
+
+	return &Client{
+		keyName: keyName,
+		service: cloudkms.NewProjectsLocationsKeyRingsCryptoKeysCryptoKeyVersionsService(svc),
+	}, nil
+}
+
+// PublicKey returns the PEM encoded public key of the key version.
+func (c *Client) PublicKey(ctx context.Context) ([]byte, error) {
+	pk, err := c.service.GetPublicKey(c.keyName).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key of %s: %w", c.keyName, toError(err))
+	}
+	if pk.PemCrc32c != 0 && int64(crc32.Checksum([]byte(pk.Pem), crc32cTable)) != pk.PemCrc32c {
+		return nil, fmt.Errorf("public key of %s was corrupted in-transit", c.keyName)
+	}
+	return []byte(pk.Pem), nil
+}
+
+// DecryptKey implements crypto.KeyDecrypter interface
+// by calling AsymmetricDecrypt API of Cloud KMS.
+func (c *Client) DecryptKey(ciphertext []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRequestTimeout)
+	defer cancel()
+
+	req := &cloudkms.AsymmetricDecryptRequest{
+		Ciphertext:       base64.StdEncoding.EncodeToString(ciphertext),
+		CiphertextCrc32c: int64(crc32.Checksum(ciphertext, crc32cTable)),
+	}
+	resp, err := c.service.AsymmetricDecrypt(c.keyName, req).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt with %s: %w", c.keyName, toError(err))
+	}
+	if !resp.VerifiedCiphertextCrc32c {
+		return nil, fmt.Errorf("request to decrypt with %s was corrupted in-transit", c.keyName)
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(resp.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the decrypted data (%w)", err)
+	}
+	if int64(crc32.Checksum(plaintext, crc32cTable)) != resp.PlaintextCrc32c {
+		return nil, fmt.Errorf("response from decrypting with %s was corrupted in-transit", c.keyName)
+	}
+	return plaintext, nil
+}
+
+func toError(err error) error {
+	if e, ok := err.(*googleapi.Error); ok {
+		return fmt.Errorf("code=%d, message=%s", e.Code, e.Message)
+	}
+	return err
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpkms
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"hash/crc32"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+
+	"github.com/pipe-cd/pipecd/pkg/crypto"
+)
+
+const testKeyName = "projects/p/locations/global/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1"
+
+// fakeKMS serves the subset of Cloud KMS REST API used by Client.
+func fakeKMS(t *testing.T) *httptest.Server {
+	privatePem, publicPem, err := crypto.GenerateRSAPems(crypto.DefauleRSAKeySize)
+	require.NoError(t, err)
+	key, err := crypto.ParseRSAPrivateKeyFromPem(privatePem)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/"+testKeyName+"/publicKey", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"pem":       string(publicPem),
+			"pemCrc32c": strconv.FormatUint(uint64(crc32.Checksum(publicPem, crc32cTable)), 10),
+			"algorithm": "RSA_DECRYPT_OAEP_2048_SHA256",
+		})
+	})
+	mux.HandleFunc("POST /v1/"+testKeyName+":asymmetricDecrypt", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Ciphertext       string `json:"ciphertext"`
+			CiphertextCrc32c string `json:"ciphertextCrc32c"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		ciphertext, err := base64.StdEncoding.DecodeString(req.Ciphertext)
+		require.NoError(t, err)
+		plaintext, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, key, ciphertext, nil)
+		if err != nil {
+			http.Error(w, `{"error":{"code":400,"message":"Decryption failed"}}`, http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"plaintext":                base64.StdEncoding.EncodeToString(plaintext),
+			"plaintextCrc32c":          strconv.FormatUint(uint64(crc32.Checksum(plaintext, crc32cTable)), 10),
+			"verifiedCiphertextCrc32c": req.CiphertextCrc32c == strconv.FormatUint(uint64(crc32.Checksum(ciphertext, crc32cTable)), 10),
+		})
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+
+	srv := fakeKMS(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, testKeyName, "", option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+
+	publicKey, err := c.PublicKey(ctx)
+	require.NoError(t, err)
+
+	encrypter, err := crypto.NewHybridEncrypter(publicKey)
+	require.NoError(t, err)
+	decrypter := crypto.NewHybridDecrypterWithKeyDecrypter(c)
+
+	text := "my-secret-value"
+	encryptedText, err := encrypter.Encrypt(text)
+	require.NoError(t, err)
+
+	decryptedText, err := decrypter.Decrypt(encryptedText)
+	require.NoError(t, err)
+	assert.Equal(t, text, decryptedText)
+}
+
+func TestDecryptKeyError(t *testing.T) {
+	t.Parallel()
+
+	srv := fakeKMS(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+	c, err := NewClient(ctx, testKeyName, "", option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+
+	_, err = c.DecryptKey([]byte("invalid"))
+	assert.Error(t, err)
+
+	unknown, err := NewClient(ctx, "projects/p/locations/global/keyRings/r/cryptoKeys/unknown/cryptoKeyVersions/1", "", option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+	_, err = unknown.PublicKey(ctx)
+	assert.Error(t, err)
+}
@@ -88,20 +88,44 @@ func (e *HybridEncrypter) Encrypt(text string) (string, error) {
 	return base64.StdEncoding.EncodeToString(ciphertext), nil
 }
 
-type HybridDecrypter struct {
+// KeyDecrypter decrypts the RSA-OAEP (SHA-256) encrypted symmetric key
+// which is embedded into the ciphertext produced by HybridEncrypter.
+// This allows the private key to be held by an external key management service
+// while keeping the same ciphertext format (envelope encryption).
+type KeyDecrypter interface {
+	DecryptKey(ciphertext []byte) ([]byte, error)
+}
+
+type rsaKeyDecrypter struct {
 	key *rsa.PrivateKey
 }
 
+func (d *rsaKeyDecrypter) DecryptKey(ciphertext []byte) ([]byte, error) {
+	return rsa.DecryptOAEP(sha256.New(), rand.Reader, d.key, ciphertext, nil)
+}
+
+type HybridDecrypter struct {
+	keyDecrypter KeyDecrypter
+}
+
 func NewHybridDecrypter(key []byte) (*HybridDecrypter, error) {
 	k, err := ParseRSAPrivateKeyFromPem(key)
 	if err != nil {
 		return nil, err
 	}
 	return &HybridDecrypter{
-		key: k,
+		keyDecrypter: &rsaKeyDecrypter{key: k},
 	}, nil
 }
 
+// NewHybridDecrypterWithKeyDecrypter returns a HybridDecrypter
+// that delegates the decryption of the symmetric key to the given KeyDecrypter.
+func NewHybridDecrypterWithKeyDecrypter(kd KeyDecrypter) *HybridDecrypter {
+	return &HybridDecrypter{
+		keyDecrypter: kd,
+	}
+}
+
 // Decrypt performs a regular AES-GCM + RSA-OAEP decryption.
 //
 // The implementation of this function was brought from well known Bitnami's SealedSecret library.
@@ -123,7 +147,7 @@ func (d *HybridDecrypter) Decrypt(encryptedText string) (string, error) {
 	rsaCiphertext := ciphertext[2 : rsaLen+2]
 	aesCiphertext := ciphertext[rsaLen+2:]
 
-	symKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, d.key, rsaCiphertext, nil)
+	symKey, err := d.keyDecrypter.DecryptKey(rsaCiphertext)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,104 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type auditLogCollection struct {
+}
+
+func (a *auditLogCollection) Kind() string {
+	return "AuditLog"
+}
+
+func (a *auditLogCollection) Factory() Factory {
+	return func() interface{} {
+		return &model.AuditLog{}
+	}
+}
+
+type AuditLogStore interface {
+	Add(ctx context.Context, l model.AuditLog) error
+	List(ctx context.Context, opts ListOptions) ([]*model.AuditLog, string, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type auditLogStore struct {
+	backend
+	nowFunc func() time.Time
+}
+
+func NewAuditLogStore(ds DataStore) AuditLogStore {
+	return &auditLogStore{
+		backend: backend{
+			ds:  ds,
+			col: &auditLogCollection{},
+		},
+		nowFunc: time.Now,
+	}
+}
+
+func (s *auditLogStore) Add(ctx context.Context, l model.AuditLog) error {
+	now := s.nowFunc().Unix()
+	if l.CreatedAt == 0 {
+		l.CreatedAt = now
+	}
+	if l.UpdatedAt == 0 {
+		l.UpdatedAt = now
+	}
+	if err := l.Validate(); err != nil {
+		return fmt.Errorf("failed to validate audit log: %w: %w", ErrInvalidArgument, err)
+	}
+	return s.ds.Create(ctx, s.col, l.Id, &l)
+}
+
+func (s *auditLogStore) List(ctx context.Context, opts ListOptions) ([]*model.AuditLog, string, error) {
+	it, err := s.ds.Find(ctx, s.col, opts)
+	if err != nil {
+		return nil, "", err
+	}
+	logs := make([]*model.AuditLog, 0)
+	for {
+		var l model.AuditLog
+		err := it.Next(&l)
+		if err == ErrIteratorDone {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		logs = append(logs, &l)
+	}
+
+	// In case there is no more elements found, cursor should be set to empty too.
+	if len(logs) == 0 {
+		return logs, "", nil
+	}
+	cursor, err := it.Cursor()
+	if err != nil {
+		return nil, "", err
+	}
+	return logs, cursor, nil
+}
+
+func (s *auditLogStore) Delete(ctx context.Context, id string) error {
+	return s.ds.Delete(ctx, s.col, id)
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestAddAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := model.AuditLog{
+		Id:           "id",
+		ProjectId:    "project",
+		ActorType:    model.AuditLogActorType_AUDIT_LOG_ACTOR_USER,
+		Actor:        "user",
+		Method:       "/grpc.service.webservice.WebService/DisablePiped",
+		ResourceType: model.ProjectRBACResource_PIPED,
+		Action:       model.ProjectRBACPolicy_UPDATE,
+		ResourceId:   "piped-id",
+		StatusCode:   "OK",
+		CreatedAt:    12345,
+		UpdatedAt:    12345,
+	}
+
+	testcases := []struct {
+		name    string
+		log     model.AuditLog
+		ds      DataStore
+		wantErr bool
+	}{
+		{
+			name: "Invalid audit log",
+			log:  model.AuditLog{},
+			ds: func() DataStore {
+				return NewMockDataStore(ctrl)
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "OK",
+			log:  log,
+			ds: func() DataStore {
+				ds := NewMockDataStore(ctrl)
+				ds.EXPECT().
+					Create(gomock.Any(), gomock.Any(), log.Id, &log).
+					Return(nil)
+				return ds
+			}(),
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewAuditLogStore(tc.ds)
+			err := s.Add(context.Background(), tc.log)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestDeleteAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ds := NewMockDataStore(ctrl)
+	ds.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), "id").
+		Return(ErrNotFound)
+
+	s := NewAuditLogStore(ds)
+	err := s.Delete(context.Background(), "id")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
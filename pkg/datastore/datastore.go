@@ -71,6 +71,22 @@ type Collection interface {
 	Factory() Factory
 }
 
+// Collections returns all collections managed by the datastore.
+func Collections() []Collection {
+	return []Collection{
+		&projectCollection{},
+		&apiKeyCollection{},
+		&pipedCollection{},
+		&applicationCollection{},
+		&deploymentCollection{},
+		&deploymentChainCollection{},
+		&deploymentTraceCollection{},
+		&commandCollection{},
+		&eventCollection{},
+		&auditLogCollection{},
+	}
+}
+
 type DataStore interface {
 	// Find finds the documents matched given criteria.
 	Find(ctx context.Context, col Collection, opts ListOptions) (Iterator, error)
@@ -83,6 +99,9 @@ type DataStore interface {
 	// Update updates an existing entity in the datastore.
 	// If updating entity was not found in the datastore, ErrNotFound will be returned.
 	Update(ctx context.Context, col Collection, id string, updater Updater) error
+	// Delete deletes an existing entity from the datastore.
+	// If deleting entity was not found in the datastore, ErrNotFound will be returned.
+	Delete(ctx context.Context, col Collection, id string) error
 	// Close closes datastore resources held by the client.
 	Close() error
 }
@@ -171,6 +171,20 @@ func (mr *MockProjectStoreMockRecorder) List(ctx, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProjectStore)(nil).List), ctx, opts)
 }
 
+// UpdateProjectFreezeWindows mocks base method.
+func (m *MockProjectStore) UpdateProjectFreezeWindows(ctx context.Context, id string, windows []*model.ProjectFreezeWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectFreezeWindows", ctx, id, windows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectFreezeWindows indicates an expected call of UpdateProjectFreezeWindows.
+func (mr *MockProjectStoreMockRecorder) UpdateProjectFreezeWindows(ctx, id, windows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectFreezeWindows", reflect.TypeOf((*MockProjectStore)(nil).UpdateProjectFreezeWindows), ctx, id, windows)
+}
+
 // UpdateProjectRBACConfig mocks base method.
 func (m *MockProjectStore) UpdateProjectRBACConfig(ctx context.Context, id string, rbac *model.ProjectRBACConfig) error {
 	m.ctrl.T.Helper()
@@ -199,6 +213,20 @@ func (mr *MockProjectStoreMockRecorder) UpdateProjectRBACRole(ctx, id, name, pol
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectRBACRole", reflect.TypeOf((*MockProjectStore)(nil).UpdateProjectRBACRole), ctx, id, name, policies)
 }
 
+// UpdateProjectRegistryWebhookConfig mocks base method.
+func (m *MockProjectStore) UpdateProjectRegistryWebhookConfig(ctx context.Context, id string, webhook *model.ProjectRegistryWebhookConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectRegistryWebhookConfig", ctx, id, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProjectRegistryWebhookConfig indicates an expected call of UpdateProjectRegistryWebhookConfig.
+func (mr *MockProjectStoreMockRecorder) UpdateProjectRegistryWebhookConfig(ctx, id, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectRegistryWebhookConfig", reflect.TypeOf((*MockProjectStore)(nil).UpdateProjectRegistryWebhookConfig), ctx, id, webhook)
+}
+
 // UpdateProjectSSOConfig mocks base method.
 func (m *MockProjectStore) UpdateProjectSSOConfig(ctx context.Context, id string, sso *model.ProjectSSOConfig) error {
 	m.ctrl.T.Helper()
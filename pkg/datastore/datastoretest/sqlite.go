@@ -0,0 +1,38 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastoretest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/datastore/sqlite"
+)
+
+// NewInMemoryDataStore returns a datastore backed by an in-memory SQLite database
+// to run the real store logic in tests. It will be closed when the test finishes.
+func NewInMemoryDataStore(t testing.TB) datastore.DataStore {
+	t.Helper()
+
+	ds, err := sqlite.NewSQLite(context.Background(), sqlite.InMemory)
+	if err != nil {
+		t.Fatalf("failed to create in-memory datastore: %v", err)
+	}
+	t.Cleanup(func() {
+		ds.Close()
+	})
+	return ds
+}
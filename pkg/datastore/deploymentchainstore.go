@@ -97,14 +97,99 @@ var (
 			return nil
 		}
 	}
+
+	blockGateStatusUpdateFunc = func(blockIndex uint32, status model.ChainGateStatus, reason string) func(*model.DeploymentChain) error {
+		return func(dc *model.DeploymentChain) error {
+			gate, err := getBlockGate(dc, blockIndex)
+			if err != nil {
+				return err
+			}
+
+			// If the gate is already finished, keep its finished status.
+			if gate.IsCompleted() {
+				return nil
+			}
+
+			now := time.Now().Unix()
+			if gate.StartedAt == 0 {
+				gate.StartedAt = now
+			}
+			gate.Status = status
+			gate.StatusReason = reason
+			if gate.IsCompleted() {
+				gate.CompletedAt = now
+			}
+			return nil
+		}
+	}
+
+	blockGateAnalysisResultFunc = func(blockIndex uint32, passed bool, reason string) func(*model.DeploymentChain) error {
+		return func(dc *model.DeploymentChain) error {
+			gate, err := getBlockGate(dc, blockIndex)
+			if err != nil {
+				return err
+			}
+			if len(gate.AnalysisConfig) == 0 {
+				return fmt.Errorf("gate of block %d does not require analysis: %w", blockIndex, ErrInvalidArgument)
+			}
+
+			// Multiple pipeds can run the same analysis so only the first result is used.
+			if gate.IsCompleted() || gate.AnalysisPassed {
+				return nil
+			}
+
+			if passed {
+				gate.AnalysisPassed = true
+				return nil
+			}
+			gate.Status = model.ChainGateStatus_CHAIN_GATE_FAILED
+			gate.StatusReason = fmt.Sprintf("Analysis %s failed: %s", gate.AnalysisTemplate, reason)
+			gate.CompletedAt = time.Now().Unix()
+			return nil
+		}
+	}
+
+	blockGateApproveFunc = func(blockIndex uint32, approver string) func(*model.DeploymentChain) error {
+		return func(dc *model.DeploymentChain) error {
+			gate, err := getBlockGate(dc, blockIndex)
+			if err != nil {
+				return err
+			}
+			if !gate.RequireApproval {
+				return fmt.Errorf("gate of block %d does not require approval: %w", blockIndex, ErrInvalidArgument)
+			}
+			if gate.IsCompleted() {
+				return fmt.Errorf("gate of block %d is already finished: %w", blockIndex, ErrInvalidArgument)
+			}
+			if gate.Approver != "" {
+				return fmt.Errorf("gate of block %d is already approved: %w", blockIndex, ErrInvalidArgument)
+			}
+			gate.Approver = approver
+			return nil
+		}
+	}
 )
 
+func getBlockGate(dc *model.DeploymentChain, blockIndex uint32) (*model.ChainBlockGate, error) {
+	if blockIndex >= uint32(len(dc.Blocks)) {
+		return nil, fmt.Errorf("invalid block index %d provided: %w", blockIndex, ErrInvalidArgument)
+	}
+	gate := dc.Blocks[blockIndex].Gate
+	if gate == nil {
+		return nil, fmt.Errorf("block %d does not have any gate: %w", blockIndex, ErrInvalidArgument)
+	}
+	return gate, nil
+}
+
 type DeploymentChainStore interface {
 	Add(ctx context.Context, d *model.DeploymentChain) error
 	Get(ctx context.Context, id string) (*model.DeploymentChain, error)
 	List(ctx context.Context, opts ListOptions) ([]*model.DeploymentChain, string, error)
 	AddNodeDeployment(ctx context.Context, chainID string, deployment *model.Deployment) error
 	UpdateNodeDeploymentStatus(ctx context.Context, chainID string, blockIndex uint32, deploymentID string, status model.DeploymentStatus, reason string) error
+	UpdateBlockGateStatus(ctx context.Context, chainID string, blockIndex uint32, status model.ChainGateStatus, reason string) error
+	UpdateBlockGateAnalysisResult(ctx context.Context, chainID string, blockIndex uint32, passed bool, reason string) error
+	ApproveBlockGate(ctx context.Context, chainID string, blockIndex uint32, approver string) error
 }
 
 type deploymentChainStore struct {
@@ -194,3 +279,18 @@ func (s *deploymentChainStore) UpdateNodeDeploymentStatus(ctx context.Context, c
 	updater := nodeDeploymentStatusUpdateFunc(blockIndex, deploymentID, status, reason)
 	return s.update(ctx, chainID, updater)
 }
+
+func (s *deploymentChainStore) UpdateBlockGateStatus(ctx context.Context, chainID string, blockIndex uint32, status model.ChainGateStatus, reason string) error {
+	updater := blockGateStatusUpdateFunc(blockIndex, status, reason)
+	return s.update(ctx, chainID, updater)
+}
+
+func (s *deploymentChainStore) UpdateBlockGateAnalysisResult(ctx context.Context, chainID string, blockIndex uint32, passed bool, reason string) error {
+	updater := blockGateAnalysisResultFunc(blockIndex, passed, reason)
+	return s.update(ctx, chainID, updater)
+}
+
+func (s *deploymentChainStore) ApproveBlockGate(ctx context.Context, chainID string, blockIndex uint32, approver string) error {
+	updater := blockGateApproveFunc(blockIndex, approver)
+	return s.update(ctx, chainID, updater)
+}
@@ -326,3 +326,163 @@ func TestDeploymentChainNodeDeploymentStatusUpdater(t *testing.T) {
 		})
 	}
 }
+
+func TestDeploymentChainBlockGateStatusUpdater(t *testing.T) {
+	testcases := []struct {
+		name           string
+		gate           *model.ChainBlockGate
+		status         model.ChainGateStatus
+		expectedStatus model.ChainGateStatus
+		expectedErr    error
+	}{
+		{
+			name:        "block without gate",
+			status:      model.ChainGateStatus_CHAIN_GATE_RUNNING,
+			expectedErr: ErrInvalidArgument,
+		},
+		{
+			name:           "gate starts running",
+			gate:           &model.ChainBlockGate{WaitSeconds: 60},
+			status:         model.ChainGateStatus_CHAIN_GATE_RUNNING,
+			expectedStatus: model.ChainGateStatus_CHAIN_GATE_RUNNING,
+		},
+		{
+			name: "finished gate keeps its status",
+			gate: &model.ChainBlockGate{
+				WaitSeconds: 60,
+				Status:      model.ChainGateStatus_CHAIN_GATE_FAILED,
+			},
+			status:         model.ChainGateStatus_CHAIN_GATE_PASSED,
+			expectedStatus: model.ChainGateStatus_CHAIN_GATE_FAILED,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dc := &model.DeploymentChain{
+				Blocks: []*model.ChainBlock{
+					{},
+					{Gate: tc.gate},
+				},
+			}
+			err := blockGateStatusUpdateFunc(1, tc.status, "")(dc)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, dc.Blocks[1].Gate.Status)
+		})
+	}
+}
+
+func TestDeploymentChainBlockGateAnalysisResultUpdater(t *testing.T) {
+	testcases := []struct {
+		name                   string
+		gate                   *model.ChainBlockGate
+		passed                 bool
+		expectedStatus         model.ChainGateStatus
+		expectedAnalysisPassed bool
+		expectedErr            error
+	}{
+		{
+			name:        "analysis is not required",
+			gate:        &model.ChainBlockGate{WaitSeconds: 60},
+			passed:      true,
+			expectedErr: ErrInvalidArgument,
+		},
+		{
+			name: "analysis passed",
+			gate: &model.ChainBlockGate{
+				AnalysisConfig: []byte("{}"),
+				Status:         model.ChainGateStatus_CHAIN_GATE_RUNNING,
+			},
+			passed:                 true,
+			expectedStatus:         model.ChainGateStatus_CHAIN_GATE_RUNNING,
+			expectedAnalysisPassed: true,
+		},
+		{
+			name: "analysis failed",
+			gate: &model.ChainBlockGate{
+				AnalysisConfig: []byte("{}"),
+				Status:         model.ChainGateStatus_CHAIN_GATE_RUNNING,
+			},
+			expectedStatus: model.ChainGateStatus_CHAIN_GATE_FAILED,
+		},
+		{
+			name: "the first reported result is kept",
+			gate: &model.ChainBlockGate{
+				AnalysisConfig: []byte("{}"),
+				AnalysisPassed: true,
+				Status:         model.ChainGateStatus_CHAIN_GATE_RUNNING,
+			},
+			expectedStatus:         model.ChainGateStatus_CHAIN_GATE_RUNNING,
+			expectedAnalysisPassed: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dc := &model.DeploymentChain{
+				Blocks: []*model.ChainBlock{
+					{},
+					{Gate: tc.gate},
+				},
+			}
+			err := blockGateAnalysisResultFunc(1, tc.passed, "")(dc)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, dc.Blocks[1].Gate.Status)
+			assert.Equal(t, tc.expectedAnalysisPassed, dc.Blocks[1].Gate.AnalysisPassed)
+		})
+	}
+}
+
+func TestDeploymentChainBlockGateApprover(t *testing.T) {
+	testcases := []struct {
+		name        string
+		gate        *model.ChainBlockGate
+		expectedErr error
+	}{
+		{
+			name:        "approval is not required",
+			gate:        &model.ChainBlockGate{WaitSeconds: 60},
+			expectedErr: ErrInvalidArgument,
+		},
+		{
+			name: "already approved",
+			gate: &model.ChainBlockGate{
+				RequireApproval: true,
+				Approver:        "key-1",
+			},
+			expectedErr: ErrInvalidArgument,
+		},
+		{
+			name: "approved",
+			gate: &model.ChainBlockGate{
+				RequireApproval: true,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dc := &model.DeploymentChain{
+				Blocks: []*model.ChainBlock{
+					{},
+					{Gate: tc.gate},
+				},
+			}
+			err := blockGateApproveFunc(1, "key-2")(dc)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "key-2", dc.Blocks[1].Gate.Approver)
+		})
+	}
+}
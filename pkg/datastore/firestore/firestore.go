@@ -246,6 +246,24 @@ func (s *FireStore) Update(ctx context.Context, col datastore.Collection, id str
 	return nil
 }
 
+func (s *FireStore) Delete(ctx context.Context, col datastore.Collection, id string) error {
+	kind := col.Kind()
+	colName := makeCollectionName(s.collectionNamePrefix, kind)
+	ref := s.client.Collection(s.namespace).Doc(s.environment).Collection(colName).Doc(id)
+	if _, err := ref.Delete(ctx, firestore.Exists); err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() == codes.NotFound {
+			return datastore.ErrNotFound
+		}
+		s.logger.Error("failed to delete entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
 func (s *FireStore) Close() error {
 	return s.client.Close()
 }
@@ -112,6 +112,20 @@ func (mr *MockDataStoreMockRecorder) Create(ctx, col, id, entity interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataStore)(nil).Create), ctx, col, id, entity)
 }
 
+// Delete mocks base method.
+func (m *MockDataStore) Delete(ctx context.Context, col Collection, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, col, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDataStoreMockRecorder) Delete(ctx, col, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataStore)(nil).Delete), ctx, col, id)
+}
+
 // Find mocks base method.
 func (m *MockDataStore) Find(ctx context.Context, col Collection, opts ListOptions) (Iterator, error) {
 	m.ctrl.T.Helper()
@@ -147,3 +147,21 @@ CREATE INDEX deploymenttrace_project_id_updated_at_desc ON DeploymentTrace (Proj
 -- index on `CommitHash` ASC and `UpdatedAt` DESC
 ALTER TABLE DeploymentTrace ADD COLUMN CommitHash VARCHAR(40) GENERATED ALWAYS AS (IFNULL(data->>"$.commit_hash", "")) VIRTUAL NOT NULL;
 CREATE INDEX deploymenttrace_commit_hash_updated_at_desc ON DeploymentTrace (CommitHash, UpdatedAt DESC);
+
+--
+-- AuditLog table indexes
+--
+
+-- index on `ProjectId` ASC and `CreatedAt` DESC
+CREATE INDEX auditlog_project_id_created_at_desc ON AuditLog (ProjectId, CreatedAt DESC);
+
+-- index on `Actor` ASC, `ProjectId` ASC and `CreatedAt` DESC
+ALTER TABLE AuditLog ADD COLUMN Actor VARCHAR(100) GENERATED ALWAYS AS (IFNULL(data->>"$.actor", "")) VIRTUAL NOT NULL;
+CREATE INDEX auditlog_actor_project_id_created_at_desc ON AuditLog (Actor, ProjectId, CreatedAt DESC);
+
+-- index on `ResourceType` ASC, `ProjectId` ASC and `CreatedAt` DESC
+ALTER TABLE AuditLog ADD COLUMN ResourceType INT GENERATED ALWAYS AS (IFNULL(data->>"$.resource_type", 0)) VIRTUAL NOT NULL;
+CREATE INDEX auditlog_resource_type_project_id_created_at_desc ON AuditLog (ResourceType, ProjectId, CreatedAt DESC);
+
+-- index on `CreatedAt` ASC
+CREATE INDEX auditlog_created_at_asc ON AuditLog (CreatedAt);
@@ -34,6 +34,16 @@ type Iterator struct {
 	last   dataConverter
 }
 
+// NewIterator returns an iterator over the given rows.
+// Each row must contain only the JSON encoded data of an entity.
+// It is also used by the other SQL datastores sharing the query builder of MySQL.
+func NewIterator(rows *sql.Rows, orders []datastore.Order) *Iterator {
+	return &Iterator{
+		rows:   rows,
+		orders: orders,
+	}
+}
+
 // Next implementation for MySQL Iterator
 func (it *Iterator) Next(dst interface{}) error {
 	if !it.rows.Next() {
@@ -100,6 +100,14 @@ func wrapModel(entity interface{}) (interface{}, error) {
 			DeploymentTrace: *e,
 			Extra:           e.Id,
 		}, nil
+	case *model.AuditLog:
+		if e == nil {
+			return nil, fmt.Errorf("nil entity given")
+		}
+		return &auditLog{
+			AuditLog: *e,
+			Extra:    e.Actor,
+		}, nil
 	default:
 		return nil, fmt.Errorf("%T is not supported", e)
 	}
@@ -165,3 +173,8 @@ type deploymentTrace struct {
 	model.DeploymentTrace `json:",inline"`
 	Extra                 string `json:"_extra"`
 }
+
+type auditLog struct {
+	model.AuditLog `json:",inline"`
+	Extra          string `json:"_extra"`
+}
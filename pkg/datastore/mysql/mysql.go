@@ -96,12 +96,10 @@ func (m *MySQL) Find(ctx context.Context, col datastore.Collection, opts datasto
 		return nil, err
 	}
 
-	whereConditionVals := refineFiltersValue(opts.Filters)
-	cursorVals, err := makePaginationCursorValues(opts)
+	whereConditionVals, err := MakeFindQueryValues(opts)
 	if err != nil {
 		return nil, err
 	}
-	whereConditionVals = append(whereConditionVals, cursorVals...)
 
 	rows, err := m.client.QueryContext(ctx, query, whereConditionVals...)
 	if err != nil {
@@ -113,10 +111,7 @@ func (m *MySQL) Find(ctx context.Context, col datastore.Collection, opts datasto
 		)
 		return nil, err
 	}
-	return &Iterator{
-		rows:   rows,
-		orders: opts.Orders,
-	}, nil
+	return NewIterator(rows, opts.Orders), nil
 }
 
 // Get implementation for MySQL
@@ -260,6 +255,28 @@ func (m *MySQL) Update(ctx context.Context, col datastore.Collection, id string,
 	return tx.Commit()
 }
 
+// Delete implementation for MySQL
+func (m *MySQL) Delete(ctx context.Context, col datastore.Collection, id string) error {
+	kind := col.Kind()
+	res, err := m.client.ExecContext(ctx, buildDeleteQuery(kind), makeRowID(id))
+	if err != nil {
+		m.logger.Error("failed to delete entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return datastore.ErrNotFound
+	}
+	return nil
+}
+
 // Close implementation for MySQL
 func (m *MySQL) Close() error {
 	return m.client.Close()
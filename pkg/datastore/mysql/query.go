@@ -36,6 +36,24 @@ var operatorMap = map[datastore.Operator]string{
 	datastore.OperatorContains:           "MEMBER OF",
 }
 
+// Dialect represents the parts of query which differ between
+// the SQL databases sharing this query builder.
+type Dialect struct {
+	// IDPlaceholder is the placeholder used to bind a value compared with Id column.
+	IDPlaceholder string
+	// ContainsCondition returns the condition to find ones that have
+	// the value bound to the placeholder in the given array field.
+	ContainsCondition func(field string) string
+}
+
+// MySQLDialect is the dialect of MySQL.
+var MySQLDialect = Dialect{
+	IDPlaceholder: "UUID_TO_BIN(?,true)",
+	ContainsCondition: func(field string) string {
+		return fmt.Sprintf("? %s (%s)", operatorMap[datastore.OperatorContains], field)
+	},
+}
+
 func buildGetQuery(table string) string {
 	return fmt.Sprintf("SELECT Data FROM %s WHERE Id = UUID_TO_BIN(?,true)", table)
 }
@@ -48,10 +66,20 @@ func buildCreateQuery(table string) string {
 	return fmt.Sprintf("INSERT INTO %s (Id, Data) VALUE (UUID_TO_BIN(?,true), ?)", table)
 }
 
+func buildDeleteQuery(table string) string {
+	return fmt.Sprintf("DELETE FROM %s WHERE Id = UUID_TO_BIN(?,true)", table)
+}
+
 func buildFindQuery(table string, ops datastore.ListOptions) (string, error) {
+	return BuildFindQuery(table, ops, MySQLDialect)
+}
+
+// BuildFindQuery returns the query to find entities matched the given options.
+// The values to be bound to the query can be made by MakeFindQueryValues.
+func BuildFindQuery(table string, ops datastore.ListOptions, dialect Dialect) (string, error) {
 	filters := refineFiltersField(ops.Filters)
 
-	whereClause, err := buildWhereClause(filters)
+	whereClause, err := buildWhereClause(filters, dialect)
 	if err != nil {
 		return "", err
 	}
@@ -63,14 +91,14 @@ func buildFindQuery(table string, ops datastore.ListOptions) (string, error) {
 		"SELECT Data FROM %s %s %s %s %s",
 		table,
 		whereClause,
-		buildPaginationCondition(ops),
+		buildPaginationCondition(ops, dialect),
 		orderByClause,
 		buildLimitClause(ops.Limit),
 	)
 	return strings.Join(strings.Fields(rawQuery), " "), nil
 }
 
-func buildWhereClause(filters []datastore.ListFilter) (string, error) {
+func buildWhereClause(filters []datastore.ListFilter, dialect Dialect) (string, error) {
 	if len(filters) == 0 {
 		return "", nil
 	}
@@ -87,7 +115,7 @@ func buildWhereClause(filters []datastore.ListFilter) (string, error) {
 			valLength := reflect.ValueOf(filter.Value).Len()
 			conds[i] = fmt.Sprintf("%s %s (?%s)", filter.Field, op, strings.Repeat(",?", valLength-1))
 		case datastore.OperatorContains:
-			conds[i] = fmt.Sprintf("? %s (%s)", op, filter.Field)
+			conds[i] = dialect.ContainsCondition(filter.Field)
 		default:
 			conds[i] = fmt.Sprintf("%s %s ?", filter.Field, op)
 		}
@@ -95,7 +123,7 @@ func buildWhereClause(filters []datastore.ListFilter) (string, error) {
 	return fmt.Sprintf("WHERE %s", strings.Join(conds, " AND ")), nil
 }
 
-func buildPaginationCondition(opts datastore.ListOptions) string {
+func buildPaginationCondition(opts datastore.ListOptions, dialect Dialect) string {
 	// Skip on no cursor.
 	if len(opts.Cursor) == 0 {
 		return ""
@@ -120,7 +148,7 @@ func buildPaginationCondition(opts datastore.ListOptions) string {
 	subSetConds := make([]string, len(opts.Orders))
 	for i, o := range opts.Orders {
 		if o.Field == "Id" {
-			subSetConds[i] = fmt.Sprintf("%s %s UUID_TO_BIN(?,true)", o.Field, makeCompareOperatorForSubSet(o.Direction))
+			subSetConds[i] = fmt.Sprintf("%s %s %s", o.Field, makeCompareOperatorForSubSet(o.Direction), dialect.IDPlaceholder)
 		} else {
 			subSetConds[i] = fmt.Sprintf("%s = ?", o.Field)
 		}
@@ -215,6 +243,16 @@ func refineFiltersField(filters []datastore.ListFilter) []datastore.ListFilter {
 	return out
 }
 
+// MakeFindQueryValues returns the values to be bound to the query built by BuildFindQuery.
+func MakeFindQueryValues(opts datastore.ListOptions) ([]interface{}, error) {
+	vals := refineFiltersValue(opts.Filters)
+	cursorVals, err := makePaginationCursorValues(opts)
+	if err != nil {
+		return nil, err
+	}
+	return append(vals, cursorVals...), nil
+}
+
 // refineFiltersValue destructs all slide/array type values and makes an array of all element values.
 func refineFiltersValue(filters []datastore.ListFilter) []interface{} {
 	var filtersVals []interface{}
@@ -86,6 +86,27 @@ func TestBuildCreateQuery(t *testing.T) {
 	}
 }
 
+func TestBuildDeleteQuery(t *testing.T) {
+	testcases := []struct {
+		name          string
+		kind          string
+		expectedQuery string
+	}{
+		{
+			name:          "query for AuditLog kind",
+			kind:          "AuditLog",
+			expectedQuery: "DELETE FROM AuditLog WHERE Id = UUID_TO_BIN(?,true)",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			query := buildDeleteQuery(tc.kind)
+			assert.Equal(t, tc.expectedQuery, query)
+		})
+	}
+}
+
 func TestBuildFindQuery(t *testing.T) {
 	testcases := []struct {
 		name          string
@@ -0,0 +1,111 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ensurer
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore/postgres"
+)
+
+var (
+	//go:embed schema.sql
+	postgresDatabaseSchema string
+	//go:embed indexes.sql
+	postgresDatabaseIndexes string
+)
+
+type postgresEnsurer struct {
+	client *sql.DB
+	logger *zap.Logger
+}
+
+func NewPostgreSQLEnsurer(url, database, usernameFile, passwordFile string, logger *zap.Logger) (SQLEnsurer, error) {
+	dataSourceName, err := postgres.BuildDataSourceName(url, database, usernameFile, passwordFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to sql database: %w", err)
+	}
+
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to sql database: %w", err)
+	}
+
+	return &postgresEnsurer{
+		client: db,
+		logger: logger.Named("postgres-ensurer"),
+	}, nil
+}
+
+func (p *postgresEnsurer) EnsureIndexes(ctx context.Context) error {
+	// All statements use IF NOT EXISTS so that
+	// the already created indexes are just skipped.
+	for _, stmt := range makeCreateIndexStatements(postgresDatabaseIndexes) {
+		if _, err := p.client.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *postgresEnsurer) EnsureSchema(ctx context.Context) error {
+	_, err := p.client.ExecContext(ctx, postgresDatabaseSchema)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p *postgresEnsurer) Close() error {
+	return p.client.Close()
+}
+
+func (p *postgresEnsurer) Ping() error {
+	return p.client.Ping()
+}
+
+func makeCreateIndexStatements(indexesStatements string) []string {
+	items := strings.Split(strings.TrimSpace(indexesStatements), ";")
+	statements := make([]string, 0, len(items))
+	for _, item := range items {
+		item = removeComments(item)
+		// Ignore dummy statement.
+		if item == "" {
+			continue
+		}
+		statements = append(statements, item)
+	}
+	return statements
+}
+
+// removeComments removes all SQL comment lines from the given statement.
+func removeComments(stmt string) string {
+	lines := strings.Split(stmt, "\n")
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "--") {
+			continue
+		}
+		out = append(out, line)
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
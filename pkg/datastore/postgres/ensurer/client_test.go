@@ -0,0 +1,65 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ensurer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeCreateIndexStatements(t *testing.T) {
+	testcases := []struct {
+		name               string
+		rawIndexes         string
+		expectedStatements []string
+	}{
+		{
+			name:       "Only one CREATE INDEX statement",
+			rawIndexes: "CREATE INDEX IF NOT EXISTS piped_project_id_asc ON Piped ((COALESCE(Data #>> '{project_id}', '')));\n",
+			expectedStatements: []string{
+				"CREATE INDEX IF NOT EXISTS piped_project_id_asc ON Piped ((COALESCE(Data #>> '{project_id}', '')))",
+			},
+		},
+		{
+			name:       "Statements with comments",
+			rawIndexes: "--\n-- Piped table indexes\n--\n\n-- index on `ProjectId` ASC\nCREATE INDEX IF NOT EXISTS piped_project_id_asc ON Piped ((COALESCE(Data #>> '{project_id}', '')));\n\n-- index on `PipedId` ASC\nCREATE INDEX IF NOT EXISTS command_piped_id ON Command ((COALESCE(Data #>> '{piped_id}', '')));\n",
+			expectedStatements: []string{
+				"CREATE INDEX IF NOT EXISTS piped_project_id_asc ON Piped ((COALESCE(Data #>> '{project_id}', '')))",
+				"CREATE INDEX IF NOT EXISTS command_piped_id ON Command ((COALESCE(Data #>> '{piped_id}', '')))",
+			},
+		},
+		{
+			name:               "Only comments",
+			rawIndexes:         "--\n-- Nothing here\n--\n",
+			expectedStatements: []string{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			statements := makeCreateIndexStatements(tc.rawIndexes)
+			assert.Equal(t, tc.expectedStatements, statements)
+		})
+	}
+}
+
+func TestIndexesStatements(t *testing.T) {
+	statements := makeCreateIndexStatements(postgresDatabaseIndexes)
+	assert.NotEmpty(t, statements)
+	for _, stmt := range statements {
+		assert.Contains(t, stmt, "CREATE INDEX IF NOT EXISTS")
+	}
+}
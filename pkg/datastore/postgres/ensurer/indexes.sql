@@ -0,0 +1,138 @@
+--
+-- The expressions in the indexes must be kept in sync with
+-- the ones built by fieldExpression in query.go to be used by the queries.
+--
+
+--
+-- Application table indexes
+--
+
+-- index on `Disabled` and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_disabled_updated_at_desc ON Application ((COALESCE((Data #>> '{disabled}')::boolean, false)), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `Name` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_name_updated_at_desc ON Application ((COALESCE(Data #>> '{name}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `Kind` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_kind_updated_at_desc ON Application ((COALESCE((Data #>> '{kind}')::bigint, 0)), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `SyncState.Status` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_sync_state_updated_at_desc ON Application ((COALESCE((Data #>> '{sync_state,status}')::bigint, 0)), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_project_id_updated_at_desc ON Application ((COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `PipedId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_piped_id_updated_at_desc ON Application ((COALESCE(Data #>> '{piped_id}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+--
+-- Command table indexes
+--
+
+-- index on `Status` ASC and `CreatedAt` ASC
+CREATE INDEX IF NOT EXISTS command_status_created_at_asc ON Command ((COALESCE((Data #>> '{status}')::bigint, 0)), (COALESCE((Data #>> '{created_at}')::bigint, 0)));
+
+-- index on `PipedId` ASC
+CREATE INDEX IF NOT EXISTS command_piped_id ON Command ((COALESCE(Data #>> '{piped_id}', '')));
+
+--
+-- Deployment table indexes
+--
+
+-- index on `ApplicationId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_application_id_updated_at_desc ON Deployment ((COALESCE(Data #>> '{application_id}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `ApplicationName` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_application_name_updated_at_desc ON Deployment ((COALESCE(Data #>> '{application_name}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_project_id_updated_at_desc ON Deployment ((COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `Kind` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_kind_updated_at_desc ON Deployment ((COALESCE((Data #>> '{kind}')::bigint, 0)), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `Status` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_status_updated_at_desc ON Deployment ((COALESCE((Data #>> '{status}')::bigint, 0)), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `PipedId` ASC
+CREATE INDEX IF NOT EXISTS deployment_piped_id ON Deployment ((COALESCE(Data #>> '{piped_id}', '')));
+
+-- index on `CompletedAt` DESC and `Id` ASC
+CREATE INDEX IF NOT EXISTS deployment_completed_at_desc_id ON Deployment ((COALESCE((Data #>> '{completed_at}')::bigint, 0)) DESC, Id);
+
+-- index on `CompletedAt` ASC and `Id` ASC
+CREATE INDEX IF NOT EXISTS deployment_completed_at_id_asc ON Deployment ((COALESCE((Data #>> '{completed_at}')::bigint, 0)), Id);
+
+-- index on `DeploymentChainId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_chain_id_updated_at_desc ON Deployment ((COALESCE(Data #>> '{deployment_chain_id}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `DeploymentTraceCommitHash` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_trace_commit_hash_updated_at_desc ON Deployment ((COALESCE(Data #>> '{deployment_trace_commit_hash}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+--
+-- Event table indexes
+--
+
+-- index on `ProjectId` ASC and `CreatedAt` ASC
+CREATE INDEX IF NOT EXISTS event_project_id_created_at_asc ON Event ((COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{created_at}')::bigint, 0)));
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_project_id_updated_at_desc ON Event ((COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `EventKey` ASC, `Name` ASC, `ProjectId` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_event_key_name_project_id_created_at_desc ON Event ((COALESCE(Data #>> '{event_key}', '')), (COALESCE(Data #>> '{name}', '')), (COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{created_at}')::bigint, 0)) DESC);
+
+-- index on `ProjectId` ASC, `Status` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_project_id_status_created_at_desc ON Event ((COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{status}')::bigint, 0)), (COALESCE((Data #>> '{created_at}')::bigint, 0)) DESC);
+
+-- index on `ProjectId` ASC, `Status` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_project_id_status_updated_at_desc ON Event ((COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{status}')::bigint, 0)), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `Name` ASC, `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_name_project_id_updated_at_desc ON Event ((COALESCE(Data #>> '{name}', '')), (COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `Name` ASC, `ProjectId` ASC, `Status` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_name_project_id_status_updated_at_desc ON Event ((COALESCE(Data #>> '{name}', '')), (COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{status}')::bigint, 0)), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+--
+-- Piped table indexes
+--
+
+-- index on `ProjectId` ASC
+CREATE INDEX IF NOT EXISTS piped_project_id_asc ON Piped ((COALESCE(Data #>> '{project_id}', '')));
+
+--
+-- DeploymentChain table indexes
+--
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deploymentchain_project_id_updated_at_desc ON DeploymentChain ((COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `Status` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deploymentchain_status_updated_at_desc ON DeploymentChain ((COALESCE((Data #>> '{status}')::bigint, 0)), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+--
+-- DeploymentTrace table indexes
+--
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deploymenttrace_project_id_updated_at_desc ON DeploymentTrace ((COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+-- index on `CommitHash` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deploymenttrace_commit_hash_updated_at_desc ON DeploymentTrace ((COALESCE(Data #>> '{commit_hash}', '')), (COALESCE((Data #>> '{updated_at}')::bigint, 0)) DESC);
+
+--
+-- AuditLog table indexes
+--
+
+-- index on `ProjectId` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS auditlog_project_id_created_at_desc ON AuditLog ((COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{created_at}')::bigint, 0)) DESC);
+
+-- index on `Actor` ASC, `ProjectId` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS auditlog_actor_project_id_created_at_desc ON AuditLog ((COALESCE(Data #>> '{actor}', '')), (COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{created_at}')::bigint, 0)) DESC);
+
+-- index on `ResourceType` ASC, `ProjectId` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS auditlog_resource_type_project_id_created_at_desc ON AuditLog ((COALESCE((Data #>> '{resource_type}')::bigint, 0)), (COALESCE(Data #>> '{project_id}', '')), (COALESCE((Data #>> '{created_at}')::bigint, 0)) DESC);
+
+-- index on `CreatedAt` ASC
+CREATE INDEX IF NOT EXISTS auditlog_created_at_asc ON AuditLog ((COALESCE((Data #>> '{created_at}')::bigint, 0)));
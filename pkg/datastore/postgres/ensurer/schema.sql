@@ -0,0 +1,89 @@
+--
+-- Project table
+--
+
+CREATE TABLE IF NOT EXISTS Project (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL
+);
+
+--
+-- Application table
+--
+
+CREATE TABLE IF NOT EXISTS Application (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL
+);
+
+--
+-- Command table
+--
+
+CREATE TABLE IF NOT EXISTS Command (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL
+);
+
+--
+-- Deployment table
+--
+
+CREATE TABLE IF NOT EXISTS Deployment (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL
+);
+
+--
+-- Piped table
+--
+
+CREATE TABLE IF NOT EXISTS Piped (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL
+);
+
+--
+-- APIKey table
+--
+
+CREATE TABLE IF NOT EXISTS APIKey (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL
+);
+
+--
+-- Event table
+--
+
+CREATE TABLE IF NOT EXISTS Event (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL
+);
+
+--
+-- DeploymentChain table
+--
+
+CREATE TABLE IF NOT EXISTS DeploymentChain (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL
+);
+
+--
+-- DeploymentTrace table
+--
+
+CREATE TABLE IF NOT EXISTS DeploymentTrace (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL
+);
+
+--
+-- AuditLog table
+--
+
+CREATE TABLE IF NOT EXISTS AuditLog (
+  Id UUID PRIMARY KEY,
+  Data JSONB NOT NULL
+);
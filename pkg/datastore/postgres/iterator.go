@@ -0,0 +1,93 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+)
+
+// Iterator for PostgreSQL result set
+type Iterator struct {
+	rows   *sql.Rows
+	orders []datastore.Order
+	last   string
+}
+
+// Next implementation for PostgreSQL Iterator
+func (it *Iterator) Next(dst interface{}) error {
+	if !it.rows.Next() {
+		if err := it.rows.Err(); err != nil {
+			return err
+		}
+		it.rows.Close()
+		return datastore.ErrIteratorDone
+	}
+	var val string
+	if err := it.rows.Scan(&val); err != nil {
+		return err
+	}
+
+	// Update last iterated item as last read row.
+	it.last = val
+
+	return decodeJSONValue(val, dst)
+}
+
+// Cursor builds a base64 string (encode from string in map[string]interface{} format).
+// The cursor contains only values attached with the fields used
+// as ordering fields.
+func (it *Iterator) Cursor() (string, error) {
+	if it.last == "" {
+		return "", datastore.ErrInvalidCursor
+	}
+
+	var lastObjData map[string]interface{}
+	if err := json.Unmarshal([]byte(it.last), &lastObjData); err != nil {
+		return "", datastore.ErrInvalidCursor
+	}
+
+	cursor := make(map[string]interface{}, len(it.orders))
+	for _, o := range it.orders {
+		val, ok := lookupField(lastObjData, o.Field)
+		if !ok {
+			return "", datastore.ErrInvalidCursor
+		}
+		cursor[o.Field] = val
+	}
+
+	b, _ := json.Marshal(cursor)
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// lookupField returns the value of the given field of model from the decoded JSON data.
+// Missing numeric fields are treated as zero since zero values are omitted while encoding.
+func lookupField(data map[string]interface{}, field string) (interface{}, bool) {
+	var cur interface{} = data
+	for _, p := range strings.Split(field, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[convertCamelToSnake(p)]; !ok {
+			return float64(0), true
+		}
+	}
+	return cur, true
+}
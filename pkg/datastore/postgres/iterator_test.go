@@ -0,0 +1,143 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+)
+
+func TestCursor(t *testing.T) {
+	testcases := []struct {
+		name         string
+		iter         Iterator
+		expectCursor string
+		expectErr    bool
+	}{
+		{
+			name:      "invalid cursor error returns on last is empty",
+			iter:      Iterator{},
+			expectErr: true,
+		},
+		{
+			name: "valid last cursor",
+			iter: Iterator{
+				last: `{"id":"object-id","created_at":100,"updated_at":200}`,
+				orders: []datastore.Order{
+					{
+						Field:     "UpdatedAt",
+						Direction: datastore.Desc,
+					},
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+			},
+			expectCursor: base64.StdEncoding.EncodeToString([]byte(`{"Id":"object-id","UpdatedAt":200}`)),
+		},
+		{
+			name: "omitted zero value is treated as zero",
+			iter: Iterator{
+				last: `{"id":"object-id","updated_at":200}`,
+				orders: []datastore.Order{
+					{
+						Field:     "CompletedAt",
+						Direction: datastore.Desc,
+					},
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+			},
+			expectCursor: base64.StdEncoding.EncodeToString([]byte(`{"CompletedAt":0,"Id":"object-id"}`)),
+		},
+		{
+			name: "invalid last cursor: malformed data",
+			iter: Iterator{
+				last: `{"id":`,
+				orders: []datastore.Order{
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cursor, err := tc.iter.Cursor()
+			assert.Equal(t, tc.expectErr, err != nil)
+			assert.Equal(t, tc.expectCursor, cursor)
+		})
+	}
+}
+
+func TestLookupField(t *testing.T) {
+	data := map[string]interface{}{
+		"id": "object-id",
+		"sync_state": map[string]interface{}{
+			"status": float64(2),
+		},
+		"labels": "invalid",
+	}
+
+	testcases := []struct {
+		name     string
+		field    string
+		expected interface{}
+		found    bool
+	}{
+		{
+			name:     "top level field",
+			field:    "Id",
+			expected: "object-id",
+			found:    true,
+		},
+		{
+			name:     "nested field",
+			field:    "SyncState.Status",
+			expected: float64(2),
+			found:    true,
+		},
+		{
+			name:     "missing field",
+			field:    "UpdatedAt",
+			expected: float64(0),
+			found:    true,
+		},
+		{
+			name:  "parent is not an object",
+			field: "Labels.Env",
+			found: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, found := lookupField(data, tc.field)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
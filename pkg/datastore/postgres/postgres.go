@@ -0,0 +1,348 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib" // Register the pgx driver.
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+)
+
+const (
+	postgresErrorCodeUniqueViolation = "23505"
+	postgresErrorCodeRaiseException  = "P0001"
+)
+
+// PostgreSQL client wrapper
+type PostgreSQL struct {
+	client       *sql.DB
+	logger       *zap.Logger
+	usernameFile string
+	passwordFile string
+}
+
+// Option for create PostgreSQL typed instance
+type Option func(*PostgreSQL)
+
+// WithLogger returns logger setup function
+func WithLogger(logger *zap.Logger) Option {
+	return func(p *PostgreSQL) {
+		p.logger = logger
+	}
+}
+
+// WithAuthenticationFile returns auth info setup function
+func WithAuthenticationFile(usernameFile, passwordFile string) Option {
+	return func(p *PostgreSQL) {
+		p.usernameFile = usernameFile
+		p.passwordFile = passwordFile
+	}
+}
+
+// NewPostgreSQL returns new PostgreSQL instance
+func NewPostgreSQL(url, database string, opts ...Option) (*PostgreSQL, error) {
+	p := &PostgreSQL{
+		logger: zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	dataSourceName, err := BuildDataSourceName(url, database, p.usernameFile, p.passwordFile)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("pgx", dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	p.client = db
+
+	return p, nil
+}
+
+// Find implementation for PostgreSQL
+func (p *PostgreSQL) Find(ctx context.Context, col datastore.Collection, opts datastore.ListOptions) (datastore.Iterator, error) {
+	kind := col.Kind()
+	if opts.Cursor != "" && len(opts.Orders) == 0 {
+		return nil, errors.New("opts.Cursor also requires Orders to be set")
+	}
+
+	query, args, err := buildFindQuery(kind, opts)
+	if err != nil {
+		p.logger.Error("failed to build find entities query",
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	rows, err := p.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("failed to find entities",
+			zap.String("kind", kind),
+			zap.String("query", query),
+			zap.Any("args", args),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return &Iterator{
+		rows:   rows,
+		orders: opts.Orders,
+	}, nil
+}
+
+// Get implementation for PostgreSQL
+func (p *PostgreSQL) Get(ctx context.Context, col datastore.Collection, id string, v interface{}) error {
+	kind := col.Kind()
+	row := p.client.QueryRowContext(ctx, buildGetQuery(kind), makeRowID(id))
+	var val string
+	err := row.Scan(&val)
+	if err == sql.ErrNoRows {
+		return datastore.ErrNotFound
+	}
+	if err != nil {
+		p.logger.Error("failed to get entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return decodeJSONValue(val, v)
+}
+
+// Create implementation for PostgreSQL
+func (p *PostgreSQL) Create(ctx context.Context, col datastore.Collection, id string, entity interface{}) error {
+	kind := col.Kind()
+	data, err := encodeJSONValue(entity)
+	if err != nil {
+		p.logger.Error("failed to create entity: failed to encode json data",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	_, err = p.client.ExecContext(ctx, buildCreateQuery(kind), makeRowID(id), data)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == postgresErrorCodeUniqueViolation {
+			return datastore.ErrAlreadyExists
+		}
+		if pgErr.Code == postgresErrorCodeRaiseException {
+			return fmt.Errorf("%w: %s", datastore.ErrUserDefined, pgErr.Message)
+		}
+	}
+	if err != nil {
+		p.logger.Error("failed to create entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// Update implementation for PostgreSQL
+func (p *PostgreSQL) Update(ctx context.Context, col datastore.Collection, id string, updater datastore.Updater) error {
+	kind := col.Kind()
+	// Start transaction with default isolation level.
+	tx, err := p.client.BeginTx(ctx, nil)
+	if err != nil {
+		p.logger.Error("failed to update entity: failed to start transaction",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// Lock the row to prevent concurrent updates from being lost.
+	row := tx.QueryRowContext(ctx, buildGetForUpdateQuery(kind), makeRowID(id))
+	var val string
+	err = row.Scan(&val)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return datastore.ErrNotFound
+	}
+	if err != nil {
+		p.logger.Error("failed to update entity: failed to get entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		tx.Rollback()
+		return err
+	}
+
+	entity := col.Factory()()
+	if err := decodeJSONValue(val, entity); err != nil {
+		p.logger.Error("failed to update entity: failed to decode data",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		tx.Rollback()
+		return err
+	}
+
+	if err := updater(entity); err != nil {
+		p.logger.Error("failed to update entity: failed to apply updater",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		tx.Rollback()
+		return err
+	}
+
+	data, err := encodeJSONValue(entity)
+	if err != nil {
+		p.logger.Error("failed to update entity: failed to encode json data",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		tx.Rollback()
+		return err
+	}
+	_, err = tx.ExecContext(ctx, buildUpdateQuery(kind), data, makeRowID(id))
+	if err != nil {
+		p.logger.Error("failed to update entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete implementation for PostgreSQL
+func (p *PostgreSQL) Delete(ctx context.Context, col datastore.Collection, id string) error {
+	kind := col.Kind()
+	res, err := p.client.ExecContext(ctx, buildDeleteQuery(kind), makeRowID(id))
+	if err != nil {
+		p.logger.Error("failed to delete entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return datastore.ErrNotFound
+	}
+	return nil
+}
+
+// Close implementation for PostgreSQL
+func (p *PostgreSQL) Close() error {
+	return p.client.Close()
+}
+
+// Ping implementation for PostgreSQL
+func (p *PostgreSQL) Ping() error {
+	return p.client.Ping()
+}
+
+// BuildDataSourceName returns source name to make connection to database.
+// The url must be in the form of postgres://[user[:password]@]host[:port][/database][?params].
+func BuildDataSourceName(rawURL, database, usernameFile, passwordFile string) (string, error) {
+	if rawURL == "" {
+		return "", fmt.Errorf("url is required field")
+	}
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "postgres://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse url: %w", err)
+	}
+
+	if database != "" {
+		u.Path = "/" + database
+	}
+	if strings.TrimPrefix(u.Path, "/") == "" {
+		return "", fmt.Errorf("database is not set")
+	}
+
+	// In case username and password files are not provided,
+	// those values may be included in the URL already.
+	if usernameFile == "" || passwordFile == "" {
+		return u.String(), nil
+	}
+
+	username, err := os.ReadFile(usernameFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read username file: %w", err)
+	}
+	password, err := os.ReadFile(passwordFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password file: %w", err)
+	}
+	u.User = url.UserPassword(strings.TrimSpace(string(username)), strings.TrimSpace(string(password)))
+
+	return u.String(), nil
+}
+
+func encodeJSONValue(entity interface{}) (string, error) {
+	if entity == nil {
+		return "", fmt.Errorf("nil entity given")
+	}
+	encodedEntity, err := json.Marshal(entity)
+	if err != nil {
+		return "", err
+	}
+	return string(encodedEntity), nil
+}
+
+func decodeJSONValue(val string, target interface{}) error {
+	return json.Unmarshal([]byte(val), target)
+}
+
+// makeRowID converts a given string which not in UUID format to UUID string.
+// Otherwise, return the id itself.
+// It produces the same IDs as the MySQL datastore so that data can be moved between them.
+func makeRowID(id string) string {
+	_, err := uuid.Parse(id)
+	if err == nil {
+		return id
+	}
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(id)).String()
+}
@@ -0,0 +1,371 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+)
+
+var operatorMap = map[datastore.Operator]string{
+	datastore.OperatorEqual:              "=",
+	datastore.OperatorNotEqual:           "!=",
+	datastore.OperatorIn:                 "IN",
+	datastore.OperatorNotIn:              "NOT IN",
+	datastore.OperatorGreaterThan:        ">",
+	datastore.OperatorGreaterThanOrEqual: ">=",
+	datastore.OperatorLessThan:           "<",
+	datastore.OperatorLessThanOrEqual:    "<=",
+	datastore.OperatorContains:           "@>",
+}
+
+// valueType represents the SQL type used to compare a JSONB field.
+type valueType string
+
+const (
+	valueTypeText    valueType = "text"
+	valueTypeBigint  valueType = "bigint"
+	valueTypeDouble  valueType = "double precision"
+	valueTypeBoolean valueType = "boolean"
+)
+
+// queryBuilder builds a query while collecting its positional arguments.
+type queryBuilder struct {
+	args []interface{}
+}
+
+// bind adds the given value as a new argument and returns its placeholder.
+func (b *queryBuilder) bind(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+func buildGetQuery(table string) string {
+	return fmt.Sprintf("SELECT Data::text FROM %s WHERE Id = $1", table)
+}
+
+func buildGetForUpdateQuery(table string) string {
+	return fmt.Sprintf("SELECT Data::text FROM %s WHERE Id = $1 FOR UPDATE", table)
+}
+
+func buildUpdateQuery(table string) string {
+	return fmt.Sprintf("UPDATE %s SET Data = $1::jsonb WHERE Id = $2", table)
+}
+
+func buildCreateQuery(table string) string {
+	return fmt.Sprintf("INSERT INTO %s (Id, Data) VALUES ($1, $2::jsonb)", table)
+}
+
+func buildDeleteQuery(table string) string {
+	return fmt.Sprintf("DELETE FROM %s WHERE Id = $1", table)
+}
+
+// buildFindQuery returns the query to find entities matched the given options
+// and the arguments to be bound to its placeholders.
+func buildFindQuery(table string, opts datastore.ListOptions) (string, []interface{}, error) {
+	b := &queryBuilder{}
+
+	conds, err := buildFilterConditions(b, opts.Filters)
+	if err != nil {
+		return "", nil, err
+	}
+	paginationCond, err := buildPaginationCondition(b, opts)
+	if err != nil {
+		return "", nil, err
+	}
+	if paginationCond != "" {
+		conds = append(conds, paginationCond)
+	}
+	orderByClause, err := buildOrderByClause(opts.Orders)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var whereClause string
+	if len(conds) > 0 {
+		whereClause = fmt.Sprintf("WHERE %s", strings.Join(conds, " AND "))
+	}
+	rawQuery := fmt.Sprintf(
+		"SELECT Data::text FROM %s %s %s %s",
+		table,
+		whereClause,
+		orderByClause,
+		buildLimitClause(opts.Limit),
+	)
+	return strings.Join(strings.Fields(rawQuery), " "), b.args, nil
+}
+
+func buildFilterConditions(b *queryBuilder, filters []datastore.ListFilter) ([]string, error) {
+	conds := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		op, ok := operatorMap[filter.Operator]
+		if !ok {
+			return nil, fmt.Errorf("unsupported operator given: %v", filter.Operator)
+		}
+
+		switch filter.Operator {
+		case datastore.OperatorIn, datastore.OperatorNotIn:
+			fv := reflect.ValueOf(filter.Value)
+			if fv.Kind() != reflect.Slice && fv.Kind() != reflect.Array {
+				return nil, fmt.Errorf("value of operator %s must be a slice or an array", op)
+			}
+			if fv.Len() == 0 {
+				return nil, fmt.Errorf("value of operator %s must not be empty", op)
+			}
+			placeholders := make([]string, fv.Len())
+			var typ valueType
+			for i := 0; i < fv.Len(); i++ {
+				v, t, err := normalizeValue(filter.Field, fv.Index(i).Interface())
+				if err != nil {
+					return nil, err
+				}
+				typ = t
+				placeholders[i] = b.bind(v)
+			}
+			conds = append(conds, fmt.Sprintf("%s %s (%s)", fieldExpression(filter.Field, typ), op, strings.Join(placeholders, ", ")))
+
+		case datastore.OperatorContains:
+			v, typ, err := normalizeValue(filter.Field, filter.Value)
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, fmt.Sprintf("%s %s jsonb_build_array(CAST(%s AS %s))", jsonbFieldExpression(filter.Field), op, b.bind(v), typ))
+
+		default:
+			v, typ, err := normalizeValue(filter.Field, filter.Value)
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, fmt.Sprintf("%s %s %s", fieldExpression(filter.Field, typ), op, b.bind(v)))
+		}
+	}
+	return conds, nil
+}
+
+// buildPaginationCondition builds the condition to skip all entities
+// which have been returned in the previous pages.
+// The condition should be in format:
+//
+//	X >= Vx AND Y >= Vy AND ... AND NOT (X = Vx AND Y = Vy AND ... AND Id <= last_iterated_id)
+//
+// with x, y, etc is not Id field, and the comparison operators are reversed on Desc ordering.
+func buildPaginationCondition(b *queryBuilder, opts datastore.ListOptions) (string, error) {
+	// Skip on no cursor.
+	if len(opts.Cursor) == 0 {
+		return "", nil
+	}
+
+	cursor, err := decodeCursor(opts.Cursor)
+	if err != nil {
+		return "", err
+	}
+
+	outerSetConds := make([]string, 0, len(opts.Orders))
+	subSetConds := make([]string, 0, len(opts.Orders))
+	var idCond string
+	for _, o := range opts.Orders {
+		val, ok := cursor[o.Field]
+		if !ok {
+			return "", fmt.Errorf("cursor does not contain values that match to ordering field %s", o.Field)
+		}
+		if o.Field == "Id" {
+			id, ok := val.(string)
+			if !ok {
+				return "", fmt.Errorf("cursor contains invalid value for Id field")
+			}
+			idCond = fmt.Sprintf("Id %s %s", makeCompareOperatorForSubSet(o.Direction), b.bind(makeRowID(id)))
+			continue
+		}
+
+		v, typ, err := normalizeValue(o.Field, val)
+		if err != nil {
+			return "", err
+		}
+		field := fieldExpression(o.Field, typ)
+		placeholder := b.bind(v)
+		outerSetConds = append(outerSetConds, fmt.Sprintf("%s %s %s", field, makeCompareOperatorForOuterSet(o.Direction), placeholder))
+		subSetConds = append(subSetConds, fmt.Sprintf("%s = %s", field, placeholder))
+	}
+	if idCond == "" {
+		return "", fmt.Errorf("id field is required as ordering field")
+	}
+	subSetConds = append(subSetConds, idCond)
+
+	if len(outerSetConds) == 0 {
+		return fmt.Sprintf("NOT (%s)", strings.Join(subSetConds, " AND ")), nil
+	}
+	return fmt.Sprintf("%s AND NOT (%s)", strings.Join(outerSetConds, " AND "), strings.Join(subSetConds, " AND ")), nil
+}
+
+func makeCompareOperatorForOuterSet(direction datastore.OrderDirection) string {
+	if direction == datastore.Asc {
+		return ">="
+	}
+	return "<="
+}
+
+func makeCompareOperatorForSubSet(direction datastore.OrderDirection) string {
+	if direction == datastore.Asc {
+		return "<="
+	}
+	return ">="
+}
+
+func buildOrderByClause(orders []datastore.Order) (string, error) {
+	if len(orders) == 0 {
+		return "", nil
+	}
+
+	conds := make([]string, len(orders))
+	hasIDFieldInOrdering := false
+	for i, ord := range orders {
+		if ord.Field == "Id" {
+			hasIDFieldInOrdering = true
+		}
+		// All fields used for ordering except Id are timestamps.
+		conds[i] = fmt.Sprintf("%s %s", fieldExpression(ord.Field, valueTypeBigint), toPostgresDirection(ord.Direction))
+	}
+
+	if !hasIDFieldInOrdering {
+		return "", fmt.Errorf("id field is required as ordering field")
+	}
+
+	return fmt.Sprintf("ORDER BY %s", strings.Join(conds, ", ")), nil
+}
+
+func buildLimitClause(limit int) string {
+	var clause string
+	if limit > 0 {
+		clause = fmt.Sprintf("LIMIT %d ", limit)
+	}
+	return clause
+}
+
+func toPostgresDirection(d datastore.OrderDirection) string {
+	switch d {
+	case datastore.Asc:
+		return "ASC"
+	case datastore.Desc:
+		return "DESC"
+	default:
+		return ""
+	}
+}
+
+// fieldExpression returns the expression to extract the value of the given field
+// from the JSONB data. Missing fields are treated as their zero values
+// since the zero values are omitted while encoding entities.
+// The expressions must be kept in sync with the ones used in indexes.sql.
+func fieldExpression(field string, typ valueType) string {
+	if field == "Id" {
+		return "Id"
+	}
+	text := fmt.Sprintf("Data #>> '{%s}'", jsonPath(field))
+	switch typ {
+	case valueTypeBigint:
+		return fmt.Sprintf("COALESCE((%s)::bigint, 0)", text)
+	case valueTypeDouble:
+		return fmt.Sprintf("COALESCE((%s)::double precision, 0)", text)
+	case valueTypeBoolean:
+		return fmt.Sprintf("COALESCE((%s)::boolean, false)", text)
+	default:
+		return fmt.Sprintf("COALESCE(%s, '')", text)
+	}
+}
+
+// jsonbFieldExpression returns the expression to extract the given field as JSONB.
+func jsonbFieldExpression(field string) string {
+	return fmt.Sprintf("COALESCE(Data #> '{%s}', '[]'::jsonb)", jsonPath(field))
+}
+
+// jsonPath converts the given field name of model to the path of JSON keys.
+// e.g. SyncState.Status -> sync_state,status
+func jsonPath(field string) string {
+	parts := strings.Split(field, ".")
+	for i, p := range parts {
+		parts[i] = convertCamelToSnake(p)
+	}
+	return strings.Join(parts, ",")
+}
+
+func convertCamelToSnake(key string) string {
+	runes := []rune(key)
+	var out strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				out.WriteRune('_')
+			}
+			out.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		out.WriteRune(r)
+	}
+	return out.String()
+}
+
+// normalizeValue converts the given value to one of the basic types
+// that can be bound to the query and returns the SQL type to compare with.
+func normalizeValue(field string, value interface{}) (interface{}, valueType, error) {
+	if field == "Id" {
+		id, ok := value.(string)
+		if !ok {
+			return nil, "", fmt.Errorf("value of Id field must be a string")
+		}
+		return makeRowID(id), valueTypeText, nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), valueTypeText, nil
+	case reflect.Bool:
+		return v.Bool(), valueTypeBoolean, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), valueTypeBigint, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), valueTypeBigint, nil
+	case reflect.Float32, reflect.Float64:
+		// Numbers decoded from the JSON cursor are float64.
+		f := v.Float()
+		if f == math.Trunc(f) {
+			return int64(f), valueTypeBigint, nil
+		}
+		return f, valueTypeDouble, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported value type %T given for field %s", value, field)
+	}
+}
+
+// decodeCursor decodes the given cursor into the map of values of ordering fields.
+func decodeCursor(cursor string) (map[string]interface{}, error) {
+	data, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	obj := make(map[string]interface{})
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
@@ -0,0 +1,334 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestBuildGetQuery(t *testing.T) {
+	assert.Equal(t, "SELECT Data::text FROM Project WHERE Id = $1", buildGetQuery("Project"))
+	assert.Equal(t, "SELECT Data::text FROM Project WHERE Id = $1 FOR UPDATE", buildGetForUpdateQuery("Project"))
+}
+
+func TestBuildUpdateQuery(t *testing.T) {
+	assert.Equal(t, "UPDATE Project SET Data = $1::jsonb WHERE Id = $2", buildUpdateQuery("Project"))
+}
+
+func TestBuildCreateQuery(t *testing.T) {
+	assert.Equal(t, "INSERT INTO Project (Id, Data) VALUES ($1, $2::jsonb)", buildCreateQuery("Project"))
+}
+
+func TestBuildDeleteQuery(t *testing.T) {
+	assert.Equal(t, "DELETE FROM AuditLog WHERE Id = $1", buildDeleteQuery("AuditLog"))
+}
+
+func TestBuildFindQuery(t *testing.T) {
+	cursor := base64.StdEncoding.EncodeToString([]byte(`{"Id":"object-id","UpdatedAt":100}`))
+	idOnlyCursor := base64.StdEncoding.EncodeToString([]byte(`{"Id":"object-id"}`))
+
+	testcases := []struct {
+		name          string
+		kind          string
+		listOptions   datastore.ListOptions
+		expectedQuery string
+		expectedArgs  []interface{}
+		wantErr       bool
+	}{
+		{
+			name:          "query without filter and order",
+			kind:          "Project",
+			listOptions:   datastore.ListOptions{},
+			expectedQuery: "SELECT Data::text FROM Project",
+		},
+		{
+			name: "query with string filter",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "ProjectId",
+						Operator: datastore.OperatorEqual,
+						Value:    "project-1",
+					},
+				},
+			},
+			expectedQuery: "SELECT Data::text FROM Application WHERE COALESCE(Data #>> '{project_id}', '') = $1",
+			expectedArgs:  []interface{}{"project-1"},
+		},
+		{
+			name: "query with nested enum and boolean filters",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "SyncState.Status",
+						Operator: datastore.OperatorEqual,
+						Value:    model.ApplicationSyncStatus_OUT_OF_SYNC,
+					},
+					{
+						Field:    "Disabled",
+						Operator: datastore.OperatorEqual,
+						Value:    false,
+					},
+				},
+			},
+			expectedQuery: "SELECT Data::text FROM Application WHERE COALESCE((Data #>> '{sync_state,status}')::bigint, 0) = $1 AND COALESCE((Data #>> '{disabled}')::boolean, false) = $2",
+			expectedArgs:  []interface{}{int64(model.ApplicationSyncStatus_OUT_OF_SYNC), false},
+		},
+		{
+			name: "query with IN operator",
+			kind: "Deployment",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "Status",
+						Operator: datastore.OperatorIn,
+						Value:    []model.DeploymentStatus{model.DeploymentStatus_DEPLOYMENT_PENDING, model.DeploymentStatus_DEPLOYMENT_RUNNING},
+					},
+				},
+			},
+			expectedQuery: "SELECT Data::text FROM Deployment WHERE COALESCE((Data #>> '{status}')::bigint, 0) IN ($1, $2)",
+			expectedArgs:  []interface{}{int64(model.DeploymentStatus_DEPLOYMENT_PENDING), int64(model.DeploymentStatus_DEPLOYMENT_RUNNING)},
+		},
+		{
+			name: "query with IN operator on Id field",
+			kind: "Project",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "Id",
+						Operator: datastore.OperatorIn,
+						Value:    []string{"pipecd"},
+					},
+				},
+			},
+			expectedQuery: "SELECT Data::text FROM Project WHERE Id IN ($1)",
+			expectedArgs:  []interface{}{"1b247cf8-ee2c-56db-af91-be9e25ff3b6a"},
+		},
+		{
+			name: "query with Contains operator",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "Tags",
+						Operator: datastore.OperatorContains,
+						Value:    "tag-1",
+					},
+				},
+			},
+			expectedQuery: "SELECT Data::text FROM Application WHERE COALESCE(Data #> '{tags}', '[]'::jsonb) @> jsonb_build_array(CAST($1 AS text))",
+			expectedArgs:  []interface{}{"tag-1"},
+		},
+		{
+			name: "query with orders and limit",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Orders: []datastore.Order{
+					{
+						Field:     "UpdatedAt",
+						Direction: datastore.Desc,
+					},
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+				Limit: 10,
+			},
+			expectedQuery: "SELECT Data::text FROM Application ORDER BY COALESCE((Data #>> '{updated_at}')::bigint, 0) DESC, Id ASC LIMIT 10",
+		},
+		{
+			name: "query with cursor",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "ProjectId",
+						Operator: datastore.OperatorEqual,
+						Value:    "project-1",
+					},
+				},
+				Orders: []datastore.Order{
+					{
+						Field:     "UpdatedAt",
+						Direction: datastore.Desc,
+					},
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+				Cursor: cursor,
+				Limit:  10,
+			},
+			expectedQuery: "SELECT Data::text FROM Application WHERE COALESCE(Data #>> '{project_id}', '') = $1 AND COALESCE((Data #>> '{updated_at}')::bigint, 0) <= $2 AND NOT (COALESCE((Data #>> '{updated_at}')::bigint, 0) = $2 AND Id <= $3) ORDER BY COALESCE((Data #>> '{updated_at}')::bigint, 0) DESC, Id ASC LIMIT 10",
+			expectedArgs:  []interface{}{"project-1", int64(100), makeRowID("object-id")},
+		},
+		{
+			name: "query with cursor on Id field only",
+			kind: "Project",
+			listOptions: datastore.ListOptions{
+				Orders: []datastore.Order{
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+				Cursor: idOnlyCursor,
+			},
+			expectedQuery: "SELECT Data::text FROM Project WHERE NOT (Id <= $1) ORDER BY Id ASC",
+			expectedArgs:  []interface{}{makeRowID("object-id")},
+		},
+		{
+			name: "error on missing Id field in orders",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Orders: []datastore.Order{
+					{
+						Field:     "UpdatedAt",
+						Direction: datastore.Desc,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error on cursor not matching orders",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Orders: []datastore.Order{
+					{
+						Field:     "CreatedAt",
+						Direction: datastore.Desc,
+					},
+					{
+						Field:     "Id",
+						Direction: datastore.Asc,
+					},
+				},
+				Cursor: cursor,
+			},
+			wantErr: true,
+		},
+		{
+			name: "error on empty IN values",
+			kind: "Application",
+			listOptions: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{
+						Field:    "Kind",
+						Operator: datastore.OperatorIn,
+						Value:    []model.ApplicationKind{},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, args, err := buildFindQuery(tc.kind, tc.listOptions)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedQuery, query)
+			assert.Equal(t, tc.expectedArgs, args)
+		})
+	}
+}
+
+func TestConvertCamelToSnake(t *testing.T) {
+	testcases := []struct {
+		input    string
+		expected string
+	}{
+		{input: "Id", expected: "id"},
+		{input: "ProjectId", expected: "project_id"},
+		{input: "DeploymentTraceCommitHash", expected: "deployment_trace_commit_hash"},
+		{input: "APIKey", expected: "api_key"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.input, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertCamelToSnake(tc.input))
+		})
+	}
+}
+
+func TestNormalizeValue(t *testing.T) {
+	testcases := []struct {
+		name         string
+		field        string
+		value        interface{}
+		expected     interface{}
+		expectedType valueType
+		wantErr      bool
+	}{
+		{
+			name:         "integral float from cursor",
+			field:        "UpdatedAt",
+			value:        float64(100),
+			expected:     int64(100),
+			expectedType: valueTypeBigint,
+		},
+		{
+			name:         "non integral float",
+			field:        "Score",
+			value:        1.5,
+			expected:     1.5,
+			expectedType: valueTypeDouble,
+		},
+		{
+			name:         "enum",
+			field:        "Kind",
+			value:        model.ApplicationKind_ECS,
+			expected:     int64(model.ApplicationKind_ECS),
+			expectedType: valueTypeBigint,
+		},
+		{
+			name:    "non string Id",
+			field:   "Id",
+			value:   1,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			field:   "Labels",
+			value:   map[string]string{},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, typ, err := normalizeValue(tc.field, tc.value)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, v)
+			assert.Equal(t, tc.expectedType, typ)
+		})
+	}
+}
@@ -48,6 +48,8 @@ type ProjectStore interface {
 	DeleteProjectRBACRole(ctx context.Context, id, name string) error
 	AddProjectUserGroup(ctx context.Context, id, sso, role string) error
 	DeleteProjectUserGroup(ctx context.Context, id, sso string) error
+	UpdateProjectRegistryWebhookConfig(ctx context.Context, id string, webhook *model.ProjectRegistryWebhookConfig) error
+	UpdateProjectFreezeWindows(ctx context.Context, id string, windows []*model.ProjectFreezeWindow) error
 }
 
 type projectStore struct {
@@ -200,3 +202,22 @@ func (s *projectStore) DeleteProjectUserGroup(ctx context.Context, id, sso strin
 		return p.DeleteUserGroup(sso)
 	})
 }
+
+// UpdateProjectRegistryWebhookConfig updates the settings for the container registry webhooks.
+func (s *projectStore) UpdateProjectRegistryWebhookConfig(ctx context.Context, id string, webhook *model.ProjectRegistryWebhookConfig) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		if p.RegistryWebhook == nil {
+			p.RegistryWebhook = &model.ProjectRegistryWebhookConfig{}
+		}
+		p.RegistryWebhook.Update(webhook)
+		return nil
+	})
+}
+
+// UpdateProjectFreezeWindows replaces the deployment freeze windows of the project.
+func (s *projectStore) UpdateProjectFreezeWindows(ctx context.Context, id string, windows []*model.ProjectFreezeWindow) error {
+	return s.update(ctx, id, func(p *model.Project) error {
+		p.FreezeWindows = windows
+		return nil
+	})
+}
@@ -0,0 +1,249 @@
+--
+-- The generated columns are named after the fields of model
+-- so that the query builder of MySQL datastore can be reused.
+--
+
+--
+-- Project table
+--
+
+CREATE TABLE IF NOT EXISTS Project (
+  Id TEXT PRIMARY KEY,
+  Data TEXT NOT NULL,
+  CreatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.created_at'), 0)) VIRTUAL,
+  UpdatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.updated_at'), 0)) VIRTUAL
+);
+
+--
+-- Application table
+--
+
+CREATE TABLE IF NOT EXISTS Application (
+  Id TEXT PRIMARY KEY,
+  Data TEXT NOT NULL,
+  ProjectId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.project_id'), '')) VIRTUAL,
+  CreatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.created_at'), 0)) VIRTUAL,
+  UpdatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.updated_at'), 0)) VIRTUAL,
+  Disabled INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.disabled'), 0)) VIRTUAL,
+  Name TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.name'), '')) VIRTUAL,
+  Kind INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.kind'), 0)) VIRTUAL,
+  SyncState_Status INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.sync_state.status'), 0)) VIRTUAL,
+  PipedId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.piped_id'), '')) VIRTUAL
+);
+
+-- index on `Disabled` and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_disabled_updated_at_desc ON Application (Disabled, UpdatedAt DESC);
+
+-- index on `Name` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_name_updated_at_desc ON Application (Name, UpdatedAt DESC);
+
+-- index on `Kind` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_kind_updated_at_desc ON Application (Kind, UpdatedAt DESC);
+
+-- index on `SyncState.Status` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_sync_state_updated_at_desc ON Application (SyncState_Status, UpdatedAt DESC);
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_project_id_updated_at_desc ON Application (ProjectId, UpdatedAt DESC);
+
+-- index on `PipedId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS application_piped_id_updated_at_desc ON Application (PipedId, UpdatedAt DESC);
+
+--
+-- Command table
+--
+
+CREATE TABLE IF NOT EXISTS Command (
+  Id TEXT PRIMARY KEY,
+  Data TEXT NOT NULL,
+  ProjectId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.project_id'), '')) VIRTUAL,
+  CreatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.created_at'), 0)) VIRTUAL,
+  UpdatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.updated_at'), 0)) VIRTUAL,
+  Status INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.status'), 0)) VIRTUAL,
+  PipedId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.piped_id'), '')) VIRTUAL
+);
+
+-- index on `Status` ASC and `CreatedAt` ASC
+CREATE INDEX IF NOT EXISTS command_status_created_at_asc ON Command (Status, CreatedAt);
+
+-- index on `PipedId` ASC
+CREATE INDEX IF NOT EXISTS command_piped_id ON Command (PipedId);
+
+--
+-- Deployment table
+--
+
+CREATE TABLE IF NOT EXISTS Deployment (
+  Id TEXT PRIMARY KEY,
+  Data TEXT NOT NULL,
+  ProjectId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.project_id'), '')) VIRTUAL,
+  CreatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.created_at'), 0)) VIRTUAL,
+  UpdatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.updated_at'), 0)) VIRTUAL,
+  ApplicationId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.application_id'), '')) VIRTUAL,
+  ApplicationName TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.application_name'), '')) VIRTUAL,
+  Kind INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.kind'), 0)) VIRTUAL,
+  Status INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.status'), 0)) VIRTUAL,
+  PipedId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.piped_id'), '')) VIRTUAL,
+  CompletedAt INTEGER GENERATED ALWAYS AS (json_extract(Data, '$.completed_at')) VIRTUAL,
+  DeploymentChainId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.deployment_chain_id'), '')) VIRTUAL,
+  DeploymentTraceCommitHash TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.deployment_trace_commit_hash'), '')) VIRTUAL
+);
+
+-- index on `ApplicationId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_application_id_updated_at_desc ON Deployment (ApplicationId, UpdatedAt DESC);
+
+-- index on `ApplicationName` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_application_name_updated_at_desc ON Deployment (ApplicationName, UpdatedAt DESC);
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_project_id_updated_at_desc ON Deployment (ProjectId, UpdatedAt DESC);
+
+-- index on `Kind` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_kind_updated_at_desc ON Deployment (Kind, UpdatedAt DESC);
+
+-- index on `Status` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_status_updated_at_desc ON Deployment (Status, UpdatedAt DESC);
+
+-- index on `PipedId` ASC
+CREATE INDEX IF NOT EXISTS deployment_piped_id ON Deployment (PipedId);
+
+-- index on `CompletedAt` DESC and `Id` ASC
+CREATE INDEX IF NOT EXISTS deployment_completed_at_desc_id ON Deployment (CompletedAt DESC, Id);
+
+-- index on `CompletedAt` ASC and `Id` ASC
+CREATE INDEX IF NOT EXISTS deployment_completed_at_id_asc ON Deployment (CompletedAt, Id);
+
+-- index on `DeploymentChainId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_chain_id_updated_at_desc ON Deployment (DeploymentChainId, UpdatedAt DESC);
+
+-- index on `DeploymentTraceCommitHash` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deployment_trace_commit_hash_updated_at_desc ON Deployment (DeploymentTraceCommitHash, UpdatedAt DESC);
+
+--
+-- Piped table
+--
+
+CREATE TABLE IF NOT EXISTS Piped (
+  Id TEXT PRIMARY KEY,
+  Data TEXT NOT NULL,
+  ProjectId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.project_id'), '')) VIRTUAL,
+  CreatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.created_at'), 0)) VIRTUAL,
+  UpdatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.updated_at'), 0)) VIRTUAL,
+  Disabled INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.disabled'), 0)) VIRTUAL
+);
+
+-- index on `ProjectId` ASC
+CREATE INDEX IF NOT EXISTS piped_project_id_asc ON Piped (ProjectId);
+
+--
+-- APIKey table
+--
+
+CREATE TABLE IF NOT EXISTS APIKey (
+  Id TEXT PRIMARY KEY,
+  Data TEXT NOT NULL,
+  ProjectId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.project_id'), '')) VIRTUAL,
+  CreatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.created_at'), 0)) VIRTUAL,
+  UpdatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.updated_at'), 0)) VIRTUAL,
+  Disabled INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.disabled'), 0)) VIRTUAL
+);
+
+--
+-- Event table
+--
+
+CREATE TABLE IF NOT EXISTS Event (
+  Id TEXT PRIMARY KEY,
+  Data TEXT NOT NULL,
+  ProjectId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.project_id'), '')) VIRTUAL,
+  CreatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.created_at'), 0)) VIRTUAL,
+  UpdatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.updated_at'), 0)) VIRTUAL,
+  EventKey TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.event_key'), '')) VIRTUAL,
+  Name TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.name'), '')) VIRTUAL,
+  Status INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.status'), 0)) VIRTUAL
+);
+
+-- index on `ProjectId` ASC and `CreatedAt` ASC
+CREATE INDEX IF NOT EXISTS event_project_id_created_at_asc ON Event (ProjectId, CreatedAt);
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_project_id_updated_at_desc ON Event (ProjectId, UpdatedAt DESC);
+
+-- index on `EventKey` ASC, `Name` ASC, `ProjectId` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_event_key_name_project_id_created_at_desc ON Event (EventKey, Name, ProjectId, CreatedAt DESC);
+
+-- index on `ProjectId` ASC, `Status` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_project_id_status_created_at_desc ON Event (ProjectId, Status, CreatedAt DESC);
+
+-- index on `ProjectId` ASC, `Status` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_project_id_status_updated_at_desc ON Event (ProjectId, Status, UpdatedAt DESC);
+
+-- index on `Name` ASC, `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_name_project_id_updated_at_desc ON Event (Name, ProjectId, UpdatedAt DESC);
+
+-- index on `Name` ASC, `ProjectId` ASC, `Status` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS event_name_project_id_status_updated_at_desc ON Event (Name, ProjectId, Status, UpdatedAt DESC);
+
+--
+-- DeploymentChain table
+--
+
+CREATE TABLE IF NOT EXISTS DeploymentChain (
+  Id TEXT PRIMARY KEY,
+  Data TEXT NOT NULL,
+  ProjectId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.project_id'), '')) VIRTUAL,
+  CreatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.created_at'), 0)) VIRTUAL,
+  UpdatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.updated_at'), 0)) VIRTUAL,
+  Status INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.status'), 0)) VIRTUAL
+);
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deploymentchain_project_id_updated_at_desc ON DeploymentChain (ProjectId, UpdatedAt DESC);
+
+-- index on `Status` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deploymentchain_status_updated_at_desc ON DeploymentChain (Status, UpdatedAt DESC);
+
+--
+-- DeploymentTrace table
+--
+
+CREATE TABLE IF NOT EXISTS DeploymentTrace (
+  Id TEXT PRIMARY KEY,
+  Data TEXT NOT NULL,
+  ProjectId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.project_id'), '')) VIRTUAL,
+  CreatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.created_at'), 0)) VIRTUAL,
+  UpdatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.updated_at'), 0)) VIRTUAL,
+  CommitHash TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.commit_hash'), '')) VIRTUAL
+);
+
+-- index on `ProjectId` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deploymenttrace_project_id_updated_at_desc ON DeploymentTrace (ProjectId, UpdatedAt DESC);
+
+-- index on `CommitHash` ASC and `UpdatedAt` DESC
+CREATE INDEX IF NOT EXISTS deploymenttrace_commit_hash_updated_at_desc ON DeploymentTrace (CommitHash, UpdatedAt DESC);
+
+--
+-- AuditLog table
+--
+
+CREATE TABLE IF NOT EXISTS AuditLog (
+  Id TEXT PRIMARY KEY,
+  Data TEXT NOT NULL,
+  ProjectId TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.project_id'), '')) VIRTUAL,
+  CreatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.created_at'), 0)) VIRTUAL,
+  UpdatedAt INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.updated_at'), 0)) VIRTUAL,
+  Actor TEXT GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.actor'), '')) VIRTUAL,
+  ResourceType INTEGER GENERATED ALWAYS AS (COALESCE(json_extract(Data, '$.resource_type'), 0)) VIRTUAL
+);
+
+-- index on `ProjectId` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS auditlog_project_id_created_at_desc ON AuditLog (ProjectId, CreatedAt DESC);
+
+-- index on `Actor` ASC, `ProjectId` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS auditlog_actor_project_id_created_at_desc ON AuditLog (Actor, ProjectId, CreatedAt DESC);
+
+-- index on `ResourceType` ASC, `ProjectId` ASC and `CreatedAt` DESC
+CREATE INDEX IF NOT EXISTS auditlog_resource_type_project_id_created_at_desc ON AuditLog (ResourceType, ProjectId, CreatedAt DESC);
+
+-- index on `CreatedAt` ASC
+CREATE INDEX IF NOT EXISTS auditlog_created_at_asc ON AuditLog (CreatedAt);
@@ -0,0 +1,332 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlite provides a datastore backed by an embedded SQLite database.
+// It is intended to run a control plane as a single binary, e.g. for quickstart,
+// and to run the store logic in tests without any external services.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/datastore/mysql"
+)
+
+// InMemory is the path to use an in-memory database
+// whose data will be lost once the datastore is closed.
+const InMemory = ":memory:"
+
+//go:embed schema.sql
+var databaseSchema string
+
+// dialect is the difference from MySQL to be used in the shared query builder.
+var dialect = mysql.Dialect{
+	IDPlaceholder: "?",
+	ContainsCondition: func(field string) string {
+		return fmt.Sprintf("? IN (SELECT value FROM json_each(%s))", field)
+	},
+}
+
+// SQLite client wrapper
+type SQLite struct {
+	client *sql.DB
+	logger *zap.Logger
+}
+
+// Option for create SQLite typed instance
+type Option func(*SQLite)
+
+// WithLogger returns logger setup function
+func WithLogger(logger *zap.Logger) Option {
+	return func(s *SQLite) {
+		s.logger = logger
+	}
+}
+
+// NewSQLite opens the SQLite database file at the given path
+// and prepares its schema and indexes.
+// The file will be created if it does not exist.
+// Use InMemory as the path to use an in-memory database.
+func NewSQLite(ctx context.Context, path string, opts ...Option) (*SQLite, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path is required field")
+	}
+	s := &SQLite{
+		logger: zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.logger = s.logger.Named("sqlite")
+
+	db, err := sql.Open("sqlite", buildDataSourceName(path))
+	if err != nil {
+		return nil, err
+	}
+	// Each connection to an in-memory database has its own database,
+	// so only one connection must be used.
+	if path == InMemory {
+		db.SetMaxOpenConns(1)
+	}
+	s.client = db
+
+	if _, err := db.ExecContext(ctx, databaseSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to prepare sqlite database schema: %w", err)
+	}
+	return s, nil
+}
+
+// Find implementation for SQLite
+func (s *SQLite) Find(ctx context.Context, col datastore.Collection, opts datastore.ListOptions) (datastore.Iterator, error) {
+	kind := col.Kind()
+	if opts.Cursor != "" && len(opts.Orders) == 0 {
+		return nil, errors.New("opts.Cursor also requires Orders to be set")
+	}
+
+	query, err := mysql.BuildFindQuery(kind, opts, dialect)
+	if err != nil {
+		s.logger.Error("failed to build find entities query",
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	vals, err := mysql.MakeFindQueryValues(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.client.QueryContext(ctx, query, vals...)
+	if err != nil {
+		s.logger.Error("failed to find entities",
+			zap.String("kind", kind),
+			zap.String("query", query),
+			zap.Any("whereConditionValues", vals),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return mysql.NewIterator(rows, opts.Orders), nil
+}
+
+// Get implementation for SQLite
+func (s *SQLite) Get(ctx context.Context, col datastore.Collection, id string, v interface{}) error {
+	kind := col.Kind()
+	row := s.client.QueryRowContext(ctx, buildGetQuery(kind), id)
+	var val string
+	err := row.Scan(&val)
+	if err == sql.ErrNoRows {
+		return datastore.ErrNotFound
+	}
+	if err != nil {
+		s.logger.Error("failed to get entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return json.Unmarshal([]byte(val), v)
+}
+
+// Create implementation for SQLite
+func (s *SQLite) Create(ctx context.Context, col datastore.Collection, id string, entity interface{}) error {
+	kind := col.Kind()
+	data, err := encodeJSONValue(entity)
+	if err != nil {
+		s.logger.Error("failed to create entity: failed to encode json data",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	_, err = s.client.ExecContext(ctx, buildCreateQuery(kind), id, data)
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY {
+		return datastore.ErrAlreadyExists
+	}
+	if err != nil {
+		s.logger.Error("failed to create entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// Update implementation for SQLite
+func (s *SQLite) Update(ctx context.Context, col datastore.Collection, id string, updater datastore.Updater) error {
+	kind := col.Kind()
+	// The transaction is started with IMMEDIATE mode
+	// to prevent concurrent updates from being lost.
+	tx, err := s.client.BeginTx(ctx, nil)
+	if err != nil {
+		s.logger.Error("failed to update entity: failed to start transaction",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	row := tx.QueryRowContext(ctx, buildGetQuery(kind), id)
+	var val string
+	err = row.Scan(&val)
+	if err == sql.ErrNoRows {
+		tx.Rollback()
+		return datastore.ErrNotFound
+	}
+	if err != nil {
+		s.logger.Error("failed to update entity: failed to get entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		tx.Rollback()
+		return err
+	}
+
+	entity := col.Factory()()
+	if err := json.Unmarshal([]byte(val), entity); err != nil {
+		s.logger.Error("failed to update entity: failed to decode data",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		tx.Rollback()
+		return err
+	}
+
+	if err := updater(entity); err != nil {
+		s.logger.Error("failed to update entity: failed to apply updater",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		tx.Rollback()
+		return err
+	}
+
+	data, err := encodeJSONValue(entity)
+	if err != nil {
+		s.logger.Error("failed to update entity: failed to encode json data",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		tx.Rollback()
+		return err
+	}
+	_, err = tx.ExecContext(ctx, buildUpdateQuery(kind), data, id)
+	if err != nil {
+		s.logger.Error("failed to update entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete implementation for SQLite
+func (s *SQLite) Delete(ctx context.Context, col datastore.Collection, id string) error {
+	kind := col.Kind()
+	res, err := s.client.ExecContext(ctx, buildDeleteQuery(kind), id)
+	if err != nil {
+		s.logger.Error("failed to delete entity",
+			zap.String("id", id),
+			zap.String("kind", kind),
+			zap.Error(err),
+		)
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return datastore.ErrNotFound
+	}
+	return nil
+}
+
+// Close implementation for SQLite
+func (s *SQLite) Close() error {
+	return s.client.Close()
+}
+
+// Ping implementation for SQLite
+func (s *SQLite) Ping() error {
+	return s.client.Ping()
+}
+
+func buildDataSourceName(path string) string {
+	params := []string{
+		// Use IMMEDIATE transactions to take the write lock at the beginning
+		// instead of failing while upgrading the lock in Update.
+		"_txlock=immediate",
+		"_pragma=busy_timeout(5000)",
+	}
+	if path != InMemory {
+		params = append(params, "_pragma=journal_mode(WAL)")
+	}
+	return fmt.Sprintf("file:%s?%s", path, strings.Join(params, "&"))
+}
+
+func buildGetQuery(table string) string {
+	return fmt.Sprintf("SELECT Data FROM %s WHERE Id = ?", table)
+}
+
+func buildUpdateQuery(table string) string {
+	return fmt.Sprintf("UPDATE %s SET Data = ? WHERE Id = ?", table)
+}
+
+func buildCreateQuery(table string) string {
+	return fmt.Sprintf("INSERT INTO %s (Id, Data) VALUES (?, ?)", table)
+}
+
+func buildDeleteQuery(table string) string {
+	return fmt.Sprintf("DELETE FROM %s WHERE Id = ?", table)
+}
+
+func encodeJSONValue(entity interface{}) (string, error) {
+	if entity == nil {
+		return "", fmt.Errorf("nil entity given")
+	}
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,244 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func newTestSQLite(t *testing.T) *SQLite {
+	t.Helper()
+	s, err := NewSQLite(context.Background(), InMemory)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	return s
+}
+
+func newApplication(id, projectID string, kind model.ApplicationKind, updatedAt int64) *model.Application {
+	return &model.Application{
+		Id:        id,
+		Name:      "name-" + id,
+		PipedId:   "piped-id",
+		ProjectId: projectID,
+		Kind:      kind,
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{Id: "repo-id"},
+			Path: "path",
+		},
+		PlatformProvider: "platform-provider",
+		CreatedAt:        updatedAt,
+		UpdatedAt:        updatedAt,
+	}
+}
+
+func TestNewSQLite(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "pipecd.db")
+
+	s, err := NewSQLite(ctx, path)
+	require.NoError(t, err)
+	store := datastore.NewApplicationStore(s)
+	require.NoError(t, store.Add(ctx, newApplication("app-1", "project-1", model.ApplicationKind_KUBERNETES, 1)))
+	require.NoError(t, s.Close())
+
+	// Data must be kept after reopening the database file.
+	s, err = NewSQLite(ctx, path)
+	require.NoError(t, err)
+	defer s.Close()
+	store = datastore.NewApplicationStore(s)
+	app, err := store.Get(ctx, "app-1")
+	require.NoError(t, err)
+	assert.Equal(t, "name-app-1", app.Name)
+
+	_, err = NewSQLite(ctx, "")
+	assert.Error(t, err)
+}
+
+func TestCreateGetUpdate(t *testing.T) {
+	ctx := context.Background()
+	store := datastore.NewApplicationStore(newTestSQLite(t))
+
+	_, err := store.Get(ctx, "app-1")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+
+	app := newApplication("app-1", "project-1", model.ApplicationKind_KUBERNETES, 1)
+	require.NoError(t, store.Add(ctx, app))
+	assert.ErrorIs(t, store.Add(ctx, app), datastore.ErrAlreadyExists)
+
+	require.NoError(t, store.Disable(ctx, "app-1"))
+	got, err := store.Get(ctx, "app-1")
+	require.NoError(t, err)
+	assert.True(t, got.Disabled)
+
+	assert.ErrorIs(t, store.Disable(ctx, "not-found"), datastore.ErrNotFound)
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	store := datastore.NewAuditLogStore(newTestSQLite(t))
+
+	log := model.AuditLog{
+		Id:           "log-1",
+		ProjectId:    "project-1",
+		Actor:        "user",
+		Method:       "/grpc.service.webservice.WebService/DisablePiped",
+		ResourceType: model.ProjectRBACResource_PIPED,
+		Action:       model.ProjectRBACPolicy_UPDATE,
+		StatusCode:   "OK",
+	}
+	require.NoError(t, store.Add(ctx, log))
+
+	require.NoError(t, store.Delete(ctx, "log-1"))
+	assert.ErrorIs(t, store.Delete(ctx, "log-1"), datastore.ErrNotFound)
+
+	logs, _, err := store.List(ctx, datastore.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+}
+
+func TestFind(t *testing.T) {
+	ctx := context.Background()
+	store := datastore.NewApplicationStore(newTestSQLite(t))
+
+	for i := 0; i < 5; i++ {
+		app := newApplication(fmt.Sprintf("app-%d", i), "project-1", model.ApplicationKind_KUBERNETES, int64(i))
+		require.NoError(t, store.Add(ctx, app))
+	}
+	require.NoError(t, store.Add(ctx, newApplication("app-ecs", "project-1", model.ApplicationKind_ECS, 10)))
+	require.NoError(t, store.Add(ctx, newApplication("app-other", "project-2", model.ApplicationKind_KUBERNETES, 10)))
+	require.NoError(t, store.Disable(ctx, "app-0"))
+
+	testcases := []struct {
+		name     string
+		opts     datastore.ListOptions
+		expected []string
+	}{
+		{
+			name: "filter by string field",
+			opts: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{Field: "ProjectId", Operator: datastore.OperatorEqual, Value: "project-2"},
+				},
+			},
+			expected: []string{"app-other"},
+		},
+		{
+			name: "filter by enum and boolean fields",
+			opts: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{Field: "ProjectId", Operator: datastore.OperatorEqual, Value: "project-1"},
+					{Field: "Kind", Operator: datastore.OperatorEqual, Value: model.ApplicationKind_KUBERNETES},
+					{Field: "Disabled", Operator: datastore.OperatorEqual, Value: false},
+				},
+				Orders: []datastore.Order{
+					{Field: "UpdatedAt", Direction: datastore.Desc},
+					{Field: "Id", Direction: datastore.Asc},
+				},
+			},
+			expected: []string{"app-4", "app-3", "app-2", "app-1"},
+		},
+		{
+			name: "filter by IN operator",
+			opts: datastore.ListOptions{
+				Filters: []datastore.ListFilter{
+					{Field: "Kind", Operator: datastore.OperatorIn, Value: []model.ApplicationKind{model.ApplicationKind_ECS, model.ApplicationKind_LAMBDA}},
+				},
+			},
+			expected: []string{"app-ecs"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			apps, _, err := store.List(ctx, tc.opts)
+			require.NoError(t, err)
+			ids := make([]string, 0, len(apps))
+			for _, app := range apps {
+				ids = append(ids, app.Id)
+			}
+			assert.Equal(t, tc.expected, ids)
+		})
+	}
+}
+
+func TestFindWithCursor(t *testing.T) {
+	ctx := context.Background()
+	store := datastore.NewDeploymentStore(newTestSQLite(t))
+
+	for i := 0; i < 5; i++ {
+		d := &model.Deployment{
+			Id:              fmt.Sprintf("deployment-%d", i),
+			ApplicationId:   "app-1",
+			ApplicationName: "app",
+			PipedId:         "piped-id",
+			ProjectId:       "project-1",
+			Kind:            model.ApplicationKind_KUBERNETES,
+			GitPath: &model.ApplicationGitPath{
+				Repo: &model.ApplicationGitRepository{Id: "repo-id"},
+				Path: "path",
+			},
+			Trigger: &model.DeploymentTrigger{
+				Commit: &model.Commit{
+					Hash:      "hash",
+					Message:   "message",
+					Author:    "author",
+					Branch:    "branch",
+					CreatedAt: 1,
+				},
+				Timestamp: 1,
+			},
+			Status: model.DeploymentStatus_DEPLOYMENT_PENDING,
+			// Two deployments have the same UpdatedAt to test the pagination by Id.
+			CreatedAt: int64(i/2 + 1),
+			UpdatedAt: int64(i/2 + 1),
+		}
+		require.NoError(t, store.Add(ctx, d))
+	}
+
+	opts := datastore.ListOptions{
+		Limit: 2,
+		Filters: []datastore.ListFilter{
+			{Field: "ApplicationId", Operator: datastore.OperatorEqual, Value: "app-1"},
+		},
+		Orders: []datastore.Order{
+			{Field: "UpdatedAt", Direction: datastore.Desc},
+			{Field: "Id", Direction: datastore.Asc},
+		},
+	}
+	var ids []string
+	for {
+		deployments, cursor, err := store.List(ctx, opts)
+		require.NoError(t, err)
+		for _, d := range deployments {
+			ids = append(ids, d.Id)
+		}
+		if len(deployments) < opts.Limit {
+			break
+		}
+		opts.Cursor = cursor
+	}
+	assert.Equal(t, []string{"deployment-4", "deployment-2", "deployment-3", "deployment-0", "deployment-1"}, ids)
+}
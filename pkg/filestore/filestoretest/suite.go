@@ -0,0 +1,150 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filestoretest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+)
+
+// RunStoreTests runs the common test suite against the given empty store
+// to verify that it behaves like the other filestore.Store implementations.
+func RunStoreTests(t *testing.T, s filestore.Store) {
+	ctx := context.Background()
+
+	t.Run("get non-existent object", func(t *testing.T) {
+		_, err := s.Get(ctx, "suite/not-found/object.json")
+		assert.ErrorIs(t, err, filestore.ErrNotFound)
+
+		_, err = s.GetReader(ctx, "suite/not-found/object.json")
+		assert.ErrorIs(t, err, filestore.ErrNotFound)
+	})
+
+	t.Run("put then get", func(t *testing.T) {
+		require.NoError(t, s.Put(ctx, "suite/put/object.json", []byte("content")))
+
+		got, err := s.Get(ctx, "suite/put/object.json")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("content"), got)
+
+		rc, err := s.GetReader(ctx, "suite/put/object.json")
+		require.NoError(t, err)
+		got, err = io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		assert.Equal(t, []byte("content"), got)
+	})
+
+	t.Run("put overwrites existing object", func(t *testing.T) {
+		require.NoError(t, s.Put(ctx, "suite/overwrite/object.json", []byte("old")))
+		require.NoError(t, s.Put(ctx, "suite/overwrite/object.json", []byte("new")))
+
+		got, err := s.Get(ctx, "suite/overwrite/object.json")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("new"), got)
+	})
+
+	t.Run("list by prefix", func(t *testing.T) {
+		paths := []string{
+			"suite/list/a/1.log",
+			"suite/list/a/2.log",
+			"suite/list/ab/1.log",
+			"suite/list/b/1.log",
+		}
+		for _, p := range paths {
+			require.NoError(t, s.Put(ctx, p, []byte(p)))
+		}
+
+		testcases := []struct {
+			prefix   string
+			expected []string
+		}{
+			{prefix: "suite/list/", expected: paths},
+			{prefix: "suite/list/a/", expected: paths[:2]},
+			{prefix: "suite/list/a", expected: paths[:3]},
+			{prefix: "suite/list/b/1.log", expected: paths[3:]},
+			{prefix: "suite/list/c/", expected: nil},
+		}
+		for _, tc := range testcases {
+			objects, err := s.List(ctx, tc.prefix)
+			require.NoError(t, err)
+
+			got := make([]string, 0, len(objects))
+			for _, o := range objects {
+				got = append(got, o.Path)
+				assert.Equal(t, int64(len(o.Path)), o.Size, o.Path)
+				assert.NotZero(t, o.UpdatedAt, o.Path)
+			}
+			assert.ElementsMatch(t, tc.expected, got, tc.prefix)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, s.Put(ctx, "suite/delete/object.json", []byte("content")))
+		require.NoError(t, s.Put(ctx, "suite/delete/other.json", []byte("content")))
+		require.NoError(t, s.Delete(ctx, "suite/delete/object.json"))
+
+		_, err := s.Get(ctx, "suite/delete/object.json")
+		assert.ErrorIs(t, err, filestore.ErrNotFound)
+
+		objects, err := s.List(ctx, "suite/delete/")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, "suite/delete/other.json", objects[0].Path)
+	})
+
+	t.Run("concurrent access", func(t *testing.T) {
+		const (
+			workers = 8
+			rounds  = 20
+		)
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for r := 0; r < rounds; r++ {
+					content := []byte(fmt.Sprintf("worker-%d-round-%d", w, r))
+					assert.NoError(t, s.Put(ctx, "suite/concurrent/shared.json", content))
+					got, err := s.Get(ctx, "suite/concurrent/shared.json")
+					if assert.NoError(t, err) {
+						// Readers must never observe a partially written object.
+						assert.Regexp(t, `^worker-\d+-round-\d+$`, string(got))
+					}
+
+					own := fmt.Sprintf("suite/concurrent/%d/%d.json", w, r)
+					assert.NoError(t, s.Put(ctx, own, content))
+					_, err = s.List(ctx, "suite/concurrent/")
+					assert.NoError(t, err)
+					assert.NoError(t, s.Delete(ctx, own))
+				}
+			}(w)
+		}
+		wg.Wait()
+
+		objects, err := s.List(ctx, "suite/concurrent/")
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, "suite/concurrent/shared.json", objects[0].Path)
+	})
+}
@@ -0,0 +1,263 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package local provides a filestore.Store implementation
+// which keeps all objects as files under a directory of the local filesystem.
+// It is intended for single-node control planes that do not want to run an object storage.
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore"
+)
+
+// tmpDirName is the name of the directory under the root directory
+// where the contents are written before being moved to their final paths.
+// It must not be a valid object path to avoid being listed.
+const tmpDirName = ".tmp"
+
+// maxMoveAttempts is the maximum number of attempts to move a written object to its final path.
+// The move is retried because the parent directories may be removed by a concurrent Delete,
+// which can also be called by another process sharing the same directory.
+const maxMoveAttempts = 10
+
+type Store struct {
+	root   string
+	tmpDir string
+
+	logger *zap.Logger
+}
+
+type Option func(*Store)
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(s *Store) {
+		s.logger = logger.Named("local")
+	}
+}
+
+// NewStore creates a new Store which stores objects under the given directory.
+// The directory is created if it does not exist.
+func NewStore(dir string, opts ...Option) (*Store, error) {
+	if dir == "" {
+		return nil, errors.New("dir must be set")
+	}
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get absolute path of %s: %w", dir, err)
+	}
+
+	s := &Store{
+		root:   root,
+		tmpDir: filepath.Join(root, tmpDirName),
+		logger: zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if err := os.MkdirAll(s.tmpDir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create directory %s: %w", s.tmpDir, err)
+	}
+	return s, nil
+}
+
+// filePath converts the given object path to the path of the file storing it.
+func (s *Store) filePath(path string) (string, error) {
+	if path == "" || strings.HasSuffix(path, "/") {
+		return "", fmt.Errorf("invalid object path %q", path)
+	}
+	p := filepath.Clean(filepath.FromSlash("/" + path))
+	if p != filepath.FromSlash("/"+path) {
+		return "", fmt.Errorf("object path %q must be clean", path)
+	}
+	if first := strings.SplitN(path, "/", 2)[0]; first == tmpDirName {
+		return "", fmt.Errorf("object path %q is reserved", path)
+	}
+	return filepath.Join(s.root, p), nil
+}
+
+func (s *Store) GetReader(_ context.Context, path string) (io.ReadCloser, error) {
+	p, err := s.filePath(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, filestore.ErrNotFound
+	}
+	if err != nil {
+		s.logger.Error("failed to open file", zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *Store) Get(ctx context.Context, path string) ([]byte, error) {
+	rc, err := s.GetReader(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rc.Close(); err != nil {
+			s.logger.Error("failed to close object reader")
+		}
+	}()
+
+	return io.ReadAll(rc)
+}
+
+// Put writes the content into a temporary file and then renames it to the final path,
+// so that readers never see a partially written object.
+// It is safe to be called concurrently with Delete, even from another process using the same directory.
+func (s *Store) Put(_ context.Context, path string, content []byte) error {
+	p, err := s.filePath(path)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(s.tmpDir, "object-")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to sync temporary file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+
+	for i := 1; ; i++ {
+		err := moveFile(tmp, p)
+		if err == nil {
+			return nil
+		}
+		// The parent directory was removed by a concurrent Delete after it was created.
+		if errors.Is(err, fs.ErrNotExist) && i < maxMoveAttempts {
+			continue
+		}
+		return fmt.Errorf("failed to move object to %s: %w", path, err)
+	}
+}
+
+// moveFile moves the file at src to dst, creating the parent directories of dst.
+func moveFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+		return err
+	}
+	return os.Rename(src, dst)
+}
+
+// Delete removes the object at the given path and its parent directories that became empty.
+// Deleting a non-existent object is not treated as an error.
+func (s *Store) Delete(_ context.Context, path string) error {
+	p, err := s.filePath(path)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete object %s: %w", path, err)
+	}
+	for dir := filepath.Dir(p); dir != s.root; dir = filepath.Dir(dir) {
+		// Remove fails in case the directory is not empty or already removed.
+		if err := os.Remove(dir); err != nil {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *Store) List(_ context.Context, prefix string) ([]filestore.ObjectAttrs, error) {
+	// Start walking from the deepest directory covered by the prefix.
+	dir := s.root
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		p, err := s.filePath(prefix[:i+1] + "_")
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Dir(p)
+	}
+
+	objects := make([]filestore.ObjectAttrs, 0)
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// The directory may be removed by a concurrent Delete.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if p == s.tmpDir {
+			return filepath.SkipDir
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+		path := filepath.ToSlash(rel)
+		if !strings.HasPrefix(path, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		objects = append(objects, filestore.ObjectAttrs{
+			Path:      path,
+			Size:      info.Size(),
+			Etag:      fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size()),
+			UpdatedAt: info.ModTime().Unix(),
+		})
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to list objects",
+			zap.String("prefix", prefix),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return objects, nil
+}
+
+func (s *Store) Close() error {
+	return nil
+}
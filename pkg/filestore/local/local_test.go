@@ -0,0 +1,109 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pipe-cd/pipecd/pkg/filestore/filestoretest"
+)
+
+func TestStore(t *testing.T) {
+	s, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+
+	filestoretest.RunStoreTests(t, s)
+}
+
+func TestInvalidPath(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, p := range []string{"", "dir/", "../escape", "a/../../escape", "a//b", ".tmp/object"} {
+		assert.Error(t, s.Put(ctx, p, []byte("content")), p)
+		_, err := s.Get(ctx, p)
+		assert.Error(t, err, p)
+		assert.Error(t, s.Delete(ctx, p), p)
+	}
+	_, err = s.List(ctx, "../")
+	assert.Error(t, err)
+}
+
+func TestDeleteRemovesEmptyDirectories(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	s, err := NewStore(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, s.Put(ctx, "a/b/c/object.json", []byte("content")))
+	require.NoError(t, s.Put(ctx, "a/other.json", []byte("content")))
+
+	require.NoError(t, s.Delete(ctx, "a/b/c/object.json"))
+	_, err = os.Stat(filepath.Join(dir, "a", "b"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(dir, "a", "other.json"))
+	assert.NoError(t, err)
+
+	// Deleting non-existent object is not an error.
+	assert.NoError(t, s.Delete(ctx, "a/b/c/object.json"))
+}
+
+func TestConcurrentPutAndDeleteOnSharedDirectory(t *testing.T) {
+	t.Parallel()
+
+	// Two stores on the same directory behave like two processes sharing it,
+	// so they do not share any in-process lock.
+	dir := t.TempDir()
+	s1, err := NewStore(dir)
+	require.NoError(t, err)
+	s2, err := NewStore(dir)
+	require.NoError(t, err)
+
+	var (
+		ctx = context.Background()
+		eg  errgroup.Group
+	)
+	for i, s := range []*Store{s1, s2} {
+		eg.Go(func() error {
+			for j := 0; j < 500; j++ {
+				path := fmt.Sprintf("prefix/a/b/object-%d-%d.json", i, j)
+				if err := s.Put(ctx, path, []byte("content")); err != nil {
+					return err
+				}
+				if err := s.Delete(ctx, path); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	require.NoError(t, eg.Wait())
+
+	_, err = os.Stat(filepath.Join(dir, "prefix"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -26,6 +26,12 @@ type DeploymentData struct {
 	CompletedAt       int64             `json:"completed_at"`
 	CompleteStatus    string            `json:"complete_status"`
 	RollbackStartedAt int64             `json:"rollback_started_at"`
+	// The time when the commit triggered this deployment was created.
+	CommitTimestamp int64 `json:"commit_timestamp,omitempty"`
+	// The time when the first failed deployment since the previous
+	// successful one of the same application was completed.
+	// This is only set for a successful deployment that recovered the application.
+	FailureStartedAt int64 `json:"failure_started_at,omitempty"`
 }
 
 func BuildDeploymentData(d *model.Deployment) DeploymentData {
@@ -42,6 +48,7 @@ func BuildDeploymentData(d *model.Deployment) DeploymentData {
 		CompletedAt:       d.CompletedAt,
 		RollbackStartedAt: rollbackStartedAt,
 		CompleteStatus:    d.Status.String(),
+		CommitTimestamp:   d.Trigger.GetCommit().GetCreatedAt(),
 	}
 }
 
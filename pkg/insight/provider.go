@@ -25,6 +25,12 @@ type Provider interface {
 	GetApplicationCounts(ctx context.Context, projectID string) (*ApplicationCounts, error)
 	GetDeploymentFrequencyDataPoints(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightDataPoint, error)
 	GetDeploymentChangeFailureRateDataPoints(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightDataPoint, error)
+	// GetDeploymentLeadTimeDataPoints returns the average time in seconds
+	// from the commit being created to it being successfully deployed.
+	GetDeploymentLeadTimeDataPoints(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightDataPoint, error)
+	// GetMTTRDataPoints returns the average time in seconds
+	// from a failed deployment to the next successful one of the same application.
+	GetMTTRDataPoints(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightDataPoint, error)
 }
 
 type provider struct {
@@ -67,6 +73,26 @@ func (p *provider) GetDeploymentChangeFailureRateDataPoints(ctx context.Context,
 	return fillUpDataPoints(points, rangeFrom, rangeTo, resolution), nil
 }
 
+func (p *provider) GetDeploymentLeadTimeDataPoints(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightDataPoint, error) {
+	ds, err := p.store.ListCompletedDeployments(ctx, projectID, rangeFrom, rangeTo)
+	if err != nil {
+		return nil, err
+	}
+
+	points := buildDeploymentLeadTimeDataPoints(ds, appID, labels, resolution)
+	return fillUpDataPoints(points, rangeFrom, rangeTo, resolution), nil
+}
+
+func (p *provider) GetMTTRDataPoints(ctx context.Context, projectID, appID string, labels map[string]string, rangeFrom, rangeTo int64, resolution model.InsightResolution) ([]*model.InsightDataPoint, error) {
+	ds, err := p.store.ListCompletedDeployments(ctx, projectID, rangeFrom, rangeTo)
+	if err != nil {
+		return nil, err
+	}
+
+	points := buildMTTRDataPoints(ds, appID, labels, resolution)
+	return fillUpDataPoints(points, rangeFrom, rangeTo, resolution), nil
+}
+
 func buildDeploymentFrequencyDataPoints(ds []*DeploymentData, appID string, labels map[string]string, resolution model.InsightResolution) []*model.InsightDataPoint {
 	ds = filterDeploymentData(ds, appID, labels)
 	if len(ds) == 0 {
@@ -128,6 +154,70 @@ func buildDeploymentChangeFailureRateDataPoints(ds []*DeploymentData, appID stri
 	return out
 }
 
+func buildDeploymentLeadTimeDataPoints(ds []*DeploymentData, appID string, labels map[string]string, resolution model.InsightResolution) []*model.InsightDataPoint {
+	return buildAverageDurationDataPoints(ds, appID, labels, resolution, func(d *DeploymentData) (int64, bool) {
+		if d.CompleteStatus != model.DeploymentStatus_DEPLOYMENT_SUCCESS.String() || d.CommitTimestamp == 0 {
+			return 0, false
+		}
+		return d.CompletedAt - d.CommitTimestamp, true
+	})
+}
+
+func buildMTTRDataPoints(ds []*DeploymentData, appID string, labels map[string]string, resolution model.InsightResolution) []*model.InsightDataPoint {
+	return buildAverageDurationDataPoints(ds, appID, labels, resolution, func(d *DeploymentData) (int64, bool) {
+		if d.CompleteStatus != model.DeploymentStatus_DEPLOYMENT_SUCCESS.String() || d.FailureStartedAt == 0 {
+			return 0, false
+		}
+		return d.CompletedAt - d.FailureStartedAt, true
+	})
+}
+
+// buildAverageDurationDataPoints builds data points whose value is the average of
+// the durations returned by the given function for the deployments in each step.
+// Deployments for which the function returns false are not taken into account.
+func buildAverageDurationDataPoints(ds []*DeploymentData, appID string, labels map[string]string, resolution model.InsightResolution, duration func(*DeploymentData) (int64, bool)) []*model.InsightDataPoint {
+	ds = filterDeploymentData(ds, appID, labels)
+	if len(ds) == 0 {
+		return []*model.InsightDataPoint{}
+	}
+
+	var (
+		out                              = make([]*model.InsightDataPoint, 0)
+		curPoint *model.InsightDataPoint = nil
+		curTotal int64                   = 0
+		curCount int64                   = 0
+	)
+	for _, d := range ds {
+		v, ok := duration(d)
+		if !ok {
+			continue
+		}
+		if v < 0 {
+			v = 0
+		}
+
+		completedAt := roundTimeByResolution(d.CompletedAt, resolution)
+		if curPoint == nil || curPoint.Timestamp != completedAt {
+			if curPoint != nil {
+				curPoint.Value = float32(curTotal) / float32(curCount)
+				curTotal = 0
+				curCount = 0
+			}
+			curPoint = &model.InsightDataPoint{
+				Timestamp: completedAt,
+			}
+			out = append(out, curPoint)
+		}
+		curTotal += v
+		curCount += 1
+	}
+	if curPoint != nil {
+		curPoint.Value = float32(curTotal) / float32(curCount)
+	}
+
+	return out
+}
+
 func filterDeploymentData(ds []*DeploymentData, appID string, labels map[string]string) []*DeploymentData {
 	if appID == "" && len(labels) == 0 {
 		return ds
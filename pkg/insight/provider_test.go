@@ -202,6 +202,175 @@ func TestBuildDeploymentChangeFailureRateDataPoint(t *testing.T) {
 	}
 }
 
+func TestBuildDeploymentLeadTimeDataPoint(t *testing.T) {
+	testcases := []struct {
+		name       string
+		ds         []*DeploymentData
+		resolution model.InsightResolution
+		expected   []*model.InsightDataPoint
+	}{
+		{
+			name:       "empty",
+			ds:         []*DeploymentData{},
+			resolution: model.InsightResolution_DAILY,
+			expected:   []*model.InsightDataPoint{},
+		},
+		{
+			name: "daily resolution",
+			ds: []*DeploymentData{
+				{
+					CompletedAt:     1669574625,
+					CommitTimestamp: 1669574525,
+					CompleteStatus:  model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+				{
+					CompletedAt:     1669574635,
+					CommitTimestamp: 1669574335,
+					CompleteStatus:  model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+				{
+					CompletedAt:     1669574645,
+					CommitTimestamp: 1669570000,
+					CompleteStatus:  model.DeploymentStatus_DEPLOYMENT_FAILURE.String(),
+				},
+				{
+					CompletedAt:    1669574655,
+					CompleteStatus: model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+				{
+					CompletedAt:     1669661030,
+					CommitTimestamp: 1669660030,
+					CompleteStatus:  model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+			},
+			resolution: model.InsightResolution_DAILY,
+			expected: []*model.InsightDataPoint{
+				{
+					Timestamp: 1669507200,
+					Value:     200,
+				},
+				{
+					Timestamp: 1669593600,
+					Value:     1000,
+				},
+			},
+		},
+		{
+			name: "monthly resolution",
+			ds: []*DeploymentData{
+				{
+					CompletedAt:     1666982630,
+					CommitTimestamp: 1666982530,
+					CompleteStatus:  model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+				{
+					CompletedAt:     1669661010,
+					CommitTimestamp: 1669661000,
+					CompleteStatus:  model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+			},
+			resolution: model.InsightResolution_MONTHLY,
+			expected: []*model.InsightDataPoint{
+				{
+					Timestamp: 1664582400,
+					Value:     100,
+				},
+				{
+					Timestamp: 1667260800,
+					Value:     10,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := buildDeploymentLeadTimeDataPoints(tc.ds, "", nil, tc.resolution)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestBuildMTTRDataPoint(t *testing.T) {
+	testcases := []struct {
+		name       string
+		ds         []*DeploymentData
+		appID      string
+		resolution model.InsightResolution
+		expected   []*model.InsightDataPoint
+	}{
+		{
+			name:       "empty",
+			ds:         []*DeploymentData{},
+			resolution: model.InsightResolution_DAILY,
+			expected:   []*model.InsightDataPoint{},
+		},
+		{
+			name: "no recovery",
+			ds: []*DeploymentData{
+				{
+					CompletedAt:    1669574625,
+					CompleteStatus: model.DeploymentStatus_DEPLOYMENT_FAILURE.String(),
+				},
+				{
+					CompletedAt:    1669574635,
+					CompleteStatus: model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+			},
+			resolution: model.InsightResolution_DAILY,
+			expected:   []*model.InsightDataPoint{},
+		},
+		{
+			name: "daily resolution filtered by application",
+			ds: []*DeploymentData{
+				{
+					AppID:            "app-1",
+					CompletedAt:      1669574625,
+					FailureStartedAt: 1669574025,
+					CompleteStatus:   model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+				{
+					AppID:            "app-2",
+					CompletedAt:      1669574635,
+					FailureStartedAt: 1669570000,
+					CompleteStatus:   model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+				{
+					AppID:            "app-1",
+					CompletedAt:      1669661030,
+					FailureStartedAt: 1669660030,
+					CompleteStatus:   model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+				{
+					AppID:            "app-1",
+					CompletedAt:      1669661040,
+					FailureStartedAt: 1669659040,
+					CompleteStatus:   model.DeploymentStatus_DEPLOYMENT_SUCCESS.String(),
+				},
+			},
+			appID:      "app-1",
+			resolution: model.InsightResolution_DAILY,
+			expected: []*model.InsightDataPoint{
+				{
+					Timestamp: 1669507200,
+					Value:     600,
+				},
+				{
+					Timestamp: 1669593600,
+					Value:     1500,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := buildMTTRDataPoints(tc.ds, tc.appID, nil, tc.resolution)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func TestFillUpDataPoints(t *testing.T) {
 	testcases := []struct {
 		name       string
@@ -60,4 +60,8 @@ type Milestone struct {
 	// Mark that our collector has accumulated all deployments
 	// that was completed before this value.
 	DeploymentCompletedAtMilestone int64 `json:"deployment_completed_at_milestone"`
+	// The completed time of the first failed deployment of each application
+	// that has not been recovered by a successful deployment yet.
+	// This is used to calculate the time to restore the application.
+	OpenFailures map[string]int64 `json:"open_failures,omitempty"`
 }
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -67,3 +68,55 @@ func (k *APIKey) CompareKey(key string) error {
 func (k *APIKey) RedactSensitiveData() {
 	k.KeyHash = redactedMessage
 }
+
+// IsExpired checks whether the key has already expired at the given time.
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt > 0 && now.Unix() >= k.ExpiresAt
+}
+
+// HasPermission checks whether the key is allowed to perform the given action
+// on the given type of resource. The labels of the policies are not taken into account here.
+// A key without any policy is only restricted by its role.
+func (k *APIKey) HasPermission(typ ProjectRBACResource_ResourceType, action ProjectRBACPolicy_Action) bool {
+	if len(k.Policies) == 0 {
+		return true
+	}
+	for _, p := range k.Policies {
+		if p.HasPermission(typ, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasResourcePermission checks whether the key is allowed to perform the given action
+// on a resource of the given type that has the given labels.
+// A policy resource with labels only matches the resources containing all of those labels.
+func (k *APIKey) HasResourcePermission(typ ProjectRBACResource_ResourceType, action ProjectRBACPolicy_Action, labels map[string]string) bool {
+	if len(k.Policies) == 0 {
+		return true
+	}
+	for _, p := range k.Policies {
+		if !p.hasAction(action) {
+			continue
+		}
+		for _, r := range p.Resources {
+			if r.Type != typ && r.Type != ProjectRBACResource_ALL {
+				continue
+			}
+			if containLabels(labels, r.Labels) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containLabels(labels, required map[string]string) bool {
+	for k, v := range required {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
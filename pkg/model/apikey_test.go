@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -142,3 +143,142 @@ func TestCompareKey(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIKeyIsExpired(t *testing.T) {
+	now := time.Unix(1000, 0)
+	testcases := []struct {
+		name      string
+		expiresAt int64
+		expected  bool
+	}{
+		{
+			name:     "never expires",
+			expected: false,
+		},
+		{
+			name:      "not expired yet",
+			expiresAt: 1001,
+			expected:  false,
+		},
+		{
+			name:      "expired",
+			expiresAt: 1000,
+			expected:  true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			k := &APIKey{ExpiresAt: tc.expiresAt}
+			assert.Equal(t, tc.expected, k.IsExpired(now))
+		})
+	}
+}
+
+func TestAPIKeyHasResourcePermission(t *testing.T) {
+	key := &APIKey{
+		Policies: []*ProjectRBACPolicy{
+			{
+				Resources: []*ProjectRBACResource{
+					{Type: ProjectRBACResource_EVENT},
+				},
+				Actions: []ProjectRBACPolicy_Action{ProjectRBACPolicy_CREATE},
+			},
+			{
+				Resources: []*ProjectRBACResource{
+					{
+						Type:   ProjectRBACResource_APPLICATION,
+						Labels: map[string]string{"env": "dev"},
+					},
+				},
+				Actions: []ProjectRBACPolicy_Action{ProjectRBACPolicy_GET, ProjectRBACPolicy_UPDATE},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		key      *APIKey
+		typ      ProjectRBACResource_ResourceType
+		action   ProjectRBACPolicy_Action
+		labels   map[string]string
+		expected bool
+	}{
+		{
+			name:     "key without policies",
+			key:      &APIKey{},
+			typ:      ProjectRBACResource_APPLICATION,
+			action:   ProjectRBACPolicy_DELETE,
+			expected: true,
+		},
+		{
+			name:     "allowed resource without labels",
+			key:      key,
+			typ:      ProjectRBACResource_EVENT,
+			action:   ProjectRBACPolicy_CREATE,
+			expected: true,
+		},
+		{
+			name:     "not allowed action",
+			key:      key,
+			typ:      ProjectRBACResource_EVENT,
+			action:   ProjectRBACPolicy_LIST,
+			expected: false,
+		},
+		{
+			name:     "not allowed resource",
+			key:      key,
+			typ:      ProjectRBACResource_PIPED,
+			action:   ProjectRBACPolicy_CREATE,
+			expected: false,
+		},
+		{
+			name:     "resource with matching labels",
+			key:      key,
+			typ:      ProjectRBACResource_APPLICATION,
+			action:   ProjectRBACPolicy_UPDATE,
+			labels:   map[string]string{"env": "dev", "team": "foo"},
+			expected: true,
+		},
+		{
+			name:     "resource with unmatching labels",
+			key:      key,
+			typ:      ProjectRBACResource_APPLICATION,
+			action:   ProjectRBACPolicy_UPDATE,
+			labels:   map[string]string{"env": "prod"},
+			expected: false,
+		},
+		{
+			name:     "resource without labels",
+			key:      key,
+			typ:      ProjectRBACResource_APPLICATION,
+			action:   ProjectRBACPolicy_GET,
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.key.HasResourcePermission(tc.typ, tc.action, tc.labels)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestAPIKeyHasPermission(t *testing.T) {
+	key := &APIKey{
+		Policies: []*ProjectRBACPolicy{
+			{
+				Resources: []*ProjectRBACResource{
+					{
+						Type:   ProjectRBACResource_APPLICATION,
+						Labels: map[string]string{"env": "dev"},
+					},
+				},
+				Actions: []ProjectRBACPolicy_Action{ProjectRBACPolicy_UPDATE},
+			},
+		},
+	}
+	assert.True(t, key.HasPermission(ProjectRBACResource_APPLICATION, ProjectRBACPolicy_UPDATE))
+	assert.False(t, key.HasPermission(ProjectRBACResource_APPLICATION, ProjectRBACPolicy_DELETE))
+	assert.False(t, key.HasPermission(ProjectRBACResource_EVENT, ProjectRBACPolicy_CREATE))
+	assert.True(t, (&APIKey{}).HasPermission(ProjectRBACResource_EVENT, ProjectRBACPolicy_CREATE))
+}
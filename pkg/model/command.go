@@ -18,6 +18,7 @@ import "context"
 
 const (
 	MetadataKeyTriggeredDeploymentID = "TriggeredDeploymentID"
+	MetadataKeyCommandFailureReason  = "FailureReason"
 )
 
 type ReportableCommand struct {
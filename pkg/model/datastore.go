@@ -17,8 +17,10 @@ package model
 type DataStoreType string
 
 const (
-	DataStoreFirestore DataStoreType = "FIRESTORE"
-	DataStoreMySQL     DataStoreType = "MYSQL"
+	DataStoreFirestore  DataStoreType = "FIRESTORE"
+	DataStoreMySQL      DataStoreType = "MYSQL"
+	DataStorePostgreSQL DataStoreType = "POSTGRESQL"
+	DataStoreSQLite     DataStoreType = "SQLITE"
 )
 
 func (t DataStoreType) String() string {
@@ -291,6 +291,17 @@ func (m *Deployment) validate(all bool) error {
 
 	}
 
+	if _, ok := DeploymentConcurrencyPolicy_name[int32(m.GetConcurrencyPolicy())]; !ok {
+		err := DeploymentValidationError{
+			field:  "ConcurrencyPolicy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	// no validation rules for RunningCommitHash
 
 	// no validation rules for RunningConfigFilename
@@ -668,6 +679,8 @@ func (m *DeploymentTrigger) validate(all bool) error {
 
 	// no validation rules for StrategySummary
 
+	// no validation rules for IgnoreFreezeWindows
+
 	if len(errors) > 0 {
 		return DeploymentTriggerMultiError(errors)
 	}
@@ -14,7 +14,10 @@
 
 package model
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 func GetNotCompletedDeploymentChainStatuses() []ChainStatus {
 	return []ChainStatus{
@@ -50,6 +53,12 @@ func (dc *DeploymentChain) DesiredStatus() ChainStatus {
 		case ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS:
 			successBlockCtn++
 		case ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE:
+			// The failure of the block which allows to continue on failure
+			// does not make the chain failed, it's treated as a passed block.
+			if block.ContinueOnFailure {
+				successBlockCtn++
+				continue
+			}
 			failedBlockCtn++
 		case ChainBlockStatus_DEPLOYMENT_BLOCK_CANCELLED:
 			cancelledBlockCtn++
@@ -145,6 +154,15 @@ func (b *ChainBlock) DesiredStatus() ChainBlockStatus {
 	if successDeploymentCtn == len(b.Nodes) {
 		return ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS
 	}
+	// In case the block is allowed to continue on failure, it's only counted as completed
+	// after all of its nodes are completed so that the next block won't be started
+	// while some deployments of this block are still running.
+	if b.ContinueOnFailure && successDeploymentCtn+failedDeploymentCtn+cancelledDeploymentCtn < len(b.Nodes) {
+		if runningDeploymentCtn > 0 || failedDeploymentCtn > 0 || cancelledDeploymentCtn > 0 {
+			return ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING
+		}
+		return b.Status
+	}
 	// If one of the node in the block is completed with FAILURE status, the block counted as FAILURE.
 	if failedDeploymentCtn > 0 {
 		return ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE
@@ -173,3 +191,49 @@ func (b *ChainBlock) GetNodeByDeploymentID(deploymentID string) (*ChainNode, err
 	}
 	return nil, fmt.Errorf("unable to find node with the given deployment id (%s)", deploymentID)
 }
+
+// IsPassed returns true if the block is completed and the next block can be started.
+func (b *ChainBlock) IsPassed() bool {
+	switch b.Status {
+	case ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS:
+		return true
+	case ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE:
+		return b.ContinueOnFailure
+	default:
+		return false
+	}
+}
+
+// HasGates returns true if any block of the chain has a gate.
+func (dc *DeploymentChain) HasGates() bool {
+	for _, block := range dc.Blocks {
+		if block.Gate != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *ChainBlockGate) IsCompleted() bool {
+	switch g.Status {
+	case ChainGateStatus_CHAIN_GATE_PASSED,
+		ChainGateStatus_CHAIN_GATE_FAILED:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitUntil returns the time until which the gate waits after the previous block finished.
+func (g *ChainBlockGate) WaitUntil(previousBlockCompletedAt time.Time) time.Time {
+	return previousBlockCompletedAt.Add(time.Duration(g.WaitSeconds) * time.Second)
+}
+
+// IsWaitingForAnalysis returns true if the wait duration of the gate elapsed
+// and the result of its analysis has not been reported by piped yet.
+func (g *ChainBlockGate) IsWaitingForAnalysis(previousBlockCompletedAt, now time.Time) bool {
+	if g.IsCompleted() || g.AnalysisPassed || len(g.AnalysisConfig) == 0 {
+		return false
+	}
+	return !now.Before(g.WaitUntil(previousBlockCompletedAt))
+}
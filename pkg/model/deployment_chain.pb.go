@@ -167,6 +167,63 @@ func (ChainBlockStatus) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_model_deployment_chain_proto_rawDescGZIP(), []int{1}
 }
 
+// ChainGateStatus represents the current status of a gate of block in deployment chain.
+type ChainGateStatus int32
+
+const (
+	// CHAIN_GATE_PENDING means the previous block has not finished yet.
+	ChainGateStatus_CHAIN_GATE_PENDING ChainGateStatus = 0
+	// CHAIN_GATE_RUNNING means the gate is being evaluated.
+	ChainGateStatus_CHAIN_GATE_RUNNING ChainGateStatus = 1
+	// CHAIN_GATE_PASSED means all conditions of the gate were satisfied.
+	ChainGateStatus_CHAIN_GATE_PASSED ChainGateStatus = 2
+	// CHAIN_GATE_FAILED means one of the conditions of the gate was not satisfied.
+	ChainGateStatus_CHAIN_GATE_FAILED ChainGateStatus = 3
+)
+
+// Enum value maps for ChainGateStatus.
+var (
+	ChainGateStatus_name = map[int32]string{
+		0: "CHAIN_GATE_PENDING",
+		1: "CHAIN_GATE_RUNNING",
+		2: "CHAIN_GATE_PASSED",
+		3: "CHAIN_GATE_FAILED",
+	}
+	ChainGateStatus_value = map[string]int32{
+		"CHAIN_GATE_PENDING": 0,
+		"CHAIN_GATE_RUNNING": 1,
+		"CHAIN_GATE_PASSED":  2,
+		"CHAIN_GATE_FAILED":  3,
+	}
+)
+
+func (x ChainGateStatus) Enum() *ChainGateStatus {
+	p := new(ChainGateStatus)
+	*p = x
+	return p
+}
+
+func (x ChainGateStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChainGateStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_model_deployment_chain_proto_enumTypes[2].Descriptor()
+}
+
+func (ChainGateStatus) Type() protoreflect.EnumType {
+	return &file_pkg_model_deployment_chain_proto_enumTypes[2]
+}
+
+func (x ChainGateStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChainGateStatus.Descriptor instead.
+func (ChainGateStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_model_deployment_chain_proto_rawDescGZIP(), []int{2}
+}
+
 type DeploymentChain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -456,6 +513,10 @@ type ChainBlock struct {
 	StartedAt int64 `protobuf:"varint,100,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
 	// Unix time when all the applications in this chain node are deployed.
 	CompletedAt int64 `protobuf:"varint,101,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Whether the next blocks in chain should be continued even if this block finished with FAILURE status.
+	ContinueOnFailure bool `protobuf:"varint,3,opt,name=continue_on_failure,json=continueOnFailure,proto3" json:"continue_on_failure,omitempty"`
+	// The gate which must be passed before the deployments of this block become plannable.
+	Gate *ChainBlockGate `protobuf:"bytes,4,opt,name=gate,proto3" json:"gate,omitempty"`
 }
 
 func (x *ChainBlock) Reset() {
@@ -518,6 +579,151 @@ func (x *ChainBlock) GetCompletedAt() int64 {
 	return 0
 }
 
+func (x *ChainBlock) GetContinueOnFailure() bool {
+	if x != nil {
+		return x.ContinueOnFailure
+	}
+	return false
+}
+
+func (x *ChainBlock) GetGate() *ChainBlockGate {
+	if x != nil {
+		return x.Gate
+	}
+	return nil
+}
+
+// ChainBlockGate represents a promotion gate between a block and its previous block.
+type ChainBlockGate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of seconds to wait after the previous block finished.
+	WaitSeconds int64 `protobuf:"varint,1,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`
+	// The name of the analysis template used to build the analysis.
+	AnalysisTemplate string `protobuf:"bytes,2,opt,name=analysis_template,json=analysisTemplate,proto3" json:"analysis_template,omitempty"`
+	// Whether a manual approval is required.
+	RequireApproval bool `protobuf:"varint,4,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"`
+	// The options of the analysis resolved by piped, encoded in JSON.
+	// The analysis is run by piped and only its result is reported to the control-plane.
+	AnalysisConfig []byte `protobuf:"bytes,5,opt,name=analysis_config,json=analysisConfig,proto3" json:"analysis_config,omitempty"`
+	// Current status of the gate.
+	Status ChainGateStatus `protobuf:"varint,10,opt,name=status,proto3,enum=model.ChainGateStatus" json:"status,omitempty"`
+	// The reason of the current status.
+	StatusReason string `protobuf:"bytes,11,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	// The ID of the API key used to approve the gate.
+	Approver string `protobuf:"bytes,12,opt,name=approver,proto3" json:"approver,omitempty"`
+	// Whether the analysis of the gate was passed.
+	AnalysisPassed bool `protobuf:"varint,13,opt,name=analysis_passed,json=analysisPassed,proto3" json:"analysis_passed,omitempty"`
+	// Unix time when the gate evaluation is started.
+	StartedAt int64 `protobuf:"varint,100,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Unix time when the gate is passed or failed.
+	CompletedAt int64 `protobuf:"varint,101,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *ChainBlockGate) Reset() {
+	*x = ChainBlockGate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_deployment_chain_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainBlockGate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainBlockGate) ProtoMessage() {}
+
+func (x *ChainBlockGate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_deployment_chain_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainBlockGate.ProtoReflect.Descriptor instead.
+func (*ChainBlockGate) Descriptor() ([]byte, []int) {
+	return file_pkg_model_deployment_chain_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChainBlockGate) GetWaitSeconds() int64 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+func (x *ChainBlockGate) GetAnalysisTemplate() string {
+	if x != nil {
+		return x.AnalysisTemplate
+	}
+	return ""
+}
+
+func (x *ChainBlockGate) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+func (x *ChainBlockGate) GetAnalysisConfig() []byte {
+	if x != nil {
+		return x.AnalysisConfig
+	}
+	return nil
+}
+
+func (x *ChainBlockGate) GetStatus() ChainGateStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ChainGateStatus_CHAIN_GATE_PENDING
+}
+
+func (x *ChainBlockGate) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *ChainBlockGate) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *ChainBlockGate) GetAnalysisPassed() bool {
+	if x != nil {
+		return x.AnalysisPassed
+	}
+	return false
+}
+
+func (x *ChainBlockGate) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *ChainBlockGate) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
 var File_pkg_model_deployment_chain_proto protoreflect.FileDescriptor
 
 var file_pkg_model_deployment_chain_proto_rawDesc = []byte{
@@ -573,7 +779,7 @@ var file_pkg_model_deployment_chain_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d,
 	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
 	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0xc3, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x22, 0x9e, 0x02, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x69, 0x6e,
 	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x68, 0x61,
 	0x69, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x39, 0x0a,
@@ -585,31 +791,72 @@ var file_pkg_model_deployment_chain_proto_rawDesc = []byte{
 	0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
 	0x12, 0x2a, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
 	0x18, 0x65, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52,
-	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0xa5, 0x01, 0x0a,
-	0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18,
-	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e,
-	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45,
-	0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x52,
-	0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c,
-	0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x55, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
-	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
-	0x52, 0x45, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45,
-	0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
-	0x45, 0x44, 0x10, 0x04, 0x2a, 0xaa, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50,
-	0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x45,
-	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f,
-	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
-	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d,
-	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e,
-	0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
-	0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
-	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
-	0x04, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x70, 0x69, 0x70, 0x65, 0x2d, 0x63, 0x64, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x63, 0x64, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x69,
+	0x6e, 0x75, 0x65, 0x4f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x04,
+	0x67, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x74,
+	0x65, 0x52, 0x04, 0x67, 0x61, 0x74, 0x65, 0x22, 0xbb, 0x03, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x0c, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x73, 0x69, 0x73, 0x50, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x28, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4a,
+	0x04, 0x08, 0x03, 0x10, 0x04, 0x2a, 0xa5, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12,
+	0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48,
+	0x41, 0x49, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x1e, 0x0a,
+	0x1a, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x49,
+	0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xaa, 0x01,
+	0x0a, 0x10, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x42,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c,
+	0x0a, 0x18, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x4c, 0x4f,
+	0x43, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18,
+	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x45,
+	0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x6f, 0x0a, 0x0f, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x12, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x47,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x47, 0x41,
+	0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x42, 0x25, 0x5a, 0x23, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x2d, 0x63,
+	0x64, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x63, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -624,31 +871,35 @@ func file_pkg_model_deployment_chain_proto_rawDescGZIP() []byte {
 	return file_pkg_model_deployment_chain_proto_rawDescData
 }
 
-var file_pkg_model_deployment_chain_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pkg_model_deployment_chain_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_model_deployment_chain_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pkg_model_deployment_chain_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_pkg_model_deployment_chain_proto_goTypes = []interface{}{
 	(ChainStatus)(0),            // 0: model.ChainStatus
 	(ChainBlockStatus)(0),       // 1: model.ChainBlockStatus
-	(*DeploymentChain)(nil),     // 2: model.DeploymentChain
-	(*ChainApplicationRef)(nil), // 3: model.ChainApplicationRef
-	(*ChainDeploymentRef)(nil),  // 4: model.ChainDeploymentRef
-	(*ChainNode)(nil),           // 5: model.ChainNode
-	(*ChainBlock)(nil),          // 6: model.ChainBlock
-	(DeploymentStatus)(0),       // 7: model.DeploymentStatus
+	(ChainGateStatus)(0),        // 2: model.ChainGateStatus
+	(*DeploymentChain)(nil),     // 3: model.DeploymentChain
+	(*ChainApplicationRef)(nil), // 4: model.ChainApplicationRef
+	(*ChainDeploymentRef)(nil),  // 5: model.ChainDeploymentRef
+	(*ChainNode)(nil),           // 6: model.ChainNode
+	(*ChainBlock)(nil),          // 7: model.ChainBlock
+	(*ChainBlockGate)(nil),      // 8: model.ChainBlockGate
+	(DeploymentStatus)(0),       // 9: model.DeploymentStatus
 }
 var file_pkg_model_deployment_chain_proto_depIdxs = []int32{
 	0, // 0: model.DeploymentChain.status:type_name -> model.ChainStatus
-	6, // 1: model.DeploymentChain.blocks:type_name -> model.ChainBlock
-	7, // 2: model.ChainDeploymentRef.status:type_name -> model.DeploymentStatus
-	3, // 3: model.ChainNode.application_ref:type_name -> model.ChainApplicationRef
-	4, // 4: model.ChainNode.deployment_ref:type_name -> model.ChainDeploymentRef
-	5, // 5: model.ChainBlock.nodes:type_name -> model.ChainNode
+	7, // 1: model.DeploymentChain.blocks:type_name -> model.ChainBlock
+	9, // 2: model.ChainDeploymentRef.status:type_name -> model.DeploymentStatus
+	4, // 3: model.ChainNode.application_ref:type_name -> model.ChainApplicationRef
+	5, // 4: model.ChainNode.deployment_ref:type_name -> model.ChainDeploymentRef
+	6, // 5: model.ChainBlock.nodes:type_name -> model.ChainNode
 	1, // 6: model.ChainBlock.status:type_name -> model.ChainBlockStatus
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	8, // 7: model.ChainBlock.gate:type_name -> model.ChainBlockGate
+	2, // 8: model.ChainBlockGate.status:type_name -> model.ChainGateStatus
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_pkg_model_deployment_chain_proto_init() }
@@ -718,14 +969,26 @@ func file_pkg_model_deployment_chain_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_model_deployment_chain_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainBlockGate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_model_deployment_chain_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   5,
+			NumEnums:      3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
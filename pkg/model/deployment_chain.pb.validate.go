@@ -733,6 +733,37 @@ func (m *ChainBlock) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for ContinueOnFailure
+
+	if all {
+		switch v := interface{}(m.GetGate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChainBlockValidationError{
+					field:  "Gate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChainBlockValidationError{
+					field:  "Gate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChainBlockValidationError{
+				field:  "Gate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ChainBlockMultiError(errors)
 	}
@@ -809,3 +840,159 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ChainBlockValidationError{}
+
+// Validate checks the field values on ChainBlockGate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChainBlockGate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChainBlockGate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ChainBlockGateMultiError,
+// or nil if none found.
+func (m *ChainBlockGate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChainBlockGate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWaitSeconds() < 0 {
+		err := ChainBlockGateValidationError{
+			field:  "WaitSeconds",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AnalysisTemplate
+
+	// no validation rules for RequireApproval
+
+	// no validation rules for AnalysisConfig
+
+	if _, ok := ChainGateStatus_name[int32(m.GetStatus())]; !ok {
+		err := ChainBlockGateValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StatusReason
+
+	// no validation rules for Approver
+
+	// no validation rules for AnalysisPassed
+
+	if m.GetStartedAt() < 0 {
+		err := ChainBlockGateValidationError{
+			field:  "StartedAt",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCompletedAt() < 0 {
+		err := ChainBlockGateValidationError{
+			field:  "CompletedAt",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChainBlockGateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChainBlockGateMultiError is an error wrapping multiple validation errors
+// returned by ChainBlockGate.ValidateAll() if the designated constraints
+// aren't met.
+type ChainBlockGateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChainBlockGateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChainBlockGateMultiError) AllErrors() []error { return m }
+
+// ChainBlockGateValidationError is the validation error returned by
+// ChainBlockGate.Validate if the designated constraints aren't met.
+type ChainBlockGateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChainBlockGateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChainBlockGateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChainBlockGateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChainBlockGateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChainBlockGateValidationError) ErrorName() string { return "ChainBlockGateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChainBlockGateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChainBlockGate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChainBlockGateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChainBlockGateValidationError{}
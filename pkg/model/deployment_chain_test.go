@@ -16,6 +16,7 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -102,6 +103,38 @@ func TestDeploymentChainDesireStatus(t *testing.T) {
 			},
 			expectedDesireStatus: ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
 		},
+		{
+			name: "FAILURE block which continues on failure does not make the chain as FAILURE",
+			deploymentChain: DeploymentChain{
+				Status: ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
+				Blocks: []*ChainBlock{
+					{
+						Status:            ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE,
+						ContinueOnFailure: true,
+					},
+					{
+						Status: ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING,
+					},
+				},
+			},
+			expectedDesireStatus: ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
+		},
+		{
+			name: "reach SUCCESS state when all blocks finished successfully or continue on failure",
+			deploymentChain: DeploymentChain{
+				Status: ChainStatus_DEPLOYMENT_CHAIN_RUNNING,
+				Blocks: []*ChainBlock{
+					{
+						Status:            ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE,
+						ContinueOnFailure: true,
+					},
+					{
+						Status: ChainBlockStatus_DEPLOYMENT_BLOCK_SUCCESS,
+					},
+				},
+			},
+			expectedDesireStatus: ChainStatus_DEPLOYMENT_CHAIN_SUCCESS,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -113,6 +146,59 @@ func TestDeploymentChainDesireStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestChainBlockDesiredStatus(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		name                 string
+		block                *ChainBlock
+		expectedDesireStatus ChainBlockStatus
+	}{
+		{
+			name: "one FAILURE node makes the block as FAILURE",
+			block: &ChainBlock{
+				Status: ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING,
+				Nodes: []*ChainNode{
+					{DeploymentRef: &ChainDeploymentRef{Status: DeploymentStatus_DEPLOYMENT_FAILURE}},
+					{DeploymentRef: &ChainDeploymentRef{Status: DeploymentStatus_DEPLOYMENT_RUNNING}},
+				},
+			},
+			expectedDesireStatus: ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE,
+		},
+		{
+			name: "block which continues on failure waits for all nodes to be completed",
+			block: &ChainBlock{
+				Status:            ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING,
+				ContinueOnFailure: true,
+				Nodes: []*ChainNode{
+					{DeploymentRef: &ChainDeploymentRef{Status: DeploymentStatus_DEPLOYMENT_FAILURE}},
+					{DeploymentRef: &ChainDeploymentRef{Status: DeploymentStatus_DEPLOYMENT_RUNNING}},
+				},
+			},
+			expectedDesireStatus: ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING,
+		},
+		{
+			name: "block which continues on failure reaches FAILURE after all nodes are completed",
+			block: &ChainBlock{
+				Status:            ChainBlockStatus_DEPLOYMENT_BLOCK_RUNNING,
+				ContinueOnFailure: true,
+				Nodes: []*ChainNode{
+					{DeploymentRef: &ChainDeploymentRef{Status: DeploymentStatus_DEPLOYMENT_FAILURE}},
+					{DeploymentRef: &ChainDeploymentRef{Status: DeploymentStatus_DEPLOYMENT_SUCCESS}},
+				},
+			},
+			expectedDesireStatus: ChainBlockStatus_DEPLOYMENT_BLOCK_FAILURE,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedDesireStatus, tc.block.DesiredStatus())
+		})
+	}
+}
+
 func TestListAllInChainApplicationDeploymentsMap(t *testing.T) {
 	t.Parallel()
 	dc := &DeploymentChain{
@@ -360,3 +446,52 @@ func TestGetNodeByDeploymentID(t *testing.T) {
 		})
 	}
 }
+
+func TestChainBlockGateIsWaitingForAnalysis(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now                 = time.Date(2025, 10, 6, 9, 0, 0, 0, time.UTC)
+		previousCompletedAt = now.Add(-10 * time.Minute)
+	)
+
+	tests := []struct {
+		name string
+		gate *ChainBlockGate
+		want bool
+	}{
+		{
+			name: "no analysis",
+			gate: &ChainBlockGate{WaitSeconds: 60},
+			want: false,
+		},
+		{
+			name: "still waiting",
+			gate: &ChainBlockGate{WaitSeconds: 30 * 60, AnalysisConfig: []byte("{}")},
+			want: false,
+		},
+		{
+			name: "wait duration elapsed",
+			gate: &ChainBlockGate{WaitSeconds: 5 * 60, AnalysisConfig: []byte("{}")},
+			want: true,
+		},
+		{
+			name: "analysis already passed",
+			gate: &ChainBlockGate{AnalysisConfig: []byte("{}"), AnalysisPassed: true},
+			want: false,
+		},
+		{
+			name: "gate already failed",
+			gate: &ChainBlockGate{AnalysisConfig: []byte("{}"), Status: ChainGateStatus_CHAIN_GATE_FAILED},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.gate.IsWaitingForAnalysis(previousCompletedAt, now))
+		})
+	}
+}
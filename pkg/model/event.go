@@ -24,6 +24,22 @@ import (
 
 var hashFunc = sha256.New
 
+// NewEvent returns a new event which is waiting to be handled by Piped.
+func NewEvent(id, projectID, name, data string, labels, contexts map[string]string, commitHash string) Event {
+	return Event{
+		Id:                id,
+		Name:              name,
+		Data:              data,
+		Labels:            labels,
+		Contexts:          contexts,
+		TriggerCommitHash: commitHash,
+		EventKey:          MakeEventKey(name, labels),
+		ProjectId:         projectID,
+		Status:            EventStatus_EVENT_NOT_HANDLED,
+		StatusDescription: fmt.Sprintf("It is going to be replaced by %s", data),
+	}
+}
+
 func (e *Event) IsHandled() bool {
 	return e.Status == EventStatus_EVENT_SUCCESS || e.Status == EventStatus_EVENT_FAILURE
 }
@@ -91,3 +91,14 @@ func TestMakeEventKey(t *testing.T) {
 		})
 	}
 }
+
+func TestNewEvent(t *testing.T) {
+	labels := map[string]string{"app": "helloworld"}
+	e := NewEvent("id", "project", "image-update", "ghcr.io/pipe-cd/helloworld:v0.1.0", labels, nil, "")
+
+	assert.Equal(t, "id", e.Id)
+	assert.Equal(t, "project", e.ProjectId)
+	assert.Equal(t, MakeEventKey("image-update", labels), e.EventKey)
+	assert.Equal(t, EventStatus_EVENT_NOT_HANDLED, e.Status)
+	assert.Equal(t, "It is going to be replaced by ghcr.io/pipe-cd/helloworld:v0.1.0", e.StatusDescription)
+}
@@ -20,6 +20,7 @@ const (
 	FileStoreGCS   FileStoreType = "GCS"
 	FileStoreS3    FileStoreType = "S3"
 	FileStoreMINIO FileStoreType = "MINIO"
+	FileStoreLocal FileStoreType = "LOCAL"
 )
 
 func (t FileStoreType) String() string {
@@ -19,6 +19,7 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"net/url"
+	"path"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"golang.org/x/crypto/bcrypt"
@@ -175,6 +176,9 @@ func (p *Project) RedactSensitiveData() {
 	if p.Sso != nil {
 		p.Sso.RedactSensitiveData()
 	}
+	if p.RegistryWebhook != nil {
+		p.RegistryWebhook.RedactSensitiveData()
+	}
 }
 
 // RedactSensitiveData redacts sensitive data.
@@ -566,6 +570,10 @@ func (p *ProjectRBACPolicy) HasPermission(typ ProjectRBACResource_ResourceType,
 		return false
 	}
 
+	return p.hasAction(action)
+}
+
+func (p *ProjectRBACPolicy) hasAction(action ProjectRBACPolicy_Action) bool {
 	for _, a := range p.Actions {
 		if a == action || a == ProjectRBACPolicy_ALL {
 			return true
@@ -573,3 +581,67 @@ func (p *ProjectRBACPolicy) HasPermission(typ ProjectRBACResource_ResourceType,
 	}
 	return false
 }
+
+// RedactSensitiveData redacts sensitive data.
+func (p *ProjectRegistryWebhookConfig) RedactSensitiveData() {
+	if p.Secret != "" {
+		p.Secret = redactedMessage
+	}
+}
+
+// Update updates ProjectRegistryWebhookConfig with given data.
+// The current secret is kept when the given one is empty.
+func (p *ProjectRegistryWebhookConfig) Update(input *ProjectRegistryWebhookConfig) {
+	if input.Secret != "" {
+		p.Secret = input.Secret
+	}
+	p.Rules = input.Rules
+}
+
+// Encrypt encrypts sensitive data in ProjectRegistryWebhookConfig.
+func (p *ProjectRegistryWebhookConfig) Encrypt(encrypter encrypter) error {
+	if p.Secret == "" {
+		return nil
+	}
+	encryptedSecret, err := encrypter.Encrypt(p.Secret)
+	if err != nil {
+		return err
+	}
+	p.Secret = encryptedSecret
+	return nil
+}
+
+// Decrypt decrypts encrypted data in ProjectRegistryWebhookConfig.
+func (p *ProjectRegistryWebhookConfig) Decrypt(decrypter decrypter) error {
+	if p.Secret == "" {
+		return nil
+	}
+	decryptedSecret, err := decrypter.Decrypt(p.Secret)
+	if err != nil {
+		return err
+	}
+	p.Secret = decryptedSecret
+	return nil
+}
+
+// FindRule returns the first rule matching the given repository and tag.
+func (p *ProjectRegistryWebhookConfig) FindRule(repository, tag string) (*ProjectRegistryWebhookConfig_Rule, bool) {
+	for _, r := range p.Rules {
+		if r.Match(repository, tag) {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// Match reports whether the given repository and tag match this rule.
+func (r *ProjectRegistryWebhookConfig_Rule) Match(repository, tag string) bool {
+	if ok, _ := path.Match(r.Repository, repository); !ok {
+		return false
+	}
+	if r.Tag == "" {
+		return true
+	}
+	ok, _ := path.Match(r.Tag, tag)
+	return ok
+}
@@ -241,6 +241,69 @@ func (m *Project) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetRegistryWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "RegistryWebhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProjectValidationError{
+					field:  "RegistryWebhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistryWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProjectValidationError{
+				field:  "RegistryWebhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetFreezeWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("FreezeWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectValidationError{
+						field:  fmt.Sprintf("FreezeWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectValidationError{
+					field:  fmt.Sprintf("FreezeWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.GetCreatedAt() <= 0 {
 		err := ProjectValidationError{
 			field:  "CreatedAt",
@@ -1385,6 +1448,270 @@ var _ interface {
 	ErrorName() string
 } = ProjectRBACPolicyValidationError{}
 
+// Validate checks the field values on ProjectRegistryWebhookConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProjectRegistryWebhookConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectRegistryWebhookConfig with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectRegistryWebhookConfigMultiError, or nil if none found.
+func (m *ProjectRegistryWebhookConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectRegistryWebhookConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProjectRegistryWebhookConfigValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProjectRegistryWebhookConfigValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProjectRegistryWebhookConfigValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProjectRegistryWebhookConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectRegistryWebhookConfigMultiError is an error wrapping multiple
+// validation errors returned by ProjectRegistryWebhookConfig.ValidateAll() if
+// the designated constraints aren't met.
+type ProjectRegistryWebhookConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectRegistryWebhookConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectRegistryWebhookConfigMultiError) AllErrors() []error { return m }
+
+// ProjectRegistryWebhookConfigValidationError is the validation error returned
+// by ProjectRegistryWebhookConfig.Validate if the designated constraints
+// aren't met.
+type ProjectRegistryWebhookConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectRegistryWebhookConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectRegistryWebhookConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectRegistryWebhookConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectRegistryWebhookConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectRegistryWebhookConfigValidationError) ErrorName() string {
+	return "ProjectRegistryWebhookConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProjectRegistryWebhookConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectRegistryWebhookConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectRegistryWebhookConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectRegistryWebhookConfigValidationError{}
+
+// Validate checks the field values on ProjectFreezeWindow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProjectFreezeWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectFreezeWindow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectFreezeWindowMultiError, or nil if none found.
+func (m *ProjectFreezeWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectFreezeWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ProjectFreezeWindowValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reason
+
+	// no validation rules for Cron
+
+	// no validation rules for Duration
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	// no validation rules for TimeZone
+
+	if len(errors) > 0 {
+		return ProjectFreezeWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectFreezeWindowMultiError is an error wrapping multiple validation
+// errors returned by ProjectFreezeWindow.ValidateAll() if the designated
+// constraints aren't met.
+type ProjectFreezeWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectFreezeWindowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectFreezeWindowMultiError) AllErrors() []error { return m }
+
+// ProjectFreezeWindowValidationError is the validation error returned by
+// ProjectFreezeWindow.Validate if the designated constraints aren't met.
+type ProjectFreezeWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectFreezeWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectFreezeWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectFreezeWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectFreezeWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectFreezeWindowValidationError) ErrorName() string {
+	return "ProjectFreezeWindowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProjectFreezeWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectFreezeWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectFreezeWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectFreezeWindowValidationError{}
+
 // Validate checks the field values on ProjectSSOConfig_GitHub with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1780,3 +2107,134 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ProjectSSOConfig_OidcValidationError{}
+
+// Validate checks the field values on ProjectRegistryWebhookConfig_Rule with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ProjectRegistryWebhookConfig_Rule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProjectRegistryWebhookConfig_Rule
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ProjectRegistryWebhookConfig_RuleMultiError, or nil if none found.
+func (m *ProjectRegistryWebhookConfig_Rule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProjectRegistryWebhookConfig_Rule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRepository()) < 1 {
+		err := ProjectRegistryWebhookConfig_RuleValidationError{
+			field:  "Repository",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Tag
+
+	if utf8.RuneCountInString(m.GetEventName()) < 1 {
+		err := ProjectRegistryWebhookConfig_RuleValidationError{
+			field:  "EventName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for EventLabels
+
+	if len(errors) > 0 {
+		return ProjectRegistryWebhookConfig_RuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProjectRegistryWebhookConfig_RuleMultiError is an error wrapping multiple
+// validation errors returned by
+// ProjectRegistryWebhookConfig_Rule.ValidateAll() if the designated
+// constraints aren't met.
+type ProjectRegistryWebhookConfig_RuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProjectRegistryWebhookConfig_RuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProjectRegistryWebhookConfig_RuleMultiError) AllErrors() []error { return m }
+
+// ProjectRegistryWebhookConfig_RuleValidationError is the validation error
+// returned by ProjectRegistryWebhookConfig_Rule.Validate if the designated
+// constraints aren't met.
+type ProjectRegistryWebhookConfig_RuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProjectRegistryWebhookConfig_RuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProjectRegistryWebhookConfig_RuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProjectRegistryWebhookConfig_RuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProjectRegistryWebhookConfig_RuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProjectRegistryWebhookConfig_RuleValidationError) ErrorName() string {
+	return "ProjectRegistryWebhookConfig_RuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProjectRegistryWebhookConfig_RuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProjectRegistryWebhookConfig_Rule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProjectRegistryWebhookConfig_RuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProjectRegistryWebhookConfig_RuleValidationError{}
@@ -916,3 +916,82 @@ func TestGenerateAuthCodeURL_Oidc(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectRegistryWebhookConfig_FindRule(t *testing.T) {
+	cfg := &ProjectRegistryWebhookConfig{
+		Rules: []*ProjectRegistryWebhookConfig_Rule{
+			{
+				Repository: "pipe-cd/helloworld",
+				Tag:        "v*",
+				EventName:  "helloworld-release",
+			},
+			{
+				Repository: "pipe-cd/*",
+				EventName:  "pipecd-image-update",
+			},
+		},
+	}
+	testcases := []struct {
+		name       string
+		repository string
+		tag        string
+		expected   string
+		found      bool
+	}{
+		{
+			name:       "match repository and tag",
+			repository: "pipe-cd/helloworld",
+			tag:        "v0.1.0",
+			expected:   "helloworld-release",
+			found:      true,
+		},
+		{
+			name:       "fall back to the next rule when the tag does not match",
+			repository: "pipe-cd/helloworld",
+			tag:        "latest",
+			expected:   "pipecd-image-update",
+			found:      true,
+		},
+		{
+			name:       "match by glob pattern",
+			repository: "pipe-cd/pipecd",
+			tag:        "latest",
+			expected:   "pipecd-image-update",
+			found:      true,
+		},
+		{
+			name:       "no matching rule",
+			repository: "other/helloworld",
+			tag:        "v0.1.0",
+			found:      false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, found := cfg.FindRule(tc.repository, tc.tag)
+			assert.Equal(t, tc.found, found)
+			if found {
+				assert.Equal(t, tc.expected, rule.EventName)
+			}
+		})
+	}
+}
+
+func TestProjectRegistryWebhookConfig_Update(t *testing.T) {
+	cfg := &ProjectRegistryWebhookConfig{
+		Secret: "encrypted-secret",
+		Rules: []*ProjectRegistryWebhookConfig_Rule{
+			{Repository: "pipe-cd/helloworld", EventName: "old"},
+		},
+	}
+	rules := []*ProjectRegistryWebhookConfig_Rule{
+		{Repository: "pipe-cd/helloworld", EventName: "new"},
+	}
+
+	cfg.Update(&ProjectRegistryWebhookConfig{Rules: rules})
+	assert.Equal(t, "encrypted-secret", cfg.Secret)
+	assert.Equal(t, rules, cfg.Rules)
+
+	cfg.Update(&ProjectRegistryWebhookConfig{Secret: "new-secret", Rules: rules})
+	assert.Equal(t, "new-secret", cfg.Secret)
+}
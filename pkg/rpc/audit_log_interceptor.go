@@ -0,0 +1,137 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
+)
+
+// AuditLogStore is used to save the audit logs of the handled requests.
+type AuditLogStore interface {
+	Add(ctx context.Context, l model.AuditLog) error
+}
+
+// AuditLogUnaryServerInterceptor records an audit log for every handled unary gRPC request
+// whose method is marked as a create, update or delete action by the rbac method option.
+// It must be placed after the authentication interceptors to know the actor of the request.
+func AuditLogUnaryServerInterceptor(store AuditLogStore, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		rbac, ok := findMutatingRBAC(info.FullMethod)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+
+		projectID, actorType, actor, ok := extractActor(ctx)
+		if !ok {
+			logger.Warn("unable to find the actor of a mutating request to record audit log",
+				zap.String("method", info.FullMethod),
+			)
+			return resp, err
+		}
+		st := status.Convert(err)
+		e := store.Add(ctx, model.AuditLog{
+			Id:            uuid.New().String(),
+			ProjectId:     projectID,
+			ActorType:     actorType,
+			Actor:         actor,
+			Method:        info.FullMethod,
+			ResourceType:  rbac.Resource,
+			Action:        rbac.Action,
+			ResourceId:    findResourceID(req, rbac.Resource),
+			StatusCode:    st.Code().String(),
+			StatusMessage: st.Message(),
+		})
+		if e != nil {
+			logger.Error("failed to save audit log",
+				zap.String("method", info.FullMethod),
+				zap.String("actor", actor),
+				zap.Error(e),
+			)
+		}
+		return resp, err
+	}
+}
+
+// findMutatingRBAC returns the rbac option of the given method
+// only when it is specified to create, update or delete a resource.
+func findMutatingRBAC(fullMethod string) (*model.RBAC, bool) {
+	// The full method is formatted as /package.Service/Method.
+	name := strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1)
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, false
+	}
+	md, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, false
+	}
+	rbac, ok := proto.GetExtension(md.Options(), model.E_Rbac).(*model.RBAC)
+	if !ok || rbac == nil || rbac.Ignored {
+		return nil, false
+	}
+	switch rbac.Action {
+	case model.ProjectRBACPolicy_CREATE, model.ProjectRBACPolicy_UPDATE, model.ProjectRBACPolicy_DELETE:
+		return rbac, true
+	default:
+		return nil, false
+	}
+}
+
+// extractActor returns the project and the actor of the request
+// from the authentication information stored in the context.
+func extractActor(ctx context.Context) (projectID string, actorType model.AuditLogActorType, actor string, ok bool) {
+	if claims, err := rpcauth.ExtractClaims(ctx); err == nil {
+		return claims.Role.ProjectId, model.AuditLogActorType_AUDIT_LOG_ACTOR_USER, claims.Subject, true
+	}
+	if key, err := rpcauth.ExtractAPIKey(ctx); err == nil {
+		return key.ProjectId, model.AuditLogActorType_AUDIT_LOG_ACTOR_API_KEY, key.Id, true
+	}
+	return "", 0, "", false
+}
+
+// findResourceID returns the identifier of the targeted resource found in the given request.
+// The field named by the resource type (e.g. application_id) is preferred, then id and name.
+func findResourceID(req interface{}, typ model.ProjectRBACResource_ResourceType) string {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	msg := m.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for _, name := range []string{strings.ToLower(typ.String()) + "_id", "id", "name"} {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			continue
+		}
+		if v := msg.Get(fd).String(); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pipe-cd/pipecd/pkg/app/server/service/apiservice"
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
+)
+
+type fakeAuditLogStore struct {
+	logs []*model.AuditLog
+}
+
+func (s *fakeAuditLogStore) Add(_ context.Context, l model.AuditLog) error {
+	s.logs = append(s.logs, &l)
+	return nil
+}
+
+func TestAuditLogUnaryServerInterceptor(t *testing.T) {
+	key := &model.APIKey{
+		Id:        "api-key-id",
+		ProjectId: "project-id",
+	}
+	testcases := []struct {
+		name       string
+		ctx        context.Context
+		method     string
+		req        interface{}
+		handlerErr error
+		expected   *model.AuditLog
+	}{
+		{
+			name:   "not a mutating method",
+			ctx:    rpcauth.ContextWithAPIKey(context.Background(), key),
+			method: "/grpc.service.apiservice.APIService/GetApplication",
+			req:    &apiservice.GetApplicationRequest{ApplicationId: "app-id"},
+		},
+		{
+			name:   "unknown method",
+			ctx:    rpcauth.ContextWithAPIKey(context.Background(), key),
+			method: "/grpc.service.apiservice.APIService/Unknown",
+			req:    &apiservice.GetApplicationRequest{ApplicationId: "app-id"},
+		},
+		{
+			name:   "no actor",
+			ctx:    context.Background(),
+			method: "/grpc.service.apiservice.APIService/SyncApplication",
+			req:    &apiservice.SyncApplicationRequest{ApplicationId: "app-id"},
+		},
+		{
+			name:   "succeeded mutation",
+			ctx:    rpcauth.ContextWithAPIKey(context.Background(), key),
+			method: "/grpc.service.apiservice.APIService/SyncApplication",
+			req:    &apiservice.SyncApplicationRequest{ApplicationId: "app-id"},
+			expected: &model.AuditLog{
+				ProjectId:    "project-id",
+				ActorType:    model.AuditLogActorType_AUDIT_LOG_ACTOR_API_KEY,
+				Actor:        "api-key-id",
+				Method:       "/grpc.service.apiservice.APIService/SyncApplication",
+				ResourceType: model.ProjectRBACResource_APPLICATION,
+				Action:       model.ProjectRBACPolicy_UPDATE,
+				ResourceId:   "app-id",
+				StatusCode:   "OK",
+			},
+		},
+		{
+			name:       "failed mutation",
+			ctx:        rpcauth.ContextWithAPIKey(context.Background(), key),
+			method:     "/grpc.service.apiservice.APIService/RegisterEvent",
+			req:        &apiservice.RegisterEventRequest{Name: "event-name"},
+			handlerErr: status.Error(codes.PermissionDenied, "Permission denied"),
+			expected: &model.AuditLog{
+				ProjectId:     "project-id",
+				ActorType:     model.AuditLogActorType_AUDIT_LOG_ACTOR_API_KEY,
+				Actor:         "api-key-id",
+				Method:        "/grpc.service.apiservice.APIService/RegisterEvent",
+				ResourceType:  model.ProjectRBACResource_EVENT,
+				Action:        model.ProjectRBACPolicy_CREATE,
+				ResourceId:    "event-name",
+				StatusCode:    "PermissionDenied",
+				StatusMessage: "Permission denied",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &fakeAuditLogStore{}
+			in := AuditLogUnaryServerInterceptor(store, zap.NewNop())
+			_, err := in(tc.ctx, tc.req, &grpc.UnaryServerInfo{FullMethod: tc.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, tc.handlerErr
+			})
+			assert.Equal(t, tc.handlerErr, err)
+
+			if tc.expected == nil {
+				assert.Empty(t, store.logs)
+				return
+			}
+			require.Len(t, store.logs, 1)
+			got := store.logs[0]
+			assert.NotEmpty(t, got.Id)
+			got.Id = ""
+			assert.True(t, proto.Equal(tc.expected, got), got.String())
+		})
+	}
+}
@@ -37,6 +37,11 @@ type RBACAuthorizer interface {
 	Authorize(context.Context, string, model.Role) bool
 }
 
+// APIKeyAuthorizer defines a function to check whether an API key is allowed to call a specific RPC method.
+type APIKeyAuthorizer interface {
+	Authorize(context.Context, string, *model.APIKey) bool
+}
+
 // PipedTokenVerifier verifies the given piped token.
 type PipedTokenVerifier interface {
 	Verify(ctx context.Context, projectID, pipedID, pipedKey string) error
@@ -147,8 +152,9 @@ func ExtractPipedToken(ctx context.Context) (projectID, pipedID, pipedKey string
 
 // APIKeyUnaryServerInterceptor extracts credentials from gRPC metadata
 // and validates it by the specified Verifier.
-// The valid API key will be set to the context.
-func APIKeyUnaryServerInterceptor(verifier APIKeyVerifier, logger *zap.Logger) grpc.UnaryServerInterceptor {
+// The valid API key will be set to the context
+// only when it is allowed to call the requested method by the specified Authorizer.
+func APIKeyUnaryServerInterceptor(verifier APIKeyVerifier, authorizer APIKeyAuthorizer, logger *zap.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		creds, err := extractCredentials(ctx)
 		if err != nil {
@@ -163,6 +169,12 @@ func APIKeyUnaryServerInterceptor(verifier APIKeyVerifier, logger *zap.Logger) g
 			logger.Warn("unable to verify api key", zap.Error(err))
 			return nil, errUnauthenticated
 		}
+		if !authorizer.Authorize(ctx, info.FullMethod, apiKey) {
+			logger.Warn(fmt.Sprintf("unsufficient permission for method: %s", info.FullMethod),
+				zap.String("api-key", apiKey.Id),
+			)
+			return nil, errPermissionDenied
+		}
 		ctx = ContextWithAPIKey(ctx, apiKey)
 		return handler(ctx, req)
 	}
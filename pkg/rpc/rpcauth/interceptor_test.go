@@ -180,6 +180,14 @@ func (v testAPIKeyVerifier) Verify(_ context.Context, key string) (*model.APIKey
 	return v.key, nil
 }
 
+type testAPIKeyAuthorizer struct {
+	method string
+}
+
+func (a testAPIKeyAuthorizer) Authorize(_ context.Context, method string, _ *model.APIKey) bool {
+	return method == a.method
+}
+
 func TestAPIKeyUnaryServerInterceptor(t *testing.T) {
 	verifier := testAPIKeyVerifier{
 		keyString: "test-api-key",
@@ -187,16 +195,21 @@ func TestAPIKeyUnaryServerInterceptor(t *testing.T) {
 			Id: "test-api-key",
 		},
 	}
-	in := APIKeyUnaryServerInterceptor(verifier, zap.NewNop())
+	authorizer := testAPIKeyAuthorizer{
+		method: "/test.Service/Allowed",
+	}
+	in := APIKeyUnaryServerInterceptor(verifier, authorizer, zap.NewNop())
 	testcases := []struct {
 		name        string
 		ctx         context.Context
+		method      string
 		expectedKey *model.APIKey
 		errString   string
 	}{
 		{
 			name:      "missing credentials",
 			ctx:       context.TODO(),
+			method:    "/test.Service/Allowed",
 			errString: "rpc error: code = Unauthenticated desc = missing credentials",
 		},
 		{
@@ -204,13 +217,23 @@ func TestAPIKeyUnaryServerInterceptor(t *testing.T) {
 			ctx: metadata.NewIncomingContext(context.Background(), metadata.MD{
 				"authorization": []string{"PIPED-TOKEN test-project-id,test-piped-id,test-piped-key"},
 			}),
+			method:    "/test.Service/Allowed",
 			errString: "rpc error: code = Unauthenticated desc = Unauthenticated",
 		},
+		{
+			name: "method is not allowed",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.MD{
+				"authorization": []string{"API-KEY test-api-key"},
+			}),
+			method:    "/test.Service/NotAllowed",
+			errString: "rpc error: code = PermissionDenied desc = Permission Denied",
+		},
 		{
 			name: "ok",
 			ctx: metadata.NewIncomingContext(context.Background(), metadata.MD{
 				"authorization": []string{"API-KEY test-api-key"},
 			}),
+			method: "/test.Service/Allowed",
 			expectedKey: &model.APIKey{
 				Id: "test-api-key",
 			},
@@ -218,7 +241,8 @@ func TestAPIKeyUnaryServerInterceptor(t *testing.T) {
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := in(tc.ctx, nil, nil, func(ctx context.Context, req interface{}) (interface{}, error) {
+			info := &grpc.UnaryServerInfo{FullMethod: tc.method}
+			_, err := in(tc.ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
 				apiKey, err := ExtractAPIKey(ctx)
 				if err != nil {
 					return nil, err
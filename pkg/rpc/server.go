@@ -52,6 +52,7 @@ type Server struct {
 	pipedKeyAuthStreamInterceptor     grpc.StreamServerInterceptor
 	apiKeyAuthUnaryInterceptor        grpc.UnaryServerInterceptor
 	jwtAuthUnaryInterceptor           grpc.UnaryServerInterceptor
+	auditLogUnaryInterceptor          grpc.UnaryServerInterceptor
 	requestValidationUnaryInterceptor grpc.UnaryServerInterceptor
 	logUnaryInterceptor               grpc.UnaryServerInterceptor
 	prometheusUnaryInterceptor        grpc.UnaryServerInterceptor
@@ -83,9 +84,9 @@ func WithPipedTokenAuthStreamInterceptor(verifier rpcauth.PipedTokenVerifier, lo
 }
 
 // WithAPIKeyAuthUnaryInterceptor sets an interceptor for validating API key.
-func WithAPIKeyAuthUnaryInterceptor(verifier rpcauth.APIKeyVerifier, logger *zap.Logger) Option {
+func WithAPIKeyAuthUnaryInterceptor(verifier rpcauth.APIKeyVerifier, authorizer rpcauth.APIKeyAuthorizer, logger *zap.Logger) Option {
 	return func(s *Server) {
-		s.apiKeyAuthUnaryInterceptor = rpcauth.APIKeyUnaryServerInterceptor(verifier, logger)
+		s.apiKeyAuthUnaryInterceptor = rpcauth.APIKeyUnaryServerInterceptor(verifier, authorizer, logger)
 	}
 }
 
@@ -96,6 +97,13 @@ func WithJWTAuthUnaryInterceptor(verifier jwt.Verifier, authorizer rpcauth.RBACA
 	}
 }
 
+// WithAuditLogUnaryInterceptor sets an interceptor for recording audit logs of mutating requests.
+func WithAuditLogUnaryInterceptor(store AuditLogStore, logger *zap.Logger) Option {
+	return func(s *Server) {
+		s.auditLogUnaryInterceptor = AuditLogUnaryServerInterceptor(store, logger.Named("audit-log"))
+	}
+}
+
 // WithRequestValidationUnaryInterceptor sets an interceptor for validating request payload.
 func WithRequestValidationUnaryInterceptor() Option {
 	return func(s *Server) {
@@ -222,6 +230,9 @@ func (s *Server) init() error {
 	if s.jwtAuthUnaryInterceptor != nil {
 		unaryInterceptors = append(unaryInterceptors, s.jwtAuthUnaryInterceptor)
 	}
+	if s.auditLogUnaryInterceptor != nil {
+		unaryInterceptors = append(unaryInterceptors, s.auditLogUnaryInterceptor)
+	}
 	if s.requestValidationUnaryInterceptor != nil {
 		unaryInterceptors = append(unaryInterceptors, s.requestValidationUnaryInterceptor)
 	}
@@ -0,0 +1,129 @@
+// Copyright 2024 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/datastore"
+	"github.com/pipe-cd/pipecd/pkg/datastore/datastoretest"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func newApplication(id string) *model.Application {
+	return &model.Application{
+		Id:        id,
+		Name:      "name-" + id,
+		PipedId:   "piped-id",
+		ProjectId: "project-id",
+		Kind:      model.ApplicationKind_KUBERNETES,
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{Id: "repo-id"},
+			Path: "path",
+		},
+		PlatformProvider: "platform-provider",
+	}
+}
+
+func newDeployment(id, appID string) *model.Deployment {
+	return &model.Deployment{
+		Id:              id,
+		ApplicationId:   appID,
+		ApplicationName: "name-" + appID,
+		PipedId:         "piped-id",
+		ProjectId:       "project-id",
+		Kind:            model.ApplicationKind_KUBERNETES,
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{Id: "repo-id"},
+			Path: "path",
+		},
+		Trigger: &model.DeploymentTrigger{
+			Commit: &model.Commit{
+				Hash:      "hash",
+				Message:   "message",
+				Author:    "author",
+				Branch:    "main",
+				CreatedAt: 1,
+			},
+			Timestamp: 1,
+		},
+		Status: model.DeploymentStatus_DEPLOYMENT_PENDING,
+	}
+}
+
+func TestApplicationStore(t *testing.T) {
+	ctx := context.Background()
+	store := datastore.NewApplicationStore(datastoretest.NewInMemoryDataStore(t))
+
+	require.NoError(t, store.Add(ctx, newApplication("app-1")))
+	require.NoError(t, store.Add(ctx, newApplication("app-2")))
+	assert.ErrorIs(t, store.Add(ctx, newApplication("app-1")), datastore.ErrAlreadyExists)
+
+	require.NoError(t, store.Disable(ctx, "app-1"))
+	require.NoError(t, store.UpdateDeployingStatus(ctx, "app-2", true))
+
+	app, err := store.Get(ctx, "app-1")
+	require.NoError(t, err)
+	assert.True(t, app.Disabled)
+
+	apps, _, err := store.List(ctx, datastore.ListOptions{
+		Filters: []datastore.ListFilter{
+			{Field: "ProjectId", Operator: datastore.OperatorEqual, Value: "project-id"},
+			{Field: "Disabled", Operator: datastore.OperatorEqual, Value: false},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "app-2", apps[0].Id)
+	assert.True(t, apps[0].Deploying)
+
+	_, err = store.Get(ctx, "not-found")
+	assert.ErrorIs(t, err, datastore.ErrNotFound)
+}
+
+func TestDeploymentStore(t *testing.T) {
+	ctx := context.Background()
+	store := datastore.NewDeploymentStore(datastoretest.NewInMemoryDataStore(t))
+
+	require.NoError(t, store.Add(ctx, newDeployment("deployment-1", "app-1")))
+	require.NoError(t, store.Add(ctx, newDeployment("deployment-2", "app-2")))
+
+	stages := []*model.PipelineStage{
+		{Id: "stage-1", Name: model.StageK8sSync.String(), Status: model.StageStatus_STAGE_NOT_STARTED_YET, CreatedAt: 1, UpdatedAt: 1},
+	}
+	require.NoError(t, store.UpdateToPlanned(ctx, "deployment-1", "summary", "reason", "hash", "app.pipecd.yaml", model.SyncStrategy_QUICK_SYNC, nil, stages))
+	require.NoError(t, store.UpdateStageStatus(ctx, "deployment-1", "stage-1", model.StageStatus_STAGE_SUCCESS, "", nil, true, 0, 2))
+	require.NoError(t, store.UpdateToCompleted(ctx, "deployment-1", model.DeploymentStatus_DEPLOYMENT_SUCCESS, nil, "done", 2))
+
+	d, err := store.Get(ctx, "deployment-1")
+	require.NoError(t, err)
+	assert.Equal(t, model.DeploymentStatus_DEPLOYMENT_SUCCESS, d.Status)
+	assert.Equal(t, "summary", d.Summary)
+	require.Len(t, d.Stages, 1)
+	assert.Equal(t, model.StageStatus_STAGE_SUCCESS, d.Stages[0].Status)
+
+	deployments, _, err := store.List(ctx, datastore.ListOptions{
+		Filters: []datastore.ListFilter{
+			{Field: "Status", Operator: datastore.OperatorEqual, Value: model.DeploymentStatus_DEPLOYMENT_PENDING},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, deployments, 1)
+	assert.Equal(t, "deployment-2", deployments[0].Id)
+}
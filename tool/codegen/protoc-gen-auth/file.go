@@ -148,3 +148,38 @@ func (a *authorizer) Authorize(ctx context.Context, method string, r model.Role)
 	return false
 }
 `
+
+const apiKeyFileTpl = `// Code generated by protoc-gen-auth. DO NOT EDIT.
+// source: {{ .InputPath }}
+
+package apiservice
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+	"github.com/pipe-cd/pipecd/pkg/rpc/rpcauth"
+)
+
+type authorizer struct{}
+
+// NewAPIKeyAuthorizer returns an APIKeyAuthorizer object for checking requested method based on the policies of API key.
+func NewAPIKeyAuthorizer() rpcauth.APIKeyAuthorizer {
+	return &authorizer{}
+}
+
+// Authorize checks whether an API key is allowed to call the given gRPC method or not.
+func (a *authorizer) Authorize(_ context.Context, method string, k *model.APIKey) bool {
+	switch method {
+	{{- range .Methods }}
+	case "/grpc.service.apiservice.APIService/{{ .Name }}":
+	        {{- if .Ignored }}
+			return true
+		{{- else }}
+			return k.HasPermission(model.ProjectRBACResource_{{ .Resource }}, model.ProjectRBACPolicy_{{ .Action }})
+		{{- end }}
+	{{- end }}
+	}
+	return false
+}
+`
@@ -42,7 +42,8 @@ type Method struct {
 }
 
 const (
-	filePrefix                  = "pkg/app/server/service/webservice"
+	webServiceFilePrefix        = "pkg/app/server/service/webservice"
+	apiServiceFilePrefix        = "pkg/app/server/service/apiservice"
 	generatedFileNameSuffix     = ".pb.auth.go"
 	protoFileExtention          = ".proto"
 	methodOptionsRBAC           = "rbac"
@@ -59,7 +60,17 @@ func main() {
 				return fmt.Errorf("registerAllExtensions error: %v", err)
 			}
 
-			if !f.Generate || !strings.Contains(f.GeneratedFilenamePrefix, filePrefix) {
+			if !f.Generate {
+				continue
+			}
+
+			var tpl string
+			switch {
+			case strings.Contains(f.GeneratedFilenamePrefix, webServiceFilePrefix):
+				tpl = fileTpl
+			case strings.Contains(f.GeneratedFilenamePrefix, apiServiceFilePrefix):
+				tpl = apiKeyFileTpl
+			default:
 				continue
 			}
 
@@ -86,7 +97,7 @@ func main() {
 			}
 
 			buf := bytes.Buffer{}
-			t := template.Must(template.New("auth").Parse(fileTpl))
+			t := template.Must(template.New("auth").Parse(tpl))
 			if err := t.Execute(&buf, fp); err != nil {
 				return fmt.Errorf("template execute error: %v", err)
 			}
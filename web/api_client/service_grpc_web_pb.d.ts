@@ -302,6 +302,20 @@ export class WebServiceClient {
                response: pkg_app_server_service_webservice_service_pb.DeleteProjectUserGroupResponse) => void
   ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.DeleteProjectUserGroupResponse>;
 
+  updateProjectRegistryWebhookConfig(
+    request: pkg_app_server_service_webservice_service_pb.UpdateProjectRegistryWebhookConfigRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.UpdateProjectRegistryWebhookConfigResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.UpdateProjectRegistryWebhookConfigResponse>;
+
+  updateProjectFreezeWindows(
+    request: pkg_app_server_service_webservice_service_pb.UpdateProjectFreezeWindowsRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.UpdateProjectFreezeWindowsResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.UpdateProjectFreezeWindowsResponse>;
+
   getCommand(
     request: pkg_app_server_service_webservice_service_pb.GetCommandRequest,
     metadata: grpcWeb.Metadata | undefined,
@@ -365,6 +379,13 @@ export class WebServiceClient {
                response: pkg_app_server_service_webservice_service_pb.ListEventsResponse) => void
   ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.ListEventsResponse>;
 
+  listAuditLogs(
+    request: pkg_app_server_service_webservice_service_pb.ListAuditLogsRequest,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: pkg_app_server_service_webservice_service_pb.ListAuditLogsResponse) => void
+  ): grpcWeb.ClientReadableStream<pkg_app_server_service_webservice_service_pb.ListAuditLogsResponse>;
+
 }
 
 export class WebServicePromiseClient {
@@ -582,6 +603,16 @@ export class WebServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<pkg_app_server_service_webservice_service_pb.DeleteProjectUserGroupResponse>;
 
+  updateProjectRegistryWebhookConfig(
+    request: pkg_app_server_service_webservice_service_pb.UpdateProjectRegistryWebhookConfigRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.UpdateProjectRegistryWebhookConfigResponse>;
+
+  updateProjectFreezeWindows(
+    request: pkg_app_server_service_webservice_service_pb.UpdateProjectFreezeWindowsRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.UpdateProjectFreezeWindowsResponse>;
+
   getCommand(
     request: pkg_app_server_service_webservice_service_pb.GetCommandRequest,
     metadata?: grpcWeb.Metadata
@@ -627,5 +658,10 @@ export class WebServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<pkg_app_server_service_webservice_service_pb.ListEventsResponse>;
 
+  listAuditLogs(
+    request: pkg_app_server_service_webservice_service_pb.ListAuditLogsRequest,
+    metadata?: grpcWeb.Metadata
+  ): Promise<pkg_app_server_service_webservice_service_pb.ListAuditLogsResponse>;
+
 }
 
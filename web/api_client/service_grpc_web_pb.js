@@ -46,6 +46,8 @@ var pkg_model_apikey_pb = require('pipecd/web/model/apikey_pb.js')
 
 var pkg_model_event_pb = require('pipecd/web/model/event_pb.js')
 
+var pkg_model_audit_log_pb = require('pipecd/web/model/audit_log_pb.js')
+
 var google_protobuf_wrappers_pb = require('google-protobuf/google/protobuf/wrappers_pb.js')
 
 var google_protobuf_descriptor_pb = require('google-protobuf/google/protobuf/descriptor_pb.js')
@@ -2668,6 +2670,128 @@ proto.grpc.service.webservice.WebServicePromiseClient.prototype.deleteProjectUse
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest,
+ *   !proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse>}
+ */
+const methodDescriptor_WebService_UpdateProjectRegistryWebhookConfig = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/UpdateProjectRegistryWebhookConfig',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest,
+  proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.updateProjectRegistryWebhookConfig =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/UpdateProjectRegistryWebhookConfig',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_UpdateProjectRegistryWebhookConfig,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.updateProjectRegistryWebhookConfig =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/UpdateProjectRegistryWebhookConfig',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_UpdateProjectRegistryWebhookConfig);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest,
+ *   !proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse>}
+ */
+const methodDescriptor_WebService_UpdateProjectFreezeWindows = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/UpdateProjectFreezeWindows',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest,
+  proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.updateProjectFreezeWindows =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/UpdateProjectFreezeWindows',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_UpdateProjectFreezeWindows,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.updateProjectFreezeWindows =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/UpdateProjectFreezeWindows',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_UpdateProjectFreezeWindows);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -3217,5 +3341,66 @@ proto.grpc.service.webservice.WebServicePromiseClient.prototype.listEvents =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.grpc.service.webservice.ListAuditLogsRequest,
+ *   !proto.grpc.service.webservice.ListAuditLogsResponse>}
+ */
+const methodDescriptor_WebService_ListAuditLogs = new grpc.web.MethodDescriptor(
+  '/grpc.service.webservice.WebService/ListAuditLogs',
+  grpc.web.MethodType.UNARY,
+  proto.grpc.service.webservice.ListAuditLogsRequest,
+  proto.grpc.service.webservice.ListAuditLogsResponse,
+  /**
+   * @param {!proto.grpc.service.webservice.ListAuditLogsRequest} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.grpc.service.webservice.ListAuditLogsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.grpc.service.webservice.ListAuditLogsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.grpc.service.webservice.ListAuditLogsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.grpc.service.webservice.ListAuditLogsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.grpc.service.webservice.WebServiceClient.prototype.listAuditLogs =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/ListAuditLogs',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_ListAuditLogs,
+      callback);
+};
+
+
+/**
+ * @param {!proto.grpc.service.webservice.ListAuditLogsRequest} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.grpc.service.webservice.ListAuditLogsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.grpc.service.webservice.WebServicePromiseClient.prototype.listAuditLogs =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/grpc.service.webservice.WebService/ListAuditLogs',
+      request,
+      metadata || {},
+      methodDescriptor_WebService_ListAuditLogs);
+};
+
+
 module.exports = proto.grpc.service.webservice;
 
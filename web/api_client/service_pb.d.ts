@@ -15,6 +15,7 @@ import * as pkg_model_rbac_pb from 'pipecd/web/model/rbac_pb';
 import * as pkg_model_project_pb from 'pipecd/web/model/project_pb';
 import * as pkg_model_apikey_pb from 'pipecd/web/model/apikey_pb';
 import * as pkg_model_event_pb from 'pipecd/web/model/event_pb';
+import * as pkg_model_audit_log_pb from 'pipecd/web/model/audit_log_pb';
 import * as google_protobuf_wrappers_pb from 'google-protobuf/google/protobuf/wrappers_pb';
 import * as google_protobuf_descriptor_pb from 'google-protobuf/google/protobuf/descriptor_pb';
 
@@ -792,6 +793,9 @@ export class SyncApplicationRequest extends jspb.Message {
   getSyncStrategy(): pkg_model_common_pb.SyncStrategy;
   setSyncStrategy(value: pkg_model_common_pb.SyncStrategy): SyncApplicationRequest;
 
+  getIgnoreFreezeWindows(): boolean;
+  setIgnoreFreezeWindows(value: boolean): SyncApplicationRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): SyncApplicationRequest.AsObject;
   static toObject(includeInstance: boolean, msg: SyncApplicationRequest): SyncApplicationRequest.AsObject;
@@ -804,6 +808,7 @@ export namespace SyncApplicationRequest {
   export type AsObject = {
     applicationId: string,
     syncStrategy: pkg_model_common_pb.SyncStrategy,
+    ignoreFreezeWindows: boolean,
   }
 }
 
@@ -1804,6 +1809,74 @@ export namespace DeleteProjectUserGroupResponse {
   }
 }
 
+export class UpdateProjectRegistryWebhookConfigRequest extends jspb.Message {
+  getRegistryWebhook(): pkg_model_project_pb.ProjectRegistryWebhookConfig | undefined;
+  setRegistryWebhook(value?: pkg_model_project_pb.ProjectRegistryWebhookConfig): UpdateProjectRegistryWebhookConfigRequest;
+  hasRegistryWebhook(): boolean;
+  clearRegistryWebhook(): UpdateProjectRegistryWebhookConfigRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateProjectRegistryWebhookConfigRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateProjectRegistryWebhookConfigRequest): UpdateProjectRegistryWebhookConfigRequest.AsObject;
+  static serializeBinaryToWriter(message: UpdateProjectRegistryWebhookConfigRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateProjectRegistryWebhookConfigRequest;
+  static deserializeBinaryFromReader(message: UpdateProjectRegistryWebhookConfigRequest, reader: jspb.BinaryReader): UpdateProjectRegistryWebhookConfigRequest;
+}
+
+export namespace UpdateProjectRegistryWebhookConfigRequest {
+  export type AsObject = {
+    registryWebhook?: pkg_model_project_pb.ProjectRegistryWebhookConfig.AsObject,
+  }
+}
+
+export class UpdateProjectRegistryWebhookConfigResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateProjectRegistryWebhookConfigResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateProjectRegistryWebhookConfigResponse): UpdateProjectRegistryWebhookConfigResponse.AsObject;
+  static serializeBinaryToWriter(message: UpdateProjectRegistryWebhookConfigResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateProjectRegistryWebhookConfigResponse;
+  static deserializeBinaryFromReader(message: UpdateProjectRegistryWebhookConfigResponse, reader: jspb.BinaryReader): UpdateProjectRegistryWebhookConfigResponse;
+}
+
+export namespace UpdateProjectRegistryWebhookConfigResponse {
+  export type AsObject = {
+  }
+}
+
+export class UpdateProjectFreezeWindowsRequest extends jspb.Message {
+  getFreezeWindowsList(): Array<pkg_model_project_pb.ProjectFreezeWindow>;
+  setFreezeWindowsList(value: Array<pkg_model_project_pb.ProjectFreezeWindow>): UpdateProjectFreezeWindowsRequest;
+  clearFreezeWindowsList(): UpdateProjectFreezeWindowsRequest;
+  addFreezeWindows(value?: pkg_model_project_pb.ProjectFreezeWindow, index?: number): pkg_model_project_pb.ProjectFreezeWindow;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateProjectFreezeWindowsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateProjectFreezeWindowsRequest): UpdateProjectFreezeWindowsRequest.AsObject;
+  static serializeBinaryToWriter(message: UpdateProjectFreezeWindowsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateProjectFreezeWindowsRequest;
+  static deserializeBinaryFromReader(message: UpdateProjectFreezeWindowsRequest, reader: jspb.BinaryReader): UpdateProjectFreezeWindowsRequest;
+}
+
+export namespace UpdateProjectFreezeWindowsRequest {
+  export type AsObject = {
+    freezeWindowsList: Array<pkg_model_project_pb.ProjectFreezeWindow.AsObject>,
+  }
+}
+
+export class UpdateProjectFreezeWindowsResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateProjectFreezeWindowsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateProjectFreezeWindowsResponse): UpdateProjectFreezeWindowsResponse.AsObject;
+  static serializeBinaryToWriter(message: UpdateProjectFreezeWindowsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateProjectFreezeWindowsResponse;
+  static deserializeBinaryFromReader(message: UpdateProjectFreezeWindowsResponse, reader: jspb.BinaryReader): UpdateProjectFreezeWindowsResponse;
+}
+
+export namespace UpdateProjectFreezeWindowsResponse {
+  export type AsObject = {
+  }
+}
+
 export class GetCommandRequest extends jspb.Message {
   getCommandId(): string;
   setCommandId(value: string): GetCommandRequest;
@@ -1849,6 +1922,14 @@ export class GenerateAPIKeyRequest extends jspb.Message {
   getRole(): pkg_model_apikey_pb.APIKey.Role;
   setRole(value: pkg_model_apikey_pb.APIKey.Role): GenerateAPIKeyRequest;
 
+  getPoliciesList(): Array<pkg_model_project_pb.ProjectRBACPolicy>;
+  setPoliciesList(value: Array<pkg_model_project_pb.ProjectRBACPolicy>): GenerateAPIKeyRequest;
+  clearPoliciesList(): GenerateAPIKeyRequest;
+  addPolicies(value?: pkg_model_project_pb.ProjectRBACPolicy, index?: number): pkg_model_project_pb.ProjectRBACPolicy;
+
+  getExpiresAt(): number;
+  setExpiresAt(value: number): GenerateAPIKeyRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GenerateAPIKeyRequest.AsObject;
   static toObject(includeInstance: boolean, msg: GenerateAPIKeyRequest): GenerateAPIKeyRequest.AsObject;
@@ -1861,6 +1942,8 @@ export namespace GenerateAPIKeyRequest {
   export type AsObject = {
     name: string,
     role: pkg_model_apikey_pb.APIKey.Role,
+    policiesList: Array<pkg_model_project_pb.ProjectRBACPolicy.AsObject>,
+    expiresAt: number,
   }
 }
 
@@ -2279,3 +2362,82 @@ export namespace ListEventsResponse {
   }
 }
 
+export class ListAuditLogsRequest extends jspb.Message {
+  getOptions(): ListAuditLogsRequest.Options | undefined;
+  setOptions(value?: ListAuditLogsRequest.Options): ListAuditLogsRequest;
+  hasOptions(): boolean;
+  clearOptions(): ListAuditLogsRequest;
+
+  getPageSize(): number;
+  setPageSize(value: number): ListAuditLogsRequest;
+
+  getCursor(): string;
+  setCursor(value: string): ListAuditLogsRequest;
+
+  getPageMinCreatedAt(): number;
+  setPageMinCreatedAt(value: number): ListAuditLogsRequest;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListAuditLogsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ListAuditLogsRequest): ListAuditLogsRequest.AsObject;
+  static serializeBinaryToWriter(message: ListAuditLogsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListAuditLogsRequest;
+  static deserializeBinaryFromReader(message: ListAuditLogsRequest, reader: jspb.BinaryReader): ListAuditLogsRequest;
+}
+
+export namespace ListAuditLogsRequest {
+  export type AsObject = {
+    options?: ListAuditLogsRequest.Options.AsObject,
+    pageSize: number,
+    cursor: string,
+    pageMinCreatedAt: number,
+  }
+
+  export class Options extends jspb.Message {
+    getActor(): string;
+    setActor(value: string): Options;
+
+    getResourceType(): pkg_model_project_pb.ProjectRBACResource.ResourceType;
+    setResourceType(value: pkg_model_project_pb.ProjectRBACResource.ResourceType): Options;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Options.AsObject;
+    static toObject(includeInstance: boolean, msg: Options): Options.AsObject;
+    static serializeBinaryToWriter(message: Options, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Options;
+    static deserializeBinaryFromReader(message: Options, reader: jspb.BinaryReader): Options;
+  }
+
+  export namespace Options {
+    export type AsObject = {
+      actor: string,
+      resourceType: pkg_model_project_pb.ProjectRBACResource.ResourceType,
+    }
+  }
+
+}
+
+export class ListAuditLogsResponse extends jspb.Message {
+  getAuditLogsList(): Array<pkg_model_audit_log_pb.AuditLog>;
+  setAuditLogsList(value: Array<pkg_model_audit_log_pb.AuditLog>): ListAuditLogsResponse;
+  clearAuditLogsList(): ListAuditLogsResponse;
+  addAuditLogs(value?: pkg_model_audit_log_pb.AuditLog, index?: number): pkg_model_audit_log_pb.AuditLog;
+
+  getCursor(): string;
+  setCursor(value: string): ListAuditLogsResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListAuditLogsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ListAuditLogsResponse): ListAuditLogsResponse.AsObject;
+  static serializeBinaryToWriter(message: ListAuditLogsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListAuditLogsResponse;
+  static deserializeBinaryFromReader(message: ListAuditLogsResponse, reader: jspb.BinaryReader): ListAuditLogsResponse;
+}
+
+export namespace ListAuditLogsResponse {
+  export type AsObject = {
+    auditLogsList: Array<pkg_model_audit_log_pb.AuditLog.AsObject>,
+    cursor: string,
+  }
+}
+
@@ -51,6 +51,8 @@ var pkg_model_apikey_pb = require('pipecd/web/model/apikey_pb.js');
 goog.object.extend(proto, pkg_model_apikey_pb);
 var pkg_model_event_pb = require('pipecd/web/model/event_pb.js');
 goog.object.extend(proto, pkg_model_event_pb);
+var pkg_model_audit_log_pb = require('pipecd/web/model/audit_log_pb.js');
+goog.object.extend(proto, pkg_model_audit_log_pb);
 var google_protobuf_wrappers_pb = require('google-protobuf/google/protobuf/wrappers_pb.js');
 goog.object.extend(proto, google_protobuf_wrappers_pb);
 var google_protobuf_descriptor_pb = require('google-protobuf/google/protobuf/descriptor_pb.js');
@@ -119,6 +121,9 @@ goog.exportSymbol('proto.grpc.service.webservice.ListAPIKeysResponse', null, glo
 goog.exportSymbol('proto.grpc.service.webservice.ListApplicationsRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ListApplicationsRequest.Options', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ListApplicationsResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.ListAuditLogsRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.ListAuditLogsRequest.Options', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.ListAuditLogsResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ListDeploymentChainsRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ListDeploymentChainsRequest.Options', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.ListDeploymentChainsResponse', null, global);
@@ -157,10 +162,14 @@ goog.exportSymbol('proto.grpc.service.webservice.UpdatePipedDesiredVersionReques
 goog.exportSymbol('proto.grpc.service.webservice.UpdatePipedDesiredVersionResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdatePipedRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdatePipedResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectRBACConfigRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectRBACConfigResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectRBACRoleRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectRBACRoleResponse', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest', null, global);
+goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectSSOConfigRequest', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectSSOConfigResponse', null, global);
 goog.exportSymbol('proto.grpc.service.webservice.UpdateProjectStaticAdminRequest', null, global);
@@ -2034,6 +2043,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.grpc.service.webservice.DeleteProjectUserGroupResponse.displayName = 'proto.grpc.service.webservice.DeleteProjectUserGroupResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.displayName = 'proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.displayName = 'proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.displayName = 'proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.displayName = 'proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -2475,6 +2568,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.grpc.service.webservice.ListEventsResponse.displayName = 'proto.grpc.service.webservice.ListEventsResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListAuditLogsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListAuditLogsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListAuditLogsRequest.displayName = 'proto.grpc.service.webservice.ListAuditLogsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListAuditLogsRequest.Options = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListAuditLogsRequest.Options, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListAuditLogsRequest.Options.displayName = 'proto.grpc.service.webservice.ListAuditLogsRequest.Options';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.grpc.service.webservice.ListAuditLogsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.grpc.service.webservice.ListAuditLogsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.grpc.service.webservice.ListAuditLogsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.grpc.service.webservice.ListAuditLogsResponse.displayName = 'proto.grpc.service.webservice.ListAuditLogsResponse';
+}
 
 
 
@@ -8181,7 +8337,8 @@ proto.grpc.service.webservice.SyncApplicationRequest.prototype.toObject = functi
 proto.grpc.service.webservice.SyncApplicationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    ignoreFreezeWindows: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -8226,6 +8383,10 @@ proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinaryFromReader
       var value = /** @type {!proto.model.SyncStrategy} */ (reader.readEnum());
       msg.setSyncStrategy(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIgnoreFreezeWindows(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8269,6 +8430,13 @@ proto.grpc.service.webservice.SyncApplicationRequest.serializeBinaryToWriter = f
       f
     );
   }
+  f = message.getIgnoreFreezeWindows();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -8308,6 +8476,24 @@ proto.grpc.service.webservice.SyncApplicationRequest.prototype.setSyncStrategy =
 };
 
 
+/**
+ * optional bool ignore_freeze_windows = 3;
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.getIgnoreFreezeWindows = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.grpc.service.webservice.SyncApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.setIgnoreFreezeWindows = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
@@ -15730,8 +15916,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetCommandRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetCommandRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -15740,13 +15926,13 @@ proto.grpc.service.webservice.GetCommandRequest.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetCommandRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetCommandRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    registryWebhook: (f = msg.getRegistryWebhook()) && proto.model.ProjectRegistryWebhookConfig.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -15760,23 +15946,23 @@ proto.grpc.service.webservice.GetCommandRequest.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetCommandRequest}
+ * @return {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest}
  */
-proto.grpc.service.webservice.GetCommandRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetCommandRequest;
-  return proto.grpc.service.webservice.GetCommandRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest;
+  return proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetCommandRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetCommandRequest}
+ * @return {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest}
  */
-proto.grpc.service.webservice.GetCommandRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -15784,8 +15970,9 @@ proto.grpc.service.webservice.GetCommandRequest.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCommandId(value);
+      var value = new proto.model.ProjectRegistryWebhookConfig;
+      reader.readMessage(value,proto.model.ProjectRegistryWebhookConfig.deserializeBinaryFromReader);
+      msg.setRegistryWebhook(value);
       break;
     default:
       reader.skipField();
@@ -15800,9 +15987,9 @@ proto.grpc.service.webservice.GetCommandRequest.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetCommandRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetCommandRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -15810,37 +15997,57 @@ proto.grpc.service.webservice.GetCommandRequest.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetCommandRequest} message
+ * @param {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetCommandRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCommandId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRegistryWebhook();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.model.ProjectRegistryWebhookConfig.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string command_id = 1;
- * @return {string}
+ * optional model.ProjectRegistryWebhookConfig registry_webhook = 1;
+ * @return {?proto.model.ProjectRegistryWebhookConfig}
  */
-proto.grpc.service.webservice.GetCommandRequest.prototype.getCommandId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.prototype.getRegistryWebhook = function() {
+  return /** @type{?proto.model.ProjectRegistryWebhookConfig} */ (
+    jspb.Message.getWrapperField(this, proto.model.ProjectRegistryWebhookConfig, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.GetCommandRequest} returns this
+ * @param {?proto.model.ProjectRegistryWebhookConfig|undefined} value
+ * @return {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest} returns this
+*/
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.prototype.setRegistryWebhook = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest} returns this
  */
-proto.grpc.service.webservice.GetCommandRequest.prototype.setCommandId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.prototype.clearRegistryWebhook = function() {
+  return this.setRegistryWebhook(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigRequest.prototype.hasRegistryWebhook = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -15860,8 +16067,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetCommandResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetCommandResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -15870,13 +16077,13 @@ proto.grpc.service.webservice.GetCommandResponse.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetCommandResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetCommandResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    command: (f = msg.getCommand()) && pkg_model_command_pb.Command.toObject(includeInstance, f)
+
   };
 
   if (includeInstance) {
@@ -15890,34 +16097,29 @@ proto.grpc.service.webservice.GetCommandResponse.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetCommandResponse}
+ * @return {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse}
  */
-proto.grpc.service.webservice.GetCommandResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetCommandResponse;
-  return proto.grpc.service.webservice.GetCommandResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse;
+  return proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetCommandResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetCommandResponse}
+ * @return {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse}
  */
-proto.grpc.service.webservice.GetCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = new pkg_model_command_pb.Command;
-      reader.readMessage(value,pkg_model_command_pb.Command.deserializeBinaryFromReader);
-      msg.setCommand(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -15931,9 +16133,9 @@ proto.grpc.service.webservice.GetCommandResponse.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetCommandResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetCommandResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -15941,78 +16143,40 @@ proto.grpc.service.webservice.GetCommandResponse.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetCommandResponse} message
+ * @param {!proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetCommandResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateProjectRegistryWebhookConfigResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCommand();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      pkg_model_command_pb.Command.serializeBinaryToWriter
-    );
-  }
 };
 
 
+
 /**
- * optional model.Command command = 1;
- * @return {?proto.model.Command}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.grpc.service.webservice.GetCommandResponse.prototype.getCommand = function() {
-  return /** @type{?proto.model.Command} */ (
-    jspb.Message.getWrapperField(this, pkg_model_command_pb.Command, 1));
-};
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.repeatedFields_ = [1];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {?proto.model.Command|undefined} value
- * @return {!proto.grpc.service.webservice.GetCommandResponse} returns this
-*/
-proto.grpc.service.webservice.GetCommandResponse.prototype.setCommand = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.GetCommandResponse} returns this
- */
-proto.grpc.service.webservice.GetCommandResponse.prototype.clearCommand = function() {
-  return this.setCommand(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.grpc.service.webservice.GetCommandResponse.prototype.hasCommand = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GenerateAPIKeyRequest.toObject(opt_includeInstance, this);
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -16021,14 +16185,14 @@ proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GenerateAPIKeyRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    role: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    freezeWindowsList: jspb.Message.toObjectList(msg.getFreezeWindowsList(),
+    proto.model.ProjectFreezeWindow.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -16042,23 +16206,23 @@ proto.grpc.service.webservice.GenerateAPIKeyRequest.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest}
+ * @return {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest}
  */
-proto.grpc.service.webservice.GenerateAPIKeyRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GenerateAPIKeyRequest;
-  return proto.grpc.service.webservice.GenerateAPIKeyRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest;
+  return proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest}
+ * @return {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest}
  */
-proto.grpc.service.webservice.GenerateAPIKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -16066,12 +16230,9 @@ proto.grpc.service.webservice.GenerateAPIKeyRequest.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 2:
-      var value = /** @type {!proto.model.APIKey.Role} */ (reader.readEnum());
-      msg.setRole(value);
+      var value = new proto.model.ProjectFreezeWindow;
+      reader.readMessage(value,proto.model.ProjectFreezeWindow.deserializeBinaryFromReader);
+      msg.addFreezeWindows(value);
       break;
     default:
       reader.skipField();
@@ -16086,9 +16247,9 @@ proto.grpc.service.webservice.GenerateAPIKeyRequest.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GenerateAPIKeyRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -16096,62 +16257,58 @@ proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} message
+ * @param {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GenerateAPIKeyRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getFreezeWindowsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = message.getRole();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
+      f,
+      proto.model.ProjectFreezeWindow.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * repeated model.ProjectFreezeWindow freeze_windows = 1;
+ * @return {!Array<!proto.model.ProjectFreezeWindow>}
  */
-proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.prototype.getFreezeWindowsList = function() {
+  return /** @type{!Array<!proto.model.ProjectFreezeWindow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.ProjectFreezeWindow, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest} returns this
- */
-proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {!Array<!proto.model.ProjectFreezeWindow>} value
+ * @return {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest} returns this
+*/
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.prototype.setFreezeWindowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional model.APIKey.Role role = 2;
- * @return {!proto.model.APIKey.Role}
+ * @param {!proto.model.ProjectFreezeWindow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ProjectFreezeWindow}
  */
-proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.getRole = function() {
-  return /** @type {!proto.model.APIKey.Role} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.prototype.addFreezeWindows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.ProjectFreezeWindow, opt_index);
 };
 
 
 /**
- * @param {!proto.model.APIKey.Role} value
- * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest} returns this
  */
-proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.setRole = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsRequest.prototype.clearFreezeWindowsList = function() {
+  return this.setFreezeWindowsList([]);
 };
 
 
@@ -16171,8 +16328,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GenerateAPIKeyResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GenerateAPIKeyResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -16181,13 +16338,13 @@ proto.grpc.service.webservice.GenerateAPIKeyResponse.prototype.toObject = functi
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GenerateAPIKeyResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GenerateAPIKeyResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    key: jspb.Message.getFieldWithDefault(msg, 1, "")
+
   };
 
   if (includeInstance) {
@@ -16201,33 +16358,29 @@ proto.grpc.service.webservice.GenerateAPIKeyResponse.toObject = function(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GenerateAPIKeyResponse}
+ * @return {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse}
  */
-proto.grpc.service.webservice.GenerateAPIKeyResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GenerateAPIKeyResponse;
-  return proto.grpc.service.webservice.GenerateAPIKeyResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse;
+  return proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GenerateAPIKeyResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GenerateAPIKeyResponse}
+ * @return {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse}
  */
-proto.grpc.service.webservice.GenerateAPIKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setKey(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -16241,9 +16394,9 @@ proto.grpc.service.webservice.GenerateAPIKeyResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GenerateAPIKeyResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GenerateAPIKeyResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -16251,37 +16404,12 @@ proto.grpc.service.webservice.GenerateAPIKeyResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GenerateAPIKeyResponse} message
+ * @param {!proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GenerateAPIKeyResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.UpdateProjectFreezeWindowsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getKey();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string key = 1;
- * @return {string}
- */
-proto.grpc.service.webservice.GenerateAPIKeyResponse.prototype.getKey = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.GenerateAPIKeyResponse} returns this
- */
-proto.grpc.service.webservice.GenerateAPIKeyResponse.prototype.setKey = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -16301,8 +16429,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DisableAPIKeyRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DisableAPIKeyRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetCommandRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetCommandRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -16311,13 +16439,13 @@ proto.grpc.service.webservice.DisableAPIKeyRequest.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetCommandRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableAPIKeyRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetCommandRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    commandId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -16331,23 +16459,23 @@ proto.grpc.service.webservice.DisableAPIKeyRequest.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DisableAPIKeyRequest}
+ * @return {!proto.grpc.service.webservice.GetCommandRequest}
  */
-proto.grpc.service.webservice.DisableAPIKeyRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetCommandRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DisableAPIKeyRequest;
-  return proto.grpc.service.webservice.DisableAPIKeyRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetCommandRequest;
+  return proto.grpc.service.webservice.GetCommandRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetCommandRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DisableAPIKeyRequest}
+ * @return {!proto.grpc.service.webservice.GetCommandRequest}
  */
-proto.grpc.service.webservice.DisableAPIKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetCommandRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -16356,7 +16484,7 @@ proto.grpc.service.webservice.DisableAPIKeyRequest.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setCommandId(value);
       break;
     default:
       reader.skipField();
@@ -16371,9 +16499,9 @@ proto.grpc.service.webservice.DisableAPIKeyRequest.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DisableAPIKeyRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetCommandRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DisableAPIKeyRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetCommandRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -16381,13 +16509,13 @@ proto.grpc.service.webservice.DisableAPIKeyRequest.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} message
+ * @param {!proto.grpc.service.webservice.GetCommandRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableAPIKeyRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetCommandRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getCommandId();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -16398,19 +16526,19 @@ proto.grpc.service.webservice.DisableAPIKeyRequest.serializeBinaryToWriter = fun
 
 
 /**
- * optional string id = 1;
+ * optional string command_id = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.DisableAPIKeyRequest.prototype.getId = function() {
+proto.grpc.service.webservice.GetCommandRequest.prototype.getCommandId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.DisableAPIKeyRequest} returns this
+ * @return {!proto.grpc.service.webservice.GetCommandRequest} returns this
  */
-proto.grpc.service.webservice.DisableAPIKeyRequest.prototype.setId = function(value) {
+proto.grpc.service.webservice.GetCommandRequest.prototype.setCommandId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -16431,8 +16559,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.DisableAPIKeyResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.DisableAPIKeyResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetCommandResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetCommandResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -16441,13 +16569,13 @@ proto.grpc.service.webservice.DisableAPIKeyResponse.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.DisableAPIKeyResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetCommandResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableAPIKeyResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetCommandResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    command: (f = msg.getCommand()) && pkg_model_command_pb.Command.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -16461,29 +16589,34 @@ proto.grpc.service.webservice.DisableAPIKeyResponse.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.DisableAPIKeyResponse}
+ * @return {!proto.grpc.service.webservice.GetCommandResponse}
  */
-proto.grpc.service.webservice.DisableAPIKeyResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetCommandResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.DisableAPIKeyResponse;
-  return proto.grpc.service.webservice.DisableAPIKeyResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetCommandResponse;
+  return proto.grpc.service.webservice.GetCommandResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.DisableAPIKeyResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetCommandResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.DisableAPIKeyResponse}
+ * @return {!proto.grpc.service.webservice.GetCommandResponse}
  */
-proto.grpc.service.webservice.DisableAPIKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new pkg_model_command_pb.Command;
+      reader.readMessage(value,pkg_model_command_pb.Command.deserializeBinaryFromReader);
+      msg.setCommand(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -16497,9 +16630,9 @@ proto.grpc.service.webservice.DisableAPIKeyResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.DisableAPIKeyResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetCommandResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.DisableAPIKeyResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetCommandResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -16507,129 +16640,67 @@ proto.grpc.service.webservice.DisableAPIKeyResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.DisableAPIKeyResponse} message
+ * @param {!proto.grpc.service.webservice.GetCommandResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.DisableAPIKeyResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetCommandResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getCommand();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_command_pb.Command.serializeBinaryToWriter
+    );
+  }
 };
 
 
+/**
+ * optional model.Command command = 1;
+ * @return {?proto.model.Command}
+ */
+proto.grpc.service.webservice.GetCommandResponse.prototype.getCommand = function() {
+  return /** @type{?proto.model.Command} */ (
+    jspb.Message.getWrapperField(this, pkg_model_command_pb.Command, 1));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.grpc.service.webservice.ListAPIKeysRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListAPIKeysRequest.toObject(opt_includeInstance, this);
+ * @param {?proto.model.Command|undefined} value
+ * @return {!proto.grpc.service.webservice.GetCommandResponse} returns this
+*/
+proto.grpc.service.webservice.GetCommandResponse.prototype.setCommand = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.grpc.service.webservice.ListAPIKeysRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListAPIKeysRequest.Options.toObject(includeInstance, f)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
-};
-}
-
-
-/**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListAPIKeysRequest}
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.GetCommandResponse} returns this
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListAPIKeysRequest;
-  return proto.grpc.service.webservice.ListAPIKeysRequest.deserializeBinaryFromReader(msg, reader);
+proto.grpc.service.webservice.GetCommandResponse.prototype.clearCommand = function() {
+  return this.setCommand(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListAPIKeysRequest}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 2:
-      var value = new proto.grpc.service.webservice.ListAPIKeysRequest.Options;
-      reader.readMessage(value,proto.grpc.service.webservice.ListAPIKeysRequest.Options.deserializeBinaryFromReader);
-      msg.setOptions(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.grpc.service.webservice.GetCommandResponse.prototype.hasCommand = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.grpc.service.webservice.ListAPIKeysRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListAPIKeysRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getOptions();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.grpc.service.webservice.ListAPIKeysRequest.Options.serializeBinaryToWriter
-    );
-  }
-};
-
-
+proto.grpc.service.webservice.GenerateAPIKeyRequest.repeatedFields_ = [3];
 
 
 
@@ -16646,8 +16717,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListAPIKeysRequest.Options.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GenerateAPIKeyRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -16656,13 +16727,17 @@ proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.toObject = fu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListAPIKeysRequest.Options} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.Options.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GenerateAPIKeyRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enabled: (f = msg.getEnabled()) && google_protobuf_wrappers_pb.BoolValue.toObject(includeInstance, f)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    role: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    policiesList: jspb.Message.toObjectList(msg.getPoliciesList(),
+    pkg_model_project_pb.ProjectRBACPolicy.toObject, includeInstance),
+    expiresAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -16676,23 +16751,23 @@ proto.grpc.service.webservice.ListAPIKeysRequest.Options.toObject = function(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListAPIKeysRequest.Options}
+ * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest}
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.Options.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GenerateAPIKeyRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListAPIKeysRequest.Options;
-  return proto.grpc.service.webservice.ListAPIKeysRequest.Options.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GenerateAPIKeyRequest;
+  return proto.grpc.service.webservice.GenerateAPIKeyRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListAPIKeysRequest.Options} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListAPIKeysRequest.Options}
+ * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest}
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GenerateAPIKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -16700,9 +16775,21 @@ proto.grpc.service.webservice.ListAPIKeysRequest.Options.deserializeBinaryFromRe
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new google_protobuf_wrappers_pb.BoolValue;
-      reader.readMessage(value,google_protobuf_wrappers_pb.BoolValue.deserializeBinaryFromReader);
-      msg.setEnabled(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.model.APIKey.Role} */ (reader.readEnum());
+      msg.setRole(value);
+      break;
+    case 3:
+      var value = new pkg_model_project_pb.ProjectRBACPolicy;
+      reader.readMessage(value,pkg_model_project_pb.ProjectRBACPolicy.deserializeBinaryFromReader);
+      msg.addPolicies(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setExpiresAt(value);
       break;
     default:
       reader.skipField();
@@ -16717,9 +16804,9 @@ proto.grpc.service.webservice.ListAPIKeysRequest.Options.deserializeBinaryFromRe
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListAPIKeysRequest.Options.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GenerateAPIKeyRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -16727,104 +16814,136 @@ proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.serializeBina
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListAPIKeysRequest.Options} message
+ * @param {!proto.grpc.service.webservice.GenerateAPIKeyRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.Options.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GenerateAPIKeyRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnabled();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
+      f
+    );
+  }
+  f = message.getRole();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getPoliciesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
       f,
-      google_protobuf_wrappers_pb.BoolValue.serializeBinaryToWriter
+      pkg_model_project_pb.ProjectRBACPolicy.serializeBinaryToWriter
+    );
+  }
+  f = message.getExpiresAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * optional google.protobuf.BoolValue enabled = 1;
- * @return {?proto.google.protobuf.BoolValue}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.getEnabled = function() {
-  return /** @type{?proto.google.protobuf.BoolValue} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_wrappers_pb.BoolValue, 1));
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.google.protobuf.BoolValue|undefined} value
- * @return {!proto.grpc.service.webservice.ListAPIKeysRequest.Options} returns this
-*/
-proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.setEnabled = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest} returns this
+ */
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListAPIKeysRequest.Options} returns this
+ * optional model.APIKey.Role role = 2;
+ * @return {!proto.model.APIKey.Role}
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.clearEnabled = function() {
-  return this.setEnabled(undefined);
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.getRole = function() {
+  return /** @type {!proto.model.APIKey.Role} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!proto.model.APIKey.Role} value
+ * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest} returns this
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.hasEnabled = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.setRole = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 /**
- * optional Options options = 2;
- * @return {?proto.grpc.service.webservice.ListAPIKeysRequest.Options}
+ * repeated model.ProjectRBACPolicy policies = 3;
+ * @return {!Array<!proto.model.ProjectRBACPolicy>}
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.prototype.getOptions = function() {
-  return /** @type{?proto.grpc.service.webservice.ListAPIKeysRequest.Options} */ (
-    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListAPIKeysRequest.Options, 2));
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.getPoliciesList = function() {
+  return /** @type{!Array<!proto.model.ProjectRBACPolicy>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_project_pb.ProjectRBACPolicy, 3));
 };
 
 
 /**
- * @param {?proto.grpc.service.webservice.ListAPIKeysRequest.Options|undefined} value
- * @return {!proto.grpc.service.webservice.ListAPIKeysRequest} returns this
+ * @param {!Array<!proto.model.ProjectRBACPolicy>} value
+ * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest} returns this
 */
-proto.grpc.service.webservice.ListAPIKeysRequest.prototype.setOptions = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.setPoliciesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListAPIKeysRequest} returns this
+ * @param {!proto.model.ProjectRBACPolicy=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ProjectRBACPolicy}
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.prototype.clearOptions = function() {
-  return this.setOptions(undefined);
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.addPolicies = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.model.ProjectRBACPolicy, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest} returns this
  */
-proto.grpc.service.webservice.ListAPIKeysRequest.prototype.hasOptions = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.clearPoliciesList = function() {
+  return this.setPoliciesList([]);
 };
 
 
+/**
+ * optional int64 expires_at = 4;
+ * @return {number}
+ */
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.getExpiresAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.GenerateAPIKeyRequest} returns this
  */
-proto.grpc.service.webservice.ListAPIKeysResponse.repeatedFields_ = [1];
+proto.grpc.service.webservice.GenerateAPIKeyRequest.prototype.setExpiresAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
 
 
 
@@ -16841,8 +16960,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListAPIKeysResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListAPIKeysResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GenerateAPIKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GenerateAPIKeyResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -16851,14 +16970,13 @@ proto.grpc.service.webservice.ListAPIKeysResponse.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListAPIKeysResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GenerateAPIKeyResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListAPIKeysResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GenerateAPIKeyResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    keysList: jspb.Message.toObjectList(msg.getKeysList(),
-    pkg_model_apikey_pb.APIKey.toObject, includeInstance)
+    key: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -16872,23 +16990,23 @@ proto.grpc.service.webservice.ListAPIKeysResponse.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListAPIKeysResponse}
+ * @return {!proto.grpc.service.webservice.GenerateAPIKeyResponse}
  */
-proto.grpc.service.webservice.ListAPIKeysResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GenerateAPIKeyResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListAPIKeysResponse;
-  return proto.grpc.service.webservice.ListAPIKeysResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GenerateAPIKeyResponse;
+  return proto.grpc.service.webservice.GenerateAPIKeyResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListAPIKeysResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GenerateAPIKeyResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListAPIKeysResponse}
+ * @return {!proto.grpc.service.webservice.GenerateAPIKeyResponse}
  */
-proto.grpc.service.webservice.ListAPIKeysResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GenerateAPIKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -16896,9 +17014,8 @@ proto.grpc.service.webservice.ListAPIKeysResponse.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_apikey_pb.APIKey;
-      reader.readMessage(value,pkg_model_apikey_pb.APIKey.deserializeBinaryFromReader);
-      msg.addKeys(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKey(value);
       break;
     default:
       reader.skipField();
@@ -16913,9 +17030,9 @@ proto.grpc.service.webservice.ListAPIKeysResponse.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListAPIKeysResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GenerateAPIKeyResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListAPIKeysResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GenerateAPIKeyResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -16923,58 +17040,37 @@ proto.grpc.service.webservice.ListAPIKeysResponse.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListAPIKeysResponse} message
+ * @param {!proto.grpc.service.webservice.GenerateAPIKeyResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListAPIKeysResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GenerateAPIKeyResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getKeysList();
+  f = message.getKey();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      pkg_model_apikey_pb.APIKey.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated model.APIKey keys = 1;
- * @return {!Array<!proto.model.APIKey>}
- */
-proto.grpc.service.webservice.ListAPIKeysResponse.prototype.getKeysList = function() {
-  return /** @type{!Array<!proto.model.APIKey>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_apikey_pb.APIKey, 1));
-};
-
-
-/**
- * @param {!Array<!proto.model.APIKey>} value
- * @return {!proto.grpc.service.webservice.ListAPIKeysResponse} returns this
-*/
-proto.grpc.service.webservice.ListAPIKeysResponse.prototype.setKeysList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.model.APIKey=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.APIKey}
+ * optional string key = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.ListAPIKeysResponse.prototype.addKeys = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.APIKey, opt_index);
+proto.grpc.service.webservice.GenerateAPIKeyResponse.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListAPIKeysResponse} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GenerateAPIKeyResponse} returns this
  */
-proto.grpc.service.webservice.ListAPIKeysResponse.prototype.clearKeysList = function() {
-  return this.setKeysList([]);
+proto.grpc.service.webservice.GenerateAPIKeyResponse.prototype.setKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -16994,8 +17090,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetInsightDataRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.DisableAPIKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DisableAPIKeyRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -17004,18 +17100,13 @@ proto.grpc.service.webservice.GetInsightDataRequest.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetInsightDataRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightDataRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.DisableAPIKeyRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    metricsKind: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    rangeFrom: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    rangeTo: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    resolution: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    applicationId: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -17029,23 +17120,23 @@ proto.grpc.service.webservice.GetInsightDataRequest.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetInsightDataRequest}
+ * @return {!proto.grpc.service.webservice.DisableAPIKeyRequest}
  */
-proto.grpc.service.webservice.GetInsightDataRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.DisableAPIKeyRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetInsightDataRequest;
-  return proto.grpc.service.webservice.GetInsightDataRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.DisableAPIKeyRequest;
+  return proto.grpc.service.webservice.DisableAPIKeyRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetInsightDataRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetInsightDataRequest}
+ * @return {!proto.grpc.service.webservice.DisableAPIKeyRequest}
  */
-proto.grpc.service.webservice.GetInsightDataRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.DisableAPIKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -17053,30 +17144,8 @@ proto.grpc.service.webservice.GetInsightDataRequest.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.model.InsightMetricsKind} */ (reader.readEnum());
-      msg.setMetricsKind(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setRangeFrom(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setRangeTo(value);
-      break;
-    case 4:
-      var value = /** @type {!proto.model.InsightResolution} */ (reader.readEnum());
-      msg.setResolution(value);
-      break;
-    case 10:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationId(value);
-      break;
-    case 11:
-      var value = msg.getLabelsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      msg.setId(value);
       break;
     default:
       reader.skipField();
@@ -17091,9 +17160,9 @@ proto.grpc.service.webservice.GetInsightDataRequest.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.DisableAPIKeyRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetInsightDataRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.DisableAPIKeyRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -17101,175 +17170,142 @@ proto.grpc.service.webservice.GetInsightDataRequest.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetInsightDataRequest} message
+ * @param {!proto.grpc.service.webservice.DisableAPIKeyRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightDataRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.DisableAPIKeyRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMetricsKind();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      1,
-      f
-    );
-  }
-  f = message.getRangeFrom();
-  if (f !== 0) {
-    writer.writeInt64(
-      2,
-      f
-    );
-  }
-  f = message.getRangeTo();
-  if (f !== 0) {
-    writer.writeInt64(
-      3,
-      f
-    );
-  }
-  f = message.getResolution();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      4,
-      f
-    );
-  }
-  f = message.getApplicationId();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
-      10,
+      1,
       f
     );
   }
-  f = message.getLabelsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(11, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
 };
 
 
 /**
- * optional model.InsightMetricsKind metrics_kind = 1;
- * @return {!proto.model.InsightMetricsKind}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.getMetricsKind = function() {
-  return /** @type {!proto.model.InsightMetricsKind} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.grpc.service.webservice.DisableAPIKeyRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.model.InsightMetricsKind} value
- * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.DisableAPIKeyRequest} returns this
  */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.setMetricsKind = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.grpc.service.webservice.DisableAPIKeyRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional int64 range_from = 2;
- * @return {number}
- */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.getRangeFrom = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
 
-/**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
- */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.setRangeFrom = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional int64 range_to = 3;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.getRangeTo = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.grpc.service.webservice.DisableAPIKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.DisableAPIKeyResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.DisableAPIKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.setRangeTo = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
-};
+proto.grpc.service.webservice.DisableAPIKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
 
+  };
 
-/**
- * optional model.InsightResolution resolution = 4;
- * @return {!proto.model.InsightResolution}
- */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.getResolution = function() {
-  return /** @type {!proto.model.InsightResolution} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!proto.model.InsightResolution} value
- * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.DisableAPIKeyResponse}
  */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.setResolution = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.grpc.service.webservice.DisableAPIKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.DisableAPIKeyResponse;
+  return proto.grpc.service.webservice.DisableAPIKeyResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional string application_id = 10;
- * @return {string}
- */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.getApplicationId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.DisableAPIKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.DisableAPIKeyResponse}
  */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.setApplicationId = function(value) {
-  return jspb.Message.setProto3StringField(this, 10, value);
+proto.grpc.service.webservice.DisableAPIKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * map<string, string> labels = 11;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.getLabelsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 11, opt_noLazyCreate,
-      null));
+proto.grpc.service.webservice.DisableAPIKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.DisableAPIKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.DisableAPIKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightDataRequest.prototype.clearLabelsMap = function() {
-  this.getLabelsMap().clear();
-  return this;
+proto.grpc.service.webservice.DisableAPIKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.GetInsightDataResponse.repeatedFields_ = [3,4];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -17285,8 +17321,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetInsightDataResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListAPIKeysRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListAPIKeysRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -17295,18 +17331,13 @@ proto.grpc.service.webservice.GetInsightDataResponse.prototype.toObject = functi
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetInsightDataResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightDataResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListAPIKeysRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    updatedAt: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    type: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    vectorList: jspb.Message.toObjectList(msg.getVectorList(),
-    pkg_model_insight_pb.InsightSample.toObject, includeInstance),
-    matrixList: jspb.Message.toObjectList(msg.getMatrixList(),
-    pkg_model_insight_pb.InsightSampleStream.toObject, includeInstance)
+    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListAPIKeysRequest.Options.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -17320,46 +17351,33 @@ proto.grpc.service.webservice.GetInsightDataResponse.toObject = function(include
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetInsightDataResponse}
+ * @return {!proto.grpc.service.webservice.ListAPIKeysRequest}
  */
-proto.grpc.service.webservice.GetInsightDataResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListAPIKeysRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetInsightDataResponse;
-  return proto.grpc.service.webservice.GetInsightDataResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListAPIKeysRequest;
+  return proto.grpc.service.webservice.ListAPIKeysRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetInsightDataResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetInsightDataResponse}
+ * @return {!proto.grpc.service.webservice.ListAPIKeysRequest}
  */
-proto.grpc.service.webservice.GetInsightDataResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListAPIKeysRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setUpdatedAt(value);
-      break;
     case 2:
-      var value = /** @type {!proto.model.InsightResultType} */ (reader.readEnum());
-      msg.setType(value);
-      break;
-    case 3:
-      var value = new pkg_model_insight_pb.InsightSample;
-      reader.readMessage(value,pkg_model_insight_pb.InsightSample.deserializeBinaryFromReader);
-      msg.addVector(value);
-      break;
-    case 4:
-      var value = new pkg_model_insight_pb.InsightSampleStream;
-      reader.readMessage(value,pkg_model_insight_pb.InsightSampleStream.deserializeBinaryFromReader);
-      msg.addMatrix(value);
+      var value = new proto.grpc.service.webservice.ListAPIKeysRequest.Options;
+      reader.readMessage(value,proto.grpc.service.webservice.ListAPIKeysRequest.Options.deserializeBinaryFromReader);
+      msg.setOptions(value);
       break;
     default:
       reader.skipField();
@@ -17374,9 +17392,9 @@ proto.grpc.service.webservice.GetInsightDataResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListAPIKeysRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetInsightDataResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListAPIKeysRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -17384,154 +17402,1366 @@ proto.grpc.service.webservice.GetInsightDataResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetInsightDataResponse} message
+ * @param {!proto.grpc.service.webservice.ListAPIKeysRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightDataResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListAPIKeysRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUpdatedAt();
-  if (f !== 0) {
-    writer.writeInt64(
-      1,
-      f
-    );
-  }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getOptions();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
-    );
-  }
-  f = message.getVectorList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      pkg_model_insight_pb.InsightSample.serializeBinaryToWriter
-    );
-  }
-  f = message.getMatrixList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
       f,
-      pkg_model_insight_pb.InsightSampleStream.serializeBinaryToWriter
+      proto.grpc.service.webservice.ListAPIKeysRequest.Options.serializeBinaryToWriter
     );
   }
 };
 
 
-/**
- * optional int64 updated_at = 1;
- * @return {number}
- */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.getUpdatedAt = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
 
-/**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
- */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.setUpdatedAt = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional model.InsightResultType type = 2;
- * @return {!proto.model.InsightResultType}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.getType = function() {
-  return /** @type {!proto.model.InsightResultType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListAPIKeysRequest.Options.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.model.InsightResultType} value
- * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ListAPIKeysRequest.Options} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.grpc.service.webservice.ListAPIKeysRequest.Options.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enabled: (f = msg.getEnabled()) && google_protobuf_wrappers_pb.BoolValue.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * repeated model.InsightSample vector = 3;
- * @return {!Array<!proto.model.InsightSample>}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ListAPIKeysRequest.Options}
  */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.getVectorList = function() {
-  return /** @type{!Array<!proto.model.InsightSample>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_insight_pb.InsightSample, 3));
+proto.grpc.service.webservice.ListAPIKeysRequest.Options.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ListAPIKeysRequest.Options;
+  return proto.grpc.service.webservice.ListAPIKeysRequest.Options.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!Array<!proto.model.InsightSample>} value
- * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
-*/
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.setVectorList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ListAPIKeysRequest.Options} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ListAPIKeysRequest.Options}
+ */
+proto.grpc.service.webservice.ListAPIKeysRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_wrappers_pb.BoolValue;
+      reader.readMessage(value,google_protobuf_wrappers_pb.BoolValue.deserializeBinaryFromReader);
+      msg.setEnabled(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {!proto.model.InsightSample=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.InsightSample}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.addVector = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.model.InsightSample, opt_index);
+proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListAPIKeysRequest.Options.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
- */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.clearVectorList = function() {
-  return this.setVectorList([]);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListAPIKeysRequest.Options} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListAPIKeysRequest.Options.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnabled();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_wrappers_pb.BoolValue.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.BoolValue enabled = 1;
+ * @return {?proto.google.protobuf.BoolValue}
+ */
+proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.getEnabled = function() {
+  return /** @type{?proto.google.protobuf.BoolValue} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_wrappers_pb.BoolValue, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.BoolValue|undefined} value
+ * @return {!proto.grpc.service.webservice.ListAPIKeysRequest.Options} returns this
+*/
+proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.setEnabled = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListAPIKeysRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.clearEnabled = function() {
+  return this.setEnabled(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.ListAPIKeysRequest.Options.prototype.hasEnabled = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional Options options = 2;
+ * @return {?proto.grpc.service.webservice.ListAPIKeysRequest.Options}
+ */
+proto.grpc.service.webservice.ListAPIKeysRequest.prototype.getOptions = function() {
+  return /** @type{?proto.grpc.service.webservice.ListAPIKeysRequest.Options} */ (
+    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListAPIKeysRequest.Options, 2));
+};
+
+
+/**
+ * @param {?proto.grpc.service.webservice.ListAPIKeysRequest.Options|undefined} value
+ * @return {!proto.grpc.service.webservice.ListAPIKeysRequest} returns this
+*/
+proto.grpc.service.webservice.ListAPIKeysRequest.prototype.setOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListAPIKeysRequest} returns this
+ */
+proto.grpc.service.webservice.ListAPIKeysRequest.prototype.clearOptions = function() {
+  return this.setOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.ListAPIKeysRequest.prototype.hasOptions = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListAPIKeysResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ListAPIKeysResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    keysList: jspb.Message.toObjectList(msg.getKeysList(),
+    pkg_model_apikey_pb.APIKey.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ListAPIKeysResponse}
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ListAPIKeysResponse;
+  return proto.grpc.service.webservice.ListAPIKeysResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ListAPIKeysResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ListAPIKeysResponse}
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new pkg_model_apikey_pb.APIKey;
+      reader.readMessage(value,pkg_model_apikey_pb.APIKey.deserializeBinaryFromReader);
+      msg.addKeys(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListAPIKeysResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListAPIKeysResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      pkg_model_apikey_pb.APIKey.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated model.APIKey keys = 1;
+ * @return {!Array<!proto.model.APIKey>}
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse.prototype.getKeysList = function() {
+  return /** @type{!Array<!proto.model.APIKey>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_apikey_pb.APIKey, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.APIKey>} value
+ * @return {!proto.grpc.service.webservice.ListAPIKeysResponse} returns this
+*/
+proto.grpc.service.webservice.ListAPIKeysResponse.prototype.setKeysList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.APIKey=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.APIKey}
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse.prototype.addKeys = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.APIKey, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListAPIKeysResponse} returns this
+ */
+proto.grpc.service.webservice.ListAPIKeysResponse.prototype.clearKeysList = function() {
+  return this.setKeysList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetInsightDataRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.GetInsightDataRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    metricsKind: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    rangeFrom: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    rangeTo: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    resolution: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    applicationId: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.GetInsightDataRequest}
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.GetInsightDataRequest;
+  return proto.grpc.service.webservice.GetInsightDataRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.GetInsightDataRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.GetInsightDataRequest}
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.model.InsightMetricsKind} */ (reader.readEnum());
+      msg.setMetricsKind(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRangeFrom(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setRangeTo(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.model.InsightResolution} */ (reader.readEnum());
+      msg.setResolution(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApplicationId(value);
+      break;
+    case 11:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.GetInsightDataRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.GetInsightDataRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMetricsKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getRangeFrom();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getRangeTo();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getResolution();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getApplicationId();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(11, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional model.InsightMetricsKind metrics_kind = 1;
+ * @return {!proto.model.InsightMetricsKind}
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.getMetricsKind = function() {
+  return /** @type {!proto.model.InsightMetricsKind} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.model.InsightMetricsKind} value
+ * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.setMetricsKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional int64 range_from = 2;
+ * @return {number}
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.getRangeFrom = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.setRangeFrom = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 range_to = 3;
+ * @return {number}
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.getRangeTo = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.setRangeTo = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional model.InsightResolution resolution = 4;
+ * @return {!proto.model.InsightResolution}
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.getResolution = function() {
+  return /** @type {!proto.model.InsightResolution} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.model.InsightResolution} value
+ * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.setResolution = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * optional string application_id = 10;
+ * @return {string}
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.getApplicationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * map<string, string> labels = 11;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 11, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.grpc.service.webservice.GetInsightDataRequest} returns this
+ */
+proto.grpc.service.webservice.GetInsightDataRequest.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetInsightDataResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.GetInsightDataResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    updatedAt: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    type: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    vectorList: jspb.Message.toObjectList(msg.getVectorList(),
+    pkg_model_insight_pb.InsightSample.toObject, includeInstance),
+    matrixList: jspb.Message.toObjectList(msg.getMatrixList(),
+    pkg_model_insight_pb.InsightSampleStream.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.GetInsightDataResponse}
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.GetInsightDataResponse;
+  return proto.grpc.service.webservice.GetInsightDataResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.GetInsightDataResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.GetInsightDataResponse}
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUpdatedAt(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.model.InsightResultType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 3:
+      var value = new pkg_model_insight_pb.InsightSample;
+      reader.readMessage(value,pkg_model_insight_pb.InsightSample.deserializeBinaryFromReader);
+      msg.addVector(value);
+      break;
+    case 4:
+      var value = new pkg_model_insight_pb.InsightSampleStream;
+      reader.readMessage(value,pkg_model_insight_pb.InsightSampleStream.deserializeBinaryFromReader);
+      msg.addMatrix(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.GetInsightDataResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.GetInsightDataResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUpdatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getVectorList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      pkg_model_insight_pb.InsightSample.serializeBinaryToWriter
+    );
+  }
+  f = message.getMatrixList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      pkg_model_insight_pb.InsightSampleStream.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 updated_at = 1;
+ * @return {number}
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.getUpdatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.setUpdatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional model.InsightResultType type = 2;
+ * @return {!proto.model.InsightResultType}
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.getType = function() {
+  return /** @type {!proto.model.InsightResultType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.model.InsightResultType} value
+ * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * repeated model.InsightSample vector = 3;
+ * @return {!Array<!proto.model.InsightSample>}
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.getVectorList = function() {
+  return /** @type{!Array<!proto.model.InsightSample>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_insight_pb.InsightSample, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.model.InsightSample>} value
+ * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
+*/
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.setVectorList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.model.InsightSample=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.InsightSample}
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.addVector = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.model.InsightSample, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.clearVectorList = function() {
+  return this.setVectorList([]);
+};
+
+
+/**
+ * repeated model.InsightSampleStream matrix = 4;
+ * @return {!Array<!proto.model.InsightSampleStream>}
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.getMatrixList = function() {
+  return /** @type{!Array<!proto.model.InsightSampleStream>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_insight_pb.InsightSampleStream, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.model.InsightSampleStream>} value
+ * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
+*/
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.setMatrixList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.model.InsightSampleStream=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.InsightSampleStream}
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.addMatrix = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.model.InsightSampleStream, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
+ */
+proto.grpc.service.webservice.GetInsightDataResponse.prototype.clearMatrixList = function() {
+  return this.setMatrixList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetInsightApplicationCountRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.GetInsightApplicationCountRequest}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.GetInsightApplicationCountRequest;
+  return proto.grpc.service.webservice.GetInsightApplicationCountRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.GetInsightApplicationCountRequest}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.GetInsightApplicationCountRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetInsightApplicationCountResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    updatedAt: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    countsList: jspb.Message.toObjectList(msg.getCountsList(),
+    pkg_model_insight_pb.InsightApplicationCount.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.GetInsightApplicationCountResponse}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.GetInsightApplicationCountResponse;
+  return proto.grpc.service.webservice.GetInsightApplicationCountResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.GetInsightApplicationCountResponse}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUpdatedAt(value);
+      break;
+    case 2:
+      var value = new pkg_model_insight_pb.InsightApplicationCount;
+      reader.readMessage(value,pkg_model_insight_pb.InsightApplicationCount.deserializeBinaryFromReader);
+      msg.addCounts(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.GetInsightApplicationCountResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUpdatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getCountsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      pkg_model_insight_pb.InsightApplicationCount.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional int64 updated_at = 1;
+ * @return {number}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.getUpdatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} returns this
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.setUpdatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * repeated model.InsightApplicationCount counts = 2;
+ * @return {!Array<!proto.model.InsightApplicationCount>}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.getCountsList = function() {
+  return /** @type{!Array<!proto.model.InsightApplicationCount>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_insight_pb.InsightApplicationCount, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.model.InsightApplicationCount>} value
+ * @return {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} returns this
+*/
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.setCountsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.model.InsightApplicationCount=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.InsightApplicationCount}
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.addCounts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.model.InsightApplicationCount, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} returns this
+ */
+proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.clearCountsList = function() {
+  return this.setCountsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeploymentChainsRequest.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * repeated model.InsightSampleStream matrix = 4;
- * @return {!Array<!proto.model.InsightSampleStream>}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.getMatrixList = function() {
-  return /** @type{!Array<!proto.model.InsightSampleStream>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_insight_pb.InsightSampleStream, 4));
+proto.grpc.service.webservice.ListDeploymentChainsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.toObject(includeInstance, f),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    cursor: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    pageMinUpdatedAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!Array<!proto.model.InsightSampleStream>} value
- * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
-*/
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.setMatrixList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest}
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.grpc.service.webservice.ListDeploymentChainsRequest;
+  return proto.grpc.service.webservice.ListDeploymentChainsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!proto.model.InsightSampleStream=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.InsightSampleStream}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest}
  */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.addMatrix = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.model.InsightSampleStream, opt_index);
+proto.grpc.service.webservice.ListDeploymentChainsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.grpc.service.webservice.ListDeploymentChainsRequest.Options;
+      reader.readMessage(value,proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.deserializeBinaryFromReader);
+      msg.setOptions(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCursor(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPageMinUpdatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.GetInsightDataResponse} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetInsightDataResponse.prototype.clearMatrixList = function() {
-  return this.setMatrixList([]);
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListDeploymentChainsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOptions();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.serializeBinaryToWriter
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getPageMinUpdatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -17551,8 +18781,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetInsightApplicationCountRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.toObject(opt_includeInstance, this);
 };
 
 
@@ -17561,11 +18791,11 @@ proto.grpc.service.webservice.GetInsightApplicationCountRequest.prototype.toObje
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest.Options} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightApplicationCountRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.toObject = function(includeInstance, msg) {
   var f, obj = {
 
   };
@@ -17581,23 +18811,23 @@ proto.grpc.service.webservice.GetInsightApplicationCountRequest.toObject = funct
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetInsightApplicationCountRequest}
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest.Options}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetInsightApplicationCountRequest;
-  return proto.grpc.service.webservice.GetInsightApplicationCountRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListDeploymentChainsRequest.Options;
+  return proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest.Options} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetInsightApplicationCountRequest}
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest.Options}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -17614,25 +18844,116 @@ proto.grpc.service.webservice.GetInsightApplicationCountRequest.deserializeBinar
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest.Options} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+/**
+ * optional Options options = 1;
+ * @return {?proto.grpc.service.webservice.ListDeploymentChainsRequest.Options}
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.getOptions = function() {
+  return /** @type{?proto.grpc.service.webservice.ListDeploymentChainsRequest.Options} */ (
+    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListDeploymentChainsRequest.Options, 1));
+};
+
+
+/**
+ * @param {?proto.grpc.service.webservice.ListDeploymentChainsRequest.Options|undefined} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest} returns this
+*/
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.setOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest} returns this
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.clearOptions = function() {
+  return this.setOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.hasOptions = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional int32 page_size = 2;
+ * @return {number}
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest} returns this
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string cursor = 3;
+ * @return {string}
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest} returns this
+ */
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int64 page_min_updated_at = 4;
+ * @return {number}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetInsightApplicationCountRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.getPageMinUpdatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetInsightApplicationCountRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest} returns this
  */
-proto.grpc.service.webservice.GetInsightApplicationCountRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.setPageMinUpdatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
@@ -17642,7 +18963,7 @@ proto.grpc.service.webservice.GetInsightApplicationCountRequest.serializeBinaryT
  * @private {!Array<number>}
  * @const
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.repeatedFields_ = [2];
+proto.grpc.service.webservice.ListDeploymentChainsResponse.repeatedFields_ = [1];
 
 
 
@@ -17659,8 +18980,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetInsightApplicationCountResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListDeploymentChainsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -17669,15 +18990,15 @@ proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.toObj
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListDeploymentChainsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    updatedAt: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    countsList: jspb.Message.toObjectList(msg.getCountsList(),
-    pkg_model_insight_pb.InsightApplicationCount.toObject, includeInstance)
+    deploymentChainsList: jspb.Message.toObjectList(msg.getDeploymentChainsList(),
+    pkg_model_deployment_chain_pb.DeploymentChain.toObject, includeInstance),
+    cursor: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -17691,23 +19012,23 @@ proto.grpc.service.webservice.GetInsightApplicationCountResponse.toObject = func
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetInsightApplicationCountResponse}
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsResponse}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListDeploymentChainsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetInsightApplicationCountResponse;
-  return proto.grpc.service.webservice.GetInsightApplicationCountResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListDeploymentChainsResponse;
+  return proto.grpc.service.webservice.ListDeploymentChainsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetInsightApplicationCountResponse}
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsResponse}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListDeploymentChainsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -17715,13 +19036,13 @@ proto.grpc.service.webservice.GetInsightApplicationCountResponse.deserializeBina
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setUpdatedAt(value);
+      var value = new pkg_model_deployment_chain_pb.DeploymentChain;
+      reader.readMessage(value,pkg_model_deployment_chain_pb.DeploymentChain.deserializeBinaryFromReader);
+      msg.addDeploymentChains(value);
       break;
     case 2:
-      var value = new pkg_model_insight_pb.InsightApplicationCount;
-      reader.readMessage(value,pkg_model_insight_pb.InsightApplicationCount.deserializeBinaryFromReader);
-      msg.addCounts(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCursor(value);
       break;
     default:
       reader.skipField();
@@ -17736,9 +19057,9 @@ proto.grpc.service.webservice.GetInsightApplicationCountResponse.deserializeBina
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetInsightApplicationCountResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListDeploymentChainsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -17746,83 +19067,83 @@ proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.seria
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} message
+ * @param {!proto.grpc.service.webservice.ListDeploymentChainsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListDeploymentChainsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUpdatedAt();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getDeploymentChainsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      pkg_model_deployment_chain_pb.DeploymentChain.serializeBinaryToWriter
     );
   }
-  f = message.getCountsList();
+  f = message.getCursor();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       2,
-      f,
-      pkg_model_insight_pb.InsightApplicationCount.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional int64 updated_at = 1;
- * @return {number}
+ * repeated model.DeploymentChain deployment_chains = 1;
+ * @return {!Array<!proto.model.DeploymentChain>}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.getUpdatedAt = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.getDeploymentChainsList = function() {
+  return /** @type{!Array<!proto.model.DeploymentChain>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_deployment_chain_pb.DeploymentChain, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} returns this
- */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.setUpdatedAt = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+ * @param {!Array<!proto.model.DeploymentChain>} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsResponse} returns this
+*/
+proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.setDeploymentChainsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * repeated model.InsightApplicationCount counts = 2;
- * @return {!Array<!proto.model.InsightApplicationCount>}
+ * @param {!proto.model.DeploymentChain=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.DeploymentChain}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.getCountsList = function() {
-  return /** @type{!Array<!proto.model.InsightApplicationCount>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_insight_pb.InsightApplicationCount, 2));
+proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.addDeploymentChains = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.DeploymentChain, opt_index);
 };
 
 
 /**
- * @param {!Array<!proto.model.InsightApplicationCount>} value
- * @return {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} returns this
-*/
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.setCountsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsResponse} returns this
+ */
+proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.clearDeploymentChainsList = function() {
+  return this.setDeploymentChainsList([]);
 };
 
 
 /**
- * @param {!proto.model.InsightApplicationCount=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.InsightApplicationCount}
+ * optional string cursor = 2;
+ * @return {string}
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.addCounts = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.model.InsightApplicationCount, opt_index);
+proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.GetInsightApplicationCountResponse} returns this
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListDeploymentChainsResponse} returns this
  */
-proto.grpc.service.webservice.GetInsightApplicationCountResponse.prototype.clearCountsList = function() {
-  return this.setCountsList([]);
+proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -17842,8 +19163,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeploymentChainsRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetDeploymentChainRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetDeploymentChainRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -17852,16 +19173,13 @@ proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.toObject = f
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetDeploymentChainRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetDeploymentChainRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.toObject(includeInstance, f),
-    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    cursor: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    pageMinUpdatedAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    deploymentChainId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -17875,23 +19193,23 @@ proto.grpc.service.webservice.ListDeploymentChainsRequest.toObject = function(in
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest}
+ * @return {!proto.grpc.service.webservice.GetDeploymentChainRequest}
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetDeploymentChainRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeploymentChainsRequest;
-  return proto.grpc.service.webservice.ListDeploymentChainsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetDeploymentChainRequest;
+  return proto.grpc.service.webservice.GetDeploymentChainRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetDeploymentChainRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest}
+ * @return {!proto.grpc.service.webservice.GetDeploymentChainRequest}
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetDeploymentChainRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -17899,21 +19217,8 @@ proto.grpc.service.webservice.ListDeploymentChainsRequest.deserializeBinaryFromR
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.grpc.service.webservice.ListDeploymentChainsRequest.Options;
-      reader.readMessage(value,proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.deserializeBinaryFromReader);
-      msg.setOptions(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageSize(value);
-      break;
-    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setCursor(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setPageMinUpdatedAt(value);
+      msg.setDeploymentChainId(value);
       break;
     default:
       reader.skipField();
@@ -17928,9 +19233,9 @@ proto.grpc.service.webservice.ListDeploymentChainsRequest.deserializeBinaryFromR
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetDeploymentChainRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeploymentChainsRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetDeploymentChainRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -17938,44 +19243,40 @@ proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.serializeBin
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest} message
+ * @param {!proto.grpc.service.webservice.GetDeploymentChainRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetDeploymentChainRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOptions();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.serializeBinaryToWriter
-    );
-  }
-  f = message.getPageSize();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
-  f = message.getCursor();
+  f = message.getDeploymentChainId();
   if (f.length > 0) {
     writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getPageMinUpdatedAt();
-  if (f !== 0) {
-    writer.writeInt64(
-      4,
+      1,
       f
     );
   }
 };
 
 
+/**
+ * optional string deployment_chain_id = 1;
+ * @return {string}
+ */
+proto.grpc.service.webservice.GetDeploymentChainRequest.prototype.getDeploymentChainId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.GetDeploymentChainRequest} returns this
+ */
+proto.grpc.service.webservice.GetDeploymentChainRequest.prototype.setDeploymentChainId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
 
 
 
@@ -17992,8 +19293,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.GetDeploymentChainResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -18002,13 +19303,13 @@ proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.prototype.toOb
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest.Options} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.GetDeploymentChainResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.GetDeploymentChainResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    deploymentChain: (f = msg.getDeploymentChain()) && pkg_model_deployment_chain_pb.DeploymentChain.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -18022,29 +19323,34 @@ proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.toObject = fun
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest.Options}
+ * @return {!proto.grpc.service.webservice.GetDeploymentChainResponse}
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.GetDeploymentChainResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeploymentChainsRequest.Options;
-  return proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.GetDeploymentChainResponse;
+  return proto.grpc.service.webservice.GetDeploymentChainResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest.Options} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.GetDeploymentChainResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest.Options}
+ * @return {!proto.grpc.service.webservice.GetDeploymentChainResponse}
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.GetDeploymentChainResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new pkg_model_deployment_chain_pb.DeploymentChain;
+      reader.readMessage(value,pkg_model_deployment_chain_pb.DeploymentChain.deserializeBinaryFromReader);
+      msg.setDeploymentChain(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -18058,9 +19364,9 @@ proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.deserializeBin
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.GetDeploymentChainResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -18068,40 +19374,48 @@ proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.prototype.seri
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsRequest.Options} message
+ * @param {!proto.grpc.service.webservice.GetDeploymentChainResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.Options.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.GetDeploymentChainResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getDeploymentChain();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      pkg_model_deployment_chain_pb.DeploymentChain.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional Options options = 1;
- * @return {?proto.grpc.service.webservice.ListDeploymentChainsRequest.Options}
+ * optional model.DeploymentChain deployment_chain = 1;
+ * @return {?proto.model.DeploymentChain}
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.getOptions = function() {
-  return /** @type{?proto.grpc.service.webservice.ListDeploymentChainsRequest.Options} */ (
-    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListDeploymentChainsRequest.Options, 1));
+proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.getDeploymentChain = function() {
+  return /** @type{?proto.model.DeploymentChain} */ (
+    jspb.Message.getWrapperField(this, pkg_model_deployment_chain_pb.DeploymentChain, 1));
 };
 
 
 /**
- * @param {?proto.grpc.service.webservice.ListDeploymentChainsRequest.Options|undefined} value
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest} returns this
+ * @param {?proto.model.DeploymentChain|undefined} value
+ * @return {!proto.grpc.service.webservice.GetDeploymentChainResponse} returns this
 */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.setOptions = function(value) {
+proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.setDeploymentChain = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest} returns this
+ * @return {!proto.grpc.service.webservice.GetDeploymentChainResponse} returns this
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.clearOptions = function() {
-  return this.setOptions(undefined);
+proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.clearDeploymentChain = function() {
+  return this.setDeploymentChain(undefined);
 };
 
 
@@ -18109,72 +19423,11 @@ proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.clearOptions
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.hasOptions = function() {
+proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.hasDeploymentChain = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
-/**
- * optional int32 page_size = 2;
- * @return {number}
- */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.getPageSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest} returns this
- */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.setPageSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string cursor = 3;
- * @return {string}
- */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.getCursor = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest} returns this
- */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.setCursor = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional int64 page_min_updated_at = 4;
- * @return {number}
- */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.getPageMinUpdatedAt = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsRequest} returns this
- */
-proto.grpc.service.webservice.ListDeploymentChainsRequest.prototype.setPageMinUpdatedAt = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
-};
-
-
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.repeatedFields_ = [1];
 
 
 
@@ -18191,8 +19444,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListDeploymentChainsResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListEventsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListEventsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -18201,15 +19454,16 @@ proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListEventsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListEventsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    deploymentChainsList: jspb.Message.toObjectList(msg.getDeploymentChainsList(),
-    pkg_model_deployment_chain_pb.DeploymentChain.toObject, includeInstance),
-    cursor: jspb.Message.getFieldWithDefault(msg, 2, "")
+    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListEventsRequest.Options.toObject(includeInstance, f),
+    pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    cursor: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    pageMinUpdatedAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -18223,23 +19477,23 @@ proto.grpc.service.webservice.ListDeploymentChainsResponse.toObject = function(i
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsResponse}
+ * @return {!proto.grpc.service.webservice.ListEventsRequest}
  */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListEventsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListDeploymentChainsResponse;
-  return proto.grpc.service.webservice.ListDeploymentChainsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListEventsRequest;
+  return proto.grpc.service.webservice.ListEventsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListEventsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsResponse}
+ * @return {!proto.grpc.service.webservice.ListEventsRequest}
  */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListEventsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -18247,14 +19501,22 @@ proto.grpc.service.webservice.ListDeploymentChainsResponse.deserializeBinaryFrom
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_deployment_chain_pb.DeploymentChain;
-      reader.readMessage(value,pkg_model_deployment_chain_pb.DeploymentChain.deserializeBinaryFromReader);
-      msg.addDeploymentChains(value);
+      var value = new proto.grpc.service.webservice.ListEventsRequest.Options;
+      reader.readMessage(value,proto.grpc.service.webservice.ListEventsRequest.Options.deserializeBinaryFromReader);
+      msg.setOptions(value);
       break;
     case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readString());
       msg.setCursor(value);
       break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setPageMinUpdatedAt(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -18268,9 +19530,9 @@ proto.grpc.service.webservice.ListDeploymentChainsResponse.deserializeBinaryFrom
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListEventsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListDeploymentChainsResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListEventsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -18278,86 +19540,51 @@ proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.serializeBi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListDeploymentChainsResponse} message
+ * @param {!proto.grpc.service.webservice.ListEventsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListEventsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDeploymentChainsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getOptions();
+  if (f != null) {
+    writer.writeMessage(
       1,
       f,
-      pkg_model_deployment_chain_pb.DeploymentChain.serializeBinaryToWriter
+      proto.grpc.service.webservice.ListEventsRequest.Options.serializeBinaryToWriter
+    );
+  }
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
     );
   }
   f = message.getCursor();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      3,
+      f
+    );
+  }
+  f = message.getPageMinUpdatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
       f
     );
   }
 };
 
 
-/**
- * repeated model.DeploymentChain deployment_chains = 1;
- * @return {!Array<!proto.model.DeploymentChain>}
- */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.getDeploymentChainsList = function() {
-  return /** @type{!Array<!proto.model.DeploymentChain>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_deployment_chain_pb.DeploymentChain, 1));
-};
-
-
-/**
- * @param {!Array<!proto.model.DeploymentChain>} value
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsResponse} returns this
-*/
-proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.setDeploymentChainsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.model.DeploymentChain=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.DeploymentChain}
- */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.addDeploymentChains = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.DeploymentChain, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsResponse} returns this
- */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.clearDeploymentChainsList = function() {
-  return this.setDeploymentChainsList([]);
-};
-
-
-/**
- * optional string cursor = 2;
- * @return {string}
- */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.getCursor = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.grpc.service.webservice.ListDeploymentChainsResponse} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.grpc.service.webservice.ListDeploymentChainsResponse.prototype.setCursor = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
+proto.grpc.service.webservice.ListEventsRequest.Options.repeatedFields_ = [2];
 
 
 
@@ -18374,8 +19601,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetDeploymentChainRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetDeploymentChainRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListEventsRequest.Options.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListEventsRequest.Options.toObject(opt_includeInstance, this);
 };
 
 
@@ -18384,13 +19611,15 @@ proto.grpc.service.webservice.GetDeploymentChainRequest.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetDeploymentChainRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListEventsRequest.Options} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetDeploymentChainRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListEventsRequest.Options.toObject = function(includeInstance, msg) {
   var f, obj = {
-    deploymentChainId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    statusesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -18404,23 +19633,23 @@ proto.grpc.service.webservice.GetDeploymentChainRequest.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetDeploymentChainRequest}
+ * @return {!proto.grpc.service.webservice.ListEventsRequest.Options}
  */
-proto.grpc.service.webservice.GetDeploymentChainRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListEventsRequest.Options.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetDeploymentChainRequest;
-  return proto.grpc.service.webservice.GetDeploymentChainRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListEventsRequest.Options;
+  return proto.grpc.service.webservice.ListEventsRequest.Options.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetDeploymentChainRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListEventsRequest.Options} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetDeploymentChainRequest}
+ * @return {!proto.grpc.service.webservice.ListEventsRequest.Options}
  */
-proto.grpc.service.webservice.GetDeploymentChainRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListEventsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -18429,7 +19658,19 @@ proto.grpc.service.webservice.GetDeploymentChainRequest.deserializeBinaryFromRea
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDeploymentChainId(value);
+      msg.setName(value);
+      break;
+    case 2:
+      var values = /** @type {!Array<!proto.model.EventStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addStatuses(values[i]);
+      }
+      break;
+    case 3:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -18444,9 +19685,9 @@ proto.grpc.service.webservice.GetDeploymentChainRequest.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetDeploymentChainRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListEventsRequest.Options.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetDeploymentChainRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListEventsRequest.Options.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -18454,40 +19695,209 @@ proto.grpc.service.webservice.GetDeploymentChainRequest.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetDeploymentChainRequest} message
+ * @param {!proto.grpc.service.webservice.ListEventsRequest.Options} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetDeploymentChainRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListEventsRequest.Options.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDeploymentChainId();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getStatusesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      2,
+      f
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
 /**
- * optional string deployment_chain_id = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.GetDeploymentChainRequest.prototype.getDeploymentChainId = function() {
+proto.grpc.service.webservice.ListEventsRequest.Options.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.GetDeploymentChainRequest} returns this
+ * @return {!proto.grpc.service.webservice.ListEventsRequest.Options} returns this
  */
-proto.grpc.service.webservice.GetDeploymentChainRequest.prototype.setDeploymentChainId = function(value) {
+proto.grpc.service.webservice.ListEventsRequest.Options.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * repeated model.EventStatus statuses = 2;
+ * @return {!Array<!proto.model.EventStatus>}
+ */
+proto.grpc.service.webservice.ListEventsRequest.Options.prototype.getStatusesList = function() {
+  return /** @type {!Array<!proto.model.EventStatus>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.model.EventStatus>} value
+ * @return {!proto.grpc.service.webservice.ListEventsRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListEventsRequest.Options.prototype.setStatusesList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {!proto.model.EventStatus} value
+ * @param {number=} opt_index
+ * @return {!proto.grpc.service.webservice.ListEventsRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListEventsRequest.Options.prototype.addStatuses = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListEventsRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListEventsRequest.Options.prototype.clearStatusesList = function() {
+  return this.setStatusesList([]);
+};
+
+
+/**
+ * map<string, string> labels = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.grpc.service.webservice.ListEventsRequest.Options.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.grpc.service.webservice.ListEventsRequest.Options} returns this
+ */
+proto.grpc.service.webservice.ListEventsRequest.Options.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;
+};
+
+
+/**
+ * optional Options options = 1;
+ * @return {?proto.grpc.service.webservice.ListEventsRequest.Options}
+ */
+proto.grpc.service.webservice.ListEventsRequest.prototype.getOptions = function() {
+  return /** @type{?proto.grpc.service.webservice.ListEventsRequest.Options} */ (
+    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListEventsRequest.Options, 1));
+};
+
+
+/**
+ * @param {?proto.grpc.service.webservice.ListEventsRequest.Options|undefined} value
+ * @return {!proto.grpc.service.webservice.ListEventsRequest} returns this
+*/
+proto.grpc.service.webservice.ListEventsRequest.prototype.setOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.grpc.service.webservice.ListEventsRequest} returns this
+ */
+proto.grpc.service.webservice.ListEventsRequest.prototype.clearOptions = function() {
+  return this.setOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.grpc.service.webservice.ListEventsRequest.prototype.hasOptions = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional int32 page_size = 2;
+ * @return {number}
+ */
+proto.grpc.service.webservice.ListEventsRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.ListEventsRequest} returns this
+ */
+proto.grpc.service.webservice.ListEventsRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string cursor = 3;
+ * @return {string}
+ */
+proto.grpc.service.webservice.ListEventsRequest.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListEventsRequest} returns this
+ */
+proto.grpc.service.webservice.ListEventsRequest.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int64 page_min_updated_at = 4;
+ * @return {number}
+ */
+proto.grpc.service.webservice.ListEventsRequest.prototype.getPageMinUpdatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.grpc.service.webservice.ListEventsRequest} returns this
+ */
+proto.grpc.service.webservice.ListEventsRequest.prototype.setPageMinUpdatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.grpc.service.webservice.ListEventsResponse.repeatedFields_ = [1];
 
 
 
@@ -18504,8 +19914,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.GetDeploymentChainResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListEventsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListEventsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -18514,13 +19924,15 @@ proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.toObject = fu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.GetDeploymentChainResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListEventsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetDeploymentChainResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListEventsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    deploymentChain: (f = msg.getDeploymentChain()) && pkg_model_deployment_chain_pb.DeploymentChain.toObject(includeInstance, f)
+    eventsList: jspb.Message.toObjectList(msg.getEventsList(),
+    pkg_model_event_pb.Event.toObject, includeInstance),
+    cursor: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -18534,23 +19946,23 @@ proto.grpc.service.webservice.GetDeploymentChainResponse.toObject = function(inc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.GetDeploymentChainResponse}
+ * @return {!proto.grpc.service.webservice.ListEventsResponse}
  */
-proto.grpc.service.webservice.GetDeploymentChainResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListEventsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.GetDeploymentChainResponse;
-  return proto.grpc.service.webservice.GetDeploymentChainResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListEventsResponse;
+  return proto.grpc.service.webservice.ListEventsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.GetDeploymentChainResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListEventsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.GetDeploymentChainResponse}
+ * @return {!proto.grpc.service.webservice.ListEventsResponse}
  */
-proto.grpc.service.webservice.GetDeploymentChainResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListEventsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -18558,9 +19970,13 @@ proto.grpc.service.webservice.GetDeploymentChainResponse.deserializeBinaryFromRe
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_deployment_chain_pb.DeploymentChain;
-      reader.readMessage(value,pkg_model_deployment_chain_pb.DeploymentChain.deserializeBinaryFromReader);
-      msg.setDeploymentChain(value);
+      var value = new pkg_model_event_pb.Event;
+      reader.readMessage(value,pkg_model_event_pb.Event.deserializeBinaryFromReader);
+      msg.addEvents(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCursor(value);
       break;
     default:
       reader.skipField();
@@ -18575,9 +19991,9 @@ proto.grpc.service.webservice.GetDeploymentChainResponse.deserializeBinaryFromRe
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListEventsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.GetDeploymentChainResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListEventsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -18585,57 +20001,83 @@ proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.serializeBina
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.GetDeploymentChainResponse} message
+ * @param {!proto.grpc.service.webservice.ListEventsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.GetDeploymentChainResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListEventsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getDeploymentChain();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getEventsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
       f,
-      pkg_model_deployment_chain_pb.DeploymentChain.serializeBinaryToWriter
+      pkg_model_event_pb.Event.serializeBinaryToWriter
+    );
+  }
+  f = message.getCursor();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * optional model.DeploymentChain deployment_chain = 1;
- * @return {?proto.model.DeploymentChain}
+ * repeated model.Event events = 1;
+ * @return {!Array<!proto.model.Event>}
  */
-proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.getDeploymentChain = function() {
-  return /** @type{?proto.model.DeploymentChain} */ (
-    jspb.Message.getWrapperField(this, pkg_model_deployment_chain_pb.DeploymentChain, 1));
+proto.grpc.service.webservice.ListEventsResponse.prototype.getEventsList = function() {
+  return /** @type{!Array<!proto.model.Event>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_event_pb.Event, 1));
 };
 
 
 /**
- * @param {?proto.model.DeploymentChain|undefined} value
- * @return {!proto.grpc.service.webservice.GetDeploymentChainResponse} returns this
+ * @param {!Array<!proto.model.Event>} value
+ * @return {!proto.grpc.service.webservice.ListEventsResponse} returns this
 */
-proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.setDeploymentChain = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.grpc.service.webservice.ListEventsResponse.prototype.setEventsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.GetDeploymentChainResponse} returns this
+ * @param {!proto.model.Event=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.Event}
  */
-proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.clearDeploymentChain = function() {
-  return this.setDeploymentChain(undefined);
+proto.grpc.service.webservice.ListEventsResponse.prototype.addEvents = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.Event, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.grpc.service.webservice.ListEventsResponse} returns this
  */
-proto.grpc.service.webservice.GetDeploymentChainResponse.prototype.hasDeploymentChain = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.grpc.service.webservice.ListEventsResponse.prototype.clearEventsList = function() {
+  return this.setEventsList([]);
+};
+
+
+/**
+ * optional string cursor = 2;
+ * @return {string}
+ */
+proto.grpc.service.webservice.ListEventsResponse.prototype.getCursor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.ListEventsResponse} returns this
+ */
+proto.grpc.service.webservice.ListEventsResponse.prototype.setCursor = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -18655,8 +20097,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListEventsRequest.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListAuditLogsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -18665,16 +20107,16 @@ proto.grpc.service.webservice.ListEventsRequest.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListEventsRequest} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListAuditLogsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListEventsRequest.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListAuditLogsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListEventsRequest.Options.toObject(includeInstance, f),
+    options: (f = msg.getOptions()) && proto.grpc.service.webservice.ListAuditLogsRequest.Options.toObject(includeInstance, f),
     pageSize: jspb.Message.getFieldWithDefault(msg, 2, 0),
     cursor: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    pageMinUpdatedAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    pageMinCreatedAt: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -18688,23 +20130,23 @@ proto.grpc.service.webservice.ListEventsRequest.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListEventsRequest}
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest}
  */
-proto.grpc.service.webservice.ListEventsRequest.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListAuditLogsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListEventsRequest;
-  return proto.grpc.service.webservice.ListEventsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListAuditLogsRequest;
+  return proto.grpc.service.webservice.ListAuditLogsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListEventsRequest} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListAuditLogsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListEventsRequest}
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest}
  */
-proto.grpc.service.webservice.ListEventsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListAuditLogsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -18712,8 +20154,8 @@ proto.grpc.service.webservice.ListEventsRequest.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.grpc.service.webservice.ListEventsRequest.Options;
-      reader.readMessage(value,proto.grpc.service.webservice.ListEventsRequest.Options.deserializeBinaryFromReader);
+      var value = new proto.grpc.service.webservice.ListAuditLogsRequest.Options;
+      reader.readMessage(value,proto.grpc.service.webservice.ListAuditLogsRequest.Options.deserializeBinaryFromReader);
       msg.setOptions(value);
       break;
     case 2:
@@ -18726,7 +20168,7 @@ proto.grpc.service.webservice.ListEventsRequest.deserializeBinaryFromReader = fu
       break;
     case 4:
       var value = /** @type {number} */ (reader.readInt64());
-      msg.setPageMinUpdatedAt(value);
+      msg.setPageMinCreatedAt(value);
       break;
     default:
       reader.skipField();
@@ -18741,9 +20183,9 @@ proto.grpc.service.webservice.ListEventsRequest.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListEventsRequest.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListAuditLogsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -18751,18 +20193,18 @@ proto.grpc.service.webservice.ListEventsRequest.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListEventsRequest} message
+ * @param {!proto.grpc.service.webservice.ListAuditLogsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListEventsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListAuditLogsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getOptions();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      proto.grpc.service.webservice.ListEventsRequest.Options.serializeBinaryToWriter
+      proto.grpc.service.webservice.ListAuditLogsRequest.Options.serializeBinaryToWriter
     );
   }
   f = message.getPageSize();
@@ -18779,7 +20221,7 @@ proto.grpc.service.webservice.ListEventsRequest.serializeBinaryToWriter = functi
       f
     );
   }
-  f = message.getPageMinUpdatedAt();
+  f = message.getPageMinCreatedAt();
   if (f !== 0) {
     writer.writeInt64(
       4,
@@ -18790,13 +20232,6 @@ proto.grpc.service.webservice.ListEventsRequest.serializeBinaryToWriter = functi
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.grpc.service.webservice.ListEventsRequest.Options.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -18812,8 +20247,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListEventsRequest.Options.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListEventsRequest.Options.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListAuditLogsRequest.Options.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListAuditLogsRequest.Options.toObject(opt_includeInstance, this);
 };
 
 
@@ -18822,15 +20257,14 @@ proto.grpc.service.webservice.ListEventsRequest.Options.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListEventsRequest.Options} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListAuditLogsRequest.Options} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListEventsRequest.Options.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListAuditLogsRequest.Options.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    statusesList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
-    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+    actor: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    resourceType: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -18844,23 +20278,23 @@ proto.grpc.service.webservice.ListEventsRequest.Options.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListEventsRequest.Options}
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest.Options}
  */
-proto.grpc.service.webservice.ListEventsRequest.Options.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListAuditLogsRequest.Options.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListEventsRequest.Options;
-  return proto.grpc.service.webservice.ListEventsRequest.Options.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListAuditLogsRequest.Options;
+  return proto.grpc.service.webservice.ListAuditLogsRequest.Options.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListEventsRequest.Options} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListAuditLogsRequest.Options} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListEventsRequest.Options}
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest.Options}
  */
-proto.grpc.service.webservice.ListEventsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListAuditLogsRequest.Options.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -18869,19 +20303,11 @@ proto.grpc.service.webservice.ListEventsRequest.Options.deserializeBinaryFromRea
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setActor(value);
       break;
     case 2:
-      var values = /** @type {!Array<!proto.model.EventStatus>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addStatuses(values[i]);
-      }
-      break;
-    case 3:
-      var value = msg.getLabelsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = /** @type {!proto.model.ProjectRBACResource.ResourceType} */ (reader.readEnum());
+      msg.setResourceType(value);
       break;
     default:
       reader.skipField();
@@ -18896,9 +20322,9 @@ proto.grpc.service.webservice.ListEventsRequest.Options.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListEventsRequest.Options.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListAuditLogsRequest.Options.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListEventsRequest.Options.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListAuditLogsRequest.Options.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -18906,135 +20332,89 @@ proto.grpc.service.webservice.ListEventsRequest.Options.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListEventsRequest.Options} message
+ * @param {!proto.grpc.service.webservice.ListAuditLogsRequest.Options} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListEventsRequest.Options.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListAuditLogsRequest.Options.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getActor();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getStatusesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = message.getResourceType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
   }
-  f = message.getLabelsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string actor = 1;
  * @return {string}
  */
-proto.grpc.service.webservice.ListEventsRequest.Options.prototype.getName = function() {
+proto.grpc.service.webservice.ListAuditLogsRequest.Options.prototype.getActor = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.ListEventsRequest.Options} returns this
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListEventsRequest.Options.prototype.setName = function(value) {
+proto.grpc.service.webservice.ListAuditLogsRequest.Options.prototype.setActor = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated model.EventStatus statuses = 2;
- * @return {!Array<!proto.model.EventStatus>}
- */
-proto.grpc.service.webservice.ListEventsRequest.Options.prototype.getStatusesList = function() {
-  return /** @type {!Array<!proto.model.EventStatus>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<!proto.model.EventStatus>} value
- * @return {!proto.grpc.service.webservice.ListEventsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListEventsRequest.Options.prototype.setStatusesList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
-
-
-/**
- * @param {!proto.model.EventStatus} value
- * @param {number=} opt_index
- * @return {!proto.grpc.service.webservice.ListEventsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListEventsRequest.Options.prototype.addStatuses = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListEventsRequest.Options} returns this
- */
-proto.grpc.service.webservice.ListEventsRequest.Options.prototype.clearStatusesList = function() {
-  return this.setStatusesList([]);
-};
-
-
-/**
- * map<string, string> labels = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional model.ProjectRBACResource.ResourceType resource_type = 2;
+ * @return {!proto.model.ProjectRBACResource.ResourceType}
  */
-proto.grpc.service.webservice.ListEventsRequest.Options.prototype.getLabelsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      null));
+proto.grpc.service.webservice.ListAuditLogsRequest.Options.prototype.getResourceType = function() {
+  return /** @type {!proto.model.ProjectRBACResource.ResourceType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.grpc.service.webservice.ListEventsRequest.Options} returns this
+ * @param {!proto.model.ProjectRBACResource.ResourceType} value
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest.Options} returns this
  */
-proto.grpc.service.webservice.ListEventsRequest.Options.prototype.clearLabelsMap = function() {
-  this.getLabelsMap().clear();
-  return this;
+proto.grpc.service.webservice.ListAuditLogsRequest.Options.prototype.setResourceType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 /**
  * optional Options options = 1;
- * @return {?proto.grpc.service.webservice.ListEventsRequest.Options}
+ * @return {?proto.grpc.service.webservice.ListAuditLogsRequest.Options}
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.getOptions = function() {
-  return /** @type{?proto.grpc.service.webservice.ListEventsRequest.Options} */ (
-    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListEventsRequest.Options, 1));
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.getOptions = function() {
+  return /** @type{?proto.grpc.service.webservice.ListAuditLogsRequest.Options} */ (
+    jspb.Message.getWrapperField(this, proto.grpc.service.webservice.ListAuditLogsRequest.Options, 1));
 };
 
 
 /**
- * @param {?proto.grpc.service.webservice.ListEventsRequest.Options|undefined} value
- * @return {!proto.grpc.service.webservice.ListEventsRequest} returns this
+ * @param {?proto.grpc.service.webservice.ListAuditLogsRequest.Options|undefined} value
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest} returns this
 */
-proto.grpc.service.webservice.ListEventsRequest.prototype.setOptions = function(value) {
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.setOptions = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.grpc.service.webservice.ListEventsRequest} returns this
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest} returns this
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.clearOptions = function() {
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.clearOptions = function() {
   return this.setOptions(undefined);
 };
 
@@ -19043,7 +20423,7 @@ proto.grpc.service.webservice.ListEventsRequest.prototype.clearOptions = functio
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.hasOptions = function() {
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.hasOptions = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
@@ -19052,16 +20432,16 @@ proto.grpc.service.webservice.ListEventsRequest.prototype.hasOptions = function(
  * optional int32 page_size = 2;
  * @return {number}
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.getPageSize = function() {
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.getPageSize = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.grpc.service.webservice.ListEventsRequest} returns this
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest} returns this
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.setPageSize = function(value) {
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.setPageSize = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
@@ -19070,34 +20450,34 @@ proto.grpc.service.webservice.ListEventsRequest.prototype.setPageSize = function
  * optional string cursor = 3;
  * @return {string}
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.getCursor = function() {
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.getCursor = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.ListEventsRequest} returns this
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest} returns this
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.setCursor = function(value) {
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.setCursor = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional int64 page_min_updated_at = 4;
+ * optional int64 page_min_created_at = 4;
  * @return {number}
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.getPageMinUpdatedAt = function() {
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.getPageMinCreatedAt = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.grpc.service.webservice.ListEventsRequest} returns this
+ * @return {!proto.grpc.service.webservice.ListAuditLogsRequest} returns this
  */
-proto.grpc.service.webservice.ListEventsRequest.prototype.setPageMinUpdatedAt = function(value) {
+proto.grpc.service.webservice.ListAuditLogsRequest.prototype.setPageMinCreatedAt = function(value) {
   return jspb.Message.setProto3IntField(this, 4, value);
 };
 
@@ -19108,7 +20488,7 @@ proto.grpc.service.webservice.ListEventsRequest.prototype.setPageMinUpdatedAt =
  * @private {!Array<number>}
  * @const
  */
-proto.grpc.service.webservice.ListEventsResponse.repeatedFields_ = [1];
+proto.grpc.service.webservice.ListAuditLogsResponse.repeatedFields_ = [1];
 
 
 
@@ -19125,8 +20505,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.grpc.service.webservice.ListEventsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.grpc.service.webservice.ListEventsResponse.toObject(opt_includeInstance, this);
+proto.grpc.service.webservice.ListAuditLogsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.grpc.service.webservice.ListAuditLogsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -19135,14 +20515,14 @@ proto.grpc.service.webservice.ListEventsResponse.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.grpc.service.webservice.ListEventsResponse} msg The msg instance to transform.
+ * @param {!proto.grpc.service.webservice.ListAuditLogsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListEventsResponse.toObject = function(includeInstance, msg) {
+proto.grpc.service.webservice.ListAuditLogsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    eventsList: jspb.Message.toObjectList(msg.getEventsList(),
-    pkg_model_event_pb.Event.toObject, includeInstance),
+    auditLogsList: jspb.Message.toObjectList(msg.getAuditLogsList(),
+    pkg_model_audit_log_pb.AuditLog.toObject, includeInstance),
     cursor: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
@@ -19157,23 +20537,23 @@ proto.grpc.service.webservice.ListEventsResponse.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.grpc.service.webservice.ListEventsResponse}
+ * @return {!proto.grpc.service.webservice.ListAuditLogsResponse}
  */
-proto.grpc.service.webservice.ListEventsResponse.deserializeBinary = function(bytes) {
+proto.grpc.service.webservice.ListAuditLogsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.grpc.service.webservice.ListEventsResponse;
-  return proto.grpc.service.webservice.ListEventsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.grpc.service.webservice.ListAuditLogsResponse;
+  return proto.grpc.service.webservice.ListAuditLogsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.grpc.service.webservice.ListEventsResponse} msg The message object to deserialize into.
+ * @param {!proto.grpc.service.webservice.ListAuditLogsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.grpc.service.webservice.ListEventsResponse}
+ * @return {!proto.grpc.service.webservice.ListAuditLogsResponse}
  */
-proto.grpc.service.webservice.ListEventsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.grpc.service.webservice.ListAuditLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -19181,9 +20561,9 @@ proto.grpc.service.webservice.ListEventsResponse.deserializeBinaryFromReader = f
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new pkg_model_event_pb.Event;
-      reader.readMessage(value,pkg_model_event_pb.Event.deserializeBinaryFromReader);
-      msg.addEvents(value);
+      var value = new pkg_model_audit_log_pb.AuditLog;
+      reader.readMessage(value,pkg_model_audit_log_pb.AuditLog.deserializeBinaryFromReader);
+      msg.addAuditLogs(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
@@ -19202,9 +20582,9 @@ proto.grpc.service.webservice.ListEventsResponse.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.grpc.service.webservice.ListEventsResponse.prototype.serializeBinary = function() {
+proto.grpc.service.webservice.ListAuditLogsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.grpc.service.webservice.ListEventsResponse.serializeBinaryToWriter(this, writer);
+  proto.grpc.service.webservice.ListAuditLogsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -19212,18 +20592,18 @@ proto.grpc.service.webservice.ListEventsResponse.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.grpc.service.webservice.ListEventsResponse} message
+ * @param {!proto.grpc.service.webservice.ListAuditLogsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.grpc.service.webservice.ListEventsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.grpc.service.webservice.ListAuditLogsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEventsList();
+  f = message.getAuditLogsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      pkg_model_event_pb.Event.serializeBinaryToWriter
+      pkg_model_audit_log_pb.AuditLog.serializeBinaryToWriter
     );
   }
   f = message.getCursor();
@@ -19237,40 +20617,40 @@ proto.grpc.service.webservice.ListEventsResponse.serializeBinaryToWriter = funct
 
 
 /**
- * repeated model.Event events = 1;
- * @return {!Array<!proto.model.Event>}
+ * repeated model.AuditLog audit_logs = 1;
+ * @return {!Array<!proto.model.AuditLog>}
  */
-proto.grpc.service.webservice.ListEventsResponse.prototype.getEventsList = function() {
-  return /** @type{!Array<!proto.model.Event>} */ (
-    jspb.Message.getRepeatedWrapperField(this, pkg_model_event_pb.Event, 1));
+proto.grpc.service.webservice.ListAuditLogsResponse.prototype.getAuditLogsList = function() {
+  return /** @type{!Array<!proto.model.AuditLog>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_audit_log_pb.AuditLog, 1));
 };
 
 
 /**
- * @param {!Array<!proto.model.Event>} value
- * @return {!proto.grpc.service.webservice.ListEventsResponse} returns this
+ * @param {!Array<!proto.model.AuditLog>} value
+ * @return {!proto.grpc.service.webservice.ListAuditLogsResponse} returns this
 */
-proto.grpc.service.webservice.ListEventsResponse.prototype.setEventsList = function(value) {
+proto.grpc.service.webservice.ListAuditLogsResponse.prototype.setAuditLogsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.model.Event=} opt_value
+ * @param {!proto.model.AuditLog=} opt_value
  * @param {number=} opt_index
- * @return {!proto.model.Event}
+ * @return {!proto.model.AuditLog}
  */
-proto.grpc.service.webservice.ListEventsResponse.prototype.addEvents = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.Event, opt_index);
+proto.grpc.service.webservice.ListAuditLogsResponse.prototype.addAuditLogs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.AuditLog, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.grpc.service.webservice.ListEventsResponse} returns this
+ * @return {!proto.grpc.service.webservice.ListAuditLogsResponse} returns this
  */
-proto.grpc.service.webservice.ListEventsResponse.prototype.clearEventsList = function() {
-  return this.setEventsList([]);
+proto.grpc.service.webservice.ListAuditLogsResponse.prototype.clearAuditLogsList = function() {
+  return this.setAuditLogsList([]);
 };
 
 
@@ -19278,16 +20658,16 @@ proto.grpc.service.webservice.ListEventsResponse.prototype.clearEventsList = fun
  * optional string cursor = 2;
  * @return {string}
  */
-proto.grpc.service.webservice.ListEventsResponse.prototype.getCursor = function() {
+proto.grpc.service.webservice.ListAuditLogsResponse.prototype.getCursor = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.grpc.service.webservice.ListEventsResponse} returns this
+ * @return {!proto.grpc.service.webservice.ListAuditLogsResponse} returns this
  */
-proto.grpc.service.webservice.ListEventsResponse.prototype.setCursor = function(value) {
+proto.grpc.service.webservice.ListAuditLogsResponse.prototype.setCursor = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
@@ -1,6 +1,7 @@
 import * as jspb from 'google-protobuf'
 
 
+import * as pkg_model_project_pb from 'pipecd/web/model/project_pb';
 
 
 export class APIKey extends jspb.Message {
@@ -25,6 +26,14 @@ export class APIKey extends jspb.Message {
   getLastUsedAt(): number;
   setLastUsedAt(value: number): APIKey;
 
+  getPoliciesList(): Array<pkg_model_project_pb.ProjectRBACPolicy>;
+  setPoliciesList(value: Array<pkg_model_project_pb.ProjectRBACPolicy>): APIKey;
+  clearPoliciesList(): APIKey;
+  addPolicies(value?: pkg_model_project_pb.ProjectRBACPolicy, index?: number): pkg_model_project_pb.ProjectRBACPolicy;
+
+  getExpiresAt(): number;
+  setExpiresAt(value: number): APIKey;
+
   getDisabled(): boolean;
   setDisabled(value: boolean): APIKey;
 
@@ -51,6 +60,8 @@ export namespace APIKey {
     role: APIKey.Role,
     creator: string,
     lastUsedAt: number,
+    policiesList: Array<pkg_model_project_pb.ProjectRBACPolicy.AsObject>,
+    expiresAt: number,
     disabled: boolean,
     createdAt: number,
     updatedAt: number,
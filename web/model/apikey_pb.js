@@ -23,6 +23,8 @@ var global =
 
 
 
+var pkg_model_project_pb = require('pipecd/web/model/project_pb.js');
+goog.object.extend(proto, pkg_model_project_pb);
 goog.exportSymbol('proto.model.APIKey', null, global);
 goog.exportSymbol('proto.model.APIKey.Role', null, global);
 /**
@@ -36,7 +38,7 @@ goog.exportSymbol('proto.model.APIKey.Role', null, global);
  * @constructor
  */
 proto.model.APIKey = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.APIKey.repeatedFields_, null);
 };
 goog.inherits(proto.model.APIKey, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -47,6 +49,13 @@ if (goog.DEBUG && !COMPILED) {
   proto.model.APIKey.displayName = 'proto.model.APIKey';
 }
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.APIKey.repeatedFields_ = [8];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -85,6 +94,9 @@ proto.model.APIKey.toObject = function(includeInstance, msg) {
     role: jspb.Message.getFieldWithDefault(msg, 5, 0),
     creator: jspb.Message.getFieldWithDefault(msg, 6, ""),
     lastUsedAt: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    policiesList: jspb.Message.toObjectList(msg.getPoliciesList(),
+    pkg_model_project_pb.ProjectRBACPolicy.toObject, includeInstance),
+    expiresAt: jspb.Message.getFieldWithDefault(msg, 9, 0),
     disabled: jspb.Message.getBooleanFieldWithDefault(msg, 13, false),
     createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
     updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
@@ -152,6 +164,15 @@ proto.model.APIKey.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readInt64());
       msg.setLastUsedAt(value);
       break;
+    case 8:
+      var value = new pkg_model_project_pb.ProjectRBACPolicy;
+      reader.readMessage(value,pkg_model_project_pb.ProjectRBACPolicy.deserializeBinaryFromReader);
+      msg.addPolicies(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setExpiresAt(value);
+      break;
     case 13:
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setDisabled(value);
@@ -242,6 +263,21 @@ proto.model.APIKey.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getPoliciesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      pkg_model_project_pb.ProjectRBACPolicy.serializeBinaryToWriter
+    );
+  }
+  f = message.getExpiresAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
   f = message.getDisabled();
   if (f) {
     writer.writeBool(
@@ -400,6 +436,62 @@ proto.model.APIKey.prototype.setLastUsedAt = function(value) {
 };
 
 
+/**
+ * repeated model.ProjectRBACPolicy policies = 8;
+ * @return {!Array<!proto.model.ProjectRBACPolicy>}
+ */
+proto.model.APIKey.prototype.getPoliciesList = function() {
+  return /** @type{!Array<!proto.model.ProjectRBACPolicy>} */ (
+    jspb.Message.getRepeatedWrapperField(this, pkg_model_project_pb.ProjectRBACPolicy, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.model.ProjectRBACPolicy>} value
+ * @return {!proto.model.APIKey} returns this
+*/
+proto.model.APIKey.prototype.setPoliciesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.model.ProjectRBACPolicy=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ProjectRBACPolicy}
+ */
+proto.model.APIKey.prototype.addPolicies = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.model.ProjectRBACPolicy, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.APIKey} returns this
+ */
+proto.model.APIKey.prototype.clearPoliciesList = function() {
+  return this.setPoliciesList([]);
+};
+
+
+/**
+ * optional int64 expires_at = 9;
+ * @return {number}
+ */
+proto.model.APIKey.prototype.getExpiresAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.APIKey} returns this
+ */
+proto.model.APIKey.prototype.setExpiresAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
 /**
  * optional bool disabled = 13;
  * @return {boolean}
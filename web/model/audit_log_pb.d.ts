@@ -0,0 +1,72 @@
+import * as jspb from 'google-protobuf'
+
+
+import * as pkg_model_project_pb from 'pipecd/web/model/project_pb';
+
+
+export class AuditLog extends jspb.Message {
+  getId(): string;
+  setId(value: string): AuditLog;
+
+  getProjectId(): string;
+  setProjectId(value: string): AuditLog;
+
+  getActorType(): AuditLogActorType;
+  setActorType(value: AuditLogActorType): AuditLog;
+
+  getActor(): string;
+  setActor(value: string): AuditLog;
+
+  getMethod(): string;
+  setMethod(value: string): AuditLog;
+
+  getResourceType(): pkg_model_project_pb.ProjectRBACResource.ResourceType;
+  setResourceType(value: pkg_model_project_pb.ProjectRBACResource.ResourceType): AuditLog;
+
+  getAction(): pkg_model_project_pb.ProjectRBACPolicy.Action;
+  setAction(value: pkg_model_project_pb.ProjectRBACPolicy.Action): AuditLog;
+
+  getResourceId(): string;
+  setResourceId(value: string): AuditLog;
+
+  getStatusCode(): string;
+  setStatusCode(value: string): AuditLog;
+
+  getStatusMessage(): string;
+  setStatusMessage(value: string): AuditLog;
+
+  getCreatedAt(): number;
+  setCreatedAt(value: number): AuditLog;
+
+  getUpdatedAt(): number;
+  setUpdatedAt(value: number): AuditLog;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AuditLog.AsObject;
+  static toObject(includeInstance: boolean, msg: AuditLog): AuditLog.AsObject;
+  static serializeBinaryToWriter(message: AuditLog, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AuditLog;
+  static deserializeBinaryFromReader(message: AuditLog, reader: jspb.BinaryReader): AuditLog;
+}
+
+export namespace AuditLog {
+  export type AsObject = {
+    id: string,
+    projectId: string,
+    actorType: AuditLogActorType,
+    actor: string,
+    method: string,
+    resourceType: pkg_model_project_pb.ProjectRBACResource.ResourceType,
+    action: pkg_model_project_pb.ProjectRBACPolicy.Action,
+    resourceId: string,
+    statusCode: string,
+    statusMessage: string,
+    createdAt: number,
+    updatedAt: number,
+  }
+}
+
+export enum AuditLogActorType { 
+  AUDIT_LOG_ACTOR_USER = 0,
+  AUDIT_LOG_ACTOR_API_KEY = 1,
+}
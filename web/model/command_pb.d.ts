@@ -124,6 +124,9 @@ export namespace Command {
     getSyncStrategy(): pkg_model_common_pb.SyncStrategy;
     setSyncStrategy(value: pkg_model_common_pb.SyncStrategy): SyncApplication;
 
+    getIgnoreFreezeWindows(): boolean;
+    setIgnoreFreezeWindows(value: boolean): SyncApplication;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): SyncApplication.AsObject;
     static toObject(includeInstance: boolean, msg: SyncApplication): SyncApplication.AsObject;
@@ -136,6 +139,7 @@ export namespace Command {
     export type AsObject = {
       applicationId: string,
       syncStrategy: pkg_model_common_pb.SyncStrategy,
+      ignoreFreezeWindows: boolean,
     }
   }
 
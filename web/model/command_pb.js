@@ -639,7 +639,8 @@ proto.model.Command.SyncApplication.prototype.toObject = function(opt_includeIns
 proto.model.Command.SyncApplication.toObject = function(includeInstance, msg) {
   var f, obj = {
     applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    ignoreFreezeWindows: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -684,6 +685,10 @@ proto.model.Command.SyncApplication.deserializeBinaryFromReader = function(msg,
       var value = /** @type {!proto.model.SyncStrategy} */ (reader.readEnum());
       msg.setSyncStrategy(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIgnoreFreezeWindows(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -727,6 +732,13 @@ proto.model.Command.SyncApplication.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getIgnoreFreezeWindows();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -766,6 +778,24 @@ proto.model.Command.SyncApplication.prototype.setSyncStrategy = function(value)
 };
 
 
+/**
+ * optional bool ignore_freeze_windows = 3;
+ * @return {boolean}
+ */
+proto.model.Command.SyncApplication.prototype.getIgnoreFreezeWindows = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.Command.SyncApplication} returns this
+ */
+proto.model.Command.SyncApplication.prototype.setIgnoreFreezeWindows = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
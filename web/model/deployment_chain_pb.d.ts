@@ -137,6 +137,14 @@ export class ChainBlock extends jspb.Message {
   getCompletedAt(): number;
   setCompletedAt(value: number): ChainBlock;
 
+  getContinueOnFailure(): boolean;
+  setContinueOnFailure(value: boolean): ChainBlock;
+
+  getGate(): ChainBlockGate | undefined;
+  setGate(value?: ChainBlockGate): ChainBlock;
+  hasGate(): boolean;
+  clearGate(): ChainBlock;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ChainBlock.AsObject;
   static toObject(includeInstance: boolean, msg: ChainBlock): ChainBlock.AsObject;
@@ -151,6 +159,64 @@ export namespace ChainBlock {
     status: ChainBlockStatus,
     startedAt: number,
     completedAt: number,
+    continueOnFailure: boolean,
+    gate?: ChainBlockGate.AsObject,
+  }
+}
+
+export class ChainBlockGate extends jspb.Message {
+  getWaitSeconds(): number;
+  setWaitSeconds(value: number): ChainBlockGate;
+
+  getAnalysisTemplate(): string;
+  setAnalysisTemplate(value: string): ChainBlockGate;
+
+  getRequireApproval(): boolean;
+  setRequireApproval(value: boolean): ChainBlockGate;
+
+  getAnalysisConfig(): Uint8Array | string;
+  getAnalysisConfig_asU8(): Uint8Array;
+  getAnalysisConfig_asB64(): string;
+  setAnalysisConfig(value: Uint8Array | string): ChainBlockGate;
+
+  getStatus(): ChainGateStatus;
+  setStatus(value: ChainGateStatus): ChainBlockGate;
+
+  getStatusReason(): string;
+  setStatusReason(value: string): ChainBlockGate;
+
+  getApprover(): string;
+  setApprover(value: string): ChainBlockGate;
+
+  getAnalysisPassed(): boolean;
+  setAnalysisPassed(value: boolean): ChainBlockGate;
+
+  getStartedAt(): number;
+  setStartedAt(value: number): ChainBlockGate;
+
+  getCompletedAt(): number;
+  setCompletedAt(value: number): ChainBlockGate;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ChainBlockGate.AsObject;
+  static toObject(includeInstance: boolean, msg: ChainBlockGate): ChainBlockGate.AsObject;
+  static serializeBinaryToWriter(message: ChainBlockGate, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ChainBlockGate;
+  static deserializeBinaryFromReader(message: ChainBlockGate, reader: jspb.BinaryReader): ChainBlockGate;
+}
+
+export namespace ChainBlockGate {
+  export type AsObject = {
+    waitSeconds: number,
+    analysisTemplate: string,
+    requireApproval: boolean,
+    analysisConfig: Uint8Array | string,
+    status: ChainGateStatus,
+    statusReason: string,
+    approver: string,
+    analysisPassed: boolean,
+    startedAt: number,
+    completedAt: number,
   }
 }
 
@@ -168,3 +234,9 @@ export enum ChainBlockStatus {
   DEPLOYMENT_BLOCK_FAILURE = 3,
   DEPLOYMENT_BLOCK_CANCELLED = 4,
 }
+export enum ChainGateStatus { 
+  CHAIN_GATE_PENDING = 0,
+  CHAIN_GATE_RUNNING = 1,
+  CHAIN_GATE_PASSED = 2,
+  CHAIN_GATE_FAILED = 3,
+}
@@ -27,8 +27,10 @@ var pkg_model_deployment_pb = require('pipecd/web/model/deployment_pb.js');
 goog.object.extend(proto, pkg_model_deployment_pb);
 goog.exportSymbol('proto.model.ChainApplicationRef', null, global);
 goog.exportSymbol('proto.model.ChainBlock', null, global);
+goog.exportSymbol('proto.model.ChainBlockGate', null, global);
 goog.exportSymbol('proto.model.ChainBlockStatus', null, global);
 goog.exportSymbol('proto.model.ChainDeploymentRef', null, global);
+goog.exportSymbol('proto.model.ChainGateStatus', null, global);
 goog.exportSymbol('proto.model.ChainNode', null, global);
 goog.exportSymbol('proto.model.ChainStatus', null, global);
 goog.exportSymbol('proto.model.DeploymentChain', null, global);
@@ -137,6 +139,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.ChainBlock.displayName = 'proto.model.ChainBlock';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.ChainBlockGate = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.ChainBlockGate, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.ChainBlockGate.displayName = 'proto.model.ChainBlockGate';
+}
 
 /**
  * List of repeated fields within this message type.
@@ -1072,7 +1095,9 @@ proto.model.ChainBlock.toObject = function(includeInstance, msg) {
     proto.model.ChainNode.toObject, includeInstance),
     status: jspb.Message.getFieldWithDefault(msg, 2, 0),
     startedAt: jspb.Message.getFieldWithDefault(msg, 100, 0),
-    completedAt: jspb.Message.getFieldWithDefault(msg, 101, 0)
+    completedAt: jspb.Message.getFieldWithDefault(msg, 101, 0),
+    continueOnFailure: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    gate: (f = msg.getGate()) && proto.model.ChainBlockGate.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1126,6 +1151,15 @@ proto.model.ChainBlock.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readInt64());
       msg.setCompletedAt(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setContinueOnFailure(value);
+      break;
+    case 4:
+      var value = new proto.model.ChainBlockGate;
+      reader.readMessage(value,proto.model.ChainBlockGate.deserializeBinaryFromReader);
+      msg.setGate(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1184,6 +1218,21 @@ proto.model.ChainBlock.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getContinueOnFailure();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getGate();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.model.ChainBlockGate.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -1279,6 +1328,485 @@ proto.model.ChainBlock.prototype.setCompletedAt = function(value) {
 };
 
 
+/**
+ * optional bool continue_on_failure = 3;
+ * @return {boolean}
+ */
+proto.model.ChainBlock.prototype.getContinueOnFailure = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.ChainBlock} returns this
+ */
+proto.model.ChainBlock.prototype.setContinueOnFailure = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional ChainBlockGate gate = 4;
+ * @return {?proto.model.ChainBlockGate}
+ */
+proto.model.ChainBlock.prototype.getGate = function() {
+  return /** @type{?proto.model.ChainBlockGate} */ (
+    jspb.Message.getWrapperField(this, proto.model.ChainBlockGate, 4));
+};
+
+
+/**
+ * @param {?proto.model.ChainBlockGate|undefined} value
+ * @return {!proto.model.ChainBlock} returns this
+*/
+proto.model.ChainBlock.prototype.setGate = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ChainBlock} returns this
+ */
+proto.model.ChainBlock.prototype.clearGate = function() {
+  return this.setGate(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ChainBlock.prototype.hasGate = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.ChainBlockGate.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ChainBlockGate.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.ChainBlockGate} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ChainBlockGate.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    waitSeconds: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    analysisTemplate: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    requireApproval: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    analysisConfig: msg.getAnalysisConfig_asB64(),
+    status: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    statusReason: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    approver: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    analysisPassed: jspb.Message.getBooleanFieldWithDefault(msg, 13, false),
+    startedAt: jspb.Message.getFieldWithDefault(msg, 100, 0),
+    completedAt: jspb.Message.getFieldWithDefault(msg, 101, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.ChainBlockGate}
+ */
+proto.model.ChainBlockGate.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.ChainBlockGate;
+  return proto.model.ChainBlockGate.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.ChainBlockGate} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.ChainBlockGate}
+ */
+proto.model.ChainBlockGate.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setWaitSeconds(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAnalysisTemplate(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRequireApproval(value);
+      break;
+    case 5:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setAnalysisConfig(value);
+      break;
+    case 10:
+      var value = /** @type {!proto.model.ChainGateStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStatusReason(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApprover(value);
+      break;
+    case 13:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAnalysisPassed(value);
+      break;
+    case 100:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStartedAt(value);
+      break;
+    case 101:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCompletedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.ChainBlockGate.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.ChainBlockGate.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.ChainBlockGate} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ChainBlockGate.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWaitSeconds();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getAnalysisTemplate();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getRequireApproval();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getAnalysisConfig_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      5,
+      f
+    );
+  }
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      10,
+      f
+    );
+  }
+  f = message.getStatusReason();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getApprover();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getAnalysisPassed();
+  if (f) {
+    writer.writeBool(
+      13,
+      f
+    );
+  }
+  f = message.getStartedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      100,
+      f
+    );
+  }
+  f = message.getCompletedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      101,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 wait_seconds = 1;
+ * @return {number}
+ */
+proto.model.ChainBlockGate.prototype.getWaitSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.ChainBlockGate} returns this
+ */
+proto.model.ChainBlockGate.prototype.setWaitSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string analysis_template = 2;
+ * @return {string}
+ */
+proto.model.ChainBlockGate.prototype.getAnalysisTemplate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ChainBlockGate} returns this
+ */
+proto.model.ChainBlockGate.prototype.setAnalysisTemplate = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool require_approval = 4;
+ * @return {boolean}
+ */
+proto.model.ChainBlockGate.prototype.getRequireApproval = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.ChainBlockGate} returns this
+ */
+proto.model.ChainBlockGate.prototype.setRequireApproval = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional bytes analysis_config = 5;
+ * @return {!(string|Uint8Array)}
+ */
+proto.model.ChainBlockGate.prototype.getAnalysisConfig = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * optional bytes analysis_config = 5;
+ * This is a type-conversion wrapper around `getAnalysisConfig()`
+ * @return {string}
+ */
+proto.model.ChainBlockGate.prototype.getAnalysisConfig_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getAnalysisConfig()));
+};
+
+
+/**
+ * optional bytes analysis_config = 5;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getAnalysisConfig()`
+ * @return {!Uint8Array}
+ */
+proto.model.ChainBlockGate.prototype.getAnalysisConfig_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getAnalysisConfig()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.model.ChainBlockGate} returns this
+ */
+proto.model.ChainBlockGate.prototype.setAnalysisConfig = function(value) {
+  return jspb.Message.setProto3BytesField(this, 5, value);
+};
+
+
+/**
+ * optional ChainGateStatus status = 10;
+ * @return {!proto.model.ChainGateStatus}
+ */
+proto.model.ChainBlockGate.prototype.getStatus = function() {
+  return /** @type {!proto.model.ChainGateStatus} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {!proto.model.ChainGateStatus} value
+ * @return {!proto.model.ChainBlockGate} returns this
+ */
+proto.model.ChainBlockGate.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 10, value);
+};
+
+
+/**
+ * optional string status_reason = 11;
+ * @return {string}
+ */
+proto.model.ChainBlockGate.prototype.getStatusReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ChainBlockGate} returns this
+ */
+proto.model.ChainBlockGate.prototype.setStatusReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * optional string approver = 12;
+ * @return {string}
+ */
+proto.model.ChainBlockGate.prototype.getApprover = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ChainBlockGate} returns this
+ */
+proto.model.ChainBlockGate.prototype.setApprover = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+/**
+ * optional bool analysis_passed = 13;
+ * @return {boolean}
+ */
+proto.model.ChainBlockGate.prototype.getAnalysisPassed = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 13, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.ChainBlockGate} returns this
+ */
+proto.model.ChainBlockGate.prototype.setAnalysisPassed = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 13, value);
+};
+
+
+/**
+ * optional int64 started_at = 100;
+ * @return {number}
+ */
+proto.model.ChainBlockGate.prototype.getStartedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 100, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.ChainBlockGate} returns this
+ */
+proto.model.ChainBlockGate.prototype.setStartedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 100, value);
+};
+
+
+/**
+ * optional int64 completed_at = 101;
+ * @return {number}
+ */
+proto.model.ChainBlockGate.prototype.getCompletedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 101, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.ChainBlockGate} returns this
+ */
+proto.model.ChainBlockGate.prototype.setCompletedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 101, value);
+};
+
+
 /**
  * @enum {number}
  */
@@ -1301,4 +1829,14 @@ proto.model.ChainBlockStatus = {
   DEPLOYMENT_BLOCK_CANCELLED: 4
 };
 
+/**
+ * @enum {number}
+ */
+proto.model.ChainGateStatus = {
+  CHAIN_GATE_PENDING: 0,
+  CHAIN_GATE_RUNNING: 1,
+  CHAIN_GATE_PASSED: 2,
+  CHAIN_GATE_FAILED: 3
+};
+
 goog.object.extend(exports, proto.model);
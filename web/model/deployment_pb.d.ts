@@ -53,6 +53,9 @@ export class Deployment extends jspb.Message {
   clearVersionsList(): Deployment;
   addVersions(value?: pkg_model_common_pb.ArtifactVersion, index?: number): pkg_model_common_pb.ArtifactVersion;
 
+  getConcurrencyPolicy(): DeploymentConcurrencyPolicy;
+  setConcurrencyPolicy(value: DeploymentConcurrencyPolicy): Deployment;
+
   getRunningCommitHash(): string;
   setRunningCommitHash(value: string): Deployment;
 
@@ -120,6 +123,7 @@ export namespace Deployment {
     trigger?: DeploymentTrigger.AsObject,
     summary: string,
     versionsList: Array<pkg_model_common_pb.ArtifactVersion.AsObject>,
+    concurrencyPolicy: DeploymentConcurrencyPolicy,
     runningCommitHash: string,
     runningConfigFilename: string,
     status: DeploymentStatus,
@@ -174,6 +178,9 @@ export class DeploymentTrigger extends jspb.Message {
   getStrategySummary(): string;
   setStrategySummary(value: string): DeploymentTrigger;
 
+  getIgnoreFreezeWindows(): boolean;
+  setIgnoreFreezeWindows(value: boolean): DeploymentTrigger;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): DeploymentTrigger.AsObject;
   static toObject(includeInstance: boolean, msg: DeploymentTrigger): DeploymentTrigger.AsObject;
@@ -189,6 +196,7 @@ export namespace DeploymentTrigger {
     timestamp: number,
     syncStrategy: pkg_model_common_pb.SyncStrategy,
     strategySummary: string,
+    ignoreFreezeWindows: boolean,
   }
 }
 
@@ -381,11 +389,17 @@ export enum StageStatus {
   STAGE_SKIPPED = 5,
   STAGE_EXITED = 6,
 }
+export enum DeploymentConcurrencyPolicy { 
+  CONCURRENCY_QUEUE = 0,
+  CONCURRENCY_CANCEL_RUNNING = 1,
+  CONCURRENCY_SUPERSEDE_PENDING = 2,
+}
 export enum TriggerKind { 
   ON_COMMIT = 0,
   ON_COMMAND = 1,
   ON_OUT_OF_SYNC = 2,
   ON_CHAIN = 3,
+  ON_SCHEDULE = 4,
 }
 export enum ManualOperation { 
   MANUAL_OPERATION_UNKNOWN = 0,
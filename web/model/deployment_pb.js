@@ -28,6 +28,7 @@ goog.object.extend(proto, pkg_model_common_pb);
 goog.exportSymbol('proto.model.Commit', null, global);
 goog.exportSymbol('proto.model.DeployTargets', null, global);
 goog.exportSymbol('proto.model.Deployment', null, global);
+goog.exportSymbol('proto.model.DeploymentConcurrencyPolicy', null, global);
 goog.exportSymbol('proto.model.DeploymentMetadata', null, global);
 goog.exportSymbol('proto.model.DeploymentMetadata.KeyValues', null, global);
 goog.exportSymbol('proto.model.DeploymentStatus', null, global);
@@ -237,6 +238,7 @@ proto.model.Deployment.toObject = function(includeInstance, msg) {
     summary: jspb.Message.getFieldWithDefault(msg, 22, ""),
     versionsList: jspb.Message.toObjectList(msg.getVersionsList(),
     pkg_model_common_pb.ArtifactVersion.toObject, includeInstance),
+    concurrencyPolicy: jspb.Message.getFieldWithDefault(msg, 25, 0),
     runningCommitHash: jspb.Message.getFieldWithDefault(msg, 21, ""),
     runningConfigFilename: jspb.Message.getFieldWithDefault(msg, 60, ""),
     status: jspb.Message.getFieldWithDefault(msg, 30, 0),
@@ -350,6 +352,10 @@ proto.model.Deployment.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,pkg_model_common_pb.ArtifactVersion.deserializeBinaryFromReader);
       msg.addVersions(value);
       break;
+    case 25:
+      var value = /** @type {!proto.model.DeploymentConcurrencyPolicy} */ (reader.readEnum());
+      msg.setConcurrencyPolicy(value);
+      break;
     case 21:
       var value = /** @type {string} */ (reader.readString());
       msg.setRunningCommitHash(value);
@@ -530,6 +536,13 @@ proto.model.Deployment.serializeBinaryToWriter = function(message, writer) {
       pkg_model_common_pb.ArtifactVersion.serializeBinaryToWriter
     );
   }
+  f = message.getConcurrencyPolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      25,
+      f
+    );
+  }
   f = message.getRunningCommitHash();
   if (f.length > 0) {
     writer.writeString(
@@ -943,6 +956,24 @@ proto.model.Deployment.prototype.clearVersionsList = function() {
 };
 
 
+/**
+ * optional DeploymentConcurrencyPolicy concurrency_policy = 25;
+ * @return {!proto.model.DeploymentConcurrencyPolicy}
+ */
+proto.model.Deployment.prototype.getConcurrencyPolicy = function() {
+  return /** @type {!proto.model.DeploymentConcurrencyPolicy} */ (jspb.Message.getFieldWithDefault(this, 25, 0));
+};
+
+
+/**
+ * @param {!proto.model.DeploymentConcurrencyPolicy} value
+ * @return {!proto.model.Deployment} returns this
+ */
+proto.model.Deployment.prototype.setConcurrencyPolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 25, value);
+};
+
+
 /**
  * optional string running_commit_hash = 21;
  * @return {string}
@@ -1413,7 +1444,8 @@ proto.model.DeploymentTrigger.toObject = function(includeInstance, msg) {
     commander: jspb.Message.getFieldWithDefault(msg, 2, ""),
     timestamp: jspb.Message.getFieldWithDefault(msg, 3, 0),
     syncStrategy: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    strategySummary: jspb.Message.getFieldWithDefault(msg, 5, "")
+    strategySummary: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    ignoreFreezeWindows: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
   };
 
   if (includeInstance) {
@@ -1471,6 +1503,10 @@ proto.model.DeploymentTrigger.deserializeBinaryFromReader = function(msg, reader
       var value = /** @type {string} */ (reader.readString());
       msg.setStrategySummary(value);
       break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIgnoreFreezeWindows(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1536,6 +1572,13 @@ proto.model.DeploymentTrigger.serializeBinaryToWriter = function(message, writer
       f
     );
   }
+  f = message.getIgnoreFreezeWindows();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -1648,6 +1691,24 @@ proto.model.DeploymentTrigger.prototype.setStrategySummary = function(value) {
 };
 
 
+/**
+ * optional bool ignore_freeze_windows = 6;
+ * @return {boolean}
+ */
+proto.model.DeploymentTrigger.prototype.getIgnoreFreezeWindows = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.DeploymentTrigger} returns this
+ */
+proto.model.DeploymentTrigger.prototype.setIgnoreFreezeWindows = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -2962,6 +3023,15 @@ proto.model.StageStatus = {
   STAGE_EXITED: 6
 };
 
+/**
+ * @enum {number}
+ */
+proto.model.DeploymentConcurrencyPolicy = {
+  CONCURRENCY_QUEUE: 0,
+  CONCURRENCY_CANCEL_RUNNING: 1,
+  CONCURRENCY_SUPERSEDE_PENDING: 2
+};
+
 /**
  * @enum {number}
  */
@@ -2969,7 +3039,8 @@ proto.model.TriggerKind = {
   ON_COMMIT: 0,
   ON_COMMAND: 1,
   ON_OUT_OF_SYNC: 2,
-  ON_CHAIN: 3
+  ON_CHAIN: 3,
+  ON_SCHEDULE: 4
 };
 
 /**
@@ -21,6 +21,11 @@ export class NotificationEventDeploymentTriggered extends jspb.Message {
   clearMentionedGroupsList(): NotificationEventDeploymentTriggered;
   addMentionedGroups(value: string, index?: number): NotificationEventDeploymentTriggered;
 
+  getMentionedTeamsUsersList(): Array<string>;
+  setMentionedTeamsUsersList(value: Array<string>): NotificationEventDeploymentTriggered;
+  clearMentionedTeamsUsersList(): NotificationEventDeploymentTriggered;
+  addMentionedTeamsUsers(value: string, index?: number): NotificationEventDeploymentTriggered;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentTriggered.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentTriggered): NotificationEventDeploymentTriggered.AsObject;
@@ -34,6 +39,7 @@ export namespace NotificationEventDeploymentTriggered {
     deployment?: pkg_model_deployment_pb.Deployment.AsObject,
     mentionedAccountsList: Array<string>,
     mentionedGroupsList: Array<string>,
+    mentionedTeamsUsersList: Array<string>,
   }
 }
 
@@ -56,6 +62,11 @@ export class NotificationEventDeploymentPlanned extends jspb.Message {
   clearMentionedGroupsList(): NotificationEventDeploymentPlanned;
   addMentionedGroups(value: string, index?: number): NotificationEventDeploymentPlanned;
 
+  getMentionedTeamsUsersList(): Array<string>;
+  setMentionedTeamsUsersList(value: Array<string>): NotificationEventDeploymentPlanned;
+  clearMentionedTeamsUsersList(): NotificationEventDeploymentPlanned;
+  addMentionedTeamsUsers(value: string, index?: number): NotificationEventDeploymentPlanned;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentPlanned.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentPlanned): NotificationEventDeploymentPlanned.AsObject;
@@ -70,6 +81,7 @@ export namespace NotificationEventDeploymentPlanned {
     summary: string,
     mentionedAccountsList: Array<string>,
     mentionedGroupsList: Array<string>,
+    mentionedTeamsUsersList: Array<string>,
   }
 }
 
@@ -89,6 +101,11 @@ export class NotificationEventDeploymentStarted extends jspb.Message {
   clearMentionedGroupsList(): NotificationEventDeploymentStarted;
   addMentionedGroups(value: string, index?: number): NotificationEventDeploymentStarted;
 
+  getMentionedTeamsUsersList(): Array<string>;
+  setMentionedTeamsUsersList(value: Array<string>): NotificationEventDeploymentStarted;
+  clearMentionedTeamsUsersList(): NotificationEventDeploymentStarted;
+  addMentionedTeamsUsers(value: string, index?: number): NotificationEventDeploymentStarted;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentStarted.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentStarted): NotificationEventDeploymentStarted.AsObject;
@@ -102,6 +119,7 @@ export namespace NotificationEventDeploymentStarted {
     deployment?: pkg_model_deployment_pb.Deployment.AsObject,
     mentionedAccountsList: Array<string>,
     mentionedGroupsList: Array<string>,
+    mentionedTeamsUsersList: Array<string>,
   }
 }
 
@@ -124,6 +142,11 @@ export class NotificationEventDeploymentApproved extends jspb.Message {
   clearMentionedGroupsList(): NotificationEventDeploymentApproved;
   addMentionedGroups(value: string, index?: number): NotificationEventDeploymentApproved;
 
+  getMentionedTeamsUsersList(): Array<string>;
+  setMentionedTeamsUsersList(value: Array<string>): NotificationEventDeploymentApproved;
+  clearMentionedTeamsUsersList(): NotificationEventDeploymentApproved;
+  addMentionedTeamsUsers(value: string, index?: number): NotificationEventDeploymentApproved;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentApproved.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentApproved): NotificationEventDeploymentApproved.AsObject;
@@ -138,6 +161,7 @@ export namespace NotificationEventDeploymentApproved {
     approver: string,
     mentionedAccountsList: Array<string>,
     mentionedGroupsList: Array<string>,
+    mentionedTeamsUsersList: Array<string>,
   }
 }
 
@@ -177,6 +201,11 @@ export class NotificationEventDeploymentSucceeded extends jspb.Message {
   clearMentionedGroupsList(): NotificationEventDeploymentSucceeded;
   addMentionedGroups(value: string, index?: number): NotificationEventDeploymentSucceeded;
 
+  getMentionedTeamsUsersList(): Array<string>;
+  setMentionedTeamsUsersList(value: Array<string>): NotificationEventDeploymentSucceeded;
+  clearMentionedTeamsUsersList(): NotificationEventDeploymentSucceeded;
+  addMentionedTeamsUsers(value: string, index?: number): NotificationEventDeploymentSucceeded;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentSucceeded.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentSucceeded): NotificationEventDeploymentSucceeded.AsObject;
@@ -190,6 +219,7 @@ export namespace NotificationEventDeploymentSucceeded {
     deployment?: pkg_model_deployment_pb.Deployment.AsObject,
     mentionedAccountsList: Array<string>,
     mentionedGroupsList: Array<string>,
+    mentionedTeamsUsersList: Array<string>,
   }
 }
 
@@ -212,6 +242,11 @@ export class NotificationEventDeploymentFailed extends jspb.Message {
   clearMentionedGroupsList(): NotificationEventDeploymentFailed;
   addMentionedGroups(value: string, index?: number): NotificationEventDeploymentFailed;
 
+  getMentionedTeamsUsersList(): Array<string>;
+  setMentionedTeamsUsersList(value: Array<string>): NotificationEventDeploymentFailed;
+  clearMentionedTeamsUsersList(): NotificationEventDeploymentFailed;
+  addMentionedTeamsUsers(value: string, index?: number): NotificationEventDeploymentFailed;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentFailed.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentFailed): NotificationEventDeploymentFailed.AsObject;
@@ -226,6 +261,7 @@ export namespace NotificationEventDeploymentFailed {
     reason: string,
     mentionedAccountsList: Array<string>,
     mentionedGroupsList: Array<string>,
+    mentionedTeamsUsersList: Array<string>,
   }
 }
 
@@ -248,6 +284,11 @@ export class NotificationEventDeploymentCancelled extends jspb.Message {
   clearMentionedGroupsList(): NotificationEventDeploymentCancelled;
   addMentionedGroups(value: string, index?: number): NotificationEventDeploymentCancelled;
 
+  getMentionedTeamsUsersList(): Array<string>;
+  setMentionedTeamsUsersList(value: Array<string>): NotificationEventDeploymentCancelled;
+  clearMentionedTeamsUsersList(): NotificationEventDeploymentCancelled;
+  addMentionedTeamsUsers(value: string, index?: number): NotificationEventDeploymentCancelled;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentCancelled.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentCancelled): NotificationEventDeploymentCancelled.AsObject;
@@ -262,6 +303,7 @@ export namespace NotificationEventDeploymentCancelled {
     commander: string,
     mentionedAccountsList: Array<string>,
     mentionedGroupsList: Array<string>,
+    mentionedTeamsUsersList: Array<string>,
   }
 }
 
@@ -281,6 +323,11 @@ export class NotificationEventDeploymentWaitApproval extends jspb.Message {
   clearMentionedGroupsList(): NotificationEventDeploymentWaitApproval;
   addMentionedGroups(value: string, index?: number): NotificationEventDeploymentWaitApproval;
 
+  getMentionedTeamsUsersList(): Array<string>;
+  setMentionedTeamsUsersList(value: Array<string>): NotificationEventDeploymentWaitApproval;
+  clearMentionedTeamsUsersList(): NotificationEventDeploymentWaitApproval;
+  addMentionedTeamsUsers(value: string, index?: number): NotificationEventDeploymentWaitApproval;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentWaitApproval.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentWaitApproval): NotificationEventDeploymentWaitApproval.AsObject;
@@ -294,6 +341,7 @@ export namespace NotificationEventDeploymentWaitApproval {
     deployment?: pkg_model_deployment_pb.Deployment.AsObject,
     mentionedAccountsList: Array<string>,
     mentionedGroupsList: Array<string>,
+    mentionedTeamsUsersList: Array<string>,
   }
 }
 
@@ -322,6 +370,11 @@ export class NotificationEventDeploymentTriggerFailed extends jspb.Message {
   clearMentionedGroupsList(): NotificationEventDeploymentTriggerFailed;
   addMentionedGroups(value: string, index?: number): NotificationEventDeploymentTriggerFailed;
 
+  getMentionedTeamsUsersList(): Array<string>;
+  setMentionedTeamsUsersList(value: Array<string>): NotificationEventDeploymentTriggerFailed;
+  clearMentionedTeamsUsersList(): NotificationEventDeploymentTriggerFailed;
+  addMentionedTeamsUsers(value: string, index?: number): NotificationEventDeploymentTriggerFailed;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): NotificationEventDeploymentTriggerFailed.AsObject;
   static toObject(includeInstance: boolean, msg: NotificationEventDeploymentTriggerFailed): NotificationEventDeploymentTriggerFailed.AsObject;
@@ -338,6 +391,7 @@ export namespace NotificationEventDeploymentTriggerFailed {
     reason: string,
     mentionedAccountsList: Array<string>,
     mentionedGroupsList: Array<string>,
+    mentionedTeamsUsersList: Array<string>,
   }
 }
 
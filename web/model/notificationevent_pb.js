@@ -453,7 +453,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentTriggered.repeatedFields_ = [3,4];
+proto.model.NotificationEventDeploymentTriggered.repeatedFields_ = [3,4,5];
 
 
 
@@ -488,7 +488,8 @@ proto.model.NotificationEventDeploymentTriggered.toObject = function(includeInst
   var f, obj = {
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
     mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    mentionedTeamsUsersList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -538,6 +539,10 @@ proto.model.NotificationEventDeploymentTriggered.deserializeBinaryFromReader = f
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedTeamsUsers(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -589,6 +594,13 @@ proto.model.NotificationEventDeploymentTriggered.serializeBinaryToWriter = funct
       f
     );
   }
+  f = message.getMentionedTeamsUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -703,13 +715,50 @@ proto.model.NotificationEventDeploymentTriggered.prototype.clearMentionedGroupsL
 };
 
 
+/**
+ * repeated string mentioned_teams_users = 5;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentTriggered.prototype.getMentionedTeamsUsersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentTriggered.prototype.setMentionedTeamsUsersList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentTriggered.prototype.addMentionedTeamsUsers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentTriggered} returns this
+ */
+proto.model.NotificationEventDeploymentTriggered.prototype.clearMentionedTeamsUsersList = function() {
+  return this.setMentionedTeamsUsersList([]);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentPlanned.repeatedFields_ = [4,5];
+proto.model.NotificationEventDeploymentPlanned.repeatedFields_ = [4,5,6];
 
 
 
@@ -745,7 +794,8 @@ proto.model.NotificationEventDeploymentPlanned.toObject = function(includeInstan
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
     summary: jspb.Message.getFieldWithDefault(msg, 3, ""),
     mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    mentionedTeamsUsersList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -799,6 +849,10 @@ proto.model.NotificationEventDeploymentPlanned.deserializeBinaryFromReader = fun
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedTeamsUsers(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -857,6 +911,13 @@ proto.model.NotificationEventDeploymentPlanned.serializeBinaryToWriter = functio
       f
     );
   }
+  f = message.getMentionedTeamsUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -989,13 +1050,50 @@ proto.model.NotificationEventDeploymentPlanned.prototype.clearMentionedGroupsLis
 };
 
 
+/**
+ * repeated string mentioned_teams_users = 6;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentPlanned.prototype.getMentionedTeamsUsersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ */
+proto.model.NotificationEventDeploymentPlanned.prototype.setMentionedTeamsUsersList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ */
+proto.model.NotificationEventDeploymentPlanned.prototype.addMentionedTeamsUsers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentPlanned} returns this
+ */
+proto.model.NotificationEventDeploymentPlanned.prototype.clearMentionedTeamsUsersList = function() {
+  return this.setMentionedTeamsUsersList([]);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentStarted.repeatedFields_ = [3,4];
+proto.model.NotificationEventDeploymentStarted.repeatedFields_ = [3,4,5];
 
 
 
@@ -1030,7 +1128,8 @@ proto.model.NotificationEventDeploymentStarted.toObject = function(includeInstan
   var f, obj = {
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
     mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    mentionedTeamsUsersList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1080,6 +1179,10 @@ proto.model.NotificationEventDeploymentStarted.deserializeBinaryFromReader = fun
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedTeamsUsers(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1131,6 +1234,13 @@ proto.model.NotificationEventDeploymentStarted.serializeBinaryToWriter = functio
       f
     );
   }
+  f = message.getMentionedTeamsUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -1245,13 +1355,50 @@ proto.model.NotificationEventDeploymentStarted.prototype.clearMentionedGroupsLis
 };
 
 
+/**
+ * repeated string mentioned_teams_users = 5;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.getMentionedTeamsUsersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.setMentionedTeamsUsersList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.addMentionedTeamsUsers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentStarted} returns this
+ */
+proto.model.NotificationEventDeploymentStarted.prototype.clearMentionedTeamsUsersList = function() {
+  return this.setMentionedTeamsUsersList([]);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentApproved.repeatedFields_ = [4,5];
+proto.model.NotificationEventDeploymentApproved.repeatedFields_ = [4,5,6];
 
 
 
@@ -1287,7 +1434,8 @@ proto.model.NotificationEventDeploymentApproved.toObject = function(includeInsta
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
     approver: jspb.Message.getFieldWithDefault(msg, 3, ""),
     mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    mentionedTeamsUsersList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1341,6 +1489,10 @@ proto.model.NotificationEventDeploymentApproved.deserializeBinaryFromReader = fu
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedTeamsUsers(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1399,6 +1551,13 @@ proto.model.NotificationEventDeploymentApproved.serializeBinaryToWriter = functi
       f
     );
   }
+  f = message.getMentionedTeamsUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -1531,6 +1690,43 @@ proto.model.NotificationEventDeploymentApproved.prototype.clearMentionedGroupsLi
 };
 
 
+/**
+ * repeated string mentioned_teams_users = 6;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.getMentionedTeamsUsersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.setMentionedTeamsUsersList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.addMentionedTeamsUsers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentApproved} returns this
+ */
+proto.model.NotificationEventDeploymentApproved.prototype.clearMentionedTeamsUsersList = function() {
+  return this.setMentionedTeamsUsersList([]);
+};
+
+
 
 
 
@@ -1688,7 +1884,7 @@ proto.model.NotificationEventDeploymentRollingBack.prototype.hasDeployment = fun
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentSucceeded.repeatedFields_ = [3,4];
+proto.model.NotificationEventDeploymentSucceeded.repeatedFields_ = [3,4,5];
 
 
 
@@ -1723,7 +1919,8 @@ proto.model.NotificationEventDeploymentSucceeded.toObject = function(includeInst
   var f, obj = {
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
     mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    mentionedTeamsUsersList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1773,6 +1970,10 @@ proto.model.NotificationEventDeploymentSucceeded.deserializeBinaryFromReader = f
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedTeamsUsers(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1824,6 +2025,13 @@ proto.model.NotificationEventDeploymentSucceeded.serializeBinaryToWriter = funct
       f
     );
   }
+  f = message.getMentionedTeamsUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -1938,13 +2146,50 @@ proto.model.NotificationEventDeploymentSucceeded.prototype.clearMentionedGroupsL
 };
 
 
+/**
+ * repeated string mentioned_teams_users = 5;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentSucceeded.prototype.getMentionedTeamsUsersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentSucceeded} returns this
+ */
+proto.model.NotificationEventDeploymentSucceeded.prototype.setMentionedTeamsUsersList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentSucceeded} returns this
+ */
+proto.model.NotificationEventDeploymentSucceeded.prototype.addMentionedTeamsUsers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentSucceeded} returns this
+ */
+proto.model.NotificationEventDeploymentSucceeded.prototype.clearMentionedTeamsUsersList = function() {
+  return this.setMentionedTeamsUsersList([]);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentFailed.repeatedFields_ = [4,5];
+proto.model.NotificationEventDeploymentFailed.repeatedFields_ = [4,5,6];
 
 
 
@@ -1980,7 +2225,8 @@ proto.model.NotificationEventDeploymentFailed.toObject = function(includeInstanc
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
     reason: jspb.Message.getFieldWithDefault(msg, 3, ""),
     mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    mentionedTeamsUsersList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2034,6 +2280,10 @@ proto.model.NotificationEventDeploymentFailed.deserializeBinaryFromReader = func
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedTeamsUsers(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2092,6 +2342,13 @@ proto.model.NotificationEventDeploymentFailed.serializeBinaryToWriter = function
       f
     );
   }
+  f = message.getMentionedTeamsUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -2224,13 +2481,50 @@ proto.model.NotificationEventDeploymentFailed.prototype.clearMentionedGroupsList
 };
 
 
+/**
+ * repeated string mentioned_teams_users = 6;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentFailed.prototype.getMentionedTeamsUsersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentFailed} returns this
+ */
+proto.model.NotificationEventDeploymentFailed.prototype.setMentionedTeamsUsersList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentFailed} returns this
+ */
+proto.model.NotificationEventDeploymentFailed.prototype.addMentionedTeamsUsers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentFailed} returns this
+ */
+proto.model.NotificationEventDeploymentFailed.prototype.clearMentionedTeamsUsersList = function() {
+  return this.setMentionedTeamsUsersList([]);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentCancelled.repeatedFields_ = [4,5];
+proto.model.NotificationEventDeploymentCancelled.repeatedFields_ = [4,5,6];
 
 
 
@@ -2266,7 +2560,8 @@ proto.model.NotificationEventDeploymentCancelled.toObject = function(includeInst
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
     commander: jspb.Message.getFieldWithDefault(msg, 3, ""),
     mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    mentionedTeamsUsersList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2320,6 +2615,10 @@ proto.model.NotificationEventDeploymentCancelled.deserializeBinaryFromReader = f
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedTeamsUsers(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2378,6 +2677,13 @@ proto.model.NotificationEventDeploymentCancelled.serializeBinaryToWriter = funct
       f
     );
   }
+  f = message.getMentionedTeamsUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -2510,13 +2816,50 @@ proto.model.NotificationEventDeploymentCancelled.prototype.clearMentionedGroupsL
 };
 
 
+/**
+ * repeated string mentioned_teams_users = 6;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentCancelled.prototype.getMentionedTeamsUsersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentCancelled} returns this
+ */
+proto.model.NotificationEventDeploymentCancelled.prototype.setMentionedTeamsUsersList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentCancelled} returns this
+ */
+proto.model.NotificationEventDeploymentCancelled.prototype.addMentionedTeamsUsers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentCancelled} returns this
+ */
+proto.model.NotificationEventDeploymentCancelled.prototype.clearMentionedTeamsUsersList = function() {
+  return this.setMentionedTeamsUsersList([]);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentWaitApproval.repeatedFields_ = [3,4];
+proto.model.NotificationEventDeploymentWaitApproval.repeatedFields_ = [3,4,5];
 
 
 
@@ -2551,7 +2894,8 @@ proto.model.NotificationEventDeploymentWaitApproval.toObject = function(includeI
   var f, obj = {
     deployment: (f = msg.getDeployment()) && pkg_model_deployment_pb.Deployment.toObject(includeInstance, f),
     mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    mentionedTeamsUsersList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2601,6 +2945,10 @@ proto.model.NotificationEventDeploymentWaitApproval.deserializeBinaryFromReader
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedTeamsUsers(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2652,6 +3000,13 @@ proto.model.NotificationEventDeploymentWaitApproval.serializeBinaryToWriter = fu
       f
     );
   }
+  f = message.getMentionedTeamsUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -2766,13 +3121,50 @@ proto.model.NotificationEventDeploymentWaitApproval.prototype.clearMentionedGrou
 };
 
 
+/**
+ * repeated string mentioned_teams_users = 5;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentWaitApproval.prototype.getMentionedTeamsUsersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentWaitApproval} returns this
+ */
+proto.model.NotificationEventDeploymentWaitApproval.prototype.setMentionedTeamsUsersList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentWaitApproval} returns this
+ */
+proto.model.NotificationEventDeploymentWaitApproval.prototype.addMentionedTeamsUsers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentWaitApproval} returns this
+ */
+proto.model.NotificationEventDeploymentWaitApproval.prototype.clearMentionedTeamsUsersList = function() {
+  return this.setMentionedTeamsUsersList([]);
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.NotificationEventDeploymentTriggerFailed.repeatedFields_ = [5,6];
+proto.model.NotificationEventDeploymentTriggerFailed.repeatedFields_ = [5,6,7];
 
 
 
@@ -2810,7 +3202,8 @@ proto.model.NotificationEventDeploymentTriggerFailed.toObject = function(include
     commitMessage: jspb.Message.getFieldWithDefault(msg, 3, ""),
     reason: jspb.Message.getFieldWithDefault(msg, 4, ""),
     mentionedAccountsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
-    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
+    mentionedGroupsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    mentionedTeamsUsersList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -2872,6 +3265,10 @@ proto.model.NotificationEventDeploymentTriggerFailed.deserializeBinaryFromReader
       var value = /** @type {string} */ (reader.readString());
       msg.addMentionedGroups(value);
       break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addMentionedTeamsUsers(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2944,6 +3341,13 @@ proto.model.NotificationEventDeploymentTriggerFailed.serializeBinaryToWriter = f
       f
     );
   }
+  f = message.getMentionedTeamsUsersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      7,
+      f
+    );
+  }
 };
 
 
@@ -3112,6 +3516,43 @@ proto.model.NotificationEventDeploymentTriggerFailed.prototype.clearMentionedGro
 };
 
 
+/**
+ * repeated string mentioned_teams_users = 7;
+ * @return {!Array<string>}
+ */
+proto.model.NotificationEventDeploymentTriggerFailed.prototype.getMentionedTeamsUsersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 7));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.NotificationEventDeploymentTriggerFailed} returns this
+ */
+proto.model.NotificationEventDeploymentTriggerFailed.prototype.setMentionedTeamsUsersList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NotificationEventDeploymentTriggerFailed} returns this
+ */
+proto.model.NotificationEventDeploymentTriggerFailed.prototype.addMentionedTeamsUsers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NotificationEventDeploymentTriggerFailed} returns this
+ */
+proto.model.NotificationEventDeploymentTriggerFailed.prototype.clearMentionedTeamsUsersList = function() {
+  return this.setMentionedTeamsUsersList([]);
+};
+
+
 
 
 
@@ -45,6 +45,16 @@ export class Project extends jspb.Message {
   clearUserGroupsList(): Project;
   addUserGroups(value?: ProjectUserGroup, index?: number): ProjectUserGroup;
 
+  getRegistryWebhook(): ProjectRegistryWebhookConfig | undefined;
+  setRegistryWebhook(value?: ProjectRegistryWebhookConfig): Project;
+  hasRegistryWebhook(): boolean;
+  clearRegistryWebhook(): Project;
+
+  getFreezeWindowsList(): Array<ProjectFreezeWindow>;
+  setFreezeWindowsList(value: Array<ProjectFreezeWindow>): Project;
+  clearFreezeWindowsList(): Project;
+  addFreezeWindows(value?: ProjectFreezeWindow, index?: number): ProjectFreezeWindow;
+
   getCreatedAt(): number;
   setCreatedAt(value: number): Project;
 
@@ -71,6 +81,8 @@ export namespace Project {
     allowStrayAsViewer: boolean,
     rbacRolesList: Array<ProjectRBACRole.AsObject>,
     userGroupsList: Array<ProjectUserGroup.AsObject>,
+    registryWebhook?: ProjectRegistryWebhookConfig.AsObject,
+    freezeWindowsList: Array<ProjectFreezeWindow.AsObject>,
     createdAt: number,
     updatedAt: number,
   }
@@ -374,6 +386,7 @@ export namespace ProjectRBACResource {
     PROJECT = 6,
     API_KEY = 7,
     INSIGHT = 8,
+    AUDIT_LOG = 9,
   }
 }
 
@@ -412,3 +425,98 @@ export namespace ProjectRBACPolicy {
   }
 }
 
+export class ProjectRegistryWebhookConfig extends jspb.Message {
+  getSecret(): string;
+  setSecret(value: string): ProjectRegistryWebhookConfig;
+
+  getRulesList(): Array<ProjectRegistryWebhookConfig.Rule>;
+  setRulesList(value: Array<ProjectRegistryWebhookConfig.Rule>): ProjectRegistryWebhookConfig;
+  clearRulesList(): ProjectRegistryWebhookConfig;
+  addRules(value?: ProjectRegistryWebhookConfig.Rule, index?: number): ProjectRegistryWebhookConfig.Rule;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ProjectRegistryWebhookConfig.AsObject;
+  static toObject(includeInstance: boolean, msg: ProjectRegistryWebhookConfig): ProjectRegistryWebhookConfig.AsObject;
+  static serializeBinaryToWriter(message: ProjectRegistryWebhookConfig, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ProjectRegistryWebhookConfig;
+  static deserializeBinaryFromReader(message: ProjectRegistryWebhookConfig, reader: jspb.BinaryReader): ProjectRegistryWebhookConfig;
+}
+
+export namespace ProjectRegistryWebhookConfig {
+  export type AsObject = {
+    secret: string,
+    rulesList: Array<ProjectRegistryWebhookConfig.Rule.AsObject>,
+  }
+
+  export class Rule extends jspb.Message {
+    getRepository(): string;
+    setRepository(value: string): Rule;
+
+    getTag(): string;
+    setTag(value: string): Rule;
+
+    getEventName(): string;
+    setEventName(value: string): Rule;
+
+    getEventLabelsMap(): jspb.Map<string, string>;
+    clearEventLabelsMap(): Rule;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Rule.AsObject;
+    static toObject(includeInstance: boolean, msg: Rule): Rule.AsObject;
+    static serializeBinaryToWriter(message: Rule, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Rule;
+    static deserializeBinaryFromReader(message: Rule, reader: jspb.BinaryReader): Rule;
+  }
+
+  export namespace Rule {
+    export type AsObject = {
+      repository: string,
+      tag: string,
+      eventName: string,
+      eventLabelsMap: Array<[string, string]>,
+    }
+  }
+}
+
+export class ProjectFreezeWindow extends jspb.Message {
+  getName(): string;
+  setName(value: string): ProjectFreezeWindow;
+
+  getReason(): string;
+  setReason(value: string): ProjectFreezeWindow;
+
+  getCron(): string;
+  setCron(value: string): ProjectFreezeWindow;
+
+  getDuration(): string;
+  setDuration(value: string): ProjectFreezeWindow;
+
+  getStart(): string;
+  setStart(value: string): ProjectFreezeWindow;
+
+  getEnd(): string;
+  setEnd(value: string): ProjectFreezeWindow;
+
+  getTimeZone(): string;
+  setTimeZone(value: string): ProjectFreezeWindow;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ProjectFreezeWindow.AsObject;
+  static toObject(includeInstance: boolean, msg: ProjectFreezeWindow): ProjectFreezeWindow.AsObject;
+  static serializeBinaryToWriter(message: ProjectFreezeWindow, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ProjectFreezeWindow;
+  static deserializeBinaryFromReader(message: ProjectFreezeWindow, reader: jspb.BinaryReader): ProjectFreezeWindow;
+}
+
+export namespace ProjectFreezeWindow {
+  export type AsObject = {
+    name: string,
+    reason: string,
+    cron: string,
+    duration: string,
+    start: string,
+    end: string,
+    timeZone: string,
+  }
+}
@@ -26,12 +26,15 @@ var global =
 var google_protobuf_descriptor_pb = require('google-protobuf/google/protobuf/descriptor_pb.js');
 goog.object.extend(proto, google_protobuf_descriptor_pb);
 goog.exportSymbol('proto.model.Project', null, global);
+goog.exportSymbol('proto.model.ProjectFreezeWindow', null, global);
 goog.exportSymbol('proto.model.ProjectRBACConfig', null, global);
 goog.exportSymbol('proto.model.ProjectRBACPolicy', null, global);
 goog.exportSymbol('proto.model.ProjectRBACPolicy.Action', null, global);
 goog.exportSymbol('proto.model.ProjectRBACResource', null, global);
 goog.exportSymbol('proto.model.ProjectRBACResource.ResourceType', null, global);
 goog.exportSymbol('proto.model.ProjectRBACRole', null, global);
+goog.exportSymbol('proto.model.ProjectRegistryWebhookConfig', null, global);
+goog.exportSymbol('proto.model.ProjectRegistryWebhookConfig.Rule', null, global);
 goog.exportSymbol('proto.model.ProjectSSOConfig', null, global);
 goog.exportSymbol('proto.model.ProjectSSOConfig.GitHub', null, global);
 goog.exportSymbol('proto.model.ProjectSSOConfig.Google', null, global);
@@ -270,13 +273,76 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.ProjectRBACPolicy.displayName = 'proto.model.ProjectRBACPolicy';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.ProjectRegistryWebhookConfig = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.ProjectRegistryWebhookConfig.repeatedFields_, null);
+};
+goog.inherits(proto.model.ProjectRegistryWebhookConfig, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.ProjectRegistryWebhookConfig.displayName = 'proto.model.ProjectRegistryWebhookConfig';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.ProjectRegistryWebhookConfig.Rule, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.ProjectRegistryWebhookConfig.Rule.displayName = 'proto.model.ProjectRegistryWebhookConfig.Rule';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.ProjectFreezeWindow = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.ProjectFreezeWindow, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.ProjectFreezeWindow.displayName = 'proto.model.ProjectFreezeWindow';
+}
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.Project.repeatedFields_ = [9,10];
+proto.model.Project.repeatedFields_ = [9,10,12];
 
 
 
@@ -321,6 +387,9 @@ proto.model.Project.toObject = function(includeInstance, msg) {
     proto.model.ProjectRBACRole.toObject, includeInstance),
     userGroupsList: jspb.Message.toObjectList(msg.getUserGroupsList(),
     proto.model.ProjectUserGroup.toObject, includeInstance),
+    registryWebhook: (f = msg.getRegistryWebhook()) && proto.model.ProjectRegistryWebhookConfig.toObject(includeInstance, f),
+    freezeWindowsList: jspb.Message.toObjectList(msg.getFreezeWindowsList(),
+    proto.model.ProjectFreezeWindow.toObject, includeInstance),
     createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
     updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
   };
@@ -404,6 +473,16 @@ proto.model.Project.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.model.ProjectUserGroup.deserializeBinaryFromReader);
       msg.addUserGroups(value);
       break;
+    case 11:
+      var value = new proto.model.ProjectRegistryWebhookConfig;
+      reader.readMessage(value,proto.model.ProjectRegistryWebhookConfig.deserializeBinaryFromReader);
+      msg.setRegistryWebhook(value);
+      break;
+    case 12:
+      var value = new proto.model.ProjectFreezeWindow;
+      reader.readMessage(value,proto.model.ProjectFreezeWindow.deserializeBinaryFromReader);
+      msg.addFreezeWindows(value);
+      break;
     case 14:
       var value = /** @type {number} */ (reader.readInt64());
       msg.setCreatedAt(value);
@@ -516,6 +595,22 @@ proto.model.Project.serializeBinaryToWriter = function(message, writer) {
       proto.model.ProjectUserGroup.serializeBinaryToWriter
     );
   }
+  f = message.getRegistryWebhook();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      proto.model.ProjectRegistryWebhookConfig.serializeBinaryToWriter
+    );
+  }
+  f = message.getFreezeWindowsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      12,
+      f,
+      proto.model.ProjectFreezeWindow.serializeBinaryToWriter
+    );
+  }
   f = message.getCreatedAt();
   if (f !== 0) {
     writer.writeInt64(
@@ -810,6 +905,81 @@ proto.model.Project.prototype.clearUserGroupsList = function() {
 };
 
 
+/**
+ * optional ProjectRegistryWebhookConfig registry_webhook = 11;
+ * @return {?proto.model.ProjectRegistryWebhookConfig}
+ */
+proto.model.Project.prototype.getRegistryWebhook = function() {
+  return /** @type{?proto.model.ProjectRegistryWebhookConfig} */ (
+    jspb.Message.getWrapperField(this, proto.model.ProjectRegistryWebhookConfig, 11));
+};
+
+
+/**
+ * @param {?proto.model.ProjectRegistryWebhookConfig|undefined} value
+ * @return {!proto.model.Project} returns this
+*/
+proto.model.Project.prototype.setRegistryWebhook = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.Project} returns this
+ */
+proto.model.Project.prototype.clearRegistryWebhook = function() {
+  return this.setRegistryWebhook(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.Project.prototype.hasRegistryWebhook = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+/**
+ * repeated ProjectFreezeWindow freeze_windows = 12;
+ * @return {!Array<!proto.model.ProjectFreezeWindow>}
+ */
+proto.model.Project.prototype.getFreezeWindowsList = function() {
+  return /** @type{!Array<!proto.model.ProjectFreezeWindow>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.ProjectFreezeWindow, 12));
+};
+
+
+/**
+ * @param {!Array<!proto.model.ProjectFreezeWindow>} value
+ * @return {!proto.model.Project} returns this
+*/
+proto.model.Project.prototype.setFreezeWindowsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 12, value);
+};
+
+
+/**
+ * @param {!proto.model.ProjectFreezeWindow=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ProjectFreezeWindow}
+ */
+proto.model.Project.prototype.addFreezeWindows = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 12, opt_value, proto.model.ProjectFreezeWindow, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.Project} returns this
+ */
+proto.model.Project.prototype.clearFreezeWindowsList = function() {
+  return this.setFreezeWindowsList([]);
+};
+
+
 /**
  * optional int64 created_at = 14;
  * @return {number}
@@ -2929,7 +3099,8 @@ proto.model.ProjectRBACResource.ResourceType = {
   DEPLOYMENT_CHAIN: 5,
   PROJECT: 6,
   API_KEY: 7,
-  INSIGHT: 8
+  INSIGHT: 8,
+  AUDIT_LOG: 9
 };
 
 /**
@@ -3196,4 +3367,728 @@ proto.model.ProjectRBACPolicy.prototype.clearActionsList = function() {
 };
 
 
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.ProjectRegistryWebhookConfig.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.ProjectRegistryWebhookConfig.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ProjectRegistryWebhookConfig.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.ProjectRegistryWebhookConfig} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ProjectRegistryWebhookConfig.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    secret: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    rulesList: jspb.Message.toObjectList(msg.getRulesList(),
+    proto.model.ProjectRegistryWebhookConfig.Rule.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.ProjectRegistryWebhookConfig}
+ */
+proto.model.ProjectRegistryWebhookConfig.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.ProjectRegistryWebhookConfig;
+  return proto.model.ProjectRegistryWebhookConfig.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.ProjectRegistryWebhookConfig} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.ProjectRegistryWebhookConfig}
+ */
+proto.model.ProjectRegistryWebhookConfig.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSecret(value);
+      break;
+    case 2:
+      var value = new proto.model.ProjectRegistryWebhookConfig.Rule;
+      reader.readMessage(value,proto.model.ProjectRegistryWebhookConfig.Rule.deserializeBinaryFromReader);
+      msg.addRules(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.ProjectRegistryWebhookConfig.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.ProjectRegistryWebhookConfig.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.ProjectRegistryWebhookConfig} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ProjectRegistryWebhookConfig.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSecret();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRulesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.model.ProjectRegistryWebhookConfig.Rule.serializeBinaryToWriter
+    );
+  }
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ProjectRegistryWebhookConfig.Rule.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.ProjectRegistryWebhookConfig.Rule} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    repository: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    tag: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    eventName: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    eventLabelsMap: (f = msg.getEventLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.ProjectRegistryWebhookConfig.Rule}
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.ProjectRegistryWebhookConfig.Rule;
+  return proto.model.ProjectRegistryWebhookConfig.Rule.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.ProjectRegistryWebhookConfig.Rule} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.ProjectRegistryWebhookConfig.Rule}
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRepository(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTag(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEventName(value);
+      break;
+    case 4:
+      var value = msg.getEventLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.ProjectRegistryWebhookConfig.Rule.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.ProjectRegistryWebhookConfig.Rule} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRepository();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTag();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getEventName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getEventLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional string repository = 1;
+ * @return {string}
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.prototype.getRepository = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectRegistryWebhookConfig.Rule} returns this
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.prototype.setRepository = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string tag = 2;
+ * @return {string}
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.prototype.getTag = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectRegistryWebhookConfig.Rule} returns this
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.prototype.setTag = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string event_name = 3;
+ * @return {string}
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.prototype.getEventName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectRegistryWebhookConfig.Rule} returns this
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.prototype.setEventName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * map<string, string> event_labels = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.prototype.getEventLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.model.ProjectRegistryWebhookConfig.Rule} returns this
+ */
+proto.model.ProjectRegistryWebhookConfig.Rule.prototype.clearEventLabelsMap = function() {
+  this.getEventLabelsMap().clear();
+  return this;
+};
+
+
+/**
+ * optional string secret = 1;
+ * @return {string}
+ */
+proto.model.ProjectRegistryWebhookConfig.prototype.getSecret = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectRegistryWebhookConfig} returns this
+ */
+proto.model.ProjectRegistryWebhookConfig.prototype.setSecret = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated Rule rules = 2;
+ * @return {!Array<!proto.model.ProjectRegistryWebhookConfig.Rule>}
+ */
+proto.model.ProjectRegistryWebhookConfig.prototype.getRulesList = function() {
+  return /** @type{!Array<!proto.model.ProjectRegistryWebhookConfig.Rule>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.ProjectRegistryWebhookConfig.Rule, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.model.ProjectRegistryWebhookConfig.Rule>} value
+ * @return {!proto.model.ProjectRegistryWebhookConfig} returns this
+*/
+proto.model.ProjectRegistryWebhookConfig.prototype.setRulesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.model.ProjectRegistryWebhookConfig.Rule=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ProjectRegistryWebhookConfig.Rule}
+ */
+proto.model.ProjectRegistryWebhookConfig.prototype.addRules = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.model.ProjectRegistryWebhookConfig.Rule, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.ProjectRegistryWebhookConfig} returns this
+ */
+proto.model.ProjectRegistryWebhookConfig.prototype.clearRulesList = function() {
+  return this.setRulesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.ProjectFreezeWindow.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ProjectFreezeWindow.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.ProjectFreezeWindow} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ProjectFreezeWindow.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    reason: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    cron: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    duration: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    start: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    end: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    timeZone: jspb.Message.getFieldWithDefault(msg, 7, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.ProjectFreezeWindow}
+ */
+proto.model.ProjectFreezeWindow.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.ProjectFreezeWindow;
+  return proto.model.ProjectFreezeWindow.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.ProjectFreezeWindow} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.ProjectFreezeWindow}
+ */
+proto.model.ProjectFreezeWindow.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCron(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDuration(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStart(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnd(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeZone(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.ProjectFreezeWindow.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.ProjectFreezeWindow.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.ProjectFreezeWindow} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ProjectFreezeWindow.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCron();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getDuration();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getStart();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getEnd();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getTimeZone();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.model.ProjectFreezeWindow.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectFreezeWindow} returns this
+ */
+proto.model.ProjectFreezeWindow.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string reason = 2;
+ * @return {string}
+ */
+proto.model.ProjectFreezeWindow.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectFreezeWindow} returns this
+ */
+proto.model.ProjectFreezeWindow.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string cron = 3;
+ * @return {string}
+ */
+proto.model.ProjectFreezeWindow.prototype.getCron = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectFreezeWindow} returns this
+ */
+proto.model.ProjectFreezeWindow.prototype.setCron = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string duration = 4;
+ * @return {string}
+ */
+proto.model.ProjectFreezeWindow.prototype.getDuration = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectFreezeWindow} returns this
+ */
+proto.model.ProjectFreezeWindow.prototype.setDuration = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string start = 5;
+ * @return {string}
+ */
+proto.model.ProjectFreezeWindow.prototype.getStart = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectFreezeWindow} returns this
+ */
+proto.model.ProjectFreezeWindow.prototype.setStart = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string end = 6;
+ * @return {string}
+ */
+proto.model.ProjectFreezeWindow.prototype.getEnd = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectFreezeWindow} returns this
+ */
+proto.model.ProjectFreezeWindow.prototype.setEnd = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string time_zone = 7;
+ * @return {string}
+ */
+proto.model.ProjectFreezeWindow.prototype.getTimeZone = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ProjectFreezeWindow} returns this
+ */
+proto.model.ProjectFreezeWindow.prototype.setTimeZone = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
 goog.object.extend(exports, proto.model);
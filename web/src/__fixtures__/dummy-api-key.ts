@@ -14,6 +14,8 @@ export const dummyAPIKey: APIKey.AsObject = {
   createdAt: createdAt.unix(),
   updatedAt: createdAt.unix(),
   lastUsedAt: createdAt.unix(),
+  policiesList: [],
+  expiresAt: 0,
 };
 
 export function createAPIKeyFromObject(o: APIKey.AsObject): APIKey {
@@ -29,6 +31,7 @@ export function createAPIKeyFromObject(o: APIKey.AsObject): APIKey {
   key.setCreatedAt(o.createdAt);
   key.setUpdatedAt(o.updatedAt);
   key.setLastUsedAt(o.lastUsedAt);
+  key.setExpiresAt(o.expiresAt);
 
   return key;
 }
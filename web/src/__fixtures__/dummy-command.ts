@@ -19,6 +19,7 @@ export const dummyCommand: Command.AsObject = {
   syncApplication: {
     applicationId: "app-1",
     syncStrategy: SyncStrategy.AUTO,
+    ignoreFreezeWindows: false,
   },
   createdAt: createdAt.unix(),
   updatedAt: handledAt.unix(),
@@ -1,4 +1,5 @@
 import { ApplicationKind } from "pipecd/web/model/common_pb";
+import { DeploymentConcurrencyPolicy } from "pipecd/web/model/deployment_pb";
 import { createGitPathFromObject } from "./common";
 import { dummyApplication } from "./dummy-application";
 import { dummyPiped } from "./dummy-piped";
@@ -22,6 +23,7 @@ export const dummyDeployment: Deployment.AsObject = {
   statusReason: "good",
   trigger: dummyTrigger,
   versionsList: [],
+  concurrencyPolicy: DeploymentConcurrencyPolicy.CONCURRENCY_QUEUE,
   cloudProvider: "kube-1",
   platformProvider: "kube-1",
   deployTargetsByPluginMap: [],
@@ -46,6 +46,7 @@ export const dummyProject: Project.AsObject = {
   },
   rbacRolesList: [],
   userGroupsList: [],
+  freezeWindowsList: [],
   staticAdmin: {
     username: "static-admin-user",
     passwordHash: randomKeyHash(),
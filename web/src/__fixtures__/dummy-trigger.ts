@@ -18,6 +18,7 @@ export const dummyTrigger: DeploymentTrigger.AsObject = {
   },
   syncStrategy: SyncStrategy.AUTO,
   strategySummary: "",
+  ignoreFreezeWindows: false,
 };
 
 function createCommitFromObject(o: Commit.AsObject): Commit {
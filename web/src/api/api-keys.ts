@@ -7,6 +7,8 @@ import {
   ListAPIKeysRequest,
   ListAPIKeysResponse,
 } from "pipecd/web/api_client/service_pb";
+import { APIKey } from "pipecd/web/model/apikey_pb";
+import { ProjectRBACPolicy } from "pipecd/web/model/project_pb";
 import * as google_protobuf_wrappers_pb from "google-protobuf/google/protobuf/wrappers_pb";
 
 export const getAPIKeys = ({
@@ -28,12 +30,19 @@ export const getAPIKeys = ({
 export const generateAPIKey = ({
   name,
   role,
-}: GenerateAPIKeyRequest.AsObject): Promise<
-  GenerateAPIKeyResponse.AsObject
-> => {
+  policies = [],
+  expiresAt = 0,
+}: {
+  name: string;
+  role: APIKey.Role;
+  policies?: ProjectRBACPolicy[];
+  expiresAt?: number;
+}): Promise<GenerateAPIKeyResponse.AsObject> => {
   const req = new GenerateAPIKeyRequest();
   req.setName(name);
   req.setRole(role);
+  req.setPoliciesList(policies);
+  req.setExpiresAt(expiresAt);
   return apiRequest(req, apiClient.generateAPIKey);
 };
 
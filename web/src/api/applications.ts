@@ -129,12 +129,14 @@ export const addApplication = async ({
 export const syncApplication = async ({
   applicationId,
   syncStrategy,
+  ignoreFreezeWindows,
 }: SyncApplicationRequest.AsObject): Promise<
   SyncApplicationResponse.AsObject
 > => {
   const req = new SyncApplicationRequest();
   req.setApplicationId(applicationId);
   req.setSyncStrategy(syncStrategy);
+  req.setIgnoreFreezeWindows(ignoreFreezeWindows);
   return apiRequest(req, apiClient.syncApplication);
 };
 
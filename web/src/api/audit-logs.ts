@@ -0,0 +1,24 @@
+import { apiClient, apiRequest } from "./client";
+import {
+  ListAuditLogsRequest,
+  ListAuditLogsResponse,
+} from "pipecd/web/api_client/service_pb";
+
+export const getAuditLogs = ({
+  options,
+  pageSize,
+  cursor,
+  pageMinCreatedAt,
+}: ListAuditLogsRequest.AsObject): Promise<ListAuditLogsResponse.AsObject> => {
+  const req = new ListAuditLogsRequest();
+  if (options) {
+    const opts = new ListAuditLogsRequest.Options();
+    opts.setActor(options.actor);
+    opts.setResourceType(options.resourceType);
+    req.setOptions(opts);
+  }
+  req.setPageSize(pageSize);
+  req.setCursor(cursor);
+  req.setPageMinCreatedAt(pageMinCreatedAt);
+  return apiRequest(req, apiClient.listAuditLogs);
+};
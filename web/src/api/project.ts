@@ -21,11 +21,17 @@ import {
   DeleteProjectRBACRoleResponse,
   UpdateProjectRBACRoleRequest,
   UpdateProjectRBACRoleResponse,
+  UpdateProjectRegistryWebhookConfigRequest,
+  UpdateProjectRegistryWebhookConfigResponse,
+  UpdateProjectFreezeWindowsRequest,
+  UpdateProjectFreezeWindowsResponse,
 } from "pipecd/web/api_client/service_pb";
 import {
   ProjectRBACConfig,
   ProjectSSOConfig,
   ProjectRBACPolicy,
+  ProjectRegistryWebhookConfig,
+  ProjectFreezeWindow,
 } from "pipecd/web/model/project_pb";
 import { apiClient, apiRequest } from "./client";
 
@@ -158,3 +164,28 @@ export const updateRBACRole = ({
   req.setPoliciesList(policies);
   return apiRequest(req, apiClient.updateProjectRBACRole);
 };
+
+export const updateRegistryWebhook = ({
+  secret,
+  rules,
+}: {
+  secret: string;
+  rules: ProjectRegistryWebhookConfig.Rule[];
+}): Promise<UpdateProjectRegistryWebhookConfigResponse.AsObject> => {
+  const req = new UpdateProjectRegistryWebhookConfigRequest();
+  const webhook = new ProjectRegistryWebhookConfig();
+  webhook.setSecret(secret);
+  webhook.setRulesList(rules);
+  req.setRegistryWebhook(webhook);
+  return apiRequest(req, apiClient.updateProjectRegistryWebhookConfig);
+};
+
+export const updateFreezeWindows = ({
+  freezeWindows,
+}: {
+  freezeWindows: ProjectFreezeWindow[];
+}): Promise<UpdateProjectFreezeWindowsResponse.AsObject> => {
+  const req = new UpdateProjectFreezeWindowsRequest();
+  req.setFreezeWindowsList(freezeWindows);
+  return apiRequest(req, apiClient.updateProjectFreezeWindows);
+};
@@ -95,6 +95,7 @@ describe("ApplicationDetail", () => {
         expect(applicationsAPI.syncApplication).toHaveBeenCalledWith({
           applicationId: dummyApplication.id,
           syncStrategy: SyncStrategy.AUTO,
+          ignoreFreezeWindows: false,
         });
       });
     });
@@ -131,6 +132,7 @@ describe("ApplicationDetail", () => {
         expect(applicationsAPI.syncApplication).toHaveBeenCalledWith({
           applicationId: dummyApplication.id,
           syncStrategy: SyncStrategy.PIPELINE,
+          ignoreFreezeWindows: false,
         });
       });
     });
@@ -225,6 +225,7 @@ export const ApplicationDetail: FC<ApplicationDetailProps> = memo(
         syncApplication({
           applicationId: app.id,
           syncStrategy: syncStrategyByIndex[index],
+          ignoreFreezeWindows: false,
         });
       }
     };
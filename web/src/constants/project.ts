@@ -18,6 +18,7 @@ export const RBAC_RESOURCE_TYPE_TEXT: Record<
   [ProjectRBACResource.ResourceType.PROJECT]: "project",
   [ProjectRBACResource.ResourceType.API_KEY]: "apiKey",
   [ProjectRBACResource.ResourceType.INSIGHT]: "insight",
+  [ProjectRBACResource.ResourceType.AUDIT_LOG]: "auditLog",
 };
 
 export const RBAC_ACTION_TYPE_TEXT: Record<ProjectRBACPolicy.Action, string> = {
@@ -51,6 +52,7 @@ export const TEXT_TO_RBAC_RESOURCE_TYPE: Record<
   project: ProjectRBACResource.ResourceType.PROJECT,
   apiKey: ProjectRBACResource.ResourceType.API_KEY,
   insight: ProjectRBACResource.ResourceType.INSIGHT,
+  auditLog: ProjectRBACResource.ResourceType.AUDIT_LOG,
 };
 
 export const TEXT_TO_RBAC_ACTION_TYPE: Record<
@@ -65,7 +67,7 @@ export const TEXT_TO_RBAC_ACTION_TYPE: Record<
   delete: ProjectRBACPolicy.Action.DELETE,
 };
 
-// example: resources=(\*|application|deployment|event|piped|deploymentChain|project|apiKey|insight|,)+;\s*actions=(\*|get|list|create|update|delete|,)+
+// example: resources=(\*|application|deployment|event|piped|deploymentChain|project|apiKey|insight|auditLog|,)+;\s*actions=(\*|get|list|create|update|delete|,)+
 export const POLICIES_STRING_REGEX = new RegExp(
   "resources=(" +
     rbacResourceTypes()